@@ -0,0 +1,8 @@
+data "snowflake_application_package_versions" "versions" {
+  application_package = "package"
+}
+
+data "snowflake_application_package_versions" "like" {
+  application_package = "package"
+  like                = "V1_%"
+}
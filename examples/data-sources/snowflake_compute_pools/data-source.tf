@@ -0,0 +1,17 @@
+# Simple usage
+data "snowflake_compute_pools" "simple" {
+}
+
+# Filtering (like)
+data "snowflake_compute_pools" "like" {
+  like = "compute-pool-name"
+}
+
+# Filtering (starts_with)
+data "snowflake_compute_pools" "starts_with" {
+  starts_with = "COMPUTE_POOL"
+}
+
+output "compute_pools" {
+  value = data.snowflake_compute_pools.like.compute_pools
+}
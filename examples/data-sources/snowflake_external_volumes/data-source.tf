@@ -0,0 +1,12 @@
+# Simple usage
+data "snowflake_external_volumes" "simple" {
+}
+
+# Filtering (like)
+data "snowflake_external_volumes" "like" {
+  like = "external-volume-name"
+}
+
+output "external_volumes" {
+  value = data.snowflake_external_volumes.like.external_volumes
+}
@@ -0,0 +1,7 @@
+data "snowflake_failover_group_refresh_progress" "progress" {
+  name = snowflake_failover_group.target_failover_group.name
+}
+
+output "last_refresh_end_time" {
+  value = data.snowflake_failover_group_refresh_progress.progress.last_refresh_end_time
+}
@@ -0,0 +1,13 @@
+data "snowflake_git_repository" "repository" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "GIT_REPOSITORY"
+}
+
+output "branches" {
+  value = data.snowflake_git_repository.repository.branches
+}
+
+output "tags" {
+  value = data.snowflake_git_repository.repository.tags
+}
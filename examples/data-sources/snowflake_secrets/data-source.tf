@@ -0,0 +1,19 @@
+# Simple usage
+data "snowflake_secrets" "simple" {
+}
+
+# Filtering (like)
+data "snowflake_secrets" "like" {
+  like = "secret-name"
+}
+
+# Filtering (in)
+data "snowflake_secrets" "in" {
+  in {
+    schema = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\""
+  }
+}
+
+output "secrets" {
+  value = data.snowflake_secrets.like.secrets
+}
@@ -0,0 +1,26 @@
+# Simple usage
+data "snowflake_security_integrations" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_security_integrations.simple.security_integrations
+}
+
+# Filtering (like)
+data "snowflake_security_integrations" "like" {
+  like = "security-integration-name"
+}
+
+output "like_output" {
+  value = data.snowflake_security_integrations.like.security_integrations
+}
+
+# Without additional data (to limit the number of calls make for every found security integration)
+data "snowflake_security_integrations" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE SECURITY INTEGRATION for every security integration found and attaches its output to security_integrations.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_security_integrations.only_show.security_integrations
+}
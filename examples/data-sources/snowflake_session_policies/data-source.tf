@@ -0,0 +1,37 @@
+# Simple usage
+data "snowflake_session_policies" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_session_policies.simple.session_policies
+}
+
+# Filtering (like)
+data "snowflake_session_policies" "like" {
+  like = "session-policy-name"
+}
+
+output "like_output" {
+  value = data.snowflake_session_policies.like.session_policies
+}
+
+# Filtering (in)
+data "snowflake_session_policies" "in" {
+  in {
+    schema = "database.schema"
+  }
+}
+
+output "in_output" {
+  value = data.snowflake_session_policies.in.session_policies
+}
+
+# Without additional data (to limit the number of calls make for every found session policy)
+data "snowflake_session_policies" "only_show" {
+  # with_describe is turned on by default and it calls DESCRIBE SESSION POLICY for every session policy found and attaches its output to session_policies.*.describe_output field
+  with_describe = false
+}
+
+output "only_show_output" {
+  value = data.snowflake_session_policies.only_show.session_policies
+}
@@ -0,0 +1,10 @@
+data "snowflake_streamlits" "streamlits" {
+  database = "database"
+  schema   = "schema"
+}
+
+data "snowflake_streamlits" "like" {
+  database = "database"
+  schema   = "schema"
+  like     = "sales%"
+}
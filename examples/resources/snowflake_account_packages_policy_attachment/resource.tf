@@ -0,0 +1,10 @@
+resource "snowflake_packages_policy" "default" {
+  database  = "prod"
+  schema    = "security"
+  name      = "default_policy"
+  allowlist = ["numpy", "pandas"]
+}
+
+resource "snowflake_account_packages_policy_attachment" "attachment" {
+  packages_policy = snowflake_packages_policy.default.qualified_name
+}
@@ -0,0 +1 @@
+terraform import snowflake_aggregation_policy.example 'databaseName|schemaName|aggregationPolicyName'
@@ -0,0 +1 @@
+terraform import snowflake_aggregation_policy_association.example 'TABLE|"databaseName"."schemaName"."tableName"|"databaseName"."schemaName"."aggregationPolicyName"'
@@ -0,0 +1,13 @@
+resource "snowflake_aggregation_policy" "ap" {
+  database = "prod"
+  schema   = "security"
+  name     = "min_group_size"
+  body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+}
+
+resource "snowflake_aggregation_policy_association" "apa" {
+  object_type        = "TABLE"
+  object_name        = "\"prod\".\"sales\".\"orders\""
+  aggregation_policy = snowflake_aggregation_policy.ap.qualified_name
+  entity_keys        = ["customer_id"]
+}
@@ -0,0 +1,2 @@
+# format is application package name | version | patch
+terraform import snowflake_application_package_patch.example 'applicationPackageName|V1_0|1'
@@ -0,0 +1,6 @@
+resource "snowflake_application_package_patch" "v1_0_patch" {
+  application_package = snowflake_application_package.package.name
+  version             = snowflake_application_package_version.v1_0.version
+  using               = "@\"database\".\"schema\".\"stage\"/v1_0_1"
+  label               = "Version 1.0 with bug fixes"
+}
@@ -0,0 +1,3 @@
+# format is application package name | release directive name; use DEFAULT for the default release directive
+terraform import snowflake_application_package_release_directive.example 'applicationPackageName|DEFAULT'
+terraform import snowflake_application_package_release_directive.example 'applicationPackageName|releaseDirectiveName'
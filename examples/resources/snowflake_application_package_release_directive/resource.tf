@@ -0,0 +1,15 @@
+# default release directive, used by all consumers not targeted by a custom release directive
+resource "snowflake_application_package_release_directive" "default" {
+  application_package = snowflake_application_package.package.name
+  version             = snowflake_application_package_version.v1_0.version
+  patch               = 0
+}
+
+# custom release directive, used by the listed consumer accounts
+resource "snowflake_application_package_release_directive" "early_access" {
+  application_package = snowflake_application_package.package.name
+  name                = "EARLY_ACCESS"
+  accounts            = ["org_name.account_name"]
+  version             = snowflake_application_package_version.v1_0.version
+  patch               = snowflake_application_package_patch.v1_0_patch.patch
+}
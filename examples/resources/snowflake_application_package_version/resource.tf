@@ -0,0 +1,6 @@
+resource "snowflake_application_package_version" "v1_0" {
+  application_package = snowflake_application_package.package.name
+  version             = "V1_0"
+  using               = "@\"database\".\"schema\".\"stage\"/v1_0"
+  label               = "Version 1.0"
+}
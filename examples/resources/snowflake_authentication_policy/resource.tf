@@ -0,0 +1,28 @@
+# basic resource
+resource "snowflake_authentication_policy" "basic" {
+  database = "database_name"
+  schema   = "schema_name"
+  name     = "authentication_policy_name"
+}
+
+# key-pair only policy, e.g. for service users
+resource "snowflake_authentication_policy" "service" {
+  database               = "database_name"
+  schema                 = "schema_name"
+  name                   = "service_authentication_policy"
+  authentication_methods = ["KEYPAIR"]
+  client_types           = ["DRIVERS"]
+}
+
+# resource with all fields set
+resource "snowflake_authentication_policy" "complete" {
+  database                   = "database_name"
+  schema                     = "schema_name"
+  name                       = "authentication_policy_name"
+  authentication_methods     = ["SAML", "PASSWORD"]
+  mfa_authentication_methods = ["PASSWORD"]
+  mfa_enrollment             = "REQUIRED"
+  client_types               = ["SNOWFLAKE_UI", "DRIVERS", "SNOWSQL"]
+  security_integrations      = ["saml_integration_name"]
+  comment                    = "Authentication policy requiring SSO or MFA for human users"
+}
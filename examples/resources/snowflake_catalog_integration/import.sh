@@ -0,0 +1 @@
+terraform import snowflake_catalog_integration.example 'catalogIntegrationName'
@@ -0,0 +1,72 @@
+# AWS Glue Data Catalog
+resource "snowflake_catalog_integration" "glue" {
+  name                     = "GLUE_CATALOG_INTEGRATION"
+  enabled                  = true
+  refresh_interval_seconds = 60
+  comment                  = "AWS Glue catalog integration"
+
+  glue_catalog_source {
+    glue_aws_role_arn = "arn:aws:iam::123456789012:role/myrole"
+    glue_catalog_id   = "123456789012"
+    glue_region       = "us-west-2"
+    catalog_namespace = "my_namespace"
+  }
+}
+
+# use the computed values in the trust policy of the IAM role
+output "glue_aws_iam_user_arn" {
+  value = snowflake_catalog_integration.glue.aws_iam_user_arn
+}
+
+output "glue_aws_external_id" {
+  value = snowflake_catalog_integration.glue.aws_external_id
+}
+
+# Iceberg or Delta metadata files in object storage
+resource "snowflake_catalog_integration" "object_store" {
+  name    = "OBJECT_STORE_CATALOG_INTEGRATION"
+  enabled = true
+
+  object_store_catalog_source {
+    table_format = "DELTA"
+  }
+}
+
+# Snowflake Open Catalog (Polaris)
+resource "snowflake_catalog_integration" "polaris" {
+  name    = "POLARIS_CATALOG_INTEGRATION"
+  enabled = true
+
+  polaris_catalog_source {
+    catalog_namespace = "my_namespace"
+    rest_config {
+      catalog_uri  = "https://my_org-my_account.snowflakecomputing.com/polaris/api/catalog"
+      catalog_name = "my_catalog"
+    }
+    rest_authentication {
+      type                 = "OAUTH"
+      oauth_client_id      = var.polaris_client_id
+      oauth_client_secret  = var.polaris_client_secret
+      oauth_allowed_scopes = ["PRINCIPAL_ROLE:ALL"]
+    }
+  }
+}
+
+# Apache Iceberg REST catalog with SigV4 authentication
+resource "snowflake_catalog_integration" "iceberg_rest" {
+  name    = "ICEBERG_REST_CATALOG_INTEGRATION"
+  enabled = true
+
+  iceberg_rest_catalog_source {
+    rest_config {
+      catalog_uri      = "https://glue.us-west-2.amazonaws.com/iceberg"
+      catalog_name     = "123456789012"
+      catalog_api_type = "AWS_GLUE"
+    }
+    rest_authentication {
+      type                 = "SIGV4"
+      sigv4_iam_role       = "arn:aws:iam::123456789012:role/myrole"
+      sigv4_signing_region = "us-west-2"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "snowflake_compute_pool" "pool" {
+  name                = "COMPUTE_POOL"
+  min_nodes           = 1
+  max_nodes           = 3
+  instance_family     = "CPU_X64_S"
+  auto_resume         = true
+  initially_suspended = false
+  auto_suspend_secs   = 600
+  comment             = "compute pool for the container services"
+}
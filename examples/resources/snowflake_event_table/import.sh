@@ -0,0 +1 @@
+terraform import snowflake_event_table.example 'databaseName|schemaName|eventTableName'
@@ -0,0 +1,21 @@
+resource "snowflake_event_table" "event_table" {
+  database                    = "database"
+  schema                      = "schema"
+  name                        = "event_table"
+  cluster_by                  = ["timestamp"]
+  data_retention_time_in_days = 7
+  change_tracking             = true
+  comment                     = "Collects logs, traces and metrics of the UDFs"
+
+  row_access_policy {
+    policy_name = "\"database\".\"schema\".\"row_access_policy\""
+    on          = ["resource_attributes"]
+  }
+
+  tag {
+    database = "database"
+    schema   = "schema"
+    name     = "tag"
+    value    = "value"
+  }
+}
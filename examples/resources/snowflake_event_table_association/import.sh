@@ -0,0 +1,3 @@
+# format is database name | event table qualified name; leave the database name empty for the account
+terraform import snowflake_event_table_association.example 'databaseName|"databaseName"."schemaName"."eventTableName"'
+terraform import snowflake_event_table_association.example '|"databaseName"."schemaName"."eventTableName"'
@@ -0,0 +1,16 @@
+resource "snowflake_event_table" "event_table" {
+  database = "database"
+  schema   = "schema"
+  name     = "event_table"
+}
+
+# associate the event table with the current account
+resource "snowflake_event_table_association" "account" {
+  event_table = snowflake_event_table.event_table.qualified_name
+}
+
+# associate the event table with a single database
+resource "snowflake_event_table_association" "database" {
+  database    = "database"
+  event_table = snowflake_event_table.event_table.qualified_name
+}
@@ -0,0 +1,28 @@
+resource "snowflake_network_rule" "example" {
+  name       = "EXAMPLE_NETWORK_RULE"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["example.com"]
+}
+
+resource "snowflake_secret_with_generic_string" "example" {
+  name          = "EXAMPLE_SECRET"
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  secret_string = var.secret_string
+}
+
+resource "snowflake_external_access_integration" "example" {
+  name                           = "EXAMPLE_EXTERNAL_ACCESS_INTEGRATION"
+  allowed_network_rules          = [snowflake_network_rule.example.qualified_name]
+  allowed_authentication_secrets = [snowflake_secret_with_generic_string.example.qualified_name]
+  enabled                        = true
+  comment                        = "EXAMPLE_COMMENT"
+}
+
+variable "secret_string" {
+  type      = string
+  sensitive = true
+}
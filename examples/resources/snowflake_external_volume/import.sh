@@ -0,0 +1 @@
+terraform import snowflake_external_volume.example 'externalVolumeName'
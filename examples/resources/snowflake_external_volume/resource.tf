@@ -0,0 +1,33 @@
+resource "snowflake_external_volume" "example" {
+  name         = "EXAMPLE_EXTERNAL_VOLUME"
+  allow_writes = true
+  comment      = "EXAMPLE_COMMENT"
+
+  storage_location {
+    storage_location_name   = "my-s3-us-west-2"
+    storage_provider        = "S3"
+    storage_base_url        = "s3://my_example_bucket/"
+    storage_aws_role_arn    = "arn:aws:iam::123456789012:role/myrole"
+    storage_aws_external_id = "EXAMPLE_EXTERNAL_ID"
+    encryption_type         = "AWS_SSE_KMS"
+    encryption_kms_key_id   = "1234abcd-12ab-34cd-56ef-1234567890ab"
+  }
+
+  storage_location {
+    storage_location_name = "my-gcs-europe-west4"
+    storage_provider      = "GCS"
+    storage_base_url      = "gcs://my_example_bucket/"
+    encryption_type       = "NONE"
+  }
+
+  storage_location {
+    storage_location_name = "my-azure-northeurope"
+    storage_provider      = "AZURE"
+    storage_base_url      = "azure://exampleacct.blob.core.windows.net/my_container/"
+    azure_tenant_id       = "a123b4c5-1234-123a-a12b-1a23b45678c9"
+  }
+}
+
+output "storage_aws_iam_user_arn" {
+  value = snowflake_external_volume.example.storage_location[0].storage_aws_iam_user_arn
+}
@@ -31,4 +31,9 @@ resource "snowflake_failover_group" "target_failover_group" {
     source_account_name = "..."
     name                = snowflake_failover_group.fg.name
   }
+
+  # optional DR drill controls; changing a trigger value runs the corresponding action
+  suspended       = false
+  refresh_trigger = "1"
+  promote_trigger = "1"
 }
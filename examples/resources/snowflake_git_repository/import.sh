@@ -0,0 +1 @@
+terraform import snowflake_git_repository.example 'databaseName|schemaName|gitRepositoryName'
@@ -0,0 +1,15 @@
+resource "snowflake_git_repository" "repository" {
+  database        = "DATABASE"
+  schema          = "SCHEMA"
+  name            = "GIT_REPOSITORY"
+  origin          = "https://github.com/my-account/my-repository.git"
+  api_integration = "GIT_API_INTEGRATION"
+  git_credentials = "\"DATABASE\".\"SCHEMA\".\"GIT_SECRET\""
+  comment         = "repository with the procedures code"
+
+  # changing the value fetches the latest content of the remote repository
+  fetch_trigger = "2024-06-01"
+}
+
+# the content of the repository is available on the repository stage
+# e.g. IMPORTS = ('@DATABASE.SCHEMA.GIT_REPOSITORY/branches/main/procedures/handler.py')
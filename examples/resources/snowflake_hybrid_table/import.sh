@@ -0,0 +1 @@
+terraform import snowflake_hybrid_table.example 'databaseName|schemaName|hybridTableName'
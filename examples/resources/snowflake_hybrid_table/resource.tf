@@ -0,0 +1,62 @@
+resource "snowflake_hybrid_table" "customers" {
+  database = "database"
+  schema   = "schema"
+  name     = "CUSTOMERS"
+  comment  = "customers of the operational store"
+
+  column {
+    name     = "ID"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+  column {
+    name = "EMAIL"
+    type = "VARCHAR(200)"
+  }
+  column {
+    name    = "NAME"
+    type    = "VARCHAR(100)"
+    default = "'unknown'"
+  }
+
+  primary_key {
+    columns = ["ID"]
+  }
+  unique_key {
+    name    = "UQ_EMAIL"
+    columns = ["EMAIL"]
+  }
+  index {
+    name            = "IDX_NAME"
+    columns         = ["NAME"]
+    include_columns = ["EMAIL"]
+  }
+}
+
+resource "snowflake_hybrid_table" "orders" {
+  database = "database"
+  schema   = "schema"
+  name     = "ORDERS"
+
+  column {
+    name     = "ID"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+  column {
+    name = "CUSTOMER_ID"
+    type = "NUMBER(38,0)"
+  }
+
+  primary_key {
+    columns = ["ID"]
+  }
+  foreign_key {
+    name    = "FK_CUSTOMER"
+    columns = ["CUSTOMER_ID"]
+    references {
+      table_id = snowflake_hybrid_table.customers.qualified_name
+      columns  = ["ID"]
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_iceberg_table.example 'databaseName|schemaName|icebergTableName'
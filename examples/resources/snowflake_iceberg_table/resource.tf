@@ -0,0 +1,34 @@
+# iceberg table that uses Snowflake as the catalog
+resource "snowflake_iceberg_table" "managed" {
+  database        = "database"
+  schema          = "schema"
+  name            = "iceberg_table"
+  external_volume = "external_volume"
+  base_location   = "iceberg_table/"
+  cluster_by      = ["ID"]
+  comment         = "Snowflake-managed iceberg table"
+
+  column {
+    name     = "ID"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+
+  column {
+    name    = "DATA"
+    type    = "VARCHAR"
+    comment = "payload"
+  }
+}
+
+# externally cataloged iceberg table
+resource "snowflake_iceberg_table" "external" {
+  database           = "database"
+  schema             = "schema"
+  name               = "glue_iceberg_table"
+  external_volume    = "external_volume"
+  catalog            = "glue_catalog_integration"
+  catalog_table_name = "glue_table"
+  catalog_namespace  = "glue_database"
+  auto_refresh       = true
+}
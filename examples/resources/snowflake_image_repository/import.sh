@@ -0,0 +1 @@
+terraform import snowflake_image_repository.example 'databaseName|schemaName|imageRepositoryName'
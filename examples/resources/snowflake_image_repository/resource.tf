@@ -0,0 +1,11 @@
+resource "snowflake_image_repository" "repository" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "IMAGE_REPOSITORY"
+  comment  = "repository for the container service images"
+}
+
+# images can be pushed with: docker push <repository_url>/<image_name>:<tag>
+output "repository_url" {
+  value = snowflake_image_repository.repository.repository_url
+}
@@ -0,0 +1 @@
+terraform import snowflake_oauth_integration_for_custom_clients.example 'name'
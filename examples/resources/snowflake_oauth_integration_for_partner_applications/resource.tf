@@ -0,0 +1,18 @@
+# basic resource
+resource "snowflake_oauth_integration_for_partner_applications" "basic" {
+  name         = "oauth_integration"
+  oauth_client = "TABLEAU_DESKTOP"
+}
+
+# resource with all fields set
+resource "snowflake_oauth_integration_for_partner_applications" "complete" {
+  name                         = "oauth_integration"
+  oauth_client                 = "LOOKER"
+  oauth_redirect_uri           = "https://example.com"
+  enabled                      = true
+  oauth_issue_refresh_tokens   = true
+  oauth_refresh_token_validity = 3600
+  oauth_use_secondary_roles    = "IMPLICIT"
+  blocked_roles_list           = ["ACCOUNTADMIN", "SECURITYADMIN"]
+  comment                      = "example oauth integration for partner applications"
+}
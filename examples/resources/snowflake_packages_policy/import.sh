@@ -0,0 +1 @@
+terraform import snowflake_packages_policy.example 'databaseName|schemaName|packagesPolicyName'
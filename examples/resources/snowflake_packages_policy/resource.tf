@@ -0,0 +1,18 @@
+# basic resource
+resource "snowflake_packages_policy" "basic" {
+  database = "database_name"
+  schema   = "schema_name"
+  name     = "packages_policy_name"
+}
+
+# resource with all fields set
+resource "snowflake_packages_policy" "complete" {
+  database                      = "database_name"
+  schema                        = "schema_name"
+  name                          = "packages_policy_name"
+  language                      = "PYTHON"
+  allowlist                     = ["numpy", "pandas==1.5.3", "scikit-learn"]
+  blocklist                     = ["requests"]
+  additional_creation_blocklist = ["scipy"]
+  comment                       = "Packages allowed in Snowpark functions and procedures"
+}
@@ -0,0 +1 @@
+terraform import snowflake_primary_connection.example 'connectionName'
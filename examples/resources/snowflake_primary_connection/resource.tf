@@ -0,0 +1,5 @@
+resource "snowflake_primary_connection" "connection" {
+  name                        = "connection"
+  enable_failover_to_accounts = ["MY_ORG.MY_SECONDARY_ACCOUNT"]
+  comment                     = "primary connection"
+}
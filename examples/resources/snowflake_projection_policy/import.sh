@@ -0,0 +1 @@
+terraform import snowflake_projection_policy.example 'databaseName|schemaName|projectionPolicyName'
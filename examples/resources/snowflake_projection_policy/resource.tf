@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_projection_policy" "basic" {
+  database = "database_name"
+  schema   = "schema_name"
+  name     = "projection_policy_name"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+# resource with all fields set
+resource "snowflake_projection_policy" "complete" {
+  database = "database_name"
+  schema   = "schema_name"
+  name     = "projection_policy_name"
+  body     = "CASE WHEN CURRENT_ROLE() = 'ADMIN' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END"
+  comment  = "Projection policy hiding the column from non-admin roles"
+}
@@ -0,0 +1 @@
+terraform import snowflake_projection_policy_association.example 'TABLE|"databaseName"."schemaName"."tableName"|columnName|"databaseName"."schemaName"."projectionPolicyName"'
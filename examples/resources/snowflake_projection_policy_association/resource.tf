@@ -0,0 +1,13 @@
+resource "snowflake_projection_policy" "pp" {
+  database = "prod"
+  schema   = "security"
+  name     = "hide_column"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+resource "snowflake_projection_policy_association" "ppa" {
+  object_type       = "TABLE"
+  object_name       = "\"prod\".\"sales\".\"customers\""
+  column            = "email"
+  projection_policy = snowflake_projection_policy.pp.qualified_name
+}
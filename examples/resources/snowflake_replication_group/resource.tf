@@ -0,0 +1,15 @@
+# primary replication group in the source account
+resource "snowflake_replication_group" "primary" {
+  name                 = "replication_group"
+  object_types         = ["DATABASES", "ROLES"]
+  allowed_databases    = ["database"]
+  allowed_accounts     = ["MY_ORG.MY_TARGET_ACCOUNT"]
+  replication_schedule = "10 MINUTE"
+}
+
+# secondary replication group in the target account
+resource "snowflake_replication_group" "secondary" {
+  name         = "replication_group"
+  from_replica = "MY_ORG.MY_SOURCE_ACCOUNT.replication_group"
+  suspended    = false
+}
@@ -0,0 +1 @@
+terraform import snowflake_saml2_integration.example 'name'
@@ -0,0 +1,30 @@
+# basic resource
+resource "snowflake_saml2_integration" "basic" {
+  name            = "saml_integration"
+  enabled         = true
+  saml2_issuer    = "test_issuer"
+  saml2_sso_url   = "https://example.com"
+  saml2_provider  = "CUSTOM"
+  saml2_x509_cert = file("cert.pem")
+}
+
+# resource with all fields set
+resource "snowflake_saml2_integration" "complete" {
+  name                                = "saml_integration"
+  enabled                             = true
+  saml2_issuer                        = "test_issuer"
+  saml2_sso_url                       = "https://example.com"
+  saml2_provider                      = "CUSTOM"
+  saml2_x509_cert                     = file("cert.pem")
+  allowed_user_domains                = ["example.com"]
+  allowed_email_patterns              = ["^(.+dev)@example.com$"]
+  saml2_sp_initiated_login_page_label = "label"
+  saml2_enable_sp_initiated           = true
+  saml2_sign_request                  = true
+  saml2_requested_nameid_format       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  saml2_post_logout_redirect_url      = "https://example.com"
+  saml2_force_authn                   = true
+  saml2_snowflake_issuer_url          = "https://example.com"
+  saml2_snowflake_acs_url             = "https://example.com"
+  comment                             = "foo"
+}
@@ -0,0 +1 @@
+terraform import snowflake_scim_security_integration.example 'name'
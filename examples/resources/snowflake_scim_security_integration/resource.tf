@@ -0,0 +1,18 @@
+# basic resource
+resource "snowflake_scim_security_integration" "basic" {
+  name        = "scim_integration"
+  enabled     = true
+  scim_client = "GENERIC"
+  run_as_role = "GENERIC_SCIM_PROVISIONER"
+}
+
+# resource with all fields set
+resource "snowflake_scim_security_integration" "complete" {
+  name           = "scim_integration"
+  enabled        = true
+  scim_client    = "GENERIC"
+  run_as_role    = "GENERIC_SCIM_PROVISIONER"
+  network_policy = "network_policy_name"
+  sync_password  = false
+  comment        = "foo"
+}
@@ -0,0 +1 @@
+terraform import snowflake_secondary_connection.example 'connectionName'
@@ -0,0 +1,9 @@
+# the connection has to be created in another account by the snowflake_primary_connection resource with enable_failover_to_accounts containing this account
+resource "snowflake_secondary_connection" "connection" {
+  name          = "connection"
+  as_replica_of = "MY_ORG.MY_PRIMARY_ACCOUNT.connection"
+  comment       = "secondary connection"
+
+  # change the value to promote the connection to primary (e.g. during failover)
+  promote_trigger = "1"
+}
@@ -0,0 +1 @@
+terraform import snowflake_secret_with_authorization_code_grant.example 'databaseName|schemaName|secretName'
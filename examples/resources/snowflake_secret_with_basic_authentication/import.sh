@@ -0,0 +1 @@
+terraform import snowflake_secret_with_basic_authentication.example 'databaseName|schemaName|secretName'
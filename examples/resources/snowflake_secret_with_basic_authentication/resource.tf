@@ -0,0 +1,13 @@
+resource "snowflake_secret_with_basic_authentication" "example" {
+  name     = "EXAMPLE_SECRET"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  username = "EXAMPLE_USERNAME"
+  password = var.password
+  comment  = "EXAMPLE_COMMENT"
+}
+
+variable "password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import snowflake_secret_with_client_credentials.example 'databaseName|schemaName|secretName'
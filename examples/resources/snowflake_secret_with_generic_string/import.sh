@@ -0,0 +1 @@
+terraform import snowflake_secret_with_generic_string.example 'databaseName|schemaName|secretName'
@@ -0,0 +1,12 @@
+resource "snowflake_secret_with_generic_string" "example" {
+  name          = "EXAMPLE_SECRET"
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  secret_string = var.secret_string
+  comment       = "EXAMPLE_COMMENT"
+}
+
+variable "secret_string" {
+  type      = string
+  sensitive = true
+}
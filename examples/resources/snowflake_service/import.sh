@@ -0,0 +1 @@
+terraform import snowflake_service.example 'databaseName|schemaName|serviceName'
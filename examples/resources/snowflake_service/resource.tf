@@ -0,0 +1,33 @@
+# service with an inline specification
+resource "snowflake_service" "inline" {
+  database      = "DATABASE"
+  schema        = "SCHEMA"
+  name          = "SERVICE"
+  compute_pool  = "COMPUTE_POOL"
+  specification = <<-EOT
+spec:
+  containers:
+  - name: main
+    image: /database/schema/image_repository/image:latest
+  endpoints:
+  - name: api
+    port: 8080
+    public: true
+EOT
+  min_instances                = 1
+  max_instances                = 2
+  external_access_integrations = ["EXTERNAL_ACCESS_INTEGRATION"]
+  query_warehouse              = "WAREHOUSE"
+  auto_resume                  = true
+  comment                      = "service running the api"
+}
+
+# service with the specification file stored on a stage
+resource "snowflake_service" "from_stage" {
+  database           = "DATABASE"
+  schema             = "SCHEMA"
+  name               = "SERVICE_FROM_STAGE"
+  compute_pool       = "COMPUTE_POOL"
+  stage              = "@DATABASE.SCHEMA.SPECS"
+  specification_file = "service.yaml"
+}
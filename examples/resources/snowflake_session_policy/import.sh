@@ -0,0 +1 @@
+terraform import snowflake_session_policy.example 'databaseName|schemaName|sessionPolicyName'
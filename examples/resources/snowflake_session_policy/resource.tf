@@ -0,0 +1,17 @@
+# basic resource
+resource "snowflake_session_policy" "basic" {
+  database = "database_name"
+  schema   = "schema_name"
+  name     = "session_policy_name"
+}
+
+# resource with all fields set
+resource "snowflake_session_policy" "complete" {
+  database                     = "database_name"
+  schema                       = "schema_name"
+  name                         = "session_policy_name"
+  session_idle_timeout_mins    = 30
+  session_ui_idle_timeout_mins = 30
+  allowed_secondary_roles      = ["ANALYST", "REPORTER"]
+  comment                      = "Session policy with idle timeouts enforced by the security team"
+}
@@ -0,0 +1 @@
+terraform import snowflake_streamlit.example 'databaseName|schemaName|streamlitName'
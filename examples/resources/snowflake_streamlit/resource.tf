@@ -0,0 +1,11 @@
+resource "snowflake_streamlit" "streamlit" {
+  database                     = "database"
+  schema                       = "schema"
+  name                         = "streamlit"
+  root_location                = "@\"database\".\"schema\".\"stage\"/app"
+  main_file                    = "streamlit_app.py"
+  query_warehouse              = "warehouse"
+  external_access_integrations = ["integration"]
+  title                        = "Sales dashboard"
+  comment                      = "Streamlit app deployed from the analytics repository"
+}
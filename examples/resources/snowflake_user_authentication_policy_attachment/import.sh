@@ -0,0 +1 @@
+terraform import snowflake_user_authentication_policy_attachment.example '"userName"|"databaseName"."schemaName"."authenticationPolicyName"'
@@ -0,0 +1 @@
+terraform import snowflake_user_session_policy_attachment.example '"userName"|"databaseName"."schemaName"."sessionPolicyName"'
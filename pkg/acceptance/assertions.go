@@ -0,0 +1,14 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// AssertThat allows to use generated assertions (objectassert, resourceassert) in acceptance tests steps, failures of all of them are reported together.
+func AssertThat(t *testing.T, fs ...assertions.TestCheckFuncProvider) resource.TestCheckFunc {
+	t.Helper()
+	return assertions.AssertThat(t, TestClient(), fs...)
+}
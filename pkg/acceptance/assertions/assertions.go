@@ -0,0 +1,29 @@
+package assertions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestCheckFuncProvider is implemented by all the assertions (both on Snowflake objects and on resources in Terraform state)
+type TestCheckFuncProvider interface {
+	ToTerraformTestCheckFunc(t *testing.T, testClient *helpers.TestClient) resource.TestCheckFunc
+}
+
+// AssertThat combines the given assertions into a single check; all of them are run and their failures are reported together.
+func AssertThat(t *testing.T, testClient *helpers.TestClient, fs ...TestCheckFuncProvider) resource.TestCheckFunc {
+	t.Helper()
+	return func(s *terraform.State) error {
+		var result []error
+		for _, f := range fs {
+			if err := f.ToTerraformTestCheckFunc(t, testClient)(s); err != nil {
+				result = append(result, err)
+			}
+		}
+		return errors.Join(result...)
+	}
+}
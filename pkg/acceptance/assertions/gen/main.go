@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var allSdkObjects = []SdkObjectDef{
+	{IdType: "sdk.AccountObjectIdentifier", ObjectType: sdk.ObjectTypeDatabase, ObjectStruct: sdk.Database{}},
+	{IdType: "sdk.DatabaseObjectIdentifier", ObjectType: sdk.ObjectTypeSchema, ObjectStruct: sdk.Schema{}},
+	{IdType: "sdk.AccountObjectIdentifier", ObjectType: sdk.ObjectTypeWarehouse, ObjectStruct: sdk.Warehouse{}},
+	{IdType: "sdk.AccountObjectIdentifier", ObjectType: sdk.ObjectTypeComputePool, ObjectStruct: sdk.ComputePool{}},
+	{IdType: "sdk.SchemaObjectIdentifier", ObjectType: sdk.ObjectTypeHybridTable, ObjectStruct: sdk.HybridTable{}},
+	{IdType: "sdk.SchemaObjectIdentifier", ObjectType: sdk.ObjectTypeIcebergTable, ObjectStruct: sdk.IcebergTable{}},
+	{IdType: "sdk.SchemaObjectIdentifier", ObjectType: sdk.ObjectTypeService, ObjectStruct: sdk.Service{}},
+}
+
+var allResources = []ResourceDef{
+	{Name: "Database", Schema: resources.Database().Schema},
+	{Name: "Schema", Schema: resources.Schema().Schema},
+	{Name: "Warehouse", Schema: resources.Warehouse().Schema},
+	{Name: "ComputePool", Schema: resources.ComputePool().Schema},
+	{Name: "HybridTable", Schema: resources.HybridTable().Schema},
+	{Name: "IcebergTable", Schema: resources.IcebergTable().Schema},
+	{Name: "EventTable", Schema: resources.EventTable().Schema},
+	{Name: "Service", Schema: resources.Service().Schema},
+	{Name: "SecretWithAuthorizationCodeGrant", Schema: resources.SecretWithAuthorizationCodeGrant().Schema},
+	{Name: "SecretWithBasicAuthentication", Schema: resources.SecretWithBasicAuthentication().Schema},
+	{Name: "SecretWithClientCredentials", Schema: resources.SecretWithClientCredentials().Schema},
+	{Name: "SecretWithGenericString", Schema: resources.SecretWithGenericString().Schema},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Panic("Requires path as a first arg")
+	}
+	assertionsPath := filepath.Join(os.Args[1], "pkg", "acceptance", "assertions")
+
+	for _, def := range allSdkObjects {
+		model := ModelFromSdkObjectDef(def)
+		fileName := fmt.Sprintf("%s_snowflake_gen.go", camelToSnakeCase(model.Name))
+		generateAndSave(SdkObjectAssertionsTemplate, model, filepath.Join(assertionsPath, "objectassert", fileName))
+	}
+
+	for _, def := range allResources {
+		model := ModelFromResourceDef(def)
+		fileName := fmt.Sprintf("%s_resource_gen.go", camelToSnakeCase(model.Name))
+		generateAndSave(ResourceAssertionsTemplate, model, filepath.Join(assertionsPath, "resourceassert", fileName))
+	}
+}
+
+func generateAndSave(template *template.Template, model any, path string) {
+	buffer := bytes.Buffer{}
+	printTo(&buffer, template, model)
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Panicf("Cannot format code generated for %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, src, 0o600); err != nil {
+		log.Panicln(err)
+	}
+	fmt.Printf("Generated %s\n", path)
+}
+
+func printTo(writer io.Writer, template *template.Template, model any) {
+	if err := template.Execute(writer, model); err != nil {
+		log.Panicln(err)
+	}
+}
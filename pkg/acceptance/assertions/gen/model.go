@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SdkObjectDef defines SDK object for which the assertions are generated,
+// test client has to contain client named the same as the struct with Show(t, id) method (e.g. helpers.WarehouseClient.Show)
+type SdkObjectDef struct {
+	IdType       string
+	ObjectType   sdk.ObjectType
+	ObjectStruct any
+}
+
+// ResourceDef defines resource for which the assertions are generated
+type ResourceDef struct {
+	Name   string
+	Schema map[string]*schema.Schema
+}
+
+type SdkObjectAssertionsModel struct {
+	Name           string
+	IdType         string
+	ObjectTypeName string
+	Fields         []SdkObjectFieldModel
+	Imports        []string
+}
+
+type SdkObjectFieldModel struct {
+	Name       string
+	Type       string
+	IsPointer  bool
+	Comparable bool
+	IsTime     bool
+}
+
+type ResourceAssertionsModel struct {
+	Name       string
+	Attributes []ResourceAttributeModel
+	Imports    []string
+}
+
+type ResourceAttributeModel struct {
+	Name       string
+	MethodName string
+	// ArgumentType is empty for attributes without typed value assertion (lists, sets, maps)
+	ArgumentType string
+	// ValueConversion converts expected value to the string stored in Terraform state
+	ValueConversion string
+	// CountSuffix is used for collections (# for lists and sets, % for maps)
+	CountSuffix string
+}
+
+func ModelFromSdkObjectDef(def SdkObjectDef) SdkObjectAssertionsModel {
+	structType := reflect.TypeOf(def.ObjectStruct)
+	fields := make([]SdkObjectFieldModel, 0)
+	imports := []string{"fmt", "testing"}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldType := field.Type
+		isPointer := fieldType.Kind() == reflect.Pointer
+		if isPointer {
+			fieldType = fieldType.Elem()
+		}
+		isTime := fieldType == reflect.TypeOf(time.Time{})
+		model := SdkObjectFieldModel{
+			Name:       field.Name,
+			Type:       fieldType.String(),
+			IsPointer:  isPointer,
+			Comparable: fieldType.Comparable(),
+			IsTime:     isTime,
+		}
+		if isTime {
+			imports = appendIfMissing(imports, "time")
+		} else if !model.Comparable {
+			imports = appendIfMissing(imports, "reflect")
+		}
+		fields = append(fields, model)
+	}
+	slices.Sort(imports)
+	return SdkObjectAssertionsModel{
+		Name:           structType.Name(),
+		IdType:         def.IdType,
+		ObjectTypeName: fmt.Sprintf("ObjectType%s", structType.Name()),
+		Fields:         fields,
+		Imports:        imports,
+	}
+}
+
+func ModelFromResourceDef(def ResourceDef) ResourceAssertionsModel {
+	names := make([]string, 0, len(def.Schema))
+	for name := range def.Schema {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	attributes := make([]ResourceAttributeModel, 0, len(names))
+	imports := []string{"testing"}
+	for _, name := range names {
+		attribute := ResourceAttributeModel{
+			Name:       name,
+			MethodName: snakeCaseToCamel(name),
+		}
+		switch def.Schema[name].Type {
+		case schema.TypeString:
+			attribute.ArgumentType = "string"
+			attribute.ValueConversion = "expected"
+		case schema.TypeBool:
+			attribute.ArgumentType = "bool"
+			attribute.ValueConversion = "strconv.FormatBool(expected)"
+		case schema.TypeInt:
+			attribute.ArgumentType = "int"
+			attribute.ValueConversion = "strconv.Itoa(expected)"
+		case schema.TypeFloat:
+			attribute.ArgumentType = "float64"
+			attribute.ValueConversion = "strconv.FormatFloat(expected, 'f', -1, 64)"
+		case schema.TypeList, schema.TypeSet:
+			attribute.CountSuffix = "#"
+		case schema.TypeMap:
+			attribute.CountSuffix = "%"
+		}
+		if attribute.ArgumentType != "string" {
+			imports = appendIfMissing(imports, "strconv")
+		}
+		attributes = append(attributes, attribute)
+	}
+	slices.Sort(imports)
+	return ResourceAssertionsModel{
+		Name:       def.Name,
+		Attributes: attributes,
+		Imports:    imports,
+	}
+}
+
+func appendIfMissing(imports []string, imp string) []string {
+	if slices.Contains(imports, imp) {
+		return imports
+	}
+	return append(imports, imp)
+}
+
+func snakeCaseToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func camelToSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteRune('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(b.String())
+}
@@ -0,0 +1,105 @@
+package main
+
+import "text/template"
+
+const generatedCodeHeader = "// Code generated by assertions generator; DO NOT EDIT.\n"
+
+var SdkObjectAssertionsTemplate, _ = template.New("sdkObjectAssertionsTemplate").Parse(generatedCodeHeader + `
+package objectassert
+
+import (
+	{{- range .Imports }}
+	"{{ . }}"
+	{{- end }}
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type {{ .Name }}Assert struct {
+	*assertions.ObjectAssert[sdk.{{ .Name }}, {{ .IdType }}]
+}
+
+// {{ .Name }} returns assertions run on the object fetched from Snowflake when the check is executed
+func {{ .Name }}(t *testing.T, id {{ .IdType }}) *{{ .Name }}Assert {
+	t.Helper()
+	return &{{ .Name }}Assert{
+		assertions.NewObjectAssert(sdk.{{ .ObjectTypeName }}, id, func(testClient *helpers.TestClient) assertions.ObjectProvider[sdk.{{ .Name }}, {{ .IdType }}] {
+			return testClient.{{ .Name }}.Show
+		}),
+	}
+}
+
+// {{ .Name }}FromObject returns assertions run on the already fetched object
+func {{ .Name }}FromObject(t *testing.T, object *sdk.{{ .Name }}) *{{ .Name }}Assert {
+	t.Helper()
+	return &{{ .Name }}Assert{
+		assertions.NewObjectAssertFromObject(sdk.{{ .ObjectTypeName }}, object.ID(), object),
+	}
+}
+{{ range .Fields }}
+func (a *{{ $.Name }}Assert) Has{{ .Name }}(expected {{ .Type }}) *{{ $.Name }}Assert {
+	a.AddAssertion(func(o *sdk.{{ $.Name }}) error {
+		{{- if .IsPointer }}
+		if o.{{ .Name }} == nil {
+			return fmt.Errorf("expected {{ .Name }} to have value; got: nil")
+		}
+		{{- end }}
+		{{- if .IsTime }}
+		if !{{ if .IsPointer }}(*o.{{ .Name }}){{ else }}o.{{ .Name }}{{ end }}.Equal(expected) {
+		{{- else if .Comparable }}
+		if {{ if .IsPointer }}*{{ end }}o.{{ .Name }} != expected {
+		{{- else }}
+		if !reflect.DeepEqual({{ if .IsPointer }}*{{ end }}o.{{ .Name }}, expected) {
+		{{- end }}
+			return fmt.Errorf("expected {{ .Name }}: %v; got: %v", expected, {{ if .IsPointer }}*{{ end }}o.{{ .Name }})
+		}
+		return nil
+	})
+	return a
+}
+{{ end -}}
+`)
+
+var ResourceAssertionsTemplate, _ = template.New("resourceAssertionsTemplate").Parse(generatedCodeHeader + `
+package resourceassert
+
+import (
+	{{- range .Imports }}
+	"{{ . }}"
+	{{- end }}
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions"
+)
+
+type {{ .Name }}ResourceAssert struct {
+	*assertions.ResourceAssert
+}
+
+// {{ .Name }}Resource returns assertions on the resource with the given name in Terraform state (e.g. snowflake_warehouse.w)
+func {{ .Name }}Resource(t *testing.T, name string) *{{ .Name }}ResourceAssert {
+	t.Helper()
+	return &{{ .Name }}ResourceAssert{
+		assertions.NewResourceAssert(name),
+	}
+}
+{{ range .Attributes }}
+{{- if .ArgumentType }}
+func (r *{{ $.Name }}ResourceAssert) Has{{ .MethodName }}(expected {{ .ArgumentType }}) *{{ $.Name }}ResourceAssert {
+	r.AddAssertion(assertions.ValueSet("{{ .Name }}", {{ .ValueConversion }}))
+	return r
+}
+{{- else }}
+func (r *{{ $.Name }}ResourceAssert) Has{{ .MethodName }}Count(expected int) *{{ $.Name }}ResourceAssert {
+	r.AddAssertion(assertions.ValueSet("{{ .Name }}.{{ .CountSuffix }}", strconv.Itoa(expected)))
+	return r
+}
+{{- end }}
+
+func (r *{{ $.Name }}ResourceAssert) HasNo{{ .MethodName }}() *{{ $.Name }}ResourceAssert {
+	r.AddAssertion(assertions.ValueNotSet("{{ .Name }}"))
+	return r
+}
+{{ end -}}
+`)
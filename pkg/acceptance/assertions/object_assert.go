@@ -0,0 +1,86 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// ObjectProvider returns the current state of the object from Snowflake (e.g. helpers.WarehouseClient.Show)
+type ObjectProvider[T any, I sdk.ObjectIdentifier] func(*testing.T, I) (*T, error)
+
+// ObjectAssert is the base for the generated assertions on Snowflake objects (see objectassert package).
+// Object is either fetched with the test client when the assertions are run or provided upfront (see NewObjectAssertFromObject).
+type ObjectAssert[T any, I sdk.ObjectIdentifier] struct {
+	assertions []func(*T) error
+	id         I
+	objectType sdk.ObjectType
+	object     *T
+	provider   func(*helpers.TestClient) ObjectProvider[T, I]
+}
+
+func NewObjectAssert[T any, I sdk.ObjectIdentifier](objectType sdk.ObjectType, id I, provider func(*helpers.TestClient) ObjectProvider[T, I]) *ObjectAssert[T, I] {
+	return &ObjectAssert[T, I]{
+		assertions: make([]func(*T) error, 0),
+		id:         id,
+		objectType: objectType,
+		provider:   provider,
+	}
+}
+
+func NewObjectAssertFromObject[T any, I sdk.ObjectIdentifier](objectType sdk.ObjectType, id I, object *T) *ObjectAssert[T, I] {
+	return &ObjectAssert[T, I]{
+		assertions: make([]func(*T) error, 0),
+		id:         id,
+		objectType: objectType,
+		object:     object,
+	}
+}
+
+func (o *ObjectAssert[T, I]) AddAssertion(assertion func(*T) error) {
+	o.assertions = append(o.assertions, assertion)
+}
+
+// ToTerraformTestCheckFunc allows to use the assertions in acceptance tests steps
+func (o *ObjectAssert[T, I]) ToTerraformTestCheckFunc(t *testing.T, testClient *helpers.TestClient) resource.TestCheckFunc {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		return o.runAssertions(t, testClient)
+	}
+}
+
+// VerifyAll runs all the assertions and fails the test with the aggregated failures (useful in integration tests)
+func (o *ObjectAssert[T, I]) VerifyAll(t *testing.T, testClient *helpers.TestClient) {
+	t.Helper()
+	if err := o.runAssertions(t, testClient); err != nil {
+		t.Error(err)
+	}
+}
+
+func (o *ObjectAssert[T, I]) runAssertions(t *testing.T, testClient *helpers.TestClient) error {
+	t.Helper()
+	object := o.object
+	if object == nil {
+		if o.provider == nil || testClient == nil {
+			return fmt.Errorf("object %s[%s] cannot be fetched, test client is required", o.objectType, o.id.FullyQualifiedName())
+		}
+		var err error
+		object, err = o.provider(testClient)(t, o.id)
+		if err != nil {
+			return fmt.Errorf("object %s[%s] cannot be fetched: %w", o.objectType, o.id.FullyQualifiedName(), err)
+		}
+	}
+
+	var result []error
+	for i, assertion := range o.assertions {
+		if err := assertion(object); err != nil {
+			result = append(result, fmt.Errorf("object %s[%s] assertion [%d/%d]: failed with error: %w", o.objectType, o.id.FullyQualifiedName(), i+1, len(o.assertions), err))
+		}
+	}
+	return errors.Join(result...)
+}
@@ -0,0 +1,74 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectAssert(t *testing.T) {
+	id := sdk.NewAccountObjectIdentifier("WH")
+	warehouse := &sdk.Warehouse{Name: "WH", Comment: "comment", AutoSuspend: 60}
+
+	hasComment := func(expected string) func(*sdk.Warehouse) error {
+		return func(o *sdk.Warehouse) error {
+			if o.Comment != expected {
+				return fmt.Errorf("expected Comment: %v; got: %v", expected, o.Comment)
+			}
+			return nil
+		}
+	}
+	hasAutoSuspend := func(expected int) func(*sdk.Warehouse) error {
+		return func(o *sdk.Warehouse) error {
+			if o.AutoSuspend != expected {
+				return fmt.Errorf("expected AutoSuspend: %v; got: %v", expected, o.AutoSuspend)
+			}
+			return nil
+		}
+	}
+
+	t.Run("all assertions pass", func(t *testing.T) {
+		objectAssert := NewObjectAssertFromObject(sdk.ObjectTypeWarehouse, id, warehouse)
+		objectAssert.AddAssertion(hasComment("comment"))
+		objectAssert.AddAssertion(hasAutoSuspend(60))
+
+		require.NoError(t, objectAssert.ToTerraformTestCheckFunc(t, nil)(nil))
+	})
+
+	t.Run("failures are aggregated", func(t *testing.T) {
+		objectAssert := NewObjectAssertFromObject(sdk.ObjectTypeWarehouse, id, warehouse)
+		objectAssert.AddAssertion(hasComment("other"))
+		objectAssert.AddAssertion(hasAutoSuspend(60))
+		objectAssert.AddAssertion(hasAutoSuspend(120))
+
+		err := objectAssert.ToTerraformTestCheckFunc(t, nil)(nil)
+		require.Error(t, err)
+		assert.Equal(t, `object WAREHOUSE["WH"] assertion [1/3]: failed with error: expected Comment: other; got: comment
+object WAREHOUSE["WH"] assertion [3/3]: failed with error: expected AutoSuspend: 120; got: 60`, err.Error())
+	})
+
+	t.Run("object cannot be fetched without test client", func(t *testing.T) {
+		objectAssert := NewObjectAssert[sdk.Warehouse](sdk.ObjectTypeWarehouse, id, nil)
+		objectAssert.AddAssertion(hasComment("comment"))
+
+		err := objectAssert.ToTerraformTestCheckFunc(t, nil)(nil)
+		require.ErrorContains(t, err, `object WAREHOUSE["WH"] cannot be fetched, test client is required`)
+	})
+
+	t.Run("object is fetched with the test client", func(t *testing.T) {
+		objectAssert := NewObjectAssert(sdk.ObjectTypeWarehouse, id, func(*helpers.TestClient) ObjectProvider[sdk.Warehouse, sdk.AccountObjectIdentifier] {
+			return func(*testing.T, sdk.AccountObjectIdentifier) (*sdk.Warehouse, error) {
+				return nil, errors.New("not found")
+			}
+		})
+		objectAssert.AddAssertion(hasComment("comment"))
+
+		err := objectAssert.ToTerraformTestCheckFunc(t, &helpers.TestClient{})(nil)
+		require.ErrorContains(t, err, `object WAREHOUSE["WH"] cannot be fetched: not found`)
+	})
+}
@@ -0,0 +1,63 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// ResourceAssert is the base for the generated assertions on resources in Terraform state (see resourceassert package)
+type ResourceAssert struct {
+	name       string
+	assertions []ResourceAssertion
+}
+
+// ResourceAssertion checks a single attribute of the resource
+type ResourceAssertion struct {
+	attributeName string
+	expectedValue string
+	isSet         bool
+}
+
+func NewResourceAssert(name string) *ResourceAssert {
+	return &ResourceAssert{
+		name:       name,
+		assertions: make([]ResourceAssertion, 0),
+	}
+}
+
+func ValueSet(attributeName string, expectedValue string) ResourceAssertion {
+	return ResourceAssertion{attributeName: attributeName, expectedValue: expectedValue, isSet: true}
+}
+
+func ValueNotSet(attributeName string) ResourceAssertion {
+	return ResourceAssertion{attributeName: attributeName}
+}
+
+func (r *ResourceAssert) AddAssertion(assertion ResourceAssertion) {
+	r.assertions = append(r.assertions, assertion)
+}
+
+// ToTerraformTestCheckFunc allows to use the assertions in acceptance tests steps; test client is not used
+func (r *ResourceAssert) ToTerraformTestCheckFunc(t *testing.T, _ *helpers.TestClient) resource.TestCheckFunc {
+	t.Helper()
+	return func(s *terraform.State) error {
+		var result []error
+		for i, a := range r.assertions {
+			var err error
+			if a.isSet {
+				err = resource.TestCheckResourceAttr(r.name, a.attributeName, a.expectedValue)(s)
+			} else {
+				err = resource.TestCheckNoResourceAttr(r.name, a.attributeName)(s)
+			}
+			if err != nil {
+				result = append(result, fmt.Errorf("%s resource assertion [%d/%d]: failed with error: %w", r.name, i+1, len(r.assertions), err))
+			}
+		}
+		return errors.Join(result...)
+	}
+}
@@ -0,0 +1,57 @@
+package assertions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAssert(t *testing.T) {
+	state := terraform.NewState()
+	state.RootModule().Resources["snowflake_warehouse.w"] = &terraform.ResourceState{
+		Type: "snowflake_warehouse",
+		Primary: &terraform.InstanceState{
+			ID: "WH",
+			Attributes: map[string]string{
+				"name":         "WH",
+				"auto_suspend": "60",
+			},
+		},
+	}
+
+	t.Run("all assertions pass", func(t *testing.T) {
+		resourceAssert := NewResourceAssert("snowflake_warehouse.w")
+		resourceAssert.AddAssertion(ValueSet("name", "WH"))
+		resourceAssert.AddAssertion(ValueSet("auto_suspend", "60"))
+		resourceAssert.AddAssertion(ValueNotSet("comment"))
+
+		require.NoError(t, resourceAssert.ToTerraformTestCheckFunc(t, nil)(state))
+	})
+
+	t.Run("failures are aggregated", func(t *testing.T) {
+		resourceAssert := NewResourceAssert("snowflake_warehouse.w")
+		resourceAssert.AddAssertion(ValueSet("name", "OTHER"))
+		resourceAssert.AddAssertion(ValueSet("auto_suspend", "60"))
+		resourceAssert.AddAssertion(ValueNotSet("auto_suspend"))
+
+		err := resourceAssert.ToTerraformTestCheckFunc(t, nil)(state)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "snowflake_warehouse.w resource assertion [1/3]: failed with error: snowflake_warehouse.w: Attribute 'name' expected \"OTHER\", got \"WH\"")
+		assert.ErrorContains(t, err, "snowflake_warehouse.w resource assertion [3/3]: failed with error:")
+		assert.NotContains(t, err.Error(), "[2/3]")
+	})
+
+	t.Run("assertions are combined", func(t *testing.T) {
+		first := NewResourceAssert("snowflake_warehouse.w")
+		first.AddAssertion(ValueSet("name", "OTHER"))
+		second := NewResourceAssert("snowflake_warehouse.other")
+		second.AddAssertion(ValueSet("name", "WH"))
+
+		err := AssertThat(t, nil, first, second)(state)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "snowflake_warehouse.w resource assertion [1/1]")
+		assert.ErrorContains(t, err, "snowflake_warehouse.other resource assertion [1/1]")
+	})
+}
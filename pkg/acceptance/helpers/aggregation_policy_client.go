@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type AggregationPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewAggregationPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *AggregationPolicyClient {
+	return &AggregationPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *AggregationPolicyClient) client() sdk.AggregationPolicies {
+	return c.context.client.AggregationPolicies
+}
+
+func (c *AggregationPolicyClient) CreateAggregationPolicy(t *testing.T) (*sdk.AggregationPolicy, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	return c.CreateAggregationPolicyWithOptions(t, id, sdk.NewCreateAggregationPolicyRequest(id, "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"))
+}
+
+func (c *AggregationPolicyClient) CreateAggregationPolicyWithOptions(t *testing.T, id sdk.SchemaObjectIdentifier, request *sdk.CreateAggregationPolicyRequest) (*sdk.AggregationPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	aggregationPolicy, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return aggregationPolicy, c.DropAggregationPolicyFunc(t, id)
+}
+
+func (c *AggregationPolicyClient) DropAggregationPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropAggregationPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -42,6 +43,18 @@ func (c *ApiIntegrationClient) CreateApiIntegration(t *testing.T) (*sdk.ApiInteg
 	return apiIntegration, c.DropApiIntegrationFunc(t, id)
 }
 
+// TODO: Use SDK implementation for git_https_api provider once it's available
+func (c *ApiIntegrationClient) CreateGitApiIntegration(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf(`CREATE API INTEGRATION %s API_PROVIDER = git_https_api API_ALLOWED_PREFIXES = ('https://github.com/Snowflake-Labs') ALLOWED_AUTHENTICATION_SECRETS = all ENABLED = true`, id.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	return id, c.DropApiIntegrationFunc(t, id)
+}
+
 func (c *ApiIntegrationClient) DropApiIntegrationFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
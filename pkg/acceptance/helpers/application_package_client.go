@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -59,16 +58,18 @@ func (c *ApplicationPackageClient) AddApplicationPackageVersion(t *testing.T, id
 	require.NoError(t, err)
 }
 
-func (c *ApplicationPackageClient) ShowVersions(t *testing.T, id sdk.AccountObjectIdentifier) []ApplicationPackageVersion {
+func (c *ApplicationPackageClient) ShowVersions(t *testing.T, id sdk.AccountObjectIdentifier) []sdk.ApplicationPackageVersion {
 	t.Helper()
 
-	var versions []ApplicationPackageVersion
-	err := c.context.client.QueryForTests(context.Background(), &versions, fmt.Sprintf(`SHOW VERSIONS IN APPLICATION PACKAGE %s`, id.FullyQualifiedName()))
+	versions, err := c.context.client.ApplicationPackageVersions.Show(context.Background(), sdk.NewShowApplicationPackageVersionRequest(id))
 	require.NoError(t, err)
 	return versions
 }
 
-type ApplicationPackageVersion struct {
-	Version string `json:"version"`
-	Patch   int    `json:"patch"`
+func (c *ApplicationPackageClient) ShowReleaseDirectives(t *testing.T, id sdk.AccountObjectIdentifier) []sdk.ReleaseDirective {
+	t.Helper()
+
+	releaseDirectives, err := c.context.client.ReleaseDirectives.Show(context.Background(), sdk.NewShowReleaseDirectiveRequest(id))
+	require.NoError(t, err)
+	return releaseDirectives
 }
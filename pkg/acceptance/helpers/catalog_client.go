@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,22 +20,18 @@ func NewCatalogIntegrationClient(context *TestClientContext, idsGenerator *IdsGe
 	}
 }
 
-func (c *CatalogIntegrationClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *CatalogIntegrationClient) client() sdk.CatalogIntegrations {
+	return c.context.client.CatalogIntegrations
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Catalog once it's available
 func (c *CatalogIntegrationClient) Create(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
+	ctx := context.Background()
+
 	id := c.ids.RandomAccountObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create catalog integration %s
-  catalog_source=object_store
-  table_format=iceberg
-  enabled=true
-`, id.FullyQualifiedName()))
+	request := sdk.NewCreateCatalogIntegrationRequest(id, true).
+		WithObjectStoreCatalogSourceParams(*sdk.NewObjectStoreCatalogSourceParamsRequest(sdk.CatalogIntegrationTableFormatIceberg))
+	err := c.client().Create(ctx, request)
 	require.NoError(t, err)
 
 	return id, c.DropFunc(t, id)
@@ -44,9 +39,10 @@ create catalog integration %s
 
 func (c *CatalogIntegrationClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop catalog integration if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
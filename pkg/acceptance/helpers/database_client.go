@@ -104,6 +104,20 @@ func (c *DatabaseClient) UpdateDataRetentionTime(t *testing.T, id sdk.AccountObj
 	}
 }
 
+func (c *DatabaseClient) UnsetEventTable(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Alter(ctx, id, &sdk.AlterDatabaseOptions{
+			Unset: &sdk.DatabaseUnset{
+				EventTable: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+	}
+}
+
 func (c *DatabaseClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.Database, error) {
 	t.Helper()
 	ctx := context.Background()
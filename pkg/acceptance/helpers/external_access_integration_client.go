@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ExternalAccessIntegrationClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewExternalAccessIntegrationClient(context *TestClientContext, idsGenerator *IdsGenerator) *ExternalAccessIntegrationClient {
+	return &ExternalAccessIntegrationClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ExternalAccessIntegrationClient) client() sdk.ExternalAccessIntegrations {
+	return c.context.client.ExternalAccessIntegrations
+}
+
+func (c *ExternalAccessIntegrationClient) Create(t *testing.T, networkRuleId sdk.SchemaObjectIdentifier) (*sdk.ExternalAccessIntegration, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	err := c.client().Create(ctx, sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRuleId}, true))
+	require.NoError(t, err)
+
+	externalAccessIntegration, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return externalAccessIntegration, c.DropFunc(t, id)
+}
+
+func (c *ExternalAccessIntegrationClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *ExternalAccessIntegrationClient) Alter(t *testing.T, request *sdk.AlterExternalAccessIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,29 +20,26 @@ func NewExternalVolumeClient(context *TestClientContext, idsGenerator *IdsGenera
 	}
 }
 
-func (c *ExternalVolumeClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *ExternalVolumeClient) client() sdk.ExternalVolumes {
+	return c.context.client.ExternalVolumes
 }
 
-// TODO(SNOW-999142): Use SDK implementation for External Volume once it's available
 func (c *ExternalVolumeClient) Create(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
 	t.Helper()
+	ctx := context.Background()
+
 	id := c.ids.RandomAccountObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create external volume %s
-	storage_locations =
-    	(
-    		(
-            	name = 'my-s3-us-west-2'
-            	storage_provider = 's3'
-            	storage_base_url = 's3://my_example_bucket/'
-            	storage_aws_role_arn = 'arn:aws:iam::123456789012:role/myrole'
-            	encryption=(type='aws_sse_kms' kms_key_id='1234abcd-12ab-34cd-56ef-1234567890ab')
-        	)
-      	);
-`, id.FullyQualifiedName()))
+	storageLocation := sdk.NewExternalVolumeStorageLocationRequest().WithS3StorageLocationParams(
+		*sdk.NewS3StorageLocationParamsRequest(
+			"my-s3-us-west-2",
+			sdk.S3StorageProviderS3,
+			"arn:aws:iam::123456789012:role/myrole",
+			"s3://my_example_bucket/",
+		).WithEncryption(
+			*sdk.NewExternalVolumeS3EncryptionRequest(sdk.S3EncryptionTypeSseKms).WithKmsKeyId("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		),
+	)
+	err := c.client().Create(ctx, sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocationRequest{*storageLocation}))
 	require.NoError(t, err)
 
 	return id, c.DropFunc(t, id)
@@ -51,9 +47,18 @@ create external volume %s
 
 func (c *ExternalVolumeClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop external volume if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropExternalVolumeRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
+
+func (c *ExternalVolumeClient) Alter(t *testing.T, request *sdk.AlterExternalVolumeRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+// GitRepositoryOrigin is a public repository, so no credentials are needed to fetch it.
+const GitRepositoryOrigin = "https://github.com/Snowflake-Labs/terraform-provider-snowflake.git"
+
+type GitRepositoryClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewGitRepositoryClient(context *TestClientContext, idsGenerator *IdsGenerator) *GitRepositoryClient {
+	return &GitRepositoryClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *GitRepositoryClient) client() sdk.GitRepositories {
+	return c.context.client.GitRepositories
+}
+
+func (c *GitRepositoryClient) Create(t *testing.T, apiIntegrationId sdk.AccountObjectIdentifier) (*sdk.GitRepository, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	err := c.client().Create(ctx, sdk.NewCreateGitRepositoryRequest(id, GitRepositoryOrigin, apiIntegrationId))
+	require.NoError(t, err)
+
+	gitRepository, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return gitRepository, c.DropFunc(t, id)
+}
+
+func (c *GitRepositoryClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropGitRepositoryRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *GitRepositoryClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.GitRepository, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,95 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type HybridTableClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewHybridTableClient(context *TestClientContext, idsGenerator *IdsGenerator) *HybridTableClient {
+	return &HybridTableClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *HybridTableClient) client() sdk.HybridTables {
+	return c.context.client.HybridTables
+}
+
+func (c *HybridTableClient) indexesClient() sdk.HybridTableIndexes {
+	return c.context.client.HybridTableIndexes
+}
+
+// Create creates a hybrid table with "ID" primary key column and "NAME" column.
+func (c *HybridTableClient) Create(t *testing.T) (*sdk.HybridTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	columns := sdk.NewHybridTableColumnsConstraintsAndIndexesRequest().WithColumns([]sdk.HybridTableColumnRequest{
+		*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber).WithInlineConstraint(sdk.ColumnInlineConstraint{Type: sdk.ColumnConstraintTypePrimaryKey}),
+		*sdk.NewHybridTableColumnRequest("NAME", sdk.DataTypeVARCHAR),
+	})
+
+	err := c.client().Create(ctx, sdk.NewCreateHybridTableRequest(id, *columns))
+	require.NoError(t, err)
+
+	hybridTable, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return hybridTable, c.DropFunc(t, id)
+}
+
+func (c *HybridTableClient) Alter(t *testing.T, request *sdk.AlterHybridTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *HybridTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *HybridTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.HybridTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *HybridTableClient) CreateIndex(t *testing.T, tableId sdk.SchemaObjectIdentifier, columns []string) sdk.TableColumnIdentifier {
+	t.Helper()
+	ctx := context.Background()
+
+	indexName := c.ids.Alpha()
+	err := c.indexesClient().Create(ctx, sdk.NewCreateHybridTableIndexRequest(indexName, tableId, columns))
+	require.NoError(t, err)
+
+	return sdk.NewTableColumnIdentifier(tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), indexName)
+}
+
+func (c *HybridTableClient) ShowIndexes(t *testing.T, tableId sdk.SchemaObjectIdentifier) []sdk.HybridTableIndex {
+	t.Helper()
+	ctx := context.Background()
+
+	indexes, err := c.indexesClient().Show(ctx, sdk.NewShowHybridTableIndexRequest().WithInTable(tableId))
+	require.NoError(t, err)
+
+	return indexes
+}
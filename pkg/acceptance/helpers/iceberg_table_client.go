@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type IcebergTableClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewIcebergTableClient(context *TestClientContext, idsGenerator *IdsGenerator) *IcebergTableClient {
+	return &IcebergTableClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *IcebergTableClient) client() sdk.IcebergTables {
+	return c.context.client.IcebergTables
+}
+
+// Create creates an iceberg table with Snowflake as the catalog. The given external volume has to allow writes.
+func (c *IcebergTableClient) Create(t *testing.T, externalVolume string) (*sdk.IcebergTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	columns := []sdk.IcebergTableColumnRequest{
+		*sdk.NewIcebergTableColumnRequest("id", sdk.DataTypeNumber),
+	}
+	request := sdk.NewCreateIcebergTableRequest(id, columns, id.Name()).WithExternalVolume(externalVolume)
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	icebergTable, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return icebergTable, c.DropFunc(t, id)
+}
+
+func (c *IcebergTableClient) Alter(t *testing.T, request *sdk.AlterIcebergTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *IcebergTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *IcebergTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.IcebergTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ImageRepositoryClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewImageRepositoryClient(context *TestClientContext, idsGenerator *IdsGenerator) *ImageRepositoryClient {
+	return &ImageRepositoryClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ImageRepositoryClient) client() sdk.ImageRepositories {
+	return c.context.client.ImageRepositories
+}
+
+func (c *ImageRepositoryClient) Create(t *testing.T) (*sdk.ImageRepository, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	err := c.client().Create(ctx, sdk.NewCreateImageRepositoryRequest(id))
+	require.NoError(t, err)
+
+	imageRepository, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return imageRepository, c.DropFunc(t, id)
+}
+
+func (c *ImageRepositoryClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropImageRepositoryRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *ImageRepositoryClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.ImageRepository, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type NetworkRuleClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewNetworkRuleClient(context *TestClientContext, idsGenerator *IdsGenerator) *NetworkRuleClient {
+	return &NetworkRuleClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *NetworkRuleClient) client() sdk.NetworkRules {
+	return c.context.client.NetworkRules
+}
+
+func (c *NetworkRuleClient) Create(t *testing.T) (*sdk.NetworkRule, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	request := sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeHostPort, []sdk.NetworkRuleValue{{Value: "example.com"}}, sdk.NetworkRuleModeEgress)
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	networkRule, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return networkRule, c.DropFunc(t, id)
+}
+
+func (c *NetworkRuleClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true)))
+		require.NoError(t, err)
+	}
+}
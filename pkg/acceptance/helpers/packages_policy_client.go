@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type PackagesPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewPackagesPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *PackagesPolicyClient {
+	return &PackagesPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *PackagesPolicyClient) client() sdk.PackagesPolicies {
+	return c.context.client.PackagesPolicies
+}
+
+func (c *PackagesPolicyClient) CreatePackagesPolicy(t *testing.T) (*sdk.PackagesPolicy, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	return c.CreatePackagesPolicyWithOptions(t, id, sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguagePython))
+}
+
+func (c *PackagesPolicyClient) CreatePackagesPolicyWithOptions(t *testing.T, id sdk.SchemaObjectIdentifier, request *sdk.CreatePackagesPolicyRequest) (*sdk.PackagesPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	packagesPolicy, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return packagesPolicy, c.DropPackagesPolicyFunc(t, id)
+}
+
+func (c *PackagesPolicyClient) DropPackagesPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropPackagesPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
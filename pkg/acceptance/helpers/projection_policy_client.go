@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ProjectionPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewProjectionPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *ProjectionPolicyClient {
+	return &ProjectionPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ProjectionPolicyClient) client() sdk.ProjectionPolicies {
+	return c.context.client.ProjectionPolicies
+}
+
+func (c *ProjectionPolicyClient) CreateProjectionPolicy(t *testing.T) (*sdk.ProjectionPolicy, func()) {
+	t.Helper()
+	id := c.ids.RandomSchemaObjectIdentifier()
+	return c.CreateProjectionPolicyWithOptions(t, id, sdk.NewCreateProjectionPolicyRequest(id, "PROJECTION_CONSTRAINT(ALLOW => false)"))
+}
+
+func (c *ProjectionPolicyClient) CreateProjectionPolicyWithOptions(t *testing.T, id sdk.SchemaObjectIdentifier, request *sdk.CreateProjectionPolicyRequest) (*sdk.ProjectionPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	projectionPolicy, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return projectionPolicy, c.DropProjectionPolicyFunc(t, id)
+}
+
+func (c *ProjectionPolicyClient) DropProjectionPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropProjectionPolicyRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type ReplicationGroupClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewReplicationGroupClient(context *TestClientContext, idsGenerator *IdsGenerator) *ReplicationGroupClient {
+	return &ReplicationGroupClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ReplicationGroupClient) client() sdk.ReplicationGroups {
+	return c.context.client.ReplicationGroups
+}
+
+func (c *ReplicationGroupClient) CreateWithOptions(t *testing.T, objectTypes []sdk.PluralObjectType, allowedAccounts []sdk.AccountIdentifier, opts *sdk.CreateReplicationGroupOptions) (*sdk.ReplicationGroup, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+
+	err := c.client().Create(ctx, id, objectTypes, allowedAccounts, opts)
+	require.NoError(t, err)
+
+	replicationGroup, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return replicationGroup, c.DropFunc(t, id)
+}
+
+func (c *ReplicationGroupClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, id, &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)})
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type SecretClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewSecretClient(context *TestClientContext, idsGenerator *IdsGenerator) *SecretClient {
+	return &SecretClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *SecretClient) client() sdk.Secrets {
+	return c.context.client.Secrets
+}
+
+func (c *SecretClient) CreateWithOAuthClientCredentialsFlow(t *testing.T, id sdk.SchemaObjectIdentifier, apiIntegration sdk.AccountObjectIdentifier, oauthScopes []sdk.SecretScope) (*sdk.SecretObject, func()) {
+	t.Helper()
+	return c.createWith(t, id, func(ctx context.Context) error {
+		return c.client().CreateWithOAuthClientCredentialsFlow(ctx, sdk.NewCreateWithOAuthClientCredentialsFlowSecretRequest(id, apiIntegration, oauthScopes))
+	})
+}
+
+func (c *SecretClient) CreateWithOAuthAuthorizationCodeFlow(t *testing.T, id sdk.SchemaObjectIdentifier, apiIntegration sdk.AccountObjectIdentifier, refreshToken string, refreshTokenExpiryTime string) (*sdk.SecretObject, func()) {
+	t.Helper()
+	return c.createWith(t, id, func(ctx context.Context) error {
+		return c.client().CreateWithOAuthAuthorizationCodeFlow(ctx, sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(id, refreshToken, refreshTokenExpiryTime, apiIntegration))
+	})
+}
+
+func (c *SecretClient) CreateWithBasicAuthentication(t *testing.T, id sdk.SchemaObjectIdentifier, username string, password string) (*sdk.SecretObject, func()) {
+	t.Helper()
+	return c.createWith(t, id, func(ctx context.Context) error {
+		return c.client().CreateWithBasicAuthentication(ctx, sdk.NewCreateWithBasicAuthenticationSecretRequest(id, username, password))
+	})
+}
+
+func (c *SecretClient) CreateWithGenericString(t *testing.T, id sdk.SchemaObjectIdentifier, secretString string) (*sdk.SecretObject, func()) {
+	t.Helper()
+	return c.createWith(t, id, func(ctx context.Context) error {
+		return c.client().CreateWithGenericString(ctx, sdk.NewCreateWithGenericStringSecretRequest(id, secretString))
+	})
+}
+
+func (c *SecretClient) createWith(t *testing.T, id sdk.SchemaObjectIdentifier, create func(ctx context.Context) error) (*sdk.SecretObject, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := create(ctx)
+	require.NoError(t, err)
+
+	secret, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return secret, c.DropFunc(t, id)
+}
+
+func (c *SecretClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *SecretClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.SecretObject, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
@@ -70,3 +71,27 @@ func (c *SecurityIntegrationClient) DropSecurityIntegrationFunc(t *testing.T, id
 		require.NoError(t, err)
 	}
 }
+
+// TODO: Use SDK implementation for API_AUTHENTICATION security integration once it's available
+func (c *SecurityIntegrationClient) CreateApiAuthenticationWithClientCredentialsFlow(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf(`CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'foo' OAUTH_GRANT = CLIENT_CREDENTIALS OAUTH_ALLOWED_SCOPES = ('foo', 'bar') ENABLED = true`, id.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	return id, c.DropSecurityIntegrationFunc(t, id)
+}
+
+// TODO: Use SDK implementation for API_AUTHENTICATION security integration once it's available
+func (c *SecurityIntegrationClient) CreateApiAuthenticationWithAuthorizationCodeGrantFlow(t *testing.T) (sdk.AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomAccountObjectIdentifier()
+	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf(`CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 OAUTH_CLIENT_ID = 'foo' OAUTH_CLIENT_SECRET = 'foo' OAUTH_GRANT = AUTHORIZATION_CODE ENABLED = true`, id.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	return id, c.DropSecurityIntegrationFunc(t, id)
+}
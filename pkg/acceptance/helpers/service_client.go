@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+// ServiceSpecification returns a minimal service specification with a single container (running the given image)
+// exposing one public endpoint.
+func ServiceSpecification(image string) string {
+	return fmt.Sprintf(`spec:
+  containers:
+  - name: main
+    image: %s
+  endpoints:
+  - name: api
+    port: 8080
+    public: true
+`, image)
+}
+
+type ServiceClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewServiceClient(context *TestClientContext, idsGenerator *IdsGenerator) *ServiceClient {
+	return &ServiceClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *ServiceClient) client() sdk.Services {
+	return c.context.client.Services
+}
+
+// Create creates a service from the inline ServiceSpecification on the given compute pool.
+func (c *ServiceClient) Create(t *testing.T, computePoolId sdk.AccountObjectIdentifier, image string) (*sdk.Service, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	request := sdk.NewCreateServiceRequest(id, computePoolId).
+		WithFromSpecification(*sdk.NewServiceFromSpecificationRequest().WithSpecification(ServiceSpecification(image)))
+
+	err := c.client().Create(ctx, request)
+	require.NoError(t, err)
+
+	service, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return service, c.DropFunc(t, id)
+}
+
+func (c *ServiceClient) Alter(t *testing.T, request *sdk.AlterServiceRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *ServiceClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Drop(ctx, sdk.NewDropServiceRequest(id).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *ServiceClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Service, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
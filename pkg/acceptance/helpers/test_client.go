@@ -9,39 +9,54 @@ type TestClient struct {
 
 	Ids *IdsGenerator
 
-	Account             *AccountClient
-	Alert               *AlertClient
-	ApiIntegration      *ApiIntegrationClient
-	Application         *ApplicationClient
-	ApplicationPackage  *ApplicationPackageClient
-	Context             *ContextClient
-	CatalogIntegration  *CatalogIntegrationClient
-	Database            *DatabaseClient
-	DatabaseRole        *DatabaseRoleClient
-	DynamicTable        *DynamicTableClient
-	ExternalVolume      *ExternalVolumeClient
-	FailoverGroup       *FailoverGroupClient
-	FileFormat          *FileFormatClient
-	MaskingPolicy       *MaskingPolicyClient
-	MaterializedView    *MaterializedViewClient
-	NetworkPolicy       *NetworkPolicyClient
-	Parameter           *ParameterClient
-	PasswordPolicy      *PasswordPolicyClient
-	Pipe                *PipeClient
-	ResourceMonitor     *ResourceMonitorClient
-	Role                *RoleClient
-	RowAccessPolicy     *RowAccessPolicyClient
-	Schema              *SchemaClient
-	SecurityIntegration *SecurityIntegrationClient
-	SessionPolicy       *SessionPolicyClient
-	Share               *ShareClient
-	Stage               *StageClient
-	Table               *TableClient
-	Tag                 *TagClient
-	Task                *TaskClient
-	User                *UserClient
-	View                *ViewClient
-	Warehouse           *WarehouseClient
+	Account                   *AccountClient
+	AggregationPolicy         *AggregationPolicyClient
+	Alert                     *AlertClient
+	ApiIntegration            *ApiIntegrationClient
+	Application               *ApplicationClient
+	ApplicationPackage        *ApplicationPackageClient
+	AuthenticationPolicy      *AuthenticationPolicyClient
+	Context                   *ContextClient
+	CatalogIntegration        *CatalogIntegrationClient
+	ComputePool               *ComputePoolClient
+	Connection                *ConnectionClient
+	Database                  *DatabaseClient
+	DatabaseRole              *DatabaseRoleClient
+	DynamicTable              *DynamicTableClient
+	ExternalAccessIntegration *ExternalAccessIntegrationClient
+	ExternalVolume            *ExternalVolumeClient
+	FailoverGroup             *FailoverGroupClient
+	FileFormat                *FileFormatClient
+	GitRepository             *GitRepositoryClient
+	HybridTable               *HybridTableClient
+	IcebergTable              *IcebergTableClient
+	ImageRepository           *ImageRepositoryClient
+	MaskingPolicy             *MaskingPolicyClient
+	MaterializedView          *MaterializedViewClient
+	NetworkPolicy             *NetworkPolicyClient
+	NetworkRule               *NetworkRuleClient
+	Parameter                 *ParameterClient
+	PackagesPolicy            *PackagesPolicyClient
+	PasswordPolicy            *PasswordPolicyClient
+	Pipe                      *PipeClient
+	ProjectionPolicy          *ProjectionPolicyClient
+	ReplicationGroup          *ReplicationGroupClient
+	ResourceMonitor           *ResourceMonitorClient
+	Role                      *RoleClient
+	RowAccessPolicy           *RowAccessPolicyClient
+	Schema                    *SchemaClient
+	Secret                    *SecretClient
+	SecurityIntegration       *SecurityIntegrationClient
+	Service                   *ServiceClient
+	SessionPolicy             *SessionPolicyClient
+	Share                     *ShareClient
+	Stage                     *StageClient
+	Table                     *TableClient
+	Tag                       *TagClient
+	Task                      *TaskClient
+	User                      *UserClient
+	View                      *ViewClient
+	Warehouse                 *WarehouseClient
 }
 
 func NewTestClient(c *sdk.Client, database string, schema string, warehouse string, testObjectSuffix string) *TestClient {
@@ -58,39 +73,54 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 
 		Ids: idsGenerator,
 
-		Account:             NewAccountClient(context),
-		Alert:               NewAlertClient(context, idsGenerator),
-		ApiIntegration:      NewApiIntegrationClient(context, idsGenerator),
-		Application:         NewApplicationClient(context, idsGenerator),
-		ApplicationPackage:  NewApplicationPackageClient(context, idsGenerator),
-		Context:             NewContextClient(context),
-		CatalogIntegration:  NewCatalogIntegrationClient(context, idsGenerator),
-		Database:            NewDatabaseClient(context, idsGenerator),
-		DatabaseRole:        NewDatabaseRoleClient(context, idsGenerator),
-		DynamicTable:        NewDynamicTableClient(context, idsGenerator),
-		ExternalVolume:      NewExternalVolumeClient(context, idsGenerator),
-		FailoverGroup:       NewFailoverGroupClient(context, idsGenerator),
-		FileFormat:          NewFileFormatClient(context, idsGenerator),
-		MaskingPolicy:       NewMaskingPolicyClient(context, idsGenerator),
-		MaterializedView:    NewMaterializedViewClient(context, idsGenerator),
-		NetworkPolicy:       NewNetworkPolicyClient(context, idsGenerator),
-		Parameter:           NewParameterClient(context),
-		PasswordPolicy:      NewPasswordPolicyClient(context, idsGenerator),
-		Pipe:                NewPipeClient(context, idsGenerator),
-		ResourceMonitor:     NewResourceMonitorClient(context, idsGenerator),
-		Role:                NewRoleClient(context, idsGenerator),
-		RowAccessPolicy:     NewRowAccessPolicyClient(context, idsGenerator),
-		Schema:              NewSchemaClient(context, idsGenerator),
-		SecurityIntegration: NewSecurityIntegrationClient(context, idsGenerator),
-		SessionPolicy:       NewSessionPolicyClient(context, idsGenerator),
-		Share:               NewShareClient(context, idsGenerator),
-		Stage:               NewStageClient(context, idsGenerator),
-		Table:               NewTableClient(context, idsGenerator),
-		Tag:                 NewTagClient(context, idsGenerator),
-		Task:                NewTaskClient(context, idsGenerator),
-		User:                NewUserClient(context, idsGenerator),
-		View:                NewViewClient(context, idsGenerator),
-		Warehouse:           NewWarehouseClient(context, idsGenerator),
+		Account:                   NewAccountClient(context),
+		AggregationPolicy:         NewAggregationPolicyClient(context, idsGenerator),
+		Alert:                     NewAlertClient(context, idsGenerator),
+		ApiIntegration:            NewApiIntegrationClient(context, idsGenerator),
+		Application:               NewApplicationClient(context, idsGenerator),
+		ApplicationPackage:        NewApplicationPackageClient(context, idsGenerator),
+		AuthenticationPolicy:      NewAuthenticationPolicyClient(context, idsGenerator),
+		Context:                   NewContextClient(context),
+		CatalogIntegration:        NewCatalogIntegrationClient(context, idsGenerator),
+		ComputePool:               NewComputePoolClient(context, idsGenerator),
+		Connection:                NewConnectionClient(context, idsGenerator),
+		Database:                  NewDatabaseClient(context, idsGenerator),
+		DatabaseRole:              NewDatabaseRoleClient(context, idsGenerator),
+		DynamicTable:              NewDynamicTableClient(context, idsGenerator),
+		ExternalAccessIntegration: NewExternalAccessIntegrationClient(context, idsGenerator),
+		ExternalVolume:            NewExternalVolumeClient(context, idsGenerator),
+		FailoverGroup:             NewFailoverGroupClient(context, idsGenerator),
+		FileFormat:                NewFileFormatClient(context, idsGenerator),
+		GitRepository:             NewGitRepositoryClient(context, idsGenerator),
+		HybridTable:               NewHybridTableClient(context, idsGenerator),
+		IcebergTable:              NewIcebergTableClient(context, idsGenerator),
+		ImageRepository:           NewImageRepositoryClient(context, idsGenerator),
+		MaskingPolicy:             NewMaskingPolicyClient(context, idsGenerator),
+		MaterializedView:          NewMaterializedViewClient(context, idsGenerator),
+		NetworkPolicy:             NewNetworkPolicyClient(context, idsGenerator),
+		NetworkRule:               NewNetworkRuleClient(context, idsGenerator),
+		Parameter:                 NewParameterClient(context),
+		PackagesPolicy:            NewPackagesPolicyClient(context, idsGenerator),
+		PasswordPolicy:            NewPasswordPolicyClient(context, idsGenerator),
+		Pipe:                      NewPipeClient(context, idsGenerator),
+		ProjectionPolicy:          NewProjectionPolicyClient(context, idsGenerator),
+		ReplicationGroup:          NewReplicationGroupClient(context, idsGenerator),
+		ResourceMonitor:           NewResourceMonitorClient(context, idsGenerator),
+		Role:                      NewRoleClient(context, idsGenerator),
+		RowAccessPolicy:           NewRowAccessPolicyClient(context, idsGenerator),
+		Schema:                    NewSchemaClient(context, idsGenerator),
+		Secret:                    NewSecretClient(context, idsGenerator),
+		SecurityIntegration:       NewSecurityIntegrationClient(context, idsGenerator),
+		Service:                   NewServiceClient(context, idsGenerator),
+		SessionPolicy:             NewSessionPolicyClient(context, idsGenerator),
+		Share:                     NewShareClient(context, idsGenerator),
+		Stage:                     NewStageClient(context, idsGenerator),
+		Table:                     NewTableClient(context, idsGenerator),
+		Tag:                       NewTagClient(context, idsGenerator),
+		Task:                      NewTaskClient(context, idsGenerator),
+		User:                      NewUserClient(context, idsGenerator),
+		View:                      NewViewClient(context, idsGenerator),
+		Warehouse:                 NewWarehouseClient(context, idsGenerator),
 	}
 }
 
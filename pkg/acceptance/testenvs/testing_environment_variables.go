@@ -24,6 +24,9 @@ const (
 	AzureExternalSasToken  env = "TEST_SF_TF_AZURE_EXTERNAL_SAS_TOKEN" // #nosec G101
 	GcsExternalBuckerUrl   env = "TEST_SF_TF_GCS_EXTERNAL_BUCKET_URL"
 
+	IcebergExternalVolume env = "TEST_SF_TF_ICEBERG_EXTERNAL_VOLUME"
+	ServiceImage          env = "TEST_SF_TF_SERVICE_IMAGE"
+
 	SkipManagedAccountTest  env = "TEST_SF_TF_SKIP_MANAGED_ACCOUNT_TEST"
 	SkipSamlIntegrationTest env = "TEST_SF_TF_SKIP_SAML_INTEGRATION_TEST"
 
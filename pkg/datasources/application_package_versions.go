@@ -0,0 +1,108 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackageVersionsSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The application package from which to return the versions.",
+	},
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"versions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW VERSIONS, one entry per patch of each version.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"patch": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"review_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ApplicationPackageVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of the versions and patches of an application package. Filtering is aligned with the current possibilities for [SHOW VERSIONS](https://docs.snowflake.com/en/sql-reference/sql/show-versions) query.",
+		ReadContext: ReadContextApplicationPackageVersions,
+		Schema:      applicationPackageVersionsSchema,
+	}
+}
+
+func ReadContextApplicationPackageVersions(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+
+	request := sdk.NewShowApplicationPackageVersionRequest(applicationPackage)
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	versions, err := client.ApplicationPackageVersions.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(applicationPackage))
+
+	result := make([]map[string]any, len(versions))
+	for i, version := range versions {
+		result[i] = map[string]any{
+			"version":    version.Version,
+			"patch":      version.Patch,
+			"created_on": version.CreatedOn,
+			"state":      version.State,
+		}
+		if version.Label != nil {
+			result[i]["label"] = *version.Label
+		}
+		if version.Comment != nil {
+			result[i]["comment"] = *version.Comment
+		}
+		if version.ReviewStatus != nil {
+			result[i]["review_status"] = *version.ReviewStatus
+		}
+	}
+	if err := d.Set("versions", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackageVersions(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "manifest.yml", "")
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "setup.sql", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageVersions(name, stage.Location()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_application_package_versions.test", "application_package", name),
+					resource.TestCheckResourceAttr("data.snowflake_application_package_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_application_package_versions.test", "versions.0.version", "V002"),
+					resource.TestCheckResourceAttr("data.snowflake_application_package_versions.test", "versions.0.patch", "0"),
+					resource.TestCheckResourceAttr("data.snowflake_application_package_versions.test", "versions.0.label", "second version"),
+					resource.TestCheckResourceAttrSet("data.snowflake_application_package_versions.test", "versions.0.created_on"),
+					resource.TestCheckResourceAttrSet("data.snowflake_application_package_versions.test", "versions.0.state"),
+				),
+			},
+		},
+	})
+}
+
+func applicationPackageVersions(name string, using string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "test" {
+	name = "%[1]s"
+}
+
+resource "snowflake_application_package_version" "first" {
+	application_package = snowflake_application_package.test.name
+	version             = "V001"
+	using               = %[2]q
+}
+
+resource "snowflake_application_package_version" "second" {
+	application_package = snowflake_application_package.test.name
+	version             = "V002"
+	using               = %[2]q
+	label               = "second version"
+	depends_on          = [snowflake_application_package_version.first]
+}
+
+data "snowflake_application_package_versions" "test" {
+	application_package = snowflake_application_package.test.name
+	like                = "V002"
+	depends_on          = [snowflake_application_package_version.second]
+}
+`, name, using)
+}
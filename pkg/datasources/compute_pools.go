@@ -0,0 +1,150 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var computePoolsSchema = map[string]*schema.Schema{
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"starts_with": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-sensitive** characters indicating the beginning of the object name.",
+	},
+	"compute_pools": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW COMPUTE POOLS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"instance_family": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"num_services": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"num_jobs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_suspend_secs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_resume": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"active_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"idle_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_exclusive": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"application": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ComputePools() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered compute pools. Filtering is aligned with the current possibilities for [SHOW COMPUTE POOLS](https://docs.snowflake.com/en/sql-reference/sql/show-compute-pools) query.",
+		ReadContext: ReadContextComputePools,
+		Schema:      computePoolsSchema,
+	}
+}
+
+func ReadContextComputePools(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowComputePoolRequest()
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+	if v, ok := d.GetOk("starts_with"); ok {
+		request.WithStartsWith(v.(string))
+	}
+
+	computePools, err := client.ComputePools.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("compute_pools_read")
+
+	result := make([]map[string]any, len(computePools))
+	for i, computePool := range computePools {
+		var comment, application string
+		if computePool.Comment != nil {
+			comment = *computePool.Comment
+		}
+		if computePool.Application != nil {
+			application = *computePool.Application
+		}
+		result[i] = map[string]any{
+			"name":              computePool.Name,
+			"state":             string(computePool.State),
+			"min_nodes":         computePool.MinNodes,
+			"max_nodes":         computePool.MaxNodes,
+			"instance_family":   string(computePool.InstanceFamily),
+			"num_services":      computePool.NumServices,
+			"num_jobs":          computePool.NumJobs,
+			"auto_suspend_secs": computePool.AutoSuspendSecs,
+			"auto_resume":       computePool.AutoResume,
+			"active_nodes":      computePool.ActiveNodes,
+			"idle_nodes":        computePool.IdleNodes,
+			"owner":             computePool.Owner,
+			"comment":           comment,
+			"is_exclusive":      computePool.IsExclusive,
+			"application":       application,
+		}
+	}
+	if err := d.Set("compute_pools", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
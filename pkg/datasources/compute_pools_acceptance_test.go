@@ -0,0 +1,57 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ComputePools(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: computePoolsConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.name", id.Name()),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.state", "SUSPENDED"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.min_nodes", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.max_nodes", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.instance_family", "CPU_X64_XS"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.auto_resume", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.test", "compute_pools.0.comment", "some comment"),
+				),
+			},
+		},
+	})
+}
+
+func computePoolsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_compute_pool" "test" {
+	name                = "%[1]s"
+	min_nodes           = 1
+	max_nodes           = 1
+	instance_family     = "CPU_X64_XS"
+	auto_resume         = false
+	initially_suspended = true
+	comment             = "some comment"
+}
+
+data "snowflake_compute_pools" "test" {
+	like       = snowflake_compute_pool.test.name
+	depends_on = [snowflake_compute_pool.test]
+}
+`, name)
+}
@@ -0,0 +1,79 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalVolumesSchema = map[string]*schema.Schema{
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"external_volumes": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW EXTERNAL VOLUMES.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"allow_writes": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ExternalVolumes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered external volumes. Filtering is aligned with the current possibilities for [SHOW EXTERNAL VOLUMES](https://docs.snowflake.com/en/sql-reference/sql/show-external-volumes) query.",
+		ReadContext: ReadContextExternalVolumes,
+		Schema:      externalVolumesSchema,
+	}
+}
+
+func ReadContextExternalVolumes(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowExternalVolumeRequest()
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	externalVolumes, err := client.ExternalVolumes.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("external_volumes_read")
+
+	result := make([]map[string]any, len(externalVolumes))
+	for i, externalVolume := range externalVolumes {
+		var comment string
+		if externalVolume.Comment != nil {
+			comment = *externalVolume.Comment
+		}
+		result[i] = map[string]any{
+			"name":         externalVolume.Name,
+			"allow_writes": externalVolume.AllowWrites,
+			"comment":      comment,
+		}
+	}
+	if err := d.Set("external_volumes", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalVolumes(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: externalVolumesConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_external_volumes.test", "external_volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_external_volumes.test", "external_volumes.0.name", id.Name()),
+					resource.TestCheckResourceAttr("data.snowflake_external_volumes.test", "external_volumes.0.allow_writes", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_external_volumes.test", "external_volumes.0.comment", "some comment"),
+				),
+			},
+		},
+	})
+}
+
+func externalVolumesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_external_volume" "test" {
+	name         = "%[1]s"
+	allow_writes = false
+	comment      = "some comment"
+
+	storage_location {
+		storage_location_name = "s3-location"
+		storage_provider      = "S3"
+		storage_base_url      = "s3://my_example_bucket/"
+		storage_aws_role_arn  = "arn:aws:iam::123456789012:role/myrole"
+	}
+}
+
+data "snowflake_external_volumes" "test" {
+	like       = snowflake_external_volume.test.name
+	depends_on = [snowflake_external_volume.test]
+}
+`, name)
+}
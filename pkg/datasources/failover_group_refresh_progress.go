@@ -0,0 +1,156 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var failoverGroupRefreshProgressSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the failover group in the current account.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether the failover group is the primary group. The refresh progress is reported only for the secondary groups.",
+	},
+	"secondary_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current state of the scheduled refresh of the secondary failover group (STARTED or SUSPENDED).",
+	},
+	"replication_schedule": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Scheduled interval for the refresh.",
+	},
+	"next_scheduled_refresh": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time of the next scheduled refresh.",
+	},
+	"last_refresh_start_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Start time of the current or the most recent refresh.",
+	},
+	"last_refresh_end_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "End time of the most recent refresh; empty when the refresh is still in progress.",
+	},
+	"phases": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of REPLICATION_GROUP_REFRESH_PROGRESS for the current or the most recent refresh.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"phase_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"progress": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"details": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func FailoverGroupRefreshProgress() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get the replication progress of a secondary failover group together with its last refresh ([REPLICATION_GROUP_REFRESH_PROGRESS](https://docs.snowflake.com/en/sql-reference/functions/replication_group_refresh_progress)).",
+		ReadContext: ReadContextFailoverGroupRefreshProgress,
+		Schema:      failoverGroupRefreshProgressSchema,
+	}
+}
+
+func ReadContextFailoverGroupRefreshProgress(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	failoverGroup, err := client.FailoverGroups.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	var phases []sdk.ReplicationGroupRefreshProgress
+	if !failoverGroup.IsPrimary {
+		phases, err = client.ReplicationFunctions.ReplicationGroupRefreshProgress(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var lastRefreshStartTime, lastRefreshEndTime string
+	phasesResult := make([]map[string]any, len(phases))
+	for i, phase := range phases {
+		var endTime, progress, details string
+		if phase.EndTime != nil {
+			endTime = phase.EndTime.Format(time.RFC3339)
+		}
+		if phase.Progress != nil {
+			progress = *phase.Progress
+		}
+		if phase.Details != nil {
+			details = *phase.Details
+		}
+		phasesResult[i] = map[string]any{
+			"phase_name": phase.PhaseName,
+			"start_time": phase.StartTime.Format(time.RFC3339),
+			"end_time":   endTime,
+			"progress":   progress,
+			"details":    details,
+		}
+	}
+	// phases are returned in the execution order; the refresh is finished when the last phase has ended
+	if len(phases) > 0 {
+		lastRefreshStartTime = phases[0].StartTime.Format(time.RFC3339)
+		if lastPhase := phases[len(phases)-1]; lastPhase.EndTime != nil {
+			lastRefreshEndTime = lastPhase.EndTime.Format(time.RFC3339)
+		}
+	}
+
+	var secondaryState string
+	if failoverGroup.SecondaryState != sdk.FailoverGroupSecondaryStateNull {
+		secondaryState = string(failoverGroup.SecondaryState)
+	}
+
+	toSet := map[string]any{
+		"is_primary":              failoverGroup.IsPrimary,
+		"secondary_state":         secondaryState,
+		"replication_schedule":    failoverGroup.ReplicationSchedule,
+		"next_scheduled_refresh":  failoverGroup.NextScheduledRefresh,
+		"last_refresh_start_time": lastRefreshStartTime,
+		"last_refresh_end_time":   lastRefreshEndTime,
+		"phases":                  phasesResult,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FailoverGroupRefreshProgress_primary(t *testing.T) {
+	// TODO [SNOW-1002023]: Unskip; Business Critical Snowflake Edition needed
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestFailoverGroups)
+
+	accountName := testenvs.GetOrSkipTest(t, testenvs.BusinessCriticalAccount)
+
+	name := acc.TestClient().Ids.Alpha()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: failoverGroupRefreshProgressConfig(name, accountName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_failover_group_refresh_progress.p", "name", name),
+					resource.TestCheckResourceAttr("data.snowflake_failover_group_refresh_progress.p", "is_primary", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_failover_group_refresh_progress.p", "phases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func failoverGroupRefreshProgressConfig(failoverGroupName string, allowedAccount string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_failover_group" "fg" {
+		name             = "%s"
+		object_types     = ["ROLES"]
+		allowed_accounts = ["%s"]
+	}
+
+	data "snowflake_failover_group_refresh_progress" "p" {
+		name = snowflake_failover_group.fg.name
+	}
+	`, failoverGroupName, allowedAccount)
+}
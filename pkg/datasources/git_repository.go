@@ -0,0 +1,175 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositorySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database in which the git repository is located.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema in which the git repository is located.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the git repository.",
+	},
+	"origin": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Origin URL of the remote git repository.",
+	},
+	"api_integration": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the API integration used to access the remote git repository.",
+	},
+	"git_credentials": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the secret used to authenticate with the remote git repository.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Comment of the git repository.",
+	},
+	"branches": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW GIT BRANCHES.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW GIT TAGS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"commit_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"author": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func GitRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of a git repository together with its branches and tags ([SHOW GIT BRANCHES](https://docs.snowflake.com/en/sql-reference/sql/show-git-branches) and [SHOW GIT TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-git-tags)).",
+		ReadContext: ReadContextGitRepository,
+		Schema:      gitRepositorySchema,
+	}
+}
+
+func ReadContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	branches, err := client.GitBranches.Show(ctx, sdk.NewShowGitBranchRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tags, err := client.GitTags.Show(ctx, sdk.NewShowGitTagRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	var gitCredentials, comment string
+	if gitRepository.GitCredentials != nil {
+		gitCredentials = *gitRepository.GitCredentials
+	}
+	if gitRepository.Comment != nil {
+		comment = *gitRepository.Comment
+	}
+
+	branchesResult := make([]map[string]any, len(branches))
+	for i, branch := range branches {
+		branchesResult[i] = map[string]any{
+			"name":        branch.Name,
+			"path":        branch.Path,
+			"commit_hash": branch.CommitHash,
+		}
+	}
+	tagsResult := make([]map[string]any, len(tags))
+	for i, tag := range tags {
+		var author, message string
+		if tag.Author != nil {
+			author = *tag.Author
+		}
+		if tag.Message != nil {
+			message = *tag.Message
+		}
+		tagsResult[i] = map[string]any{
+			"name":        tag.Name,
+			"path":        tag.Path,
+			"commit_hash": tag.CommitHash,
+			"author":      author,
+			"message":     message,
+		}
+	}
+
+	toSet := map[string]any{
+		"origin":          gitRepository.Origin,
+		"api_integration": gitRepository.ApiIntegration,
+		"git_credentials": gitCredentials,
+		"comment":         comment,
+		"branches":        branchesResult,
+		"tags":            tagsResult,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitRepository(t *testing.T) {
+	apiIntegrationId, apiIntegrationCleanup := acc.TestClient().ApiIntegration.CreateGitApiIntegration(t)
+	t.Cleanup(apiIntegrationCleanup)
+	gitRepository, gitRepositoryCleanup := acc.TestClient().GitRepository.Create(t, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: gitRepositoryConfig(gitRepository.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_git_repository.test", "origin", helpers.GitRepositoryOrigin),
+					resource.TestCheckResourceAttr("data.snowflake_git_repository.test", "api_integration", apiIntegrationId.Name()),
+					resource.TestCheckResourceAttrSet("data.snowflake_git_repository.test", "branches.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_git_repository.test", "branches.*", map[string]string{
+						"name": "main",
+						"path": "/branches/main",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_git_repository.test", "tags.*", map[string]string{
+						"name": "v0.90.0",
+						"path": "/tags/v0.90.0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func gitRepositoryConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+data "snowflake_git_repository" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
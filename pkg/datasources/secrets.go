@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretsSchema = map[string]*schema.Schema{
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"in": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "IN clause to filter the list of secrets.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					Description:  "Returns records for the entire account.",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+				"database": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Returns records for the current database in use or for a specified database (db_name).",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+				"schema": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Returns records for the current schema in use or a specified schema (schema_name). Has to be provided as fully qualified name, e.g. `database_name.schema_name`.",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+			},
+		},
+	},
+	"secrets": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW SECRETS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"oauth_scopes": {
+					Type:     schema.TypeSet,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"owner_role_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Secrets() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered secrets. Filtering is aligned with the current possibilities for [SHOW SECRETS](https://docs.snowflake.com/en/sql-reference/sql/show-secrets) query. Sensitive values stored in the secrets are never returned.",
+		ReadContext: ReadContextSecrets,
+		Schema:      secretsSchema,
+	}
+}
+
+func ReadContextSecrets(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowSecretRequest()
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+	if v, ok := d.GetOk("in"); ok {
+		in := v.([]any)[0].(map[string]any)
+		switch {
+		case in["account"].(bool):
+			request.WithIn(sdk.In{Account: sdk.Bool(true)})
+		case in["database"].(string) != "":
+			request.WithIn(sdk.In{Database: sdk.NewAccountObjectIdentifier(in["database"].(string))})
+		case in["schema"].(string) != "":
+			request.WithIn(sdk.In{Schema: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(in["schema"].(string))})
+		}
+	}
+
+	secrets, err := client.Secrets.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("secrets_read")
+
+	result := make([]map[string]any, len(secrets))
+	for i, secret := range secrets {
+		var comment string
+		if secret.Comment != nil {
+			comment = *secret.Comment
+		}
+		result[i] = map[string]any{
+			"name":            secret.Name,
+			"database":        secret.DatabaseName,
+			"schema":          secret.SchemaName,
+			"owner":           secret.Owner,
+			"comment":         comment,
+			"secret_type":     string(secret.SecretType),
+			"oauth_scopes":    secret.OauthScopes,
+			"owner_role_type": secret.OwnerRoleType,
+		}
+	}
+	if err := d.Set("secrets", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
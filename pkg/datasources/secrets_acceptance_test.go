@@ -0,0 +1,57 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Secrets(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secretsConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.0.name", id.Name()),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.0.database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.0.schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.0.comment", "some comment"),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.test", "secrets.0.secret_type", "GENERIC_STRING"),
+					resource.TestCheckResourceAttrSet("data.snowflake_secrets.test", "secrets.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func secretsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret_with_generic_string" "test" {
+	name          = "%[1]s"
+	database      = "%[2]s"
+	schema        = "%[3]s"
+	secret_string = "foo"
+	comment       = "some comment"
+}
+
+data "snowflake_secrets" "test" {
+	like = snowflake_secret_with_generic_string.test.name
+	in {
+		schema = "\"%[2]s\".\"%[3]s\""
+	}
+	depends_on = [snowflake_secret_with_generic_string.test]
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName)
+}
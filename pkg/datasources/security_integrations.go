@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var securityIntegrationsSchema = map[string]*schema.Schema{
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESCRIBE SECURITY INTEGRATION for each security integration returned by SHOW SECURITY INTEGRATIONS. The output of describe is saved to the describe_output field. By default this value is set to true.",
+	},
+	"security_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW SECURITY INTEGRATIONS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"integration_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"category": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"describe_output": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE SECURITY INTEGRATION.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"default": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func SecurityIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered security integrations. Filtering is aligned with the current possibilities for [SHOW SECURITY INTEGRATIONS](https://docs.snowflake.com/en/sql-reference/sql/show-integrations) query. The results of SHOW and DESCRIBE are encapsulated in one output collection.",
+		ReadContext: ReadContextSecurityIntegrations,
+		Schema:      securityIntegrationsSchema,
+	}
+}
+
+func ReadContextSecurityIntegrations(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowSecurityIntegrationRequest()
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	securityIntegrations, err := client.SecurityIntegrations.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("security_integrations_read")
+
+	result := make([]map[string]any, len(securityIntegrations))
+	for i, integration := range securityIntegrations {
+		describeOutput := make([]map[string]any, 0)
+		if d.Get("with_describe").(bool) {
+			properties, err := client.SecurityIntegrations.Describe(ctx, sdk.NewAccountObjectIdentifier(integration.Name))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, property := range properties {
+				describeOutput = append(describeOutput, map[string]any{
+					"name":    property.Name,
+					"type":    property.Type,
+					"value":   property.Value,
+					"default": property.Default,
+				})
+			}
+		}
+		result[i] = map[string]any{
+			"name":             integration.Name,
+			"integration_type": integration.IntegrationType,
+			"category":         integration.Category,
+			"enabled":          integration.Enabled,
+			"comment":          integration.Comment,
+			"created_on":       integration.CreatedOn.String(),
+			"describe_output":  describeOutput,
+		}
+	}
+	if err := d.Set("security_integrations", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
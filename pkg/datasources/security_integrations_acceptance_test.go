@@ -0,0 +1,63 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecurityIntegrations(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: securityIntegrationsConfig(id.Name(), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.name", id.Name()),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.integration_type", "SCIM - GENERIC"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.category", "SECURITY"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.comment", "some comment"),
+					resource.TestCheckResourceAttrSet("data.snowflake_security_integrations.test", "security_integrations.0.created_on"),
+					resource.TestCheckResourceAttrSet("data.snowflake_security_integrations.test", "security_integrations.0.describe_output.#"),
+				),
+			},
+			{
+				Config: securityIntegrationsConfig(id.Name(), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.test", "security_integrations.0.describe_output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func securityIntegrationsConfig(name string, withDescribe bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_scim_security_integration" "test" {
+	name        = "%[1]s"
+	enabled     = false
+	scim_client = "GENERIC"
+	run_as_role = "GENERIC_SCIM_PROVISIONER"
+	comment     = "some comment"
+}
+
+data "snowflake_security_integrations" "test" {
+	like          = snowflake_scim_security_integration.test.name
+	with_describe = %[2]t
+	depends_on    = [snowflake_scim_security_integration.test]
+}
+`, name, withDescribe)
+}
@@ -0,0 +1,180 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPoliciesSchema = map[string]*schema.Schema{
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"in": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "IN clause to filter the list of session policies.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					Description:  "Returns records for the entire account.",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+				"database": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Returns records for the current database in use or for a specified database (db_name).",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+				"schema": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Returns records for the current schema in use or a specified schema (schema_name). Has to be provided as fully qualified name, e.g. `database_name.schema_name`.",
+					ExactlyOneOf: []string{"in.0.account", "in.0.database", "in.0.schema"},
+				},
+			},
+		},
+	},
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESCRIBE SESSION POLICY for each session policy returned by SHOW SESSION POLICIES. The output of describe is saved to the describe_output field. By default this value is set to true.",
+	},
+	"session_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW SESSION POLICIES.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"kind": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner_role_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"describe_output": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE SESSION POLICY.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"session_idle_timeout_mins": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"session_ui_idle_timeout_mins": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"allowed_secondary_roles": {
+								Type:     schema.TypeSet,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func SessionPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered session policies. Filtering is aligned with the current possibilities for [SHOW SESSION POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-session-policies) query. The results of SHOW and DESCRIBE are encapsulated in one output collection.",
+		ReadContext: ReadContextSessionPolicies,
+		Schema:      sessionPoliciesSchema,
+	}
+}
+
+func ReadContextSessionPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	request := sdk.NewShowSessionPolicyRequest()
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+	if v, ok := d.GetOk("in"); ok {
+		in := v.([]any)[0].(map[string]any)
+		switch {
+		case in["account"].(bool):
+			request.WithIn(&sdk.In{Account: sdk.Bool(true)})
+		case in["database"].(string) != "":
+			request.WithIn(&sdk.In{Database: sdk.NewAccountObjectIdentifier(in["database"].(string))})
+		case in["schema"].(string) != "":
+			request.WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(in["schema"].(string))})
+		}
+	}
+
+	sessionPolicies, err := client.SessionPolicies.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("session_policies_read")
+
+	result := make([]map[string]any, len(sessionPolicies))
+	for i, sessionPolicy := range sessionPolicies {
+		describeOutput := make([]map[string]any, 0)
+		if d.Get("with_describe").(bool) {
+			description, err := client.SessionPolicies.Describe(ctx, sessionPolicy.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			describeOutput = append(describeOutput, map[string]any{
+				"session_idle_timeout_mins":    description.SessionIdleTimeoutMins,
+				"session_ui_idle_timeout_mins": description.SessionUIIdleTimeoutMins,
+				"allowed_secondary_roles":      description.AllowedSecondaryRoles,
+			})
+		}
+		result[i] = map[string]any{
+			"name":            sessionPolicy.Name,
+			"database":        sessionPolicy.DatabaseName,
+			"schema":          sessionPolicy.SchemaName,
+			"kind":            sessionPolicy.Kind,
+			"owner":           sessionPolicy.Owner,
+			"comment":         sessionPolicy.Comment,
+			"owner_role_type": sessionPolicy.OwnerRoleType,
+			"created_on":      sessionPolicy.CreatedOn,
+			"describe_output": describeOutput,
+		}
+	}
+	if err := d.Set("session_policies", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SessionPolicies(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPoliciesConfig(id.Name(), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.name", id.Name()),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.kind", "SESSION_POLICY"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.comment", "some comment"),
+					resource.TestCheckResourceAttrSet("data.snowflake_session_policies.test", "session_policies.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.describe_output.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.describe_output.0.session_idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.describe_output.0.session_ui_idle_timeout_mins", "15"),
+				),
+			},
+			{
+				Config: sessionPoliciesConfig(id.Name(), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.test", "session_policies.0.describe_output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func sessionPoliciesConfig(name string, withDescribe bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_session_policy" "test" {
+	name                         = "%[1]s"
+	database                     = "%[2]s"
+	schema                       = "%[3]s"
+	session_idle_timeout_mins    = 30
+	session_ui_idle_timeout_mins = 15
+	comment                      = "some comment"
+}
+
+data "snowflake_session_policies" "test" {
+	like = snowflake_session_policy.test.name
+	in {
+		schema = "\"%[2]s\".\"%[3]s\""
+	}
+	with_describe = %[4]t
+	depends_on    = [snowflake_session_policy.test]
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, withDescribe)
+}
@@ -0,0 +1,108 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the streamlits.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the streamlits.",
+	},
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (`%` and `_`).",
+	},
+	"streamlits": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW STREAMLITS.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"query_warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"url_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Streamlits() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered streamlits. Filtering is aligned with the current possibilities for [SHOW STREAMLITS](https://docs.snowflake.com/en/sql-reference/sql/show-streamlits) query.",
+		ReadContext: ReadContextStreamlits,
+		Schema:      streamlitsSchema,
+	}
+}
+
+func ReadContextStreamlits(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+
+	request := sdk.NewShowStreamlitRequest().WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)})
+	if v, ok := d.GetOk("like"); ok {
+		request.WithLike(&sdk.Like{Pattern: sdk.String(v.(string))})
+	}
+
+	streamlits, err := client.Streamlits.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+
+	result := make([]map[string]any, len(streamlits))
+	for i, streamlit := range streamlits {
+		result[i] = map[string]any{
+			"name":            streamlit.Name,
+			"database":        streamlit.DatabaseName,
+			"schema":          streamlit.SchemaName,
+			"query_warehouse": streamlit.QueryWarehouse,
+			"title":           streamlit.Title,
+			"comment":         streamlit.Comment,
+			"url_id":          streamlit.UrlId,
+		}
+	}
+	if err := d.Set("streamlits", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
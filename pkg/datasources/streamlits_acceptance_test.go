@@ -0,0 +1,61 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Streamlits(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: streamlitsConfig(name, stage.Location()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.0.name", name),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.0.database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.0.schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.0.title", "title"),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.test", "streamlits.0.comment", "foo"),
+					resource.TestCheckResourceAttrSet("data.snowflake_streamlits.test", "streamlits.0.url_id"),
+				),
+			},
+		},
+	})
+}
+
+func streamlitsConfig(name string, rootLocation string) string {
+	return fmt.Sprintf(`
+resource "snowflake_streamlit" "test" {
+	name          = "%[1]s"
+	database      = "%[2]s"
+	schema        = "%[3]s"
+	root_location = %[4]q
+	main_file     = "streamlit_app.py"
+	title         = "title"
+	comment       = "foo"
+}
+
+data "snowflake_streamlits" "test" {
+	database   = snowflake_streamlit.test.database
+	schema     = snowflake_streamlit.test.schema
+	like       = snowflake_streamlit.test.name
+	depends_on = [snowflake_streamlit.test]
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, rootLocation)
+}
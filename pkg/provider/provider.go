@@ -451,69 +451,108 @@ func GetGrantResources() resources.TerraformGrantResources {
 func getResources() map[string]*schema.Resource {
 	// NOTE(): do not add grant resources here
 	others := map[string]*schema.Resource{
-		"snowflake_account":                                 resources.Account(),
-		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
-		"snowflake_account_parameter":                       resources.AccountParameter(),
-		"snowflake_alert":                                   resources.Alert(),
-		"snowflake_api_integration":                         resources.APIIntegration(),
-		"snowflake_database":                                resources.Database(),
-		"snowflake_database_role":                           resources.DatabaseRole(),
-		"snowflake_dynamic_table":                           resources.DynamicTable(),
-		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
-		"snowflake_external_function":                       resources.ExternalFunction(),
-		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
-		"snowflake_external_table":                          resources.ExternalTable(),
-		"snowflake_failover_group":                          resources.FailoverGroup(),
-		"snowflake_file_format":                             resources.FileFormat(),
-		"snowflake_function":                                resources.Function(),
-		"snowflake_grant_account_role":                      resources.GrantAccountRole(),
-		"snowflake_grant_application_role":                  resources.GrantApplicationRole(),
-		"snowflake_grant_database_role":                     resources.GrantDatabaseRole(),
-		"snowflake_grant_ownership":                         resources.GrantOwnership(),
-		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
-		"snowflake_grant_privileges_to_account_role":        resources.GrantPrivilegesToAccountRole(),
-		"snowflake_grant_privileges_to_database_role":       resources.GrantPrivilegesToDatabaseRole(),
-		"snowflake_grant_privileges_to_share":               resources.GrantPrivilegesToShare(),
-		"snowflake_managed_account":                         resources.ManagedAccount(),
-		"snowflake_masking_policy":                          resources.MaskingPolicy(),
-		"snowflake_materialized_view":                       resources.MaterializedView(),
-		"snowflake_network_policy":                          resources.NetworkPolicy(),
-		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
-		"snowflake_network_rule":                            resources.NetworkRule(),
-		"snowflake_notification_integration":                resources.NotificationIntegration(),
-		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
-		"snowflake_object_parameter":                        resources.ObjectParameter(),
-		"snowflake_password_policy":                         resources.PasswordPolicy(),
-		"snowflake_pipe":                                    resources.Pipe(),
-		"snowflake_procedure":                               resources.Procedure(),
-		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
-		"snowflake_role":                                    resources.Role(),
-		"snowflake_role_grants":                             resources.RoleGrants(),
-		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
-		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
-		"snowflake_saml_integration":                        resources.SAMLIntegration(),
-		"snowflake_schema":                                  resources.Schema(),
-		"snowflake_scim_integration":                        resources.SCIMIntegration(),
-		"snowflake_sequence":                                resources.Sequence(),
-		"snowflake_session_parameter":                       resources.SessionParameter(),
-		"snowflake_share":                                   resources.Share(),
-		"snowflake_stage":                                   resources.Stage(),
-		"snowflake_storage_integration":                     resources.StorageIntegration(),
-		"snowflake_stream":                                  resources.Stream(),
-		"snowflake_table":                                   resources.Table(),
-		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
-		"snowflake_table_constraint":                        resources.TableConstraint(),
-		"snowflake_tag":                                     resources.Tag(),
-		"snowflake_tag_association":                         resources.TagAssociation(),
-		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
-		"snowflake_task":                                    resources.Task(),
-		"snowflake_unsafe_execute":                          resources.UnsafeExecute(),
-		"snowflake_user":                                    resources.User(),
-		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
-		"snowflake_user_password_policy_attachment":         resources.UserPasswordPolicyAttachment(),
-		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
-		"snowflake_view":                                    resources.View(),
-		"snowflake_warehouse":                               resources.Warehouse(),
+		"snowflake_account":                                    resources.Account(),
+		"snowflake_account_password_policy_attachment":         resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_authentication_policy_attachment":   resources.AccountAuthenticationPolicyAttachment(),
+		"snowflake_account_session_policy_attachment":          resources.AccountSessionPolicyAttachment(),
+		"snowflake_account_packages_policy_attachment":         resources.AccountPackagesPolicyAttachment(),
+		"snowflake_account_parameter":                          resources.AccountParameter(),
+		"snowflake_aggregation_policy":                         resources.AggregationPolicy(),
+		"snowflake_aggregation_policy_association":             resources.AggregationPolicyAssociation(),
+		"snowflake_alert":                                      resources.Alert(),
+		"snowflake_api_integration":                            resources.APIIntegration(),
+		"snowflake_application_package":                        resources.ApplicationPackage(),
+		"snowflake_application_package_patch":                  resources.ApplicationPackagePatch(),
+		"snowflake_application_package_release_directive":      resources.ApplicationPackageReleaseDirective(),
+		"snowflake_application_package_version":                resources.ApplicationPackageVersion(),
+		"snowflake_authentication_policy":                      resources.AuthenticationPolicy(),
+		"snowflake_catalog_integration":                        resources.CatalogIntegration(),
+		"snowflake_compute_pool":                               resources.ComputePool(),
+		"snowflake_database":                                   resources.Database(),
+		"snowflake_database_role":                              resources.DatabaseRole(),
+		"snowflake_dynamic_table":                              resources.DynamicTable(),
+		"snowflake_email_notification_integration":             resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                                resources.EventTable(),
+		"snowflake_event_table_association":                    resources.EventTableAssociation(),
+		"snowflake_external_access_integration":                resources.ExternalAccessIntegration(),
+		"snowflake_external_function":                          resources.ExternalFunction(),
+		"snowflake_external_oauth_integration":                 resources.ExternalOauthIntegration(),
+		"snowflake_external_table":                             resources.ExternalTable(),
+		"snowflake_external_volume":                            resources.ExternalVolume(),
+		"snowflake_failover_group":                             resources.FailoverGroup(),
+		"snowflake_file_format":                                resources.FileFormat(),
+		"snowflake_function":                                   resources.Function(),
+		"snowflake_git_repository":                             resources.GitRepository(),
+		"snowflake_grant_account_role":                         resources.GrantAccountRole(),
+		"snowflake_grant_application_role":                     resources.GrantApplicationRole(),
+		"snowflake_grant_database_role":                        resources.GrantDatabaseRole(),
+		"snowflake_grant_ownership":                            resources.GrantOwnership(),
+		"snowflake_grant_privileges_to_role":                   resources.GrantPrivilegesToRole(),
+		"snowflake_grant_privileges_to_account_role":           resources.GrantPrivilegesToAccountRole(),
+		"snowflake_grant_privileges_to_database_role":          resources.GrantPrivilegesToDatabaseRole(),
+		"snowflake_grant_privileges_to_share":                  resources.GrantPrivilegesToShare(),
+		"snowflake_hybrid_table":                               resources.HybridTable(),
+		"snowflake_iceberg_table":                              resources.IcebergTable(),
+		"snowflake_image_repository":                           resources.ImageRepository(),
+		"snowflake_managed_account":                            resources.ManagedAccount(),
+		"snowflake_masking_policy":                             resources.MaskingPolicy(),
+		"snowflake_materialized_view":                          resources.MaterializedView(),
+		"snowflake_network_policy":                             resources.NetworkPolicy(),
+		"snowflake_network_policy_attachment":                  resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                               resources.NetworkRule(),
+		"snowflake_notification_integration":                   resources.NotificationIntegration(),
+		"snowflake_oauth_integration":                          resources.OAuthIntegration(),
+		"snowflake_oauth_integration_for_custom_clients":       resources.OauthIntegrationForCustomClients(),
+		"snowflake_oauth_integration_for_partner_applications": resources.OauthIntegrationForPartnerApplications(),
+		"snowflake_object_parameter":                           resources.ObjectParameter(),
+		"snowflake_packages_policy":                            resources.PackagesPolicy(),
+		"snowflake_password_policy":                            resources.PasswordPolicy(),
+		"snowflake_pipe":                                       resources.Pipe(),
+		"snowflake_primary_connection":                         resources.PrimaryConnection(),
+		"snowflake_procedure":                                  resources.Procedure(),
+		"snowflake_projection_policy":                          resources.ProjectionPolicy(),
+		"snowflake_projection_policy_association":              resources.ProjectionPolicyAssociation(),
+		"snowflake_replication_group":                          resources.ReplicationGroup(),
+		"snowflake_resource_monitor":                           resources.ResourceMonitor(),
+		"snowflake_role":                                       resources.Role(),
+		"snowflake_role_grants":                                resources.RoleGrants(),
+		"snowflake_role_ownership_grant":                       resources.RoleOwnershipGrant(),
+		"snowflake_row_access_policy":                          resources.RowAccessPolicy(),
+		"snowflake_saml_integration":                           resources.SAMLIntegration(),
+		"snowflake_saml2_integration":                          resources.Saml2Integration(),
+		"snowflake_schema":                                     resources.Schema(),
+		"snowflake_scim_integration":                           resources.SCIMIntegration(),
+		"snowflake_scim_security_integration":                  resources.ScimSecurityIntegration(),
+		"snowflake_secondary_connection":                       resources.SecondaryConnection(),
+		"snowflake_secret_with_authorization_code_grant":       resources.SecretWithAuthorizationCodeGrant(),
+		"snowflake_secret_with_basic_authentication":           resources.SecretWithBasicAuthentication(),
+		"snowflake_secret_with_client_credentials":             resources.SecretWithClientCredentials(),
+		"snowflake_secret_with_generic_string":                 resources.SecretWithGenericString(),
+		"snowflake_sequence":                                   resources.Sequence(),
+		"snowflake_service":                                    resources.Service(),
+		"snowflake_session_parameter":                          resources.SessionParameter(),
+		"snowflake_session_policy":                             resources.SessionPolicy(),
+		"snowflake_share":                                      resources.Share(),
+		"snowflake_stage":                                      resources.Stage(),
+		"snowflake_storage_integration":                        resources.StorageIntegration(),
+		"snowflake_stream":                                     resources.Stream(),
+		"snowflake_streamlit":                                  resources.Streamlit(),
+		"snowflake_table":                                      resources.Table(),
+		"snowflake_table_column_masking_policy_application":    resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_constraint":                           resources.TableConstraint(),
+		"snowflake_tag":                                        resources.Tag(),
+		"snowflake_tag_association":                            resources.TagAssociation(),
+		"snowflake_tag_masking_policy_association":             resources.TagMaskingPolicyAssociation(),
+		"snowflake_task":                                       resources.Task(),
+		"snowflake_unsafe_execute":                             resources.UnsafeExecute(),
+		"snowflake_user":                                       resources.User(),
+		"snowflake_user_authentication_policy_attachment":      resources.UserAuthenticationPolicyAttachment(),
+		"snowflake_user_ownership_grant":                       resources.UserOwnershipGrant(),
+		"snowflake_user_password_policy_attachment":            resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_public_keys":                           resources.UserPublicKeys(),
+		"snowflake_user_session_policy_attachment":             resources.UserSessionPolicyAttachment(),
+		"snowflake_view":                                       resources.View(),
+		"snowflake_warehouse":                                  resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -526,6 +565,8 @@ func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_application_package_versions":       datasources.ApplicationPackageVersions(),
+		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
 		"snowflake_database":                           datasources.Database(),
@@ -535,9 +576,12 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
+		"snowflake_external_volumes":                   datasources.ExternalVolumes(),
+		"snowflake_failover_group_refresh_progress":    datasources.FailoverGroupRefreshProgress(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
+		"snowflake_git_repository":                     datasources.GitRepository(),
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
@@ -549,10 +593,14 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
+		"snowflake_secrets":                            datasources.Secrets(),
+		"snowflake_security_integrations":              datasources.SecurityIntegrations(),
 		"snowflake_sequences":                          datasources.Sequences(),
+		"snowflake_session_policies":                   datasources.SessionPolicies(),
 		"snowflake_shares":                             datasources.Shares(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
+		"snowflake_streamlits":                         datasources.Streamlits(),
 		"snowflake_streams":                            datasources.Streams(),
 		"snowflake_system_generate_scim_access_token":  datasources.SystemGenerateSCIMAccessToken(),
 		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
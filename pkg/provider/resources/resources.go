@@ -3,42 +3,70 @@ package resources
 type resource string
 
 const (
-	Account                      resource = "snowflake_account"
-	Alert                        resource = "snowflake_alert"
-	ApiIntegration               resource = "snowflake_api_integration"
-	Database                     resource = "snowflake_database"
-	DatabaseRole                 resource = "snowflake_database_role"
-	DynamicTable                 resource = "snowflake_dynamic_table"
-	EmailNotificationIntegration resource = "snowflake_email_notification_integration"
-	ExternalFunction             resource = "snowflake_external_function"
-	ExternalTable                resource = "snowflake_external_table"
-	FailoverGroup                resource = "snowflake_failover_group"
-	FileFormat                   resource = "snowflake_file_format"
-	Function                     resource = "snowflake_function"
-	ManagedAccount               resource = "snowflake_managed_account"
-	MaskingPolicy                resource = "snowflake_masking_policy"
-	MaterializedView             resource = "snowflake_materialized_view"
-	NetworkPolicy                resource = "snowflake_network_policy"
-	NetworkRule                  resource = "snowflake_network_rule"
-	NotificationIntegration      resource = "snowflake_notification_integration"
-	PasswordPolicy               resource = "snowflake_password_policy"
-	Pipe                         resource = "snowflake_pipe"
-	Procedure                    resource = "snowflake_procedure"
-	ResourceMonitor              resource = "snowflake_resource_monitor"
-	Role                         resource = "snowflake_role"
-	RowAccessPolicy              resource = "snowflake_row_access_policy"
-	Schema                       resource = "snowflake_schema"
-	Sequence                     resource = "snowflake_sequence"
-	Share                        resource = "snowflake_share"
-	Stage                        resource = "snowflake_stage"
-	StorageIntegration           resource = "snowflake_storage_integration"
-	Stream                       resource = "snowflake_stream"
-	Table                        resource = "snowflake_table"
-	Tag                          resource = "snowflake_tag"
-	Task                         resource = "snowflake_task"
-	User                         resource = "snowflake_user"
-	View                         resource = "snowflake_view"
-	Warehouse                    resource = "snowflake_warehouse"
+	Account                                resource = "snowflake_account"
+	AggregationPolicy                      resource = "snowflake_aggregation_policy"
+	Alert                                  resource = "snowflake_alert"
+	ApiIntegration                         resource = "snowflake_api_integration"
+	ApplicationPackage                     resource = "snowflake_application_package"
+	AuthenticationPolicy                   resource = "snowflake_authentication_policy"
+	CatalogIntegration                     resource = "snowflake_catalog_integration"
+	ComputePool                            resource = "snowflake_compute_pool"
+	Database                               resource = "snowflake_database"
+	DatabaseRole                           resource = "snowflake_database_role"
+	DynamicTable                           resource = "snowflake_dynamic_table"
+	EmailNotificationIntegration           resource = "snowflake_email_notification_integration"
+	EventTable                             resource = "snowflake_event_table"
+	ExternalAccessIntegration              resource = "snowflake_external_access_integration"
+	ExternalFunction                       resource = "snowflake_external_function"
+	ExternalTable                          resource = "snowflake_external_table"
+	ExternalVolume                         resource = "snowflake_external_volume"
+	FailoverGroup                          resource = "snowflake_failover_group"
+	FileFormat                             resource = "snowflake_file_format"
+	Function                               resource = "snowflake_function"
+	GitRepository                          resource = "snowflake_git_repository"
+	HybridTable                            resource = "snowflake_hybrid_table"
+	IcebergTable                           resource = "snowflake_iceberg_table"
+	ImageRepository                        resource = "snowflake_image_repository"
+	ManagedAccount                         resource = "snowflake_managed_account"
+	MaskingPolicy                          resource = "snowflake_masking_policy"
+	MaterializedView                       resource = "snowflake_materialized_view"
+	NetworkPolicy                          resource = "snowflake_network_policy"
+	NetworkRule                            resource = "snowflake_network_rule"
+	NotificationIntegration                resource = "snowflake_notification_integration"
+	OauthIntegrationForCustomClients       resource = "snowflake_oauth_integration_for_custom_clients"
+	OauthIntegrationForPartnerApplications resource = "snowflake_oauth_integration_for_partner_applications"
+	PackagesPolicy                         resource = "snowflake_packages_policy"
+	PasswordPolicy                         resource = "snowflake_password_policy"
+	Pipe                                   resource = "snowflake_pipe"
+	PrimaryConnection                      resource = "snowflake_primary_connection"
+	Procedure                              resource = "snowflake_procedure"
+	ProjectionPolicy                       resource = "snowflake_projection_policy"
+	ReplicationGroup                       resource = "snowflake_replication_group"
+	ResourceMonitor                        resource = "snowflake_resource_monitor"
+	Role                                   resource = "snowflake_role"
+	RowAccessPolicy                        resource = "snowflake_row_access_policy"
+	Saml2SecurityIntegration               resource = "snowflake_saml2_integration"
+	Schema                                 resource = "snowflake_schema"
+	ScimSecurityIntegration                resource = "snowflake_scim_security_integration"
+	SecondaryConnection                    resource = "snowflake_secondary_connection"
+	SecretWithAuthorizationCodeGrant       resource = "snowflake_secret_with_authorization_code_grant"
+	SecretWithBasicAuthentication          resource = "snowflake_secret_with_basic_authentication"
+	SecretWithClientCredentials            resource = "snowflake_secret_with_client_credentials"
+	SecretWithGenericString                resource = "snowflake_secret_with_generic_string"
+	Sequence                               resource = "snowflake_sequence"
+	Service                                resource = "snowflake_service"
+	SessionPolicy                          resource = "snowflake_session_policy"
+	Share                                  resource = "snowflake_share"
+	Stage                                  resource = "snowflake_stage"
+	StorageIntegration                     resource = "snowflake_storage_integration"
+	Stream                                 resource = "snowflake_stream"
+	Streamlit                              resource = "snowflake_streamlit"
+	Table                                  resource = "snowflake_table"
+	Tag                                    resource = "snowflake_tag"
+	Task                                   resource = "snowflake_task"
+	User                                   resource = "snowflake_user"
+	View                                   resource = "snowflake_view"
+	Warehouse                              resource = "snowflake_warehouse"
 )
 
 type Resource interface {
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountAuthenticationPolicyAttachmentSchema = map[string]*schema.Schema{
+	"authentication_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the authentication policy to apply to the current account.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+// AccountAuthenticationPolicyAttachment returns a pointer to the resource representing a authentication policy attachment to the current account.
+func AccountAuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the authentication policy to use for the current account. To set the authentication policy of a different account, use a provider alias. The attachment is not read back from Snowflake, so changes made outside of Terraform (e.g. unsetting or replacing the authentication policy) are not detected.",
+
+		Create: CreateAccountAuthenticationPolicyAttachment,
+		Read:   ReadAccountAuthenticationPolicyAttachment,
+		Delete: DeleteAccountAuthenticationPolicyAttachment,
+
+		Schema: accountAuthenticationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountAuthenticationPolicyAttachment implements schema.CreateFunc.
+func CreateAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	authenticationPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("authentication_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("authentication_policy %s is not a valid authentication policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("authentication_policy"))
+	}
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			AuthenticationPolicy: authenticationPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(authenticationPolicy))
+
+	return ReadAccountAuthenticationPolicyAttachment(d, meta)
+}
+
+func ReadAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	// Note: the attached policy is not queried, as account-level policy references can't be reliably retrieved;
+	// the resource only reflects its id, so the attachment removed outside of Terraform is not detected.
+	authenticationPolicy := helpers.DecodeSnowflakeID(d.Id())
+	if err := d.Set("authentication_policy", authenticationPolicy.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAccountAuthenticationPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			AuthenticationPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountAuthenticationPolicyAttachment(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountAuthenticationPolicyAttachmentConfig(id.DatabaseName(), id.SchemaName(), id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_authentication_policy_attachment.att", "authentication_policy", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttrSet("snowflake_account_authentication_policy_attachment.att", "id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_authentication_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountAuthenticationPolicyAttachmentConfig(databaseName, schemaName, name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "ap" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+
+resource "snowflake_account_authentication_policy_attachment" "att" {
+	authentication_policy = snowflake_authentication_policy.ap.qualified_name
+}
+`, databaseName, schemaName, name)
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountPackagesPolicyAttachmentSchema = map[string]*schema.Schema{
+	"packages_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the packages policy to apply to the current account.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+// AccountPackagesPolicyAttachment returns a pointer to the resource representing a packages policy attachment to the current account.
+func AccountPackagesPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the packages policy to use for the current account. To set the packages policy of a different account, use a provider alias. The attachment is not read back from Snowflake, so changes made outside of Terraform (e.g. unsetting or replacing the packages policy) are not detected.",
+
+		Create: CreateAccountPackagesPolicyAttachment,
+		Read:   ReadAccountPackagesPolicyAttachment,
+		Delete: DeleteAccountPackagesPolicyAttachment,
+
+		Schema: accountPackagesPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountPackagesPolicyAttachment implements schema.CreateFunc.
+func CreateAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	packagesPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("packages_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("packages_policy %s is not a valid packages policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("packages_policy"))
+	}
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			PackagesPolicy: packagesPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(packagesPolicy))
+
+	return ReadAccountPackagesPolicyAttachment(d, meta)
+}
+
+func ReadAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	// Note: the attached policy is not queried, as account-level policy references can't be reliably retrieved;
+	// the resource only reflects its id, so the attachment removed outside of Terraform is not detected.
+	packagesPolicy := helpers.DecodeSnowflakeID(d.Id())
+	if err := d.Set("packages_policy", packagesPolicy.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAccountPackagesPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			PackagesPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
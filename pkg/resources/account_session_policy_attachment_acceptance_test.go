@@ -0,0 +1,52 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountSessionPolicyAttachment(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountSessionPolicyAttachmentConfig(id.DatabaseName(), id.SchemaName(), id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_session_policy_attachment.att", "session_policy", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttrSet("snowflake_account_session_policy_attachment.att", "id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_session_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountSessionPolicyAttachmentConfig(databaseName, schemaName, name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_session_policy" "sp" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+
+resource "snowflake_account_session_policy_attachment" "att" {
+	session_policy = snowflake_session_policy.sp.qualified_name
+}
+`, databaseName, schemaName, name)
+}
@@ -0,0 +1,161 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var aggregationPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the aggregation policy; must be unique for the schema in which the aggregation policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the aggregation policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the aggregation policy.",
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the SQL expression that determines the aggregation constraint, e.g. `AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)`.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the aggregation policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the aggregation policy.",
+	},
+}
+
+// AggregationPolicy returns a pointer to the resource representing an aggregation policy.
+func AggregationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage aggregation policy objects. For more information, check [aggregation policy documentation](https://docs.snowflake.com/en/user-guide/aggregation-policies).",
+
+		CreateContext: CreateContextAggregationPolicy,
+		ReadContext:   ReadContextAggregationPolicy,
+		UpdateContext: UpdateContextAggregationPolicy,
+		DeleteContext: DeleteContextAggregationPolicy,
+
+		Schema: aggregationPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateAggregationPolicyRequest(id, d.Get("body").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.AggregationPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextAggregationPolicy(ctx, d, meta)
+}
+
+func ReadContextAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve aggregation policy. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.AggregationPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]any{
+		"name":           aggregationPolicy.Name,
+		"database":       aggregationPolicy.DatabaseName,
+		"schema":         aggregationPolicy.SchemaName,
+		"body":           description.Body,
+		"comment":        aggregationPolicy.Comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("body") {
+		if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("comment") {
+		request := sdk.NewAlterAggregationPolicyRequest(id)
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithSetComment(v.(string))
+		} else {
+			request.WithUnsetComment(true)
+		}
+		if err := client.AggregationPolicies.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextAggregationPolicy(ctx, d, meta)
+}
+
+func DeleteContextAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
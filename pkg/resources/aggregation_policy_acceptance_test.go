@@ -0,0 +1,86 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AggregationPolicy_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+	newBody := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.AggregationPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: aggregationPolicyConfig(id, body, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "body", body),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "qualified_name", id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: aggregationPolicyConfig(id, newBody, "foo"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_aggregation_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "body", newBody),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_aggregation_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset comment
+			{
+				Config: aggregationPolicyConfig(id, newBody, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_aggregation_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func aggregationPolicyConfig(id sdk.SchemaObjectIdentifier, body string, comment string) string {
+	commentLine := ""
+	if comment != "" {
+		commentLine = fmt.Sprintf(`comment  = "%s"`, comment)
+	}
+	return fmt.Sprintf(`
+resource "snowflake_aggregation_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	body     = "%[4]s"
+	%[5]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), body, commentLine)
+}
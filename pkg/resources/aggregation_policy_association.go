@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var policyAssociationObjectTypes = []string{
+	string(sdk.ObjectTypeTable),
+	string(sdk.ObjectTypeView),
+}
+
+var aggregationPolicyAssociationSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("Type of the object the aggregation policy is attached to. Valid values are: %s.", strings.Join(policyAssociationObjectTypes, " | ")),
+		ValidateFunc: validation.StringInSlice(policyAssociationObjectTypes, false),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name (`database.schema.object`) of the table or view the aggregation policy is attached to.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"aggregation_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name (`database.schema.policyname`) of the aggregation policy to attach.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"entity_keys": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Columns that uniquely identify an entity within the table or view, used for entity-level privacy. The value is not read back from Snowflake.",
+	},
+}
+
+func AggregationPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches an aggregation policy to a table or a view.",
+		Create:      CreateAggregationPolicyAssociation,
+		Read:        ReadAggregationPolicyAssociation,
+		Delete:      DeleteAggregationPolicyAssociation,
+		Schema:      aggregationPolicyAssociationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateAggregationPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(d.Get("object_type").(string))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("object_name").(string))
+	aggregationPolicy := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("aggregation_policy").(string))
+	entityKeys := expandStringList(d.Get("entity_keys").([]any))
+
+	var err error
+	switch objectType {
+	case sdk.ObjectTypeTable:
+		request := sdk.NewTableSetAggregationPolicyRequest(aggregationPolicy)
+		if len(entityKeys) > 0 {
+			request.WithEntityKey(entityKeys)
+		}
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectName).WithSetAggregationPolicy(request))
+	case sdk.ObjectTypeView:
+		request := sdk.NewViewSetAggregationPolicyRequest(aggregationPolicy)
+		if len(entityKeys) > 0 {
+			request.WithEntityKey(entityKeys)
+		}
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectName).WithSetAggregationPolicy(request))
+	default:
+		err = fmt.Errorf("unsupported object type for aggregation policy association: %s", objectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(string(objectType), objectName.FullyQualifiedName(), aggregationPolicy.FullyQualifiedName()))
+
+	return ReadAggregationPolicyAssociation(d, meta)
+}
+
+func ReadAggregationPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return fmt.Errorf("required id format 'object_type|object_name|aggregation_policy', but got: '%s'", d.Id())
+	}
+
+	// Note: there is no alphanumeric id for an association, so we retrieve the aggregation policies attached to a certain object.
+	objectType := sdk.ObjectType(strings.ToUpper(parts[0]))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parts[1])
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(objectName, sdk.PolicyEntityDomain(objectType)))
+	if err != nil {
+		return err
+	}
+
+	aggregationPolicyReferences := make([]sdk.PolicyReference, 0)
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == "AGGREGATION_POLICY" {
+			aggregationPolicyReferences = append(aggregationPolicyReferences, policyReference)
+		}
+	}
+
+	// Note: this should never happen, but just in case: so far, Snowflake only allows one Aggregation Policy per object.
+	if len(aggregationPolicyReferences) > 1 {
+		return fmt.Errorf("internal error: multiple aggregation policy references attached to an object. This should never happen")
+	}
+
+	// Note: this means the resource has been deleted outside of Terraform.
+	if len(aggregationPolicyReferences) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("object_type", string(objectType)); err != nil {
+		return err
+	}
+	if err := d.Set("object_name", objectName.FullyQualifiedName()); err != nil {
+		return err
+	}
+	if err := d.Set(
+		"aggregation_policy",
+		sdk.NewSchemaObjectIdentifier(
+			*aggregationPolicyReferences[0].PolicyDb,
+			*aggregationPolicyReferences[0].PolicySchema,
+			aggregationPolicyReferences[0].PolicyName,
+		).FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteAggregationPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(d.Get("object_type").(string))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("object_name").(string))
+
+	var err error
+	switch objectType {
+	case sdk.ObjectTypeTable:
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectName).WithUnsetAggregationPolicy(sdk.Bool(true)))
+	case sdk.ObjectTypeView:
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectName).WithUnsetAggregationPolicy(sdk.Bool(true)))
+	default:
+		err = fmt.Errorf("unsupported object type for aggregation policy association: %s", objectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AggregationPolicyAssociation_table(t *testing.T) {
+	table, tableCleanup := acc.TestClient().Table.CreateTable(t)
+	t.Cleanup(tableCleanup)
+	aggregationPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckAggregationPolicyAssociationDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: aggregationPolicyAssociationConfig(aggregationPolicyId, "TABLE", table.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "object_type", "TABLE"),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "object_name", table.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "aggregation_policy", aggregationPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "entity_keys.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "entity_keys.0", "id"),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "id", fmt.Sprintf("TABLE|%s|%s", table.ID().FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())),
+				),
+			},
+			{
+				ResourceName:            "snowflake_aggregation_policy_association.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"entity_keys"},
+			},
+		},
+	})
+}
+
+func TestAcc_AggregationPolicyAssociation_view(t *testing.T) {
+	table, tableCleanup := acc.TestClient().Table.CreateTable(t)
+	t.Cleanup(tableCleanup)
+	view, viewCleanup := acc.TestClient().View.CreateView(t, fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName()))
+	t.Cleanup(viewCleanup)
+	aggregationPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckAggregationPolicyAssociationDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: aggregationPolicyAssociationConfig(aggregationPolicyId, "VIEW", view.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "object_type", "VIEW"),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "object_name", view.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_aggregation_policy_association.test", "aggregation_policy", aggregationPolicyId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func aggregationPolicyAssociationConfig(aggregationPolicyId sdk.SchemaObjectIdentifier, objectType string, objectId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_aggregation_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+}
+
+resource "snowflake_aggregation_policy_association" "test" {
+	object_type        = "%[4]s"
+	object_name        = %[5]q
+	aggregation_policy = snowflake_aggregation_policy.test.qualified_name
+	entity_keys        = ["id"]
+}
+`, aggregationPolicyId.DatabaseName(), aggregationPolicyId.SchemaName(), aggregationPolicyId.Name(), objectType, objectId.FullyQualifiedName())
+}
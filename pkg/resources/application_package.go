@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageDistributions = []string{string(sdk.DistributionInternal), string(sdk.DistributionExternal)}
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the application package.",
+	},
+	"distribution": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      string(sdk.DistributionInternal),
+		ValidateFunc: validation.StringInSlice(applicationPackageDistributions, false),
+		Description:  fmt.Sprintf("Specifies who can install applications created from the application package. `EXTERNAL` packages go through an automated security review before they can be shared outside the organization. Valid values are (case-sensitive): %v.", applicationPackageDistributions),
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(0, 90),
+		Description:  "Specifies the number of days for which Time Travel actions can be performed on the application package. When not set, the Snowflake default applies.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the application package.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// ApplicationPackage returns a pointer to the resource representing an application package.
+func ApplicationPackage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage application package objects, which contain the data content and versions of a Snowflake Native App. Versions, patches and release directives are managed with the `snowflake_application_package_version`, `snowflake_application_package_patch` and `snowflake_application_package_release_directive` resources. For more information, check [application package documentation](https://docs.snowflake.com/en/developer-guide/native-apps/creating-app-package).",
+
+		CreateContext: CreateContextApplicationPackage,
+		ReadContext:   ReadContextApplicationPackage,
+		UpdateContext: UpdateContextApplicationPackage,
+		DeleteContext: DeleteContextApplicationPackage,
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateApplicationPackageRequest(id).
+		WithDistribution(sdk.DistributionPointer(sdk.Distribution(d.Get("distribution").(string))))
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		request.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	if err := client.ApplicationPackages.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextApplicationPackage(ctx, d, meta)
+}
+
+func ReadContextApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve application package. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]any{
+		"name":                        applicationPackage.Name,
+		"distribution":                applicationPackage.Distribution,
+		"data_retention_time_in_days": applicationPackage.RetentionTime,
+		"comment":                     applicationPackage.Comment,
+		"owner":                       applicationPackage.Owner,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewApplicationPackageSetRequest(), sdk.NewApplicationPackageUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("distribution") {
+		set.WithDistribution(sdk.DistributionPointer(sdk.Distribution(d.Get("distribution").(string))))
+		runSet = true
+	}
+	if d.HasChange("data_retention_time_in_days") {
+		set.WithDataRetentionTimeInDays(sdk.Int(d.Get("data_retention_time_in_days").(int)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetTags(setTags)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadContextApplicationPackage(ctx, d, meta)
+}
+
+func DeleteContextApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ApplicationPackages.Drop(ctx, sdk.NewDropApplicationPackageRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackage_basic(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "distribution", "INTERNAL"),
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_application_package.test", "data_retention_time_in_days"),
+					resource.TestCheckResourceAttrSet("snowflake_application_package.test", "owner"),
+				),
+			},
+			{
+				Config: applicationPackageCompleteConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_application_package.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "data_retention_time_in_days", "2"),
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_application_package.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields
+			{
+				Config: applicationPackageConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_application_package.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func applicationPackageConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "test" {
+	name = "%[1]s"
+}
+`, name)
+}
+
+func applicationPackageCompleteConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "test" {
+	name                        = "%[1]s"
+	distribution                = "INTERNAL"
+	data_retention_time_in_days = 2
+	comment                     = "foo"
+}
+`, name)
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackagePatchSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the application package containing the version.",
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier of the version to add the patch for.",
+	},
+	"using": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressUsingDiffAfterImport,
+		Description:      "Path to the stage containing the application files of the patch, e.g. `@\"db\".\"schema\".\"stage\"/v1_0`. Snowflake does not return the path, so it is not read back: changes made outside of Terraform are not detected and the value is not set on import.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the label for the patch that is displayed to consumers.",
+	},
+	"patch": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of the patch assigned by Snowflake.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the patch.",
+	},
+}
+
+// ApplicationPackagePatch returns a pointer to the resource representing a patch of an application package version.
+func ApplicationPackagePatch() *schema.Resource {
+	return &schema.Resource{
+		Description: "Adds a patch to a version of an application package. Snowflake does not support dropping a single patch, so destroying this resource only removes it from the Terraform state; the patch is dropped together with its version.",
+
+		CreateContext: CreateContextApplicationPackagePatch,
+		ReadContext:   ReadContextApplicationPackagePatch,
+		DeleteContext: DeleteContextApplicationPackagePatch,
+
+		Schema: applicationPackagePatchSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextApplicationPackagePatch(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	version := d.Get("version").(string)
+
+	request := sdk.NewAddPatchForVersionRequest(sdk.String(version), d.Get("using").(string))
+	if v, ok := d.GetOk("label"); ok {
+		request.WithLabel(sdk.String(v.(string)))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackage).WithAddPatchForVersion(request)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Note: the patch number is assigned by Snowflake, the newly added patch is the latest one of the version.
+	versions, err := client.ApplicationPackageVersions.Show(ctx, sdk.NewShowApplicationPackageVersionRequest(applicationPackage))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	patch := -1
+	for _, v := range versions {
+		if strings.EqualFold(v.Version, version) && v.Patch > patch {
+			patch = v.Patch
+		}
+	}
+	if patch == -1 {
+		return diag.FromErr(fmt.Errorf("could not find the patch added for version %s of application package %s", version, applicationPackage.Name()))
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(applicationPackage.Name(), version, strconv.Itoa(patch)))
+
+	return ReadContextApplicationPackagePatch(ctx, d, meta)
+}
+
+func ReadContextApplicationPackagePatch(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := d.Id()
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("required id format 'application_package|version|patch', but got: '%s'", id))
+	}
+	applicationPackage, version := parts[0], parts[1]
+	patch, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid patch number in id '%s': %w", id, err))
+	}
+
+	versions, err := client.ApplicationPackageVersions.Show(ctx, sdk.NewShowApplicationPackageVersionRequest(sdk.NewAccountObjectIdentifier(applicationPackage)))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(err)
+	}
+
+	var found *sdk.ApplicationPackageVersion
+	for i, v := range versions {
+		if strings.EqualFold(v.Version, version) && v.Patch == patch {
+			found = &versions[i]
+			break
+		}
+	}
+
+	if found == nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve application package patch. Target object not found. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", id),
+			},
+		}
+	}
+
+	if err := d.Set("application_package", applicationPackage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("patch", patch); err != nil {
+		return diag.FromErr(err)
+	}
+	if found.Label != nil {
+		if err := d.Set("label", *found.Label); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("state", found.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func DeleteContextApplicationPackagePatch(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	// Note: there is no way to drop a single patch, it is dropped together with its version.
+	d.SetId("")
+	return nil
+}
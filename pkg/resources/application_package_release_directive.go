@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultReleaseDirectiveName is the name under which Snowflake lists the default release directive.
+const defaultReleaseDirectiveName = "DEFAULT"
+
+var applicationPackageReleaseDirectiveSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the application package to set the release directive on.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier of a custom release directive. When not set, the default release directive of the application package is managed.",
+	},
+	"accounts": {
+		Type:         schema.TypeSet,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		ForceNew:     true,
+		RequiredWith: []string{"name"},
+		Description:  "Consumer accounts targeted by a custom release directive, in the `org_name.account_name` format. Required for custom release directives.",
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the version of the application package that consumers install and upgrade to.",
+	},
+	"patch": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the patch of the version that consumers install and upgrade to.",
+	},
+}
+
+// ApplicationPackageReleaseDirective returns a pointer to the resource representing a release directive of an application package.
+func ApplicationPackageReleaseDirective() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies which version and patch of an application package is installed by consumers, either for all of them (the default release directive) or for a set of consumer accounts (a custom release directive). Snowflake does not support unsetting the default release directive, so destroying it only removes it from the Terraform state.",
+
+		CreateContext: CreateContextApplicationPackageReleaseDirective,
+		ReadContext:   ReadContextApplicationPackageReleaseDirective,
+		UpdateContext: UpdateContextApplicationPackageReleaseDirective,
+		DeleteContext: DeleteContextApplicationPackageReleaseDirective,
+
+		Schema: applicationPackageReleaseDirectiveSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextApplicationPackageReleaseDirective(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	patch := d.Get("patch").(int)
+
+	request := sdk.NewAlterApplicationPackageRequest(applicationPackage)
+	if name == "" {
+		name = defaultReleaseDirectiveName
+		request.WithSetDefaultReleaseDirective(sdk.NewSetDefaultReleaseDirectiveRequest(version, patch))
+	} else {
+		accounts := expandStringList(d.Get("accounts").(*schema.Set).List())
+		if len(accounts) == 0 {
+			return diag.FromErr(fmt.Errorf("accounts are required for the custom release directive %s", name))
+		}
+		request.WithSetReleaseDirective(sdk.NewSetReleaseDirectiveRequest(name, accounts, version, patch))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(applicationPackage.Name(), name))
+
+	return ReadContextApplicationPackageReleaseDirective(ctx, d, meta)
+}
+
+func ReadContextApplicationPackageReleaseDirective(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := d.Id()
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("required id format 'application_package|name', but got: '%s'", id))
+	}
+	applicationPackage, name := parts[0], parts[1]
+
+	releaseDirectives, err := client.ReleaseDirectives.Show(ctx, sdk.NewShowReleaseDirectiveRequest(sdk.NewAccountObjectIdentifier(applicationPackage)))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(err)
+	}
+
+	// Note: SHOW RELEASE DIRECTIVES returns one row per target account of the custom release directive.
+	var releaseDirective *sdk.ReleaseDirective
+	accounts := make([]string, 0)
+	for i, r := range releaseDirectives {
+		if !strings.EqualFold(r.Name, name) {
+			continue
+		}
+		releaseDirective = &releaseDirectives[i]
+		if r.TargetName != nil && *r.TargetName != "" {
+			accounts = append(accounts, *r.TargetName)
+		}
+	}
+
+	if releaseDirective == nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve application package release directive. Target object not found. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", id),
+			},
+		}
+	}
+
+	if err := d.Set("application_package", applicationPackage); err != nil {
+		return diag.FromErr(err)
+	}
+	if !strings.EqualFold(name, defaultReleaseDirectiveName) {
+		if err := d.Set("name", name); err != nil {
+			return diag.FromErr(err)
+		}
+		if !equalFoldStringSets(expandStringList(d.Get("accounts").(*schema.Set).List()), accounts) {
+			if err := d.Set("accounts", accounts); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if !strings.EqualFold(d.Get("version").(string), releaseDirective.Version) {
+		if err := d.Set("version", releaseDirective.Version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("patch", releaseDirective.Patch); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextApplicationPackageReleaseDirective(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	version := d.Get("version").(string)
+	patch := d.Get("patch").(int)
+
+	if d.HasChanges("version", "patch") {
+		request := sdk.NewAlterApplicationPackageRequest(applicationPackage)
+		if name := d.Get("name").(string); name == "" {
+			request.WithSetDefaultReleaseDirective(sdk.NewSetDefaultReleaseDirectiveRequest(version, patch))
+		} else {
+			request.WithModifyReleaseDirective(sdk.NewModifyReleaseDirectiveRequest(name, version, patch))
+		}
+		if err := client.ApplicationPackages.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextApplicationPackageReleaseDirective(ctx, d, meta)
+}
+
+func DeleteContextApplicationPackageReleaseDirective(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	// Note: the default release directive cannot be unset, it is only removed from the state.
+	if name := d.Get("name").(string); name != "" {
+		applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackage).WithUnsetReleaseDirective(sdk.NewUnsetReleaseDirectiveRequest(name))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// equalFoldStringSets reports whether both lists contain the same values, ignoring the order and the case.
+func equalFoldStringSets(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, v := range a {
+		if !slices.ContainsFunc(b, func(o string) bool { return strings.EqualFold(v, o) }) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackageVersionSchema = map[string]*schema.Schema{
+	"application_package": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the application package to add the version to.",
+	},
+	"version": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier of the version, e.g. `V1_0`.",
+	},
+	"using": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressUsingDiffAfterImport,
+		Description:      "Path to the stage containing the application files (including `manifest.yml` and the setup script), e.g. `@\"db\".\"schema\".\"stage\"/v1_0`. Snowflake does not return the path, so it is not read back: changes made outside of Terraform are not detected and the value is not set on import.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the label for the version that is displayed to consumers.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the first patch of the version.",
+	},
+}
+
+// ApplicationPackageVersion returns a pointer to the resource representing a version of an application package.
+func ApplicationPackageVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Adds a version to an application package; the version is created with patch 0. Further patches are added with the `snowflake_application_package_patch` resource. A version referenced by a release directive cannot be dropped, so release directives should depend on the versions they point to.",
+
+		CreateContext: CreateContextApplicationPackageVersion,
+		ReadContext:   ReadContextApplicationPackageVersion,
+		DeleteContext: DeleteContextApplicationPackageVersion,
+
+		Schema: applicationPackageVersionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// suppressUsingDiffAfterImport ignores the stage path missing in the state of the imported version or patch, as it can't be read from Snowflake.
+func suppressUsingDiffAfterImport(_, old, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func CreateContextApplicationPackageVersion(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	applicationPackage := d.Get("application_package").(string)
+	version := d.Get("version").(string)
+
+	request := sdk.NewAddVersionRequest(d.Get("using").(string)).WithVersionIdentifier(sdk.String(version))
+	if v, ok := d.GetOk("label"); ok {
+		request.WithLabel(sdk.String(v.(string)))
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(sdk.NewAccountObjectIdentifier(applicationPackage)).WithAddVersion(request)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(applicationPackage, version))
+
+	return ReadContextApplicationPackageVersion(ctx, d, meta)
+}
+
+func ReadContextApplicationPackageVersion(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := d.Id()
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("required id format 'application_package|version', but got: '%s'", id))
+	}
+	applicationPackage, version := parts[0], parts[1]
+
+	versions, err := client.ApplicationPackageVersions.Show(ctx, sdk.NewShowApplicationPackageVersionRequest(sdk.NewAccountObjectIdentifier(applicationPackage)))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(err)
+	}
+
+	// Note: SHOW VERSIONS returns one row per patch, the version itself is described by its first patch.
+	var firstPatch *sdk.ApplicationPackageVersion
+	for i, v := range versions {
+		if strings.EqualFold(v.Version, version) && (firstPatch == nil || v.Patch < firstPatch.Patch) {
+			firstPatch = &versions[i]
+		}
+	}
+
+	if firstPatch == nil {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve application package version. Target object not found. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", id),
+			},
+		}
+	}
+
+	if err := d.Set("application_package", applicationPackage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", version); err != nil {
+		return diag.FromErr(err)
+	}
+	if firstPatch.Label != nil {
+		if err := d.Set("label", *firstPatch.Label); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("state", firstPatch.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func DeleteContextApplicationPackageVersion(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	applicationPackage := sdk.NewAccountObjectIdentifier(d.Get("application_package").(string))
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(applicationPackage).WithDropVersion(sdk.NewDropVersionRequest(d.Get("version").(string)))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
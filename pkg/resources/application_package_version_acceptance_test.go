@@ -0,0 +1,164 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackageVersion_withPatchAndReleaseDirective(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "manifest.yml", "")
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "setup.sql", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageVersionConfig(name, stage.Location(), 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package_version.test", "application_package", name),
+					resource.TestCheckResourceAttr("snowflake_application_package_version.test", "version", "V001"),
+					resource.TestCheckResourceAttr("snowflake_application_package_version.test", "label", "first version"),
+					resource.TestCheckResourceAttrSet("snowflake_application_package_version.test", "state"),
+					resource.TestCheckResourceAttr("snowflake_application_package_patch.test", "version", "V001"),
+					resource.TestCheckResourceAttr("snowflake_application_package_patch.test", "patch", "1"),
+					resource.TestCheckResourceAttr("snowflake_application_package_patch.test", "label", "first patch"),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.default", "name", ""),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.default", "version", "V001"),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.default", "patch", "0"),
+				),
+			},
+			// move the default release directive to the patch
+			{
+				Config: applicationPackageVersionConfig(name, stage.Location(), 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_application_package_release_directive.default", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("snowflake_application_package_version.test", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("snowflake_application_package_patch.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.default", "patch", "1"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_application_package_version.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"using"},
+			},
+			{
+				ResourceName:            "snowflake_application_package_patch.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"using"},
+			},
+			{
+				ResourceName:      "snowflake_application_package_release_directive.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func applicationPackageVersionConfig(name string, using string, patch int) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "test" {
+	name = "%[1]s"
+}
+
+resource "snowflake_application_package_version" "test" {
+	application_package = snowflake_application_package.test.name
+	version             = "V001"
+	using               = %[2]q
+	label               = "first version"
+}
+
+resource "snowflake_application_package_patch" "test" {
+	application_package = snowflake_application_package.test.name
+	version             = snowflake_application_package_version.test.version
+	using               = %[2]q
+	label               = "first patch"
+}
+
+resource "snowflake_application_package_release_directive" "default" {
+	application_package = snowflake_application_package.test.name
+	version             = snowflake_application_package_version.test.version
+	patch               = %[3]d
+	depends_on          = [snowflake_application_package_patch.test]
+}
+`, name, using, patch)
+}
+
+func TestAcc_ApplicationPackageVersion_customReleaseDirective(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	consumerAccount := acc.SecondaryTestClient().Account.GetAccountIdentifier(t)
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "manifest.yml", "")
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "setup.sql", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageCustomReleaseDirectiveConfig(name, stage.Location(), consumerAccount.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.custom", "name", "CUSTOM"),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.custom", "accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.custom", "version", "V001"),
+					resource.TestCheckResourceAttr("snowflake_application_package_release_directive.custom", "patch", "0"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_application_package_release_directive.custom",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func applicationPackageCustomReleaseDirectiveConfig(name string, using string, consumerAccount string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "test" {
+	name = "%[1]s"
+}
+
+resource "snowflake_application_package_version" "test" {
+	application_package = snowflake_application_package.test.name
+	version             = "V001"
+	using               = %[2]q
+}
+
+resource "snowflake_application_package_release_directive" "custom" {
+	application_package = snowflake_application_package.test.name
+	name                = "CUSTOM"
+	accounts            = [%[3]q]
+	version             = snowflake_application_package_version.test.version
+	patch               = 0
+}
+`, name, using, consumerAccount)
+}
@@ -0,0 +1,308 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	authenticationPolicyAuthenticationMethods    = []string{string(sdk.AuthenticationMethodsAll), string(sdk.AuthenticationMethodsSaml), string(sdk.AuthenticationMethodsPassword), string(sdk.AuthenticationMethodsOauth), string(sdk.AuthenticationMethodsKeyPair)}
+	authenticationPolicyMfaAuthenticationMethods = []string{string(sdk.MfaAuthenticationMethodsAll), string(sdk.MfaAuthenticationMethodsSaml), string(sdk.MfaAuthenticationMethodsPassword)}
+	authenticationPolicyMfaEnrollmentOptions     = []string{string(sdk.MfaEnrollmentRequired), string(sdk.MfaEnrollmentOptional)}
+	authenticationPolicyClientTypes              = []string{string(sdk.ClientTypesAll), string(sdk.ClientTypesSnowflakeUi), string(sdk.ClientTypesDrivers), string(sdk.ClientTypesSnowSql)}
+)
+
+var authenticationPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the authentication policy; must be unique for the schema in which the authentication policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the authentication policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the authentication policy.",
+	},
+	"authentication_methods": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(authenticationPolicyAuthenticationMethods, false)},
+		Optional:    true,
+		Description: fmt.Sprintf("A list of authentication methods that are allowed during login. Valid values are (case-sensitive): %v. When not set, the Snowflake default (ALL) applies.", authenticationPolicyAuthenticationMethods),
+	},
+	"mfa_authentication_methods": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(authenticationPolicyMfaAuthenticationMethods, false)},
+		Optional:    true,
+		Description: fmt.Sprintf("A list of authentication methods that enforce multi-factor authentication (MFA) during login. Valid values are (case-sensitive): %v. When not set, the Snowflake default applies.", authenticationPolicyMfaAuthenticationMethods),
+	},
+	"mfa_enrollment": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(authenticationPolicyMfaEnrollmentOptions, false),
+		Description:  fmt.Sprintf("Determines whether a user must enroll in multi-factor authentication. Valid values are (case-sensitive): %v. When not set, the Snowflake default (OPTIONAL) applies.", authenticationPolicyMfaEnrollmentOptions),
+	},
+	"client_types": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(authenticationPolicyClientTypes, false)},
+		Optional:    true,
+		Description: fmt.Sprintf("A list of clients that can authenticate with Snowflake. Valid values are (case-sensitive): %v. When not set, the Snowflake default (ALL) applies.", authenticationPolicyClientTypes),
+	},
+	"security_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of security integrations the authentication policy is associated with. Use `[\"ALL\"]` to allow all security integrations. When not set, the Snowflake default (ALL) applies.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the authentication policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the authentication policy.",
+	},
+}
+
+// AuthenticationPolicy returns a pointer to the resource representing an authentication policy.
+func AuthenticationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage authentication policy objects. For more information, check [authentication policy documentation](https://docs.snowflake.com/en/user-guide/authentication-policies).",
+
+		CreateContext: CreateContextAuthenticationPolicy,
+		ReadContext:   ReadContextAuthenticationPolicy,
+		UpdateContext: UpdateContextAuthenticationPolicy,
+		DeleteContext: DeleteContextAuthenticationPolicy,
+
+		Schema: authenticationPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateAuthenticationPolicyRequest(id)
+	if v, ok := d.GetOk("authentication_methods"); ok {
+		request.WithAuthenticationMethods(expandAuthenticationMethods(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("mfa_authentication_methods"); ok {
+		request.WithMfaAuthenticationMethods(expandMfaAuthenticationMethods(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("mfa_enrollment"); ok {
+		request.WithMfaEnrollment(sdk.MfaEnrollmentOption(v.(string)))
+	}
+	if v, ok := d.GetOk("client_types"); ok {
+		request.WithClientTypes(expandClientTypes(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("security_integrations"); ok {
+		request.WithSecurityIntegrations(expandSecurityIntegrations(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.AuthenticationPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextAuthenticationPolicy(ctx, d, meta)
+}
+
+func ReadContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve authentication policy. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.AuthenticationPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]any{
+		"name":           authenticationPolicy.Name,
+		"database":       authenticationPolicy.DatabaseName,
+		"schema":         authenticationPolicy.SchemaName,
+		"comment":        authenticationPolicy.Comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	// Snowflake reports the defaults when nothing was set, so keep the attributes empty in that case to avoid a permanent diff.
+	for _, property := range description {
+		switch property.Property {
+		case "AUTHENTICATION_METHODS", "MFA_AUTHENTICATION_METHODS", "CLIENT_TYPES", "SECURITY_INTEGRATIONS":
+			key := strings.ToLower(property.Property)
+			if d.Get(key).(*schema.Set).Len() == 0 && property.Value == property.Default {
+				toSet[key] = []string{}
+			} else {
+				toSet[key] = sdk.ParseCommaSeparatedStringArray(property.Value)
+			}
+		case "MFA_ENROLLMENT":
+			if d.Get("mfa_enrollment").(string) == "" && property.Value == property.Default {
+				toSet["mfa_enrollment"] = ""
+			} else {
+				toSet["mfa_enrollment"] = property.Value
+			}
+		}
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewAuthenticationPolicySetRequest(), sdk.NewAuthenticationPolicyUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("authentication_methods") {
+		if v, ok := d.GetOk("authentication_methods"); ok {
+			set.WithAuthenticationMethods(expandAuthenticationMethods(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithAuthenticationMethods(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_authentication_methods") {
+		if v, ok := d.GetOk("mfa_authentication_methods"); ok {
+			set.WithMfaAuthenticationMethods(expandMfaAuthenticationMethods(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithMfaAuthenticationMethods(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_enrollment") {
+		if v, ok := d.GetOk("mfa_enrollment"); ok {
+			set.WithMfaEnrollment(sdk.MfaEnrollmentOption(v.(string)))
+			runSet = true
+		} else {
+			unset.WithMfaEnrollment(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("client_types") {
+		if v, ok := d.GetOk("client_types"); ok {
+			set.WithClientTypes(expandClientTypes(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithClientTypes(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("security_integrations") {
+		if v, ok := d.GetOk("security_integrations"); ok {
+			set.WithSecurityIntegrations(expandSecurityIntegrations(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithSecurityIntegrations(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextAuthenticationPolicy(ctx, d, meta)
+}
+
+func DeleteContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandAuthenticationMethods(methods []any) []sdk.AuthenticationMethods {
+	result := make([]sdk.AuthenticationMethods, len(methods))
+	for i, method := range methods {
+		result[i] = sdk.AuthenticationMethods{Method: sdk.AuthenticationMethodsOption(method.(string))}
+	}
+	return result
+}
+
+func expandMfaAuthenticationMethods(methods []any) []sdk.MfaAuthenticationMethods {
+	result := make([]sdk.MfaAuthenticationMethods, len(methods))
+	for i, method := range methods {
+		result[i] = sdk.MfaAuthenticationMethods{Method: sdk.MfaAuthenticationMethodsOption(method.(string))}
+	}
+	return result
+}
+
+func expandClientTypes(clientTypes []any) []sdk.ClientTypes {
+	result := make([]sdk.ClientTypes, len(clientTypes))
+	for i, clientType := range clientTypes {
+		result[i] = sdk.ClientTypes{ClientType: sdk.ClientTypesOption(clientType.(string))}
+	}
+	return result
+}
+
+func expandSecurityIntegrations(integrations []any) []sdk.SecurityIntegrationsOption {
+	result := make([]sdk.SecurityIntegrationsOption, len(integrations))
+	for i, integration := range integrations {
+		result[i] = sdk.SecurityIntegrationsOption{Name: integration.(string)}
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AuthenticationPolicy_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.AuthenticationPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: authenticationPolicyBasicConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_enrollment", ""),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "client_types.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "security_integrations.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "qualified_name", id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: authenticationPolicyCompleteConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_authentication_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "authentication_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "authentication_methods.*", "PASSWORD"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "authentication_methods.*", "KEYPAIR"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_authentication_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "mfa_authentication_methods.*", "PASSWORD"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_enrollment", "REQUIRED"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "client_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "client_types.*", "SNOWFLAKE_UI"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "client_types.*", "DRIVERS"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "security_integrations.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.test", "security_integrations.*", "ALL"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_authentication_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields
+			{
+				Config: authenticationPolicyBasicConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_authentication_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_authentication_methods.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "mfa_enrollment", ""),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "client_types.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "security_integrations.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func authenticationPolicyBasicConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func authenticationPolicyCompleteConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "test" {
+	database                   = "%[1]s"
+	schema                     = "%[2]s"
+	name                       = "%[3]s"
+	authentication_methods     = ["PASSWORD", "KEYPAIR"]
+	mfa_authentication_methods = ["PASSWORD"]
+	mfa_enrollment             = "REQUIRED"
+	client_types               = ["SNOWFLAKE_UI", "DRIVERS"]
+	security_integrations      = ["ALL"]
+	comment                    = "foo"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
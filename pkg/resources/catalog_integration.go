@@ -0,0 +1,595 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	catalogIntegrationCatalogSources              = []string{"glue_catalog_source", "object_store_catalog_source", "polaris_catalog_source", "iceberg_rest_catalog_source"}
+	catalogIntegrationTableFormats                = []string{string(sdk.CatalogIntegrationTableFormatIceberg), string(sdk.CatalogIntegrationTableFormatDelta)}
+	catalogIntegrationCatalogApiTypes             = []string{string(sdk.CatalogIntegrationCatalogApiTypePublic), string(sdk.CatalogIntegrationCatalogApiTypeAwsApiGateway), string(sdk.CatalogIntegrationCatalogApiTypeAwsPrivateApiGateway), string(sdk.CatalogIntegrationCatalogApiTypeAwsGlue)}
+	catalogIntegrationAuthenticationTypes         = []string{"OAUTH", "BEARER", "SIGV4"}
+	catalogIntegrationCatalogNamespaceDescription = "Specifies the default namespace (database in the remote catalog) for all Iceberg tables that you associate with the catalog integration."
+)
+
+var catalogIntegrationRestAuthenticationSchema = map[string]*schema.Schema{
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(catalogIntegrationAuthenticationTypes, false),
+		Description:  fmt.Sprintf("Specifies the type of authentication to use when connecting to the catalog. Valid values are (case-sensitive): %v. Polaris catalog source supports only OAUTH.", catalogIntegrationAuthenticationTypes),
+	},
+	"oauth_token_uri": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the URL for the third-party identity provider to retrieve OAuth tokens. Used with OAUTH authentication type.",
+	},
+	"oauth_client_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the client ID of the OAuth2 credential. Required for OAUTH authentication type.",
+	},
+	"oauth_client_secret": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the secret of the OAuth2 credential. Required for OAUTH authentication type. Changed in place; the value is not read back from Snowflake.",
+	},
+	"oauth_allowed_scopes": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies one or more scopes for the OAuth token. Required for OAUTH authentication type.",
+	},
+	"bearer_token": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the bearer token for the identity provider. Required for BEARER authentication type. Changed in place; the value is not read back from Snowflake.",
+	},
+	"sigv4_iam_role": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the Amazon Resource Name (ARN) of the IAM role that has permissions to access the REST API. Required for SIGV4 authentication type.",
+	},
+	"sigv4_signing_region": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the AWS region associated with the API. Used with SIGV4 authentication type.",
+	},
+	"sigv4_external_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies an external ID that Snowflake uses to establish a trust relationship with AWS. Used with SIGV4 authentication type; generated by Snowflake when not specified (the generated value is available in `aws_external_id`).",
+	},
+}
+
+var catalogIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the catalog integration.",
+	},
+	"glue_catalog_source": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: catalogIntegrationCatalogSources,
+		Description:  "Configures the catalog integration for AWS Glue Data Catalog (CATALOG_SOURCE = GLUE, TABLE_FORMAT = ICEBERG).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"glue_aws_role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the Amazon Resource Name (ARN) of the AWS IAM role to assume.",
+				},
+				"glue_catalog_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Specifies the ID of your AWS account.",
+				},
+				"glue_region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    true,
+					Description: "Specifies the AWS Region of your AWS Glue Data Catalog. Defaults to the region of your Snowflake account when not specified.",
+				},
+				"catalog_namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: catalogIntegrationCatalogNamespaceDescription,
+				},
+			},
+		},
+	},
+	"object_store_catalog_source": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: catalogIntegrationCatalogSources,
+		Description:  "Configures the catalog integration for Iceberg or Delta metadata files in object storage (CATALOG_SOURCE = OBJECT_STORE).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"table_format": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(catalogIntegrationTableFormats, false),
+					Description:  fmt.Sprintf("Specifies the table format supplied by the catalog. Valid values are (case-sensitive): %v.", catalogIntegrationTableFormats),
+				},
+			},
+		},
+	},
+	"polaris_catalog_source": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: catalogIntegrationCatalogSources,
+		Description:  "Configures the catalog integration for Snowflake Open Catalog (Polaris) (CATALOG_SOURCE = POLARIS, TABLE_FORMAT = ICEBERG). Rest configuration and authentication are not read back from Snowflake.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"catalog_namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: catalogIntegrationCatalogNamespaceDescription,
+				},
+				"rest_config": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Specifies information about the Polaris account and catalog name.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"catalog_uri": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "Specifies the Polaris account URL.",
+							},
+							"catalog_name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "Specifies the name of the catalog to use in Polaris.",
+							},
+						},
+					},
+				},
+				"rest_authentication": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Specifies authentication details that Snowflake uses to connect to Polaris.",
+					Elem: &schema.Resource{
+						Schema: catalogIntegrationRestAuthenticationSchema,
+					},
+				},
+			},
+		},
+	},
+	"iceberg_rest_catalog_source": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: catalogIntegrationCatalogSources,
+		Description:  "Configures the catalog integration for a remote catalog that complies with the open source Apache Iceberg REST OpenAPI specification (CATALOG_SOURCE = ICEBERG_REST, TABLE_FORMAT = ICEBERG). Rest configuration and authentication are not read back from Snowflake.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"catalog_namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: catalogIntegrationCatalogNamespaceDescription,
+				},
+				"rest_config": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Specifies information about the REST API of the catalog.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"catalog_uri": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "Specifies the endpoint URL of the catalog REST API.",
+							},
+							"prefix": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+								Description: "Specifies an optional prefix appended to all API routes.",
+							},
+							"catalog_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    true,
+								Description: "Specifies the name of the catalog (the warehouse in the Iceberg REST specification).",
+							},
+							"catalog_api_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(catalogIntegrationCatalogApiTypes, false),
+								Description:  fmt.Sprintf("Specifies the connection type for the catalog API. Valid values are (case-sensitive): %v.", catalogIntegrationCatalogApiTypes),
+							},
+						},
+					},
+				},
+				"rest_authentication": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Specifies authentication details that Snowflake uses to connect to the catalog REST API.",
+					Elem: &schema.Resource{
+						Schema: catalogIntegrationRestAuthenticationSchema,
+					},
+				},
+			},
+		},
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies whether the catalog integration is available to use for Iceberg tables. The catalog integration is recreated when changed.",
+	},
+	"refresh_interval_seconds": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      30,
+		ValidateFunc: validation.IntBetween(30, 86400),
+		Description:  "Specifies the number of seconds that Snowflake waits between attempts to poll the external Iceberg catalog for metadata updates for automated refresh.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the catalog integration.",
+	},
+	"catalog_source": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The catalog source of the catalog integration.",
+	},
+	"aws_iam_user_arn": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The AWS IAM user created for your Snowflake account that has to be allowed to assume the IAM role (GLUE_AWS_IAM_USER_ARN for GLUE catalog source, API_AWS_IAM_USER_ARN for SIGV4 authentication).",
+	},
+	"aws_external_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The external ID that has to be used in the trust relationship of the IAM role (GLUE_AWS_EXTERNAL_ID for GLUE catalog source, SIGV4_EXTERNAL_ID for SIGV4 authentication).",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the catalog integration was created.",
+	},
+}
+
+// CatalogIntegration returns a pointer to the resource representing a catalog integration.
+func CatalogIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage catalog integration objects. For more information, check [catalog integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration).",
+
+		CreateContext: CreateContextCatalogIntegration,
+		ReadContext:   ReadContextCatalogIntegration,
+		UpdateContext: UpdateContextCatalogIntegration,
+		DeleteContext: DeleteContextCatalogIntegration,
+
+		Schema: catalogIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateCatalogIntegrationRequest(id, d.Get("enabled").(bool)).
+		WithRefreshIntervalSeconds(d.Get("refresh_interval_seconds").(int))
+
+	if v, ok := d.GetOk("glue_catalog_source"); ok {
+		source := v.([]any)[0].(map[string]any)
+		glue := sdk.NewGlueCatalogSourceParamsRequest(source["glue_aws_role_arn"].(string), source["glue_catalog_id"].(string))
+		if region := source["glue_region"].(string); region != "" {
+			glue.WithGlueRegion(region)
+		}
+		if namespace := source["catalog_namespace"].(string); namespace != "" {
+			glue.WithCatalogNamespace(namespace)
+		}
+		request.WithGlueCatalogSourceParams(*glue)
+	}
+	if v, ok := d.GetOk("object_store_catalog_source"); ok {
+		source := v.([]any)[0].(map[string]any)
+		request.WithObjectStoreCatalogSourceParams(*sdk.NewObjectStoreCatalogSourceParamsRequest(sdk.CatalogIntegrationTableFormat(source["table_format"].(string))))
+	}
+	if v, ok := d.GetOk("polaris_catalog_source"); ok {
+		source := v.([]any)[0].(map[string]any)
+		restConfig := source["rest_config"].([]any)[0].(map[string]any)
+		restAuthentication := source["rest_authentication"].([]any)[0].(map[string]any)
+		if authenticationType := restAuthentication["type"].(string); authenticationType != "OAUTH" {
+			return diag.FromErr(fmt.Errorf("polaris catalog source supports only OAUTH authentication type, got: %s", authenticationType))
+		}
+		oauth, err := catalogIntegrationOAuthRequest(restAuthentication)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		polaris := sdk.NewPolarisCatalogSourceParamsRequest(
+			*sdk.NewPolarisRestConfigRequest(restConfig["catalog_uri"].(string), restConfig["catalog_name"].(string)),
+			*oauth,
+		)
+		if namespace := source["catalog_namespace"].(string); namespace != "" {
+			polaris.WithCatalogNamespace(namespace)
+		}
+		request.WithPolarisCatalogSourceParams(*polaris)
+	}
+	if v, ok := d.GetOk("iceberg_rest_catalog_source"); ok {
+		source := v.([]any)[0].(map[string]any)
+		restConfigValues := source["rest_config"].([]any)[0].(map[string]any)
+		restConfig := sdk.NewIcebergRestRestConfigRequest(restConfigValues["catalog_uri"].(string))
+		if prefix := restConfigValues["prefix"].(string); prefix != "" {
+			restConfig.WithPrefix(prefix)
+		}
+		if catalogName := restConfigValues["catalog_name"].(string); catalogName != "" {
+			restConfig.WithCatalogName(catalogName)
+		}
+		if catalogApiType := restConfigValues["catalog_api_type"].(string); catalogApiType != "" {
+			restConfig.WithCatalogApiType(sdk.CatalogIntegrationCatalogApiType(catalogApiType))
+		}
+		restAuthentication, err := catalogIntegrationIcebergRestAuthenticationRequest(source["rest_authentication"].([]any)[0].(map[string]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		icebergRest := sdk.NewIcebergRestCatalogSourceParamsRequest(*restConfig, *restAuthentication)
+		if namespace := source["catalog_namespace"].(string); namespace != "" {
+			icebergRest.WithCatalogNamespace(namespace)
+		}
+		request.WithIcebergRestCatalogSourceParams(*icebergRest)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.CatalogIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextCatalogIntegration(ctx, d, meta)
+}
+
+func catalogIntegrationOAuthRequest(restAuthentication map[string]any) (*sdk.CatalogIntegrationOAuthRequest, error) {
+	clientId, clientSecret := restAuthentication["oauth_client_id"].(string), restAuthentication["oauth_client_secret"].(string)
+	scopes := restAuthentication["oauth_allowed_scopes"].([]any)
+	if clientId == "" || clientSecret == "" || len(scopes) == 0 {
+		return nil, errors.New("oauth_client_id, oauth_client_secret and oauth_allowed_scopes are required for OAUTH authentication type")
+	}
+	allowedScopes := make([]sdk.CatalogIntegrationOAuthScope, len(scopes))
+	for i, scope := range scopes {
+		allowedScopes[i] = sdk.CatalogIntegrationOAuthScope{Scope: scope.(string)}
+	}
+	oauth := sdk.NewCatalogIntegrationOAuthRequest(clientId, clientSecret, allowedScopes)
+	if tokenUri := restAuthentication["oauth_token_uri"].(string); tokenUri != "" {
+		oauth.WithOauthTokenUri(tokenUri)
+	}
+	return oauth, nil
+}
+
+func catalogIntegrationIcebergRestAuthenticationRequest(restAuthentication map[string]any) (*sdk.CatalogIntegrationIcebergRestAuthenticationRequest, error) {
+	request := sdk.NewCatalogIntegrationIcebergRestAuthenticationRequest()
+	switch authenticationType := restAuthentication["type"].(string); authenticationType {
+	case "OAUTH":
+		oauth, err := catalogIntegrationOAuthRequest(restAuthentication)
+		if err != nil {
+			return nil, err
+		}
+		request.WithOAuth(*oauth)
+	case "BEARER":
+		bearerToken := restAuthentication["bearer_token"].(string)
+		if bearerToken == "" {
+			return nil, errors.New("bearer_token is required for BEARER authentication type")
+		}
+		request.WithBearer(*sdk.NewCatalogIntegrationBearerRequest(bearerToken))
+	case "SIGV4":
+		iamRole := restAuthentication["sigv4_iam_role"].(string)
+		if iamRole == "" {
+			return nil, errors.New("sigv4_iam_role is required for SIGV4 authentication type")
+		}
+		sigV4 := sdk.NewCatalogIntegrationSigV4Request(iamRole)
+		if region := restAuthentication["sigv4_signing_region"].(string); region != "" {
+			sigV4.WithSigv4SigningRegion(region)
+		}
+		if externalId := restAuthentication["sigv4_external_id"].(string); externalId != "" {
+			sigV4.WithSigv4ExternalId(externalId)
+		}
+		request.WithSigV4(*sigV4)
+	default:
+		return nil, fmt.Errorf("unsupported authentication type: %s", authenticationType)
+	}
+	return request, nil
+}
+
+func ReadContextCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	integration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve catalog integration. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.CatalogIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	values := make(map[string]string)
+	for _, property := range properties {
+		values[property.Name] = property.Value
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	comment := ""
+	if integration.Comment != nil {
+		comment = *integration.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := values["REFRESH_INTERVAL_SECONDS"]; ok && v != "" {
+		refreshIntervalSeconds, err := strconv.Atoi(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("refresh_interval_seconds", refreshIntervalSeconds); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	catalogSource := values["CATALOG_SOURCE"]
+	if err := d.Set("catalog_source", catalogSource); err != nil {
+		return diag.FromErr(err)
+	}
+	switch sdk.CatalogIntegrationCatalogSource(catalogSource) {
+	case sdk.CatalogIntegrationCatalogSourceGlue:
+		if err := d.Set("glue_catalog_source", []any{map[string]any{
+			"glue_aws_role_arn": values["GLUE_AWS_ROLE_ARN"],
+			"glue_catalog_id":   values["GLUE_CATALOG_ID"],
+			"glue_region":       values["GLUE_REGION"],
+			"catalog_namespace": values["CATALOG_NAMESPACE"],
+		}}); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("aws_iam_user_arn", values["GLUE_AWS_IAM_USER_ARN"]); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("aws_external_id", values["GLUE_AWS_EXTERNAL_ID"]); err != nil {
+			return diag.FromErr(err)
+		}
+	case sdk.CatalogIntegrationCatalogSourceObjectStore:
+		if err := d.Set("object_store_catalog_source", []any{map[string]any{
+			"table_format": values["TABLE_FORMAT"],
+		}}); err != nil {
+			return diag.FromErr(err)
+		}
+	default:
+		// Rest configuration and authentication details (including secrets) are kept as configured.
+		if err := d.Set("aws_iam_user_arn", values["API_AWS_IAM_USER_ARN"]); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("aws_external_id", values["SIGV4_EXTERNAL_ID"]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewCatalogIntegrationSetRequest(), sdk.NewCatalogIntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("refresh_interval_seconds") {
+		runSet = true
+		set.WithRefreshIntervalSeconds(d.Get("refresh_interval_seconds").(int))
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.WithComment(comment)
+		} else {
+			runUnset = true
+			unset.WithComment(true)
+		}
+	}
+
+	if runSet {
+		if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Only one of the secrets can be changed in a single statement.
+	for _, source := range []string{"polaris_catalog_source", "iceberg_rest_catalog_source"} {
+		if d.HasChange(fmt.Sprintf("%s.0.rest_authentication.0.oauth_client_secret", source)) {
+			clientSecret := d.Get(fmt.Sprintf("%s.0.rest_authentication.0.oauth_client_secret", source)).(string)
+			restAuthentication := sdk.NewCatalogIntegrationSetRestAuthenticationRequest().WithOauthClientSecret(clientSecret)
+			if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*sdk.NewCatalogIntegrationSetRequest().WithRestAuthentication(*restAuthentication))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange(fmt.Sprintf("%s.0.rest_authentication.0.bearer_token", source)) {
+			bearerToken := d.Get(fmt.Sprintf("%s.0.rest_authentication.0.bearer_token", source)).(string)
+			restAuthentication := sdk.NewCatalogIntegrationSetRestAuthenticationRequest().WithBearerToken(bearerToken)
+			if err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).WithSet(*sdk.NewCatalogIntegrationSetRequest().WithRestAuthentication(*restAuthentication))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadContextCatalogIntegration(ctx, d, meta)
+}
+
+func DeleteContextCatalogIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,199 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_CatalogIntegration_objectStore(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.CatalogIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: catalogIntegrationObjectStoreConfig(id, "ICEBERG", 30, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "catalog_source", "OBJECT_STORE"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "object_store_catalog_source.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "object_store_catalog_source.0.table_format", "ICEBERG"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "refresh_interval_seconds", "30"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_catalog_integration.test", "created_on"),
+				),
+			},
+			// change refresh interval and comment in place
+			{
+				Config: catalogIntegrationObjectStoreConfig(id, "ICEBERG", 120, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_catalog_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "refresh_interval_seconds", "120"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "comment", "some comment"),
+				),
+			},
+			// unset comment
+			{
+				Config: catalogIntegrationObjectStoreConfig(id, "ICEBERG", 120, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "comment", ""),
+				),
+			},
+			// change table format with recreation
+			{
+				Config: catalogIntegrationObjectStoreConfig(id, "DELTA", 120, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_catalog_integration.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "object_store_catalog_source.0.table_format", "DELTA"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_catalog_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_CatalogIntegration_glue(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.CatalogIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: catalogIntegrationGlueConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "catalog_source", "GLUE"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "glue_catalog_source.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "glue_catalog_source.0.glue_aws_role_arn", "arn:aws:iam::123456789012:role/myrole"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "glue_catalog_source.0.glue_catalog_id", "123456789012"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "glue_catalog_source.0.glue_region", "us-west-2"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "glue_catalog_source.0.catalog_namespace", "my_namespace"),
+					resource.TestCheckResourceAttrSet("snowflake_catalog_integration.test", "aws_iam_user_arn"),
+					resource.TestCheckResourceAttrSet("snowflake_catalog_integration.test", "aws_external_id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_catalog_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_CatalogIntegration_icebergRest(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.CatalogIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: catalogIntegrationIcebergRestConfig(id, "token"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "catalog_source", "ICEBERG_REST"),
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "iceberg_rest_catalog_source.0.rest_authentication.0.type", "BEARER"),
+				),
+			},
+			// change bearer token in place
+			{
+				Config: catalogIntegrationIcebergRestConfig(id, "other_token"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_catalog_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_catalog_integration.test", "iceberg_rest_catalog_source.0.rest_authentication.0.bearer_token", "other_token"),
+				),
+			},
+		},
+	})
+}
+
+func catalogIntegrationObjectStoreConfig(id sdk.AccountObjectIdentifier, tableFormat string, refreshIntervalSeconds int, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_catalog_integration" "test" {
+	name                     = "%[1]s"
+	enabled                  = true
+	refresh_interval_seconds = %[3]d
+	comment                  = "%[4]s"
+
+	object_store_catalog_source {
+		table_format = "%[2]s"
+	}
+}
+`, id.Name(), tableFormat, refreshIntervalSeconds, comment)
+}
+
+func catalogIntegrationGlueConfig(id sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_catalog_integration" "test" {
+	name    = "%[1]s"
+	enabled = false
+
+	glue_catalog_source {
+		glue_aws_role_arn = "arn:aws:iam::123456789012:role/myrole"
+		glue_catalog_id   = "123456789012"
+		glue_region       = "us-west-2"
+		catalog_namespace = "my_namespace"
+	}
+}
+`, id.Name())
+}
+
+func catalogIntegrationIcebergRestConfig(id sdk.AccountObjectIdentifier, bearerToken string) string {
+	return fmt.Sprintf(`
+resource "snowflake_catalog_integration" "test" {
+	name    = "%[1]s"
+	enabled = false
+
+	iceberg_rest_catalog_source {
+		rest_config {
+			catalog_uri  = "https://example.com/api/catalog"
+			catalog_name = "my_catalog"
+		}
+		rest_authentication {
+			type         = "BEARER"
+			bearer_token = "%[2]s"
+		}
+	}
+}
+`, id.Name(), bearerToken)
+}
@@ -0,0 +1,312 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var computePoolSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the compute pool; must be unique for the account in which the compute pool is created.",
+	},
+	"for_application": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		Description:      "Specifies the name of the Snowflake Native App that the compute pool is created for. Only the application can use the compute pool.",
+	},
+	"min_nodes": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the minimum number of nodes for the compute pool.",
+	},
+	"max_nodes": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the maximum number of nodes for the compute pool; must be greater than or equal to `min_nodes`.",
+	},
+	"instance_family": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllComputePoolInstanceFamilies), true),
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			return normalizeComputePoolInstanceFamily(oldValue) == normalizeComputePoolInstanceFamily(newValue)
+		},
+		Description: fmt.Sprintf("Identifies the type of machine to provision for the nodes in the compute pool. Valid values are (case-insensitive): %s.", sdk.AsStringList(sdk.AllComputePoolInstanceFamilies)),
+	},
+	"auto_resume": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to automatically resume the compute pool when a service or job is submitted to it.",
+	},
+	"initially_suspended": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"suspended"},
+		DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+			return d.Id() != ""
+		},
+		Description: "Specifies whether the compute pool is created initially in the suspended state. Used only during creation; use `suspended` to suspend or resume an existing compute pool.",
+	},
+	"suspended": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"initially_suspended"},
+		Description:   "Suspends (`true`) or resumes (`false`) the compute pool (`ALTER COMPUTE POOL ... SUSPEND | RESUME`). The value is read back from the state of the compute pool, so when it is set, a compute pool suspended or resumed automatically (see `auto_resume` and `auto_suspend_secs`) is brought back to the configured value.",
+	},
+	"auto_suspend_secs": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      3600,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Number of seconds of inactivity after which the compute pool is automatically suspended. The value of 0 disables automatic suspension.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the compute pool.",
+	},
+	"stop_all_services_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to stop all services running on the compute pool (`ALTER COMPUTE POOL ... STOP ALL`) before dropping it. When not set, destroying a compute pool with running services fails.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current state of the compute pool (e.g. IDLE, ACTIVE, SUSPENDED, STARTING, STOPPING or RESIZING) as returned by DESCRIBE COMPUTE POOL.",
+	},
+	"status_message": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current status message of the compute pool as returned by DESCRIBE COMPUTE POOL.",
+	},
+	"num_services": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of services running on the compute pool.",
+	},
+}
+
+// ComputePool returns a pointer to the resource representing a compute pool.
+func ComputePool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage compute pool objects. For more information, check [compute pool documentation](https://docs.snowflake.com/en/developer-guide/snowpark-container-services/working-with-compute-pool).",
+
+		CreateContext: CreateContextComputePool,
+		ReadContext:   ReadContextComputePool,
+		UpdateContext: UpdateContextComputePool,
+		DeleteContext: DeleteContextComputePool,
+
+		Schema: computePoolSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportComputePool,
+		},
+	}
+}
+
+// ImportComputePool sets the default of stop_all_services_on_destroy, because it is not a property of the compute pool and can't be read back.
+func ImportComputePool(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("stop_all_services_on_destroy", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func normalizeComputePoolInstanceFamily(s string) string {
+	instanceFamily, err := sdk.ToComputePoolInstanceFamily(s)
+	if err != nil {
+		return s
+	}
+	return string(instanceFamily)
+}
+
+func CreateContextComputePool(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	minNodes, maxNodes := d.Get("min_nodes").(int), d.Get("max_nodes").(int)
+	if minNodes > maxNodes {
+		return diag.FromErr(fmt.Errorf("min_nodes (%d) can't be greater than max_nodes (%d)", minNodes, maxNodes))
+	}
+	instanceFamily, err := sdk.ToComputePoolInstanceFamily(d.Get("instance_family").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	initiallySuspended := d.Get("initially_suspended").(bool)
+	if v, ok := d.GetOk("suspended"); ok {
+		initiallySuspended = v.(bool)
+	}
+
+	request := sdk.NewCreateComputePoolRequest(id, minNodes, maxNodes, instanceFamily).
+		WithAutoResume(d.Get("auto_resume").(bool)).
+		WithInitiallySuspended(initiallySuspended).
+		WithAutoSuspendSecs(d.Get("auto_suspend_secs").(int))
+	if v, ok := d.GetOk("for_application"); ok {
+		request.WithForApplication(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.ComputePools.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextComputePool(ctx, d, meta)
+}
+
+func ReadContextComputePool(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if _, err := client.ComputePools.ShowByID(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve compute pool. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	computePool, err := client.ComputePools.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var forApplication, comment, statusMessage string
+	if computePool.Application != nil {
+		forApplication = *computePool.Application
+	}
+	if computePool.Comment != nil {
+		comment = *computePool.Comment
+	}
+	if computePool.StatusMessage != nil {
+		statusMessage = *computePool.StatusMessage
+	}
+
+	toSet := map[string]any{
+		"name":              computePool.Name,
+		"for_application":   forApplication,
+		"min_nodes":         computePool.MinNodes,
+		"max_nodes":         computePool.MaxNodes,
+		"instance_family":   string(computePool.InstanceFamily),
+		"auto_resume":       computePool.AutoResume,
+		"auto_suspend_secs": computePool.AutoSuspendSecs,
+		"comment":           comment,
+		"suspended":         computePool.State == sdk.ComputePoolStateSuspended,
+		"state":             string(computePool.State),
+		"status_message":    statusMessage,
+		"num_services":      computePool.NumServices,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextComputePool(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChanges("min_nodes", "max_nodes") {
+		minNodes, maxNodes := d.Get("min_nodes").(int), d.Get("max_nodes").(int)
+		if minNodes > maxNodes {
+			return diag.FromErr(fmt.Errorf("min_nodes (%d) can't be greater than max_nodes (%d)", minNodes, maxNodes))
+		}
+	}
+
+	set := sdk.NewComputePoolSetRequest()
+	runSet := false
+	if d.HasChange("min_nodes") {
+		set.WithMinNodes(d.Get("min_nodes").(int))
+		runSet = true
+	}
+	if d.HasChange("max_nodes") {
+		set.WithMaxNodes(d.Get("max_nodes").(int))
+		runSet = true
+	}
+	if d.HasChange("auto_resume") {
+		set.WithAutoResume(d.Get("auto_resume").(bool))
+		runSet = true
+	}
+	if d.HasChange("auto_suspend_secs") {
+		set.WithAutoSuspendSecs(d.Get("auto_suspend_secs").(int))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			if err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithUnset(*sdk.NewComputePoolUnsetRequest().WithComment(true))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if runSet {
+		if err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("suspended") {
+		request := sdk.NewAlterComputePoolRequest(id)
+		if d.Get("suspended").(bool) {
+			request.WithSuspend(true)
+		} else {
+			request.WithResume(true)
+		}
+		if err := client.ComputePools.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error changing state of compute pool %v err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadContextComputePool(ctx, d, meta)
+}
+
+func DeleteContextComputePool(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.Get("stop_all_services_on_destroy").(bool) {
+		if err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithIfExists(true).WithStopAll(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error stopping services on compute pool %v err = %w", d.Id(), err))
+		}
+	}
+	if err := client.ComputePools.Drop(ctx, sdk.NewDropComputePoolRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(fmt.Errorf("error dropping compute pool %v (a compute pool with running services can't be dropped; stop the services first or set stop_all_services_on_destroy to true) err = %w", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,209 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ComputePool_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ComputePool),
+		Steps: []resource.TestStep{
+			{
+				Config: computePoolConfig(id, 1, 1, 3600, ""),
+				Check: acc.AssertThat(t,
+					resourceassert.ComputePoolResource(t, "snowflake_compute_pool.test").
+						HasName(id.Name()).
+						HasMinNodes(1).
+						HasMaxNodes(1).
+						HasInstanceFamily(string(sdk.ComputePoolInstanceFamilyCpuX64XS)).
+						HasAutoResume(false).
+						HasInitiallySuspended(true).
+						HasAutoSuspendSecs(3600).
+						HasComment("").
+						HasState(string(sdk.ComputePoolStateSuspended)).
+						HasNumServices(0),
+					objectassert.ComputePool(t, id).
+						HasMinNodes(1).
+						HasMaxNodes(1).
+						HasInstanceFamily(sdk.ComputePoolInstanceFamilyCpuX64XS).
+						HasAutoResume(false).
+						HasAutoSuspendSecs(3600).
+						HasState(sdk.ComputePoolStateSuspended),
+				),
+			},
+			// change the properties in place
+			{
+				Config: computePoolConfig(id, 1, 2, 600, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_compute_pool.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "max_nodes", "2"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "auto_suspend_secs", "600"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "comment", "some comment"),
+				),
+			},
+			// unset the comment
+			{
+				Config: computePoolConfig(id, 1, 2, 600, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_compute_pool.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initially_suspended", "state", "status_message"},
+			},
+		},
+	})
+}
+
+func TestAcc_ComputePool_resumeAndSuspend(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ComputePool),
+		Steps: []resource.TestStep{
+			{
+				Config: computePoolSuspendedConfig(id, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "suspended", "true"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "state", string(sdk.ComputePoolStateSuspended)),
+				),
+			},
+			// resume the pool in place
+			{
+				Config: computePoolSuspendedConfig(id, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_compute_pool.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "suspended", "false"),
+					resource.TestCheckResourceAttrWith("snowflake_compute_pool.test", "state", func(value string) error {
+						if value == string(sdk.ComputePoolStateSuspended) {
+							return fmt.Errorf("expected compute pool to be resumed, got state %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// suspend the pool again
+			{
+				Config: computePoolSuspendedConfig(id, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_compute_pool.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "suspended", "true"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "state", string(sdk.ComputePoolStateSuspended)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ComputePool_stopAllServicesOnDestroy(t *testing.T) {
+	image := testenvs.GetOrSkipTest(t, testenvs.ServiceImage)
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ComputePool),
+		Steps: []resource.TestStep{
+			{
+				Config: computePoolStopAllServicesOnDestroyConfig(id),
+				Check: acc.AssertThat(t,
+					resourceassert.ComputePoolResource(t, "snowflake_compute_pool.test").
+						HasStopAllServicesOnDestroy(true),
+				),
+			},
+			// a service running on the compute pool is stopped when the compute pool is destroyed
+			{
+				PreConfig: func() {
+					_, serviceCleanup := acc.TestClient().Service.Create(t, id, image)
+					t.Cleanup(serviceCleanup)
+				},
+				Config: computePoolStopAllServicesOnDestroyConfig(id),
+				Check: acc.AssertThat(t,
+					resourceassert.ComputePoolResource(t, "snowflake_compute_pool.test").
+						HasNumServices(1),
+				),
+			},
+		},
+	})
+}
+
+func computePoolConfig(id sdk.AccountObjectIdentifier, minNodes int, maxNodes int, autoSuspendSecs int, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_compute_pool" "test" {
+	name                = "%[1]s"
+	min_nodes           = %[2]d
+	max_nodes           = %[3]d
+	instance_family     = "%[4]s"
+	auto_resume         = false
+	initially_suspended = true
+	auto_suspend_secs   = %[5]d
+	comment             = "%[6]s"
+}
+`, id.Name(), minNodes, maxNodes, sdk.ComputePoolInstanceFamilyCpuX64XS, autoSuspendSecs, comment)
+}
+
+func computePoolSuspendedConfig(id sdk.AccountObjectIdentifier, suspended bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_compute_pool" "test" {
+	name                = "%[1]s"
+	min_nodes           = 1
+	max_nodes           = 1
+	instance_family     = "%[2]s"
+	auto_resume         = false
+	suspended           = %[3]t
+}
+`, id.Name(), sdk.ComputePoolInstanceFamilyCpuX64XS, suspended)
+}
+
+func computePoolStopAllServicesOnDestroyConfig(id sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_compute_pool" "test" {
+	name                         = "%[1]s"
+	min_nodes                    = 1
+	max_nodes                    = 1
+	instance_family              = "%[2]s"
+	stop_all_services_on_destroy = true
+}
+`, id.Name(), sdk.ComputePoolInstanceFamilyCpuX64XS)
+}
@@ -40,6 +40,9 @@ var databaseSchema = map[string]*schema.Schema{
 		Description:  "Number of days for which Snowflake retains historical data for performing Time Travel actions (SELECT, CLONE, UNDROP) on the object. A value of 0 effectively disables Time Travel for the specified database. Default value for this field is set to -1, which is a fallback to use Snowflake default. For more information, see [Understanding & Using Time Travel](https://docs.snowflake.com/en/user-guide/data-time-travel).",
 		ValidateFunc: validation.IntBetween(-1, 90),
 	},
+	"log_level":    logLevelSchema("database"),
+	"trace_level":  traceLevelSchema("database"),
+	"metric_level": metricLevelSchema("database"),
 	"from_share": {
 		Type:          schema.TypeMap,
 		Elem:          &schema.Schema{Type: schema.TypeString},
@@ -121,6 +124,9 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error creating database %v: %w", name, err)
 		}
 		d.SetId(name)
+		if err := updateDatabaseLoggingLevels(ctx, client, id, d); err != nil {
+			return fmt.Errorf("error setting logging levels on database %v: %w", name, err)
+		}
 		return ReadDatabase(d, meta)
 	}
 	// Is it a Secondary Database?
@@ -135,6 +141,9 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error creating database %v: %w", name, err)
 		}
 		d.SetId(name)
+		if err := updateDatabaseLoggingLevels(ctx, client, id, d); err != nil {
+			return fmt.Errorf("error setting logging levels on database %v: %w", name, err)
+		}
 		// todo: add failover_configuration block
 		return ReadDatabase(d, meta)
 	}
@@ -165,6 +174,10 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(name)
 
+	if err := updateDatabaseLoggingLevels(ctx, client, id, d); err != nil {
+		return fmt.Errorf("error setting logging levels on database %v: %w", name, err)
+	}
+
 	if v, ok := d.GetOk("replication_configuration"); ok {
 		replicationConfiguration := v.([]interface{})[0].(map[string]interface{})
 		accounts := replicationConfiguration["accounts"].([]interface{})
@@ -227,6 +240,10 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := readLoggingLevels(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -287,6 +304,10 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := updateDatabaseLoggingLevels(ctx, client, id, d); err != nil {
+		return fmt.Errorf("error updating logging levels on %v err = %w", d.Id(), err)
+	}
+
 	// If replication configuration changes, need to update accounts that have permission to replicate database
 	if d.HasChange("replication_configuration") {
 		oldConfig, newConfig := d.GetChange("replication_configuration")
@@ -368,3 +389,40 @@ func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// updateDatabaseLoggingLevels sets or unsets the changed logging levels; it is used both on create and on update.
+func updateDatabaseLoggingLevels(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d *schema.ResourceData) error {
+	set, unset := &sdk.DatabaseSet{}, &sdk.DatabaseUnset{}
+	if d.HasChange("log_level") {
+		if v := d.Get("log_level").(string); v != "" {
+			set.LogLevel = sdk.Pointer(sdk.LogLevel(v))
+		} else {
+			unset.LogLevel = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("trace_level") {
+		if v := d.Get("trace_level").(string); v != "" {
+			set.TraceLevel = sdk.Pointer(sdk.TraceLevel(v))
+		} else {
+			unset.TraceLevel = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("metric_level") {
+		if v := d.Get("metric_level").(string); v != "" {
+			set.MetricLevel = sdk.Pointer(sdk.MetricLevel(v))
+		} else {
+			unset.MetricLevel = sdk.Bool(true)
+		}
+	}
+	if set.LogLevel != nil || set.TraceLevel != nil || set.MetricLevel != nil {
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+	if unset.LogLevel != nil || unset.TraceLevel != nil || unset.MetricLevel != nil {
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Unset: unset}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
@@ -334,6 +335,91 @@ func TestAcc_Database_DefaultDataRetentionTime_SetOutsideOfTerraform(t *testing.
 	})
 }
 
+func TestAcc_Database_loggingLevels(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	resourceName := "snowflake_database.db"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Database),
+		Steps: []resource.TestStep{
+			// set
+			{
+				Config: dbConfigWithLoggingLevels(name, "INFO", "ON_EVENT", "ALL"),
+				Check: acc.AssertThat(t,
+					resourceassert.DatabaseResource(t, resourceName).
+						HasLogLevel("INFO").
+						HasTraceLevel("ON_EVENT").
+						HasMetricLevel("ALL"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_retention_time_in_days"},
+			},
+			// change
+			{
+				Config: dbConfigWithLoggingLevels(name, "ERROR", "ALWAYS", "NONE"),
+				Check: acc.AssertThat(t,
+					resourceassert.DatabaseResource(t, resourceName).
+						HasLogLevel("ERROR").
+						HasTraceLevel("ALWAYS").
+						HasMetricLevel("NONE"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// unset
+			{
+				Config: dbConfigWithoutLoggingLevels(name),
+				Check: acc.AssertThat(t,
+					resourceassert.DatabaseResource(t, resourceName).
+						HasLogLevel("").
+						HasTraceLevel("").
+						HasMetricLevel(""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func dbConfigWithLoggingLevels(name string, logLevel string, traceLevel string, metricLevel string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "db" {
+  name         = "%[1]s"
+  log_level    = "%[2]s"
+  trace_level  = "%[3]s"
+  metric_level = "%[4]s"
+}
+`, name, logLevel, traceLevel, metricLevel)
+}
+
+func dbConfigWithoutLoggingLevels(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "db" {
+  name = "%[1]s"
+}
+`, name)
+}
+
 func dbConfig(prefix string) string {
 	s := `
 resource "snowflake_database" "db" {
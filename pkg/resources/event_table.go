@@ -0,0 +1,295 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the event table; must be unique for the schema in which the event table is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the event table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the event table.",
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the event table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		Description:  "Specifies the retention period for the event table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the event table. The default value for this field is -1, which is a fallback to use Snowflake default - in this case the schema value.",
+		ValidateFunc: validation.IntBetween(-1, 90),
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table. Default false.",
+	},
+	"row_access_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the row access policy to set on the event table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Fully qualified name of the row access policy, e.g. `\"db\".\"schema\".\"policy\"`.",
+				},
+				"on": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Defines which columns will be passed to the row access policy.",
+				},
+			},
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the event table.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the event table.",
+	},
+	"tag": tagReferenceSchema,
+}
+
+// EventTable returns a pointer to the resource representing an event table.
+func EventTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage event table objects, which collect logs, traces and metrics emitted by functions and procedures. Clustering, change tracking and the row access policy are not returned by Snowflake when reading the event table, so drift in those is not detected. For more information, check [event table documentation](https://docs.snowflake.com/en/developer-guide/logging-tracing/event-table-setting-up).",
+
+		CreateContext: CreateContextEventTable,
+		ReadContext:   ReadContextEventTable,
+		UpdateContext: UpdateContextEventTable,
+		DeleteContext: DeleteContextEventTable,
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateEventTableRequest(id)
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+	if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+		request.WithDataRetentionTimeInDays(sdk.Int(v))
+	}
+	if v, ok := d.GetOk("change_tracking"); ok {
+		request.WithChangeTracking(sdk.Bool(v.(bool)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("row_access_policy"); ok {
+		policyName, on := expandEventTableRowAccessPolicy(v.([]any))
+		request.WithRowAccessPolicy(&sdk.TableRowAccessPolicy{Name: policyName, On: on})
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
+	if err := client.EventTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextEventTable(ctx, d, meta)
+}
+
+func ReadContextEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	eventTable, err := client.EventTables.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve event table. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	dataRetentionTimeInDays := -1
+	retention, err := client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterDataRetentionTimeInDays, sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: id})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Only a value set directly on the event table is tracked; inherited values are represented by -1.
+	if retention.Level == sdk.ParameterType(sdk.ObjectTypeTable) {
+		days, err := strconv.Atoi(retention.Value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		dataRetentionTimeInDays = days
+	}
+
+	toSet := map[string]any{
+		"name":                        eventTable.Name,
+		"database":                    eventTable.DatabaseName,
+		"schema":                      eventTable.SchemaName,
+		"data_retention_time_in_days": dataRetentionTimeInDays,
+		"comment":                     eventTable.Comment,
+		"owner":                       eventTable.Owner,
+		"qualified_name":              id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewEventTableSetRequest(), sdk.NewEventTableUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("data_retention_time_in_days") {
+		if days := d.Get("data_retention_time_in_days").(int); days != -1 {
+			set.WithDataRetentionTimeInDays(sdk.Int(days))
+			runSet = true
+		} else {
+			unset.WithDataRetentionTimeInDays(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("change_tracking") {
+		set.WithChangeTracking(sdk.Bool(d.Get("change_tracking").(bool)))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewEventTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]any)); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(&clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(sdk.Bool(true))
+		}
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithClusteringAction(clusteringAction)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		oldPolicy, newPolicy := d.GetChange("row_access_policy")
+		if len(oldPolicy.([]any)) > 0 {
+			policyName, _ := expandEventTableRowAccessPolicy(oldPolicy.([]any))
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithDropRowAccessPolicy(sdk.NewEventTableDropRowAccessPolicyRequest(policyName))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(newPolicy.([]any)) > 0 {
+			policyName, on := expandEventTableRowAccessPolicy(newPolicy.([]any))
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithAddRowAccessPolicy(sdk.NewEventTableAddRowAccessPolicyRequest(policyName, on))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSetTags(setTags)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadContextEventTable(ctx, d, meta)
+}
+
+func DeleteContextEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.EventTables.Drop(ctx, sdk.NewDropEventTableRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandEventTableRowAccessPolicy(policies []any) (sdk.SchemaObjectIdentifier, []string) {
+	policy := policies[0].(map[string]any)
+	return sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy["policy_name"].(string)), expandStringList(policy["on"].([]any))
+}
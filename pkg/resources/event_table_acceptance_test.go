@@ -0,0 +1,118 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTable_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	tag, tagCleanup := acc.TestClient().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: eventTableBasicConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					acc.AssertThat(t,
+						resourceassert.EventTableResource(t, "snowflake_event_table.test").
+							HasName(id.Name()).
+							HasDatabase(id.DatabaseName()).
+							HasSchema(id.SchemaName()).
+							HasDataRetentionTimeInDays(-1).
+							HasChangeTracking(false).
+							HasComment("").
+							HasQualifiedName(id.FullyQualifiedName()),
+					),
+					resource.TestCheckResourceAttrSet("snowflake_event_table.test", "owner"),
+				),
+			},
+			{
+				Config: eventTableCompleteConfig(id, tag.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_event_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "cluster_by.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "cluster_by.0", "timestamp"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "data_retention_time_in_days", "5"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "comment", "foo"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "tag.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "tag.0.value", "v1"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_event_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_by", "change_tracking", "tag"},
+			},
+			// unset optional fields
+			{
+				Config: eventTableBasicConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_event_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "cluster_by.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "data_retention_time_in_days", "-1"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "change_tracking", "false"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func eventTableBasicConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_event_table" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func eventTableCompleteConfig(id sdk.SchemaObjectIdentifier, tagId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_event_table" "test" {
+	database                    = "%[1]s"
+	schema                      = "%[2]s"
+	name                        = "%[3]s"
+	cluster_by                  = ["timestamp"]
+	data_retention_time_in_days = 5
+	change_tracking             = true
+	comment                     = "foo"
+
+	tag {
+		database = "%[4]s"
+		schema   = "%[5]s"
+		name     = "%[6]s"
+		value    = "v1"
+	}
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), tagId.DatabaseName(), tagId.SchemaName(), tagId.Name())
+}
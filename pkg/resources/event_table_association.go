@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTableAssociationSchema = map[string]*schema.Schema{
+	"event_table": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Qualified name (`\"db\".\"schema\".\"event_table\"`) of the event table to associate.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Name of the database to associate the event table with. When not set, the event table is associated with the current account.",
+	},
+}
+
+// EventTableAssociation returns a pointer to the resource representing an event table association with the current account or a database.
+func EventTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the event table in which logs, traces and metrics are collected, either for the current account or for a single database. To set the event table of a different account, use a provider alias.",
+
+		Create: CreateEventTableAssociation,
+		Read:   ReadEventTableAssociation,
+		Delete: DeleteEventTableAssociation,
+
+		Schema: eventTableAssociationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateEventTableAssociation implements schema.CreateFunc.
+func CreateEventTableAssociation(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	eventTable := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("event_table").(string))
+	database := d.Get("database").(string)
+
+	var err error
+	if database == "" {
+		err = client.Parameters.SetAccountParameter(ctx, sdk.AccountParameterEventTable, eventTable.FullyQualifiedName())
+	} else {
+		err = client.Databases.Alter(ctx, sdk.NewAccountObjectIdentifier(database), &sdk.AlterDatabaseOptions{
+			Set: &sdk.DatabaseSet{
+				EventTable: &eventTable,
+			},
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(database, eventTable.FullyQualifiedName()))
+
+	return ReadEventTableAssociation(d, meta)
+}
+
+func ReadEventTableAssociation(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 2 {
+		return fmt.Errorf("required id format 'database|event_table' (with an empty database for the account), but got: '%s'", d.Id())
+	}
+	database := parts[0]
+
+	var parameter *sdk.Parameter
+	var expectedLevel sdk.ParameterType
+	var err error
+	if database == "" {
+		parameter, err = client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameterEventTable)
+		expectedLevel = sdk.ParameterTypeAccount
+	} else {
+		parameter, err = client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterEventTable, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: sdk.NewAccountObjectIdentifier(database)})
+		expectedLevel = sdk.ParameterType(sdk.ObjectTypeDatabase)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Note: this means the association has been removed outside of Terraform; the value set on a different level
+	// (e.g. database inheriting the event table from the account) is not the managed association.
+	if parameter.Value == "" || parameter.Level != expectedLevel {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("database", database); err != nil {
+		return err
+	}
+	if err := d.Set("event_table", sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parameter.Value).FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteEventTableAssociation implements schema.DeleteFunc.
+func DeleteEventTableAssociation(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	var err error
+	if database := d.Get("database").(string); database == "" {
+		err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Unset: &sdk.AccountUnset{
+				Parameters: &sdk.AccountLevelParametersUnset{
+					AccountParameters: &sdk.AccountParametersUnset{
+						EventTable: sdk.Bool(true),
+					},
+				},
+			},
+		})
+	} else {
+		err = client.Databases.Alter(ctx, sdk.NewAccountObjectIdentifier(database), &sdk.AlterDatabaseOptions{
+			Unset: &sdk.DatabaseUnset{
+				EventTable: sdk.Bool(true),
+			},
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTableAssociation_database(t *testing.T) {
+	databaseName := acc.TestClient().Ids.Alpha()
+	eventTableName := acc.TestClient().Ids.Alpha()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTableAssociationDatabaseConfig(databaseName, eventTableName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table_association.test", "database", databaseName),
+					resource.TestCheckResourceAttrPair("snowflake_event_table_association.test", "event_table", "snowflake_event_table.test", "qualified_name"),
+					resource.TestCheckResourceAttrSet("snowflake_event_table_association.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_event_table_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// the association removed outside of Terraform is recreated (the event table inherited from the account isn't treated as the association)
+			{
+				PreConfig:          acc.TestClient().Database.UnsetEventTable(t, sdk.NewAccountObjectIdentifier(databaseName)),
+				Config:             eventTableAssociationDatabaseConfig(databaseName, eventTableName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func eventTableAssociationDatabaseConfig(databaseName, eventTableName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]s"
+}
+
+resource "snowflake_event_table" "test" {
+	database = snowflake_database.test.name
+	schema   = "PUBLIC"
+	name     = "%[2]s"
+}
+
+resource "snowflake_event_table_association" "test" {
+	database    = snowflake_database.test.name
+	event_table = snowflake_event_table.test.qualified_name
+}
+`, databaseName, eventTableName)
+}
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the external access integration.",
+	},
+	"allowed_network_rules": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		MinItems:    1,
+		Description: "Specifies the allowed network rules. Only egress rules may be specified. Rules have to be provided as fully qualified names, e.g. `snowflake_network_rule.example.qualified_name`.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the security integrations whose OAuth authorization server issued the secret used by the UDF or procedure. The security integration must be the type used for external API integration.",
+	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the secrets that a UDF or procedure can use when referring to this integration. Secrets have to be provided as fully qualified names, e.g. `snowflake_secret_with_generic_string.example.qualified_name`.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the external access integration was created.",
+	},
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration.
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage external access integration objects. For more information, check [external access integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration).",
+
+		CreateContext: CreateContextExternalAccessIntegration,
+		ReadContext:   ReadContextExternalAccessIntegration,
+		UpdateContext: UpdateContextExternalAccessIntegration,
+		DeleteContext: DeleteContextExternalAccessIntegration,
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	networkRules := expandSchemaObjectIdentifiers(d.Get("allowed_network_rules").(*schema.Set).List())
+	request := sdk.NewCreateExternalAccessIntegrationRequest(id, networkRules, d.Get("enabled").(bool))
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		request.WithAllowedApiAuthenticationIntegrations(expandAccountObjectIdentifiers(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		request.WithAllowedAuthenticationSecrets(expandSchemaObjectIdentifiers(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextExternalAccessIntegration(ctx, d, meta)
+}
+
+func ReadContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve external access integration. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, property := range properties {
+		switch property.Name {
+		case "ENABLED":
+			enabled, err := strconv.ParseBool(property.Value)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("enabled", enabled); err != nil {
+				return diag.FromErr(err)
+			}
+		case "ALLOWED_NETWORK_RULES":
+			if err := d.Set("allowed_network_rules", schemaObjectIdentifiersFromList(property.Value)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			if err := d.Set("allowed_api_authentication_integrations", accountObjectIdentifiersFromList(property.Value)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			if err := d.Set("allowed_authentication_secrets", schemaObjectIdentifiersFromList(property.Value)); err != nil {
+				return diag.FromErr(err)
+			}
+		case "COMMENT":
+			if err := d.Set("comment", property.Value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("allowed_network_rules") {
+		runSet = true
+		set.WithAllowedNetworkRules(expandSchemaObjectIdentifiers(d.Get("allowed_network_rules").(*schema.Set).List()))
+	}
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if integrations := d.Get("allowed_api_authentication_integrations").(*schema.Set).List(); len(integrations) > 0 {
+			runSet = true
+			set.WithAllowedApiAuthenticationIntegrations(expandAccountObjectIdentifiers(integrations))
+		} else {
+			runUnset = true
+			unset.WithAllowedApiAuthenticationIntegrations(true)
+		}
+	}
+	if d.HasChange("allowed_authentication_secrets") {
+		if secrets := d.Get("allowed_authentication_secrets").(*schema.Set).List(); len(secrets) > 0 {
+			runSet = true
+			set.WithAllowedAuthenticationSecrets(expandSchemaObjectIdentifiers(secrets))
+		} else {
+			runUnset = true
+			unset.WithAllowedAuthenticationSecrets(true)
+		}
+	}
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.WithComment(comment)
+		} else {
+			runUnset = true
+			unset.WithComment(true)
+		}
+	}
+
+	if runSet {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextExternalAccessIntegration(ctx, d, meta)
+}
+
+func DeleteContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,119 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	networkRuleId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	secretId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: externalAccessIntegrationConfig(id, networkRuleId, secretId, true, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_network_rules.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_external_access_integration.test", "allowed_network_rules.*", networkRuleId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_external_access_integration.test", "created_on"),
+				),
+			},
+			// set optional fields
+			{
+				Config: externalAccessIntegrationConfig(id, networkRuleId, secretId, false, true, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_access_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.*", secretId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "comment", "some comment"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_external_access_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).
+						WithSet(*sdk.NewExternalAccessIntegrationSetRequest().WithEnabled(true)))
+				},
+				Config: externalAccessIntegrationConfig(id, networkRuleId, secretId, false, true, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_access_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "false"),
+				),
+			},
+			// unset optional fields
+			{
+				Config: externalAccessIntegrationConfig(id, networkRuleId, secretId, true, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func externalAccessIntegrationConfig(id sdk.AccountObjectIdentifier, networkRuleId sdk.SchemaObjectIdentifier, secretId sdk.SchemaObjectIdentifier, enabled bool, withSecret bool, comment string) string {
+	secrets := "[]"
+	if withSecret {
+		secrets = "[snowflake_secret_with_generic_string.test.qualified_name]"
+	}
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "test" {
+	name       = "%[2]s"
+	database   = "%[3]s"
+	schema     = "%[4]s"
+	type       = "HOST_PORT"
+	mode       = "EGRESS"
+	value_list = ["example.com"]
+}
+
+resource "snowflake_secret_with_generic_string" "test" {
+	name          = "%[5]s"
+	database      = "%[3]s"
+	schema        = "%[4]s"
+	secret_string = "foo"
+}
+
+resource "snowflake_external_access_integration" "test" {
+	name                           = "%[1]s"
+	allowed_network_rules          = [snowflake_network_rule.test.qualified_name]
+	allowed_authentication_secrets = %[6]s
+	enabled                        = %[7]t
+	comment                        = "%[8]s"
+}
+`, id.Name(), networkRuleId.Name(), networkRuleId.DatabaseName(), networkRuleId.SchemaName(), secretId.Name(), secrets, enabled, comment)
+}
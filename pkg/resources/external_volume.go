@@ -0,0 +1,421 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var externalVolumeStorageProviders = []string{"S3", "S3GOV", "GCS", "AZURE"}
+
+var externalVolumeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the external volume.",
+	},
+	"storage_location": {
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Description: "List of named cloud storage locations in different regions and, optionally, cloud platforms. The order of storage locations is kept. " +
+			"Storage locations are added and removed in place (a changed storage location is removed and added again, as well as all the storage locations after it); when none of the existing storage locations can be kept and the name of the first one is reused, the external volume is recreated.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_location_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the storage location. Must be unique for the external volume.",
+				},
+				"storage_provider": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(externalVolumeStorageProviders, false),
+					Description:  fmt.Sprintf("Specifies the cloud storage provider that stores your data files. Valid values are (case-sensitive): %v.", externalVolumeStorageProviders),
+				},
+				"storage_base_url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the base URL for your cloud storage location.",
+				},
+				"storage_aws_role_arn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the case-sensitive Amazon Resource Name (ARN) of the AWS identity and access management (IAM) role that grants privileges on the S3 bucket containing your data files. Required for S3 and S3GOV storage providers.",
+				},
+				"storage_aws_external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies an external ID that Snowflake uses to establish a trust relationship with AWS. Generated by Snowflake when not specified for S3 and S3GOV storage providers (the generated value is not tracked).",
+				},
+				"encryption_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the encryption type used. Valid values are AWS_SSE_S3, AWS_SSE_KMS and NONE for S3 and S3GOV storage providers, and GCS_SSE_KMS and NONE for GCS storage provider. Ignored for AZURE storage provider. When not specified, the default chosen by Snowflake is not tracked.",
+				},
+				"encryption_kms_key_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the ID for the KMS-managed key used to encrypt files. Used with AWS_SSE_KMS and GCS_SSE_KMS encryption types.",
+				},
+				"azure_tenant_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the ID for your Office 365 tenant that the allowed and blocked storage accounts belong to. Required for AZURE storage provider.",
+				},
+				"storage_aws_iam_user_arn": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The AWS IAM user created for your Snowflake account that has to be granted access to the S3 bucket.",
+				},
+				"storage_gcp_service_account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The GCS service account created for your Snowflake account that has to be granted access to the GCS bucket.",
+				},
+				"azure_multi_tenant_app_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the Snowflake client application created for your account.",
+				},
+				"azure_consent_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL to the Microsoft permissions request page.",
+				},
+			},
+		},
+	},
+	"allow_writes": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether write operations are allowed for the external volume; must be set to true for Iceberg tables that use Snowflake as the catalog.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external volume.",
+	},
+}
+
+// ExternalVolume returns a pointer to the resource representing an external volume.
+func ExternalVolume() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage external volume objects. For more information, check [external volume documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-volume).",
+
+		CreateContext: CreateContextExternalVolume,
+		ReadContext:   ReadContextExternalVolume,
+		UpdateContext: UpdateContextExternalVolume,
+		DeleteContext: DeleteContextExternalVolume,
+
+		CustomizeDiff: customdiff.ForceNewIfChange("storage_location", func(ctx context.Context, old, new, meta any) bool {
+			return externalVolumeStorageLocationsRequireRecreation(
+				externalVolumeStorageLocationsFromList(old.([]any)),
+				externalVolumeStorageLocationsFromList(new.([]any)),
+			)
+		}),
+
+		Schema: externalVolumeSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// externalVolumeStorageLocation holds the configurable part of a storage location.
+type externalVolumeStorageLocation struct {
+	Name                 string
+	StorageProvider      string
+	StorageBaseUrl       string
+	StorageAwsRoleArn    string
+	StorageAwsExternalId string
+	EncryptionType       string
+	EncryptionKmsKeyId   string
+	AzureTenantId        string
+}
+
+func externalVolumeStorageLocationsFromList(v []any) []externalVolumeStorageLocation {
+	storageLocations := make([]externalVolumeStorageLocation, 0, len(v))
+	for _, raw := range v {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]any)
+		storageLocations = append(storageLocations, externalVolumeStorageLocation{
+			Name:                 m["storage_location_name"].(string),
+			StorageProvider:      m["storage_provider"].(string),
+			StorageBaseUrl:       m["storage_base_url"].(string),
+			StorageAwsRoleArn:    m["storage_aws_role_arn"].(string),
+			StorageAwsExternalId: m["storage_aws_external_id"].(string),
+			EncryptionType:       m["encryption_type"].(string),
+			EncryptionKmsKeyId:   m["encryption_kms_key_id"].(string),
+			AzureTenantId:        m["azure_tenant_id"].(string),
+		})
+	}
+	return storageLocations
+}
+
+func (l externalVolumeStorageLocation) toRequest() (*sdk.ExternalVolumeStorageLocationRequest, error) {
+	request := sdk.NewExternalVolumeStorageLocationRequest()
+	switch l.StorageProvider {
+	case "S3", "S3GOV":
+		if l.StorageAwsRoleArn == "" {
+			return nil, fmt.Errorf("storage_aws_role_arn is required for storage location %s with %s storage provider", l.Name, l.StorageProvider)
+		}
+		params := sdk.NewS3StorageLocationParamsRequest(l.Name, sdk.S3StorageProvider(l.StorageProvider), l.StorageAwsRoleArn, l.StorageBaseUrl)
+		if l.StorageAwsExternalId != "" {
+			params.WithStorageAwsExternalId(l.StorageAwsExternalId)
+		}
+		if l.EncryptionType != "" {
+			encryption := sdk.NewExternalVolumeS3EncryptionRequest(sdk.S3EncryptionType(l.EncryptionType))
+			if l.EncryptionKmsKeyId != "" {
+				encryption.WithKmsKeyId(l.EncryptionKmsKeyId)
+			}
+			params.WithEncryption(*encryption)
+		}
+		request.WithS3StorageLocationParams(*params)
+	case "GCS":
+		params := sdk.NewGCSStorageLocationParamsRequest(l.Name, l.StorageBaseUrl)
+		if l.EncryptionType != "" {
+			encryption := sdk.NewExternalVolumeGCSEncryptionRequest(sdk.GCSEncryptionType(l.EncryptionType))
+			if l.EncryptionKmsKeyId != "" {
+				encryption.WithKmsKeyId(l.EncryptionKmsKeyId)
+			}
+			params.WithEncryption(*encryption)
+		}
+		request.WithGCSStorageLocationParams(*params)
+	case "AZURE":
+		if l.AzureTenantId == "" {
+			return nil, fmt.Errorf("azure_tenant_id is required for storage location %s with AZURE storage provider", l.Name)
+		}
+		request.WithAzureStorageLocationParams(*sdk.NewAzureStorageLocationParamsRequest(l.Name, l.AzureTenantId, l.StorageBaseUrl))
+	default:
+		return nil, fmt.Errorf("invalid storage provider %s for storage location %s", l.StorageProvider, l.Name)
+	}
+	return request, nil
+}
+
+// externalVolumeStorageLocationsToKeep returns which of the old storage locations can stay in place. They have to form
+// a prefix of the new storage locations (with the same order), because storage locations can only be added at the end.
+func externalVolumeStorageLocationsToKeep(old, new []externalVolumeStorageLocation) (keep []bool, keptCount int) {
+	keep = make([]bool, len(old))
+	for i, l := range old {
+		if keptCount < len(new) && l == new[keptCount] {
+			keep[i] = true
+			keptCount++
+		}
+	}
+	return keep, keptCount
+}
+
+// externalVolumeStorageLocationsRequireRecreation checks if storage locations cannot be updated in place. An external
+// volume has to keep at least one storage location, so when none of the existing ones stay in place, the first one is
+// removed after the new ones are added. It is not possible when its name is reused by one of the new storage locations.
+func externalVolumeStorageLocationsRequireRecreation(old, new []externalVolumeStorageLocation) bool {
+	if _, keptCount := externalVolumeStorageLocationsToKeep(old, new); len(old) == 0 || keptCount > 0 {
+		return false
+	}
+	return slices.ContainsFunc(new, func(l externalVolumeStorageLocation) bool { return l.Name == old[0].Name })
+}
+
+// externalVolumeStorageLocationsUpdatePlan returns the names of storage locations that have to be removed before adding
+// the new ones, the storage locations to add (in order) and the names of storage locations that have to be removed
+// afterward.
+func externalVolumeStorageLocationsUpdatePlan(old, new []externalVolumeStorageLocation) (removeBefore []string, add []externalVolumeStorageLocation, removeAfter []string) {
+	keep, keptCount := externalVolumeStorageLocationsToKeep(old, new)
+	for i, l := range old {
+		switch {
+		case keep[i]:
+			continue
+		case keptCount == 0 && len(removeAfter) == 0:
+			removeAfter = append(removeAfter, l.Name)
+		default:
+			removeBefore = append(removeBefore, l.Name)
+		}
+	}
+	return removeBefore, new[keptCount:], removeAfter
+}
+
+func CreateContextExternalVolume(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	storageLocations := externalVolumeStorageLocationsFromList(d.Get("storage_location").([]any))
+	storageLocationRequests := make([]sdk.ExternalVolumeStorageLocationRequest, len(storageLocations))
+	for i, storageLocation := range storageLocations {
+		request, err := storageLocation.toRequest()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		storageLocationRequests[i] = *request
+	}
+
+	request := sdk.NewCreateExternalVolumeRequest(id, storageLocationRequests).WithAllowWrites(d.Get("allow_writes").(bool))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.ExternalVolumes.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextExternalVolume(ctx, d, meta)
+}
+
+func ReadContextExternalVolume(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve external volume. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.ExternalVolumes.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	storageLocations, err := sdk.ParseExternalVolumeStorageLocations(properties)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", externalVolume.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("allow_writes", externalVolume.AllowWrites); err != nil {
+		return diag.FromErr(err)
+	}
+	var comment string
+	if externalVolume.Comment != nil {
+		comment = *externalVolume.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Snowflake fills the external id and the encryption type when they are not specified, so they are tracked only
+	// for the storage locations that had them specified before (or for all of them during import).
+	previousStorageLocations := make(map[string]externalVolumeStorageLocation)
+	for _, storageLocation := range externalVolumeStorageLocationsFromList(d.Get("storage_location").([]any)) {
+		previousStorageLocations[storageLocation.Name] = storageLocation
+	}
+	storageLocationsList := make([]map[string]any, len(storageLocations))
+	for i, storageLocation := range storageLocations {
+		externalId, encryptionType := storageLocation.StorageAwsExternalId, storageLocation.EncryptionType
+		if previous, ok := previousStorageLocations[storageLocation.Name]; ok || len(previousStorageLocations) > 0 {
+			if previous.StorageAwsExternalId == "" {
+				externalId = ""
+			}
+			if previous.EncryptionType == "" {
+				encryptionType = ""
+			}
+		}
+		storageLocationsList[i] = map[string]any{
+			"storage_location_name":       storageLocation.Name,
+			"storage_provider":            storageLocation.StorageProvider,
+			"storage_base_url":            storageLocation.StorageBaseUrl,
+			"storage_aws_role_arn":        storageLocation.StorageAwsRoleArn,
+			"storage_aws_external_id":     externalId,
+			"encryption_type":             encryptionType,
+			"encryption_kms_key_id":       storageLocation.EncryptionKmsKeyId,
+			"azure_tenant_id":             storageLocation.AzureTenantId,
+			"storage_aws_iam_user_arn":    storageLocation.StorageAwsIamUserArn,
+			"storage_gcp_service_account": storageLocation.StorageGcpServiceAccount,
+			"azure_multi_tenant_app_name": storageLocation.AzureMultiTenantAppName,
+			"azure_consent_url":           storageLocation.AzureConsentUrl,
+		}
+	}
+	if err := d.Set("storage_location", storageLocationsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextExternalVolume(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChange("storage_location") {
+		oldStorageLocations, newStorageLocations := d.GetChange("storage_location")
+		removeBefore, add, removeAfter := externalVolumeStorageLocationsUpdatePlan(
+			externalVolumeStorageLocationsFromList(oldStorageLocations.([]any)),
+			externalVolumeStorageLocationsFromList(newStorageLocations.([]any)),
+		)
+
+		addRequests := make([]*sdk.ExternalVolumeStorageLocationRequest, len(add))
+		for i, storageLocation := range add {
+			request, err := storageLocation.toRequest()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addRequests[i] = request
+		}
+
+		for _, name := range removeBefore {
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithRemoveStorageLocation(name)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, request := range addRequests {
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithAddStorageLocation(*request)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, name := range removeAfter {
+			if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithRemoveStorageLocation(name)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChanges("allow_writes", "comment") {
+		set := sdk.NewAlterExternalVolumeSetRequest()
+		if d.HasChange("allow_writes") {
+			set.WithAllowWrites(d.Get("allow_writes").(bool))
+		}
+		if d.HasChange("comment") {
+			set.WithComment(d.Get("comment").(string))
+		}
+		if err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextExternalVolume(ctx, d, meta)
+}
+
+func DeleteContextExternalVolume(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ExternalVolumes.Drop(ctx, sdk.NewDropExternalVolumeRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,192 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalVolume_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	s3Location := `
+	storage_location {
+		storage_location_name = "s3-location"
+		storage_provider      = "S3"
+		storage_base_url      = "s3://my_example_bucket/"
+		storage_aws_role_arn  = "arn:aws:iam::123456789012:role/myrole"
+		encryption_type       = "AWS_SSE_KMS"
+		encryption_kms_key_id = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	}`
+	gcsLocation := `
+	storage_location {
+		storage_location_name = "gcs-location"
+		storage_provider      = "GCS"
+		storage_base_url      = "gcs://my_example_bucket/"
+	}`
+	azureLocation := `
+	storage_location {
+		storage_location_name = "azure-location"
+		storage_provider      = "AZURE"
+		storage_base_url      = "azure://exampleacct.blob.core.windows.net/my_container/"
+		azure_tenant_id       = "a123b4c5-1234-123a-a12b-1a23b45678c9"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ExternalVolume),
+		Steps: []resource.TestStep{
+			{
+				Config: externalVolumeConfig(id, true, "", s3Location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "allow_writes", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_location_name", "s3-location"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_provider", "S3"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_base_url", "s3://my_example_bucket/"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_aws_role_arn", "arn:aws:iam::123456789012:role/myrole"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.encryption_type", "AWS_SSE_KMS"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.encryption_kms_key_id", "1234abcd-12ab-34cd-56ef-1234567890ab"),
+					resource.TestCheckResourceAttrSet("snowflake_external_volume.test", "storage_location.0.storage_aws_iam_user_arn"),
+				),
+			},
+			// add storage locations in place
+			{
+				Config: externalVolumeConfig(id, false, "some comment", s3Location, gcsLocation, azureLocation),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_volume.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "allow_writes", "false"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "comment", "some comment"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.#", "3"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_location_name", "s3-location"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.1.storage_location_name", "gcs-location"),
+					resource.TestCheckResourceAttrSet("snowflake_external_volume.test", "storage_location.1.storage_gcp_service_account"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.2.storage_location_name", "azure-location"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.2.azure_tenant_id", "a123b4c5-1234-123a-a12b-1a23b45678c9"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_external_volume.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// values filled by Snowflake are imported, but they are not tracked when not specified in the config
+				ImportStateVerifyIgnore: []string{
+					"storage_location.0.storage_aws_external_id",
+					"storage_location.1.encryption_type",
+					"storage_location.2.encryption_type",
+				},
+			},
+			// remove storage locations in place
+			{
+				Config: externalVolumeConfig(id, false, "some comment", azureLocation),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_volume.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_location_name", "azure-location"),
+				),
+			},
+			// replace the only storage location in place
+			{
+				Config: externalVolumeConfig(id, false, "some comment", gcsLocation),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_volume.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_location_name", "gcs-location"),
+				),
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().ExternalVolume.Alter(t, sdk.NewAlterExternalVolumeRequest(id).
+						WithSet(*sdk.NewAlterExternalVolumeSetRequest().WithAllowWrites(true)))
+				},
+				Config: externalVolumeConfig(id, false, "some comment", gcsLocation),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_volume.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "allow_writes", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ExternalVolume_changeOnlyStorageLocationWithSameName(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	location := func(baseUrl string) string {
+		return fmt.Sprintf(`
+	storage_location {
+		storage_location_name = "gcs-location"
+		storage_provider      = "GCS"
+		storage_base_url      = "%s"
+	}`, baseUrl)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ExternalVolume),
+		Steps: []resource.TestStep{
+			{
+				Config: externalVolumeConfig(id, true, "", location("gcs://my_example_bucket/")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_base_url", "gcs://my_example_bucket/"),
+				),
+			},
+			{
+				Config: externalVolumeConfig(id, true, "", location("gcs://my_other_bucket/")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_external_volume.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_volume.test", "storage_location.0.storage_base_url", "gcs://my_other_bucket/"),
+				),
+			},
+		},
+	})
+}
+
+func externalVolumeConfig(id sdk.AccountObjectIdentifier, allowWrites bool, comment string, storageLocations ...string) string {
+	return fmt.Sprintf(`
+resource "snowflake_external_volume" "test" {
+	name         = "%[1]s"
+	allow_writes = %[2]t
+	comment      = "%[3]s"
+%[4]s
+}
+`, id.Name(), allowWrites, comment, strings.Join(storageLocations, "\n"))
+}
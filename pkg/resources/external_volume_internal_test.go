@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalVolumeStorageLocationsUpdatePlan(t *testing.T) {
+	s3 := externalVolumeStorageLocation{Name: "s3", StorageProvider: "S3", StorageBaseUrl: "s3://bucket/", StorageAwsRoleArn: "arn"}
+	gcs := externalVolumeStorageLocation{Name: "gcs", StorageProvider: "GCS", StorageBaseUrl: "gcs://bucket/"}
+	azure := externalVolumeStorageLocation{Name: "azure", StorageProvider: "AZURE", StorageBaseUrl: "azure://account/container/", AzureTenantId: "tenant"}
+	changedS3 := s3
+	changedS3.EncryptionType = "AWS_SSE_S3"
+
+	testCases := []struct {
+		name                 string
+		old                  []externalVolumeStorageLocation
+		new                  []externalVolumeStorageLocation
+		expectedRemoveBefore []string
+		expectedAdd          []externalVolumeStorageLocation
+		expectedRemoveAfter  []string
+		expectedRecreation   bool
+	}{
+		{
+			name:        "add at the end",
+			old:         []externalVolumeStorageLocation{s3},
+			new:         []externalVolumeStorageLocation{s3, gcs},
+			expectedAdd: []externalVolumeStorageLocation{gcs},
+		},
+		{
+			name:                 "remove from the middle",
+			old:                  []externalVolumeStorageLocation{s3, gcs, azure},
+			new:                  []externalVolumeStorageLocation{s3, azure},
+			expectedRemoveBefore: []string{"gcs"},
+			expectedAdd:          []externalVolumeStorageLocation{},
+		},
+		{
+			name:                 "remove the first one",
+			old:                  []externalVolumeStorageLocation{s3, gcs},
+			new:                  []externalVolumeStorageLocation{gcs},
+			expectedAdd:          []externalVolumeStorageLocation{},
+			expectedRemoveBefore: []string{"s3"},
+		},
+		{
+			name:                 "change in the middle",
+			old:                  []externalVolumeStorageLocation{gcs, s3, azure},
+			new:                  []externalVolumeStorageLocation{gcs, changedS3, azure},
+			expectedRemoveBefore: []string{"s3", "azure"},
+			expectedAdd:          []externalVolumeStorageLocation{changedS3, azure},
+		},
+		{
+			name:                "replace all",
+			old:                 []externalVolumeStorageLocation{s3},
+			new:                 []externalVolumeStorageLocation{gcs},
+			expectedAdd:         []externalVolumeStorageLocation{gcs},
+			expectedRemoveAfter: []string{"s3"},
+		},
+		{
+			name:                 "reorder",
+			old:                  []externalVolumeStorageLocation{s3, gcs, azure},
+			new:                  []externalVolumeStorageLocation{gcs, s3},
+			expectedRemoveBefore: []string{"s3", "azure"},
+			expectedAdd:          []externalVolumeStorageLocation{s3},
+		},
+		{
+			name:                "change the only one",
+			old:                 []externalVolumeStorageLocation{s3},
+			new:                 []externalVolumeStorageLocation{changedS3},
+			expectedAdd:         []externalVolumeStorageLocation{changedS3},
+			expectedRemoveAfter: []string{"s3"},
+			expectedRecreation:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			removeBefore, add, removeAfter := externalVolumeStorageLocationsUpdatePlan(tc.old, tc.new)
+			assert.Equal(t, tc.expectedRemoveBefore, removeBefore)
+			assert.Equal(t, tc.expectedAdd, add)
+			assert.Equal(t, tc.expectedRemoveAfter, removeAfter)
+			assert.Equal(t, tc.expectedRecreation, externalVolumeStorageLocationsRequireRecreation(tc.old, tc.new))
+		})
+	}
+}
@@ -93,6 +93,30 @@ var failoverGroupSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"suspended": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Suspends (`true`) or resumes (`false`) the scheduled refresh of the secondary failover group (`ALTER FAILOVER GROUP ... SUSPEND | RESUME`). Can be set only for the secondary failover groups (with `from_replica`); changing it fails once the group is primary (see `is_primary`).",
+	},
+	"refresh_trigger": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Arbitrary value; changing it triggers `ALTER FAILOVER GROUP ... REFRESH` which refreshes the secondary failover group from the primary one (e.g. set it to a timestamp). Can be set only for the secondary failover groups (with `from_replica`); changing it fails once the group is primary (see `is_primary`).",
+	},
+	"promote_trigger": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Arbitrary value; changing it triggers `ALTER FAILOVER GROUP ... PRIMARY` which promotes the secondary failover group to serve as the primary failover group (the previous primary group becomes secondary). Promotion runs after the refresh, when both triggers change together. Setting it on creation doesn't promote the group. Can be set only for the secondary failover groups (with `from_replica`); changing it fails once the group is primary (see `is_primary`).",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the failover group is currently the primary one (as returned by SHOW FAILOVER GROUPS). A secondary failover group becomes primary after promotion.",
+	},
 	"replication_schedule": {
 		Type:          schema.TypeList,
 		Optional:      true,
@@ -141,6 +165,8 @@ func FailoverGroup() *schema.Resource {
 		Update: UpdateFailoverGroup,
 		Delete: DeleteFailoverGroup,
 
+		CustomizeDiff: failoverGroupSecondaryOnlyAttributesUnchangedOnPrimary,
+
 		Schema: failoverGroupSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -148,6 +174,20 @@ func FailoverGroup() *schema.Resource {
 	}
 }
 
+// failoverGroupSecondaryOnlyAttributesUnchangedOnPrimary rejects suspending, refreshing or promoting a failover group
+// that is already primary (e.g. after the promotion), because these operations are valid only for the secondary groups.
+func failoverGroupSecondaryOnlyAttributesUnchangedOnPrimary(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.Get("is_primary").(bool) {
+		return nil
+	}
+	for _, key := range []string{"suspended", "refresh_trigger", "promote_trigger"} {
+		if d.HasChange(key) {
+			return fmt.Errorf("failover group %v is primary; %s can be changed only for the secondary failover groups", d.Id(), key)
+		}
+	}
+	return nil
+}
+
 // CreateFailoverGroup implements schema.CreateFunc.
 func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*provider.Context).Client
@@ -169,6 +209,12 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 		d.SetId(name)
+
+		if d.Get("suspended").(bool) {
+			if err := client.FailoverGroups.AlterTarget(ctx, id, &sdk.AlterTargetFailoverGroupOptions{Suspend: sdk.Bool(true)}); err != nil {
+				return err
+			}
+		}
 		return ReadFailoverGroup(d, meta)
 	}
 
@@ -275,8 +321,16 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("name", failoverGroup.Name); err != nil {
 		return err
 	}
-	// if the failover group is created from a replica, then we do not want to get the other values
-	if _, ok := d.GetOk("from_replica"); ok {
+	if err := d.Set("is_primary", failoverGroup.IsPrimary); err != nil {
+		return err
+	}
+	// only the scheduled refresh of a secondary failover group can be suspended
+	if err := d.Set("suspended", !failoverGroup.IsPrimary && failoverGroup.SecondaryState == sdk.FailoverGroupSecondaryStateSuspended); err != nil {
+		return err
+	}
+	// the replicated properties are changed only on the primary failover group, so they are not read for the secondary one;
+	// a failover group created from a replica doesn't manage them (they conflict with from_replica) even after the promotion
+	if _, ok := d.GetOk("from_replica"); ok || !failoverGroup.IsPrimary {
 		return nil
 	}
 
@@ -394,6 +448,28 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
+	// alter failover group <name> suspend | resume | refresh | primary
+	// the order matters for failover drills: the group is refreshed before the promotion
+	if d.HasChange("suspended") {
+		opts := &sdk.AlterTargetFailoverGroupOptions{Resume: sdk.Bool(true)}
+		if d.Get("suspended").(bool) {
+			opts = &sdk.AlterTargetFailoverGroupOptions{Suspend: sdk.Bool(true)}
+		}
+		if err := client.FailoverGroups.AlterTarget(ctx, id, opts); err != nil {
+			return fmt.Errorf("error suspending or resuming failover group %v err = %w", id.Name(), err)
+		}
+	}
+	if d.HasChange("refresh_trigger") {
+		if err := client.FailoverGroups.AlterTarget(ctx, id, &sdk.AlterTargetFailoverGroupOptions{Refresh: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error refreshing failover group %v err = %w", id.Name(), err)
+		}
+	}
+	if d.HasChange("promote_trigger") {
+		if err := client.FailoverGroups.AlterTarget(ctx, id, &sdk.AlterTargetFailoverGroupOptions{Primary: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error promoting failover group %v to primary err = %w", id.Name(), err)
+		}
+	}
+
 	// alter failover group <name> set ...
 	opts := &sdk.AlterSourceFailoverGroupOptions{
 		Set: &sdk.FailoverGroupSet{},
@@ -2,13 +2,16 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -268,6 +271,125 @@ func TestAcc_FailoverGroup_issue2544(t *testing.T) {
 	})
 }
 
+func TestAcc_FailoverGroup_refreshAndPromote(t *testing.T) {
+	// TODO [SNOW-1002023]: Unskip; Business Critical Snowflake Edition needed
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestFailoverGroups)
+
+	accountId := acc.TestClient().Account.GetAccountIdentifier(t)
+
+	// the primary failover group is located in the secondary account and the replica is managed by the resource
+	primaryFailoverGroup, primaryFailoverGroupCleanup := acc.SecondaryTestClient().FailoverGroup.CreateFailoverGroupWithOptions(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{accountId}, nil)
+	t.Cleanup(primaryFailoverGroupCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FailoverGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: failoverGroupFromReplica(primaryFailoverGroup, false, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "name", primaryFailoverGroup.Name),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "suspended", "false"),
+				),
+			},
+			// suspend and refresh in place
+			{
+				Config: failoverGroupFromReplica(primaryFailoverGroup, true, "1", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_failover_group.fg", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "suspended", "true"),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "refresh_trigger", "1"),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "is_primary", "false"),
+				),
+			},
+			// resume, refresh and promote
+			{
+				Config: failoverGroupFromReplica(primaryFailoverGroup, false, "2", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "suspended", "false"),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "promote_trigger", "1"),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "is_primary", "true"),
+				),
+			},
+			// the promoted group is treated as primary, so the refresh can't be triggered anymore
+			{
+				Config:      failoverGroupFromReplica(primaryFailoverGroup, false, "3", "1"),
+				ExpectError: regexp.MustCompile("refresh_trigger can be changed only for the secondary failover groups"),
+			},
+			// the previous primary group (now secondary) has to be dropped before the promoted group
+			{
+				PreConfig: primaryFailoverGroupCleanup,
+				Config:    failoverGroupFromReplica(primaryFailoverGroup, false, "2", "1"),
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+func TestAcc_FailoverGroup_secondaryOnlyAttributesOnPrimary(t *testing.T) {
+	randomCharacters := acc.TestClient().Ids.Alpha()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.FailoverGroup),
+		Steps: []resource.TestStep{
+			{
+				Config:      failoverGroupPrimaryWithSecondaryOnlyAttribute(randomCharacters, "suspended = true"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("all of `from_replica,suspended` must be specified"),
+			},
+			{
+				Config:      failoverGroupPrimaryWithSecondaryOnlyAttribute(randomCharacters, `refresh_trigger = "1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("all of `from_replica,refresh_trigger` must be specified"),
+			},
+			{
+				Config:      failoverGroupPrimaryWithSecondaryOnlyAttribute(randomCharacters, `promote_trigger = "1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("all of `from_replica,promote_trigger` must be specified"),
+			},
+		},
+	})
+}
+
+func failoverGroupPrimaryWithSecondaryOnlyAttribute(randomCharacters string, attribute string) string {
+	return fmt.Sprintf(`
+resource "snowflake_failover_group" "fg" {
+	name = "%s"
+	object_types = ["ROLES"]
+	%s
+}
+`, randomCharacters, attribute)
+}
+
+func failoverGroupFromReplica(primaryFailoverGroup *sdk.FailoverGroup, suspended bool, refreshTrigger string, promoteTrigger string) string {
+	return fmt.Sprintf(`
+resource "snowflake_failover_group" "fg" {
+	name = "%[1]s"
+	from_replica {
+		organization_name   = "%[2]s"
+		source_account_name = "%[3]s"
+		name                = "%[1]s"
+	}
+	suspended       = %[4]t
+	refresh_trigger = "%[5]s"
+	promote_trigger = "%[6]s"
+}
+`, primaryFailoverGroup.Name, primaryFailoverGroup.OrganizationName, primaryFailoverGroup.AccountName, suspended, refreshTrigger, promoteTrigger)
+}
+
 func failoverGroupBasic(randomCharacters, accountName, databaseName string) string {
 	return fmt.Sprintf(`
 resource "snowflake_failover_group" "fg" {
@@ -121,6 +121,9 @@ var functionSchema = map[string]*schema.Schema{
 		Default:     "user-defined function",
 		Description: "Specifies a comment for the function.",
 	},
+	"log_level":    logLevelSchema("function"),
+	"trace_level":  traceLevelSchema("function"),
+	"metric_level": metricLevelSchema("function"),
 	"runtime_version": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -277,6 +280,9 @@ func createJavaFunction(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	nid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argumentTypes)
 	d.SetId(nid.FullyQualifiedName())
+	if err := updateFunctionLoggingLevels(ctx, client, nid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -349,6 +355,9 @@ func createScalaFunction(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	nid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argumentTypes)
 	d.SetId(nid.FullyQualifiedName())
+	if err := updateFunctionLoggingLevels(ctx, client, nid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -395,6 +404,9 @@ func createSQLFunction(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	nid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argumentTypes)
 	d.SetId(nid.FullyQualifiedName())
+	if err := updateFunctionLoggingLevels(ctx, client, nid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -462,6 +474,9 @@ func createPythonFunction(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	nid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argumentTypes)
 	d.SetId(nid.FullyQualifiedName())
+	if err := updateFunctionLoggingLevels(ctx, client, nid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -511,6 +526,9 @@ func createJavascriptFunction(ctx context.Context, d *schema.ResourceData, meta
 	}
 	nid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argumentTypes)
 	d.SetId(nid.FullyQualifiedName())
+	if err := updateFunctionLoggingLevels(ctx, client, nid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -649,6 +667,10 @@ func ReadContextFunction(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 	}
+	if err := readLoggingLevels(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeFunction, Name: id}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -694,6 +716,10 @@ func UpdateContextFunction(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if err := updateFunctionLoggingLevels(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextFunction(ctx, d, meta)
 }
 
@@ -772,3 +798,41 @@ func parseFunctionReturnsRequest(s string) (*sdk.FunctionReturnsRequest, diag.Di
 	}
 	return returns, nil
 }
+
+// updateFunctionLoggingLevels sets or unsets the changed logging levels; it is used both on create and on update.
+func updateFunctionLoggingLevels(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if d.HasChange("log_level") {
+		request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("log_level").(string); v != "" {
+			request.WithSetLogLevel(sdk.String(v))
+		} else {
+			request.WithUnsetLogLevel(sdk.Bool(true))
+		}
+		if err := client.Functions.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("trace_level") {
+		request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("trace_level").(string); v != "" {
+			request.WithSetTraceLevel(sdk.String(v))
+		} else {
+			request.WithUnsetTraceLevel(sdk.Bool(true))
+		}
+		if err := client.Functions.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("metric_level") {
+		request := sdk.NewAlterFunctionRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("metric_level").(string); v != "" {
+			request.WithSetMetricLevel(sdk.String(v))
+		} else {
+			request.WithUnsetMetricLevel(sdk.Bool(true))
+		}
+		if err := client.Functions.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -290,6 +290,90 @@ resource "snowflake_function" "f" {
 `, database, schema, name, comment)
 }
 
+func TestAcc_Function_loggingLevels(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	resourceName := "snowflake_function.f"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Function),
+		Steps: []resource.TestStep{
+			// set
+			{
+				Config: functionConfigWithLoggingLevels(acc.TestDatabaseName, acc.TestSchemaName, name, "INFO", "ON_EVENT", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", "ON_EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", "ALL"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"null_input_behavior", "return_behavior"},
+			},
+			// change
+			{
+				Config: functionConfigWithLoggingLevels(acc.TestDatabaseName, acc.TestSchemaName, name, "ERROR", "ALWAYS", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", "ALWAYS"),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", "NONE"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// unset
+			{
+				Config: functionConfig(acc.TestDatabaseName, acc.TestSchemaName, name, "user-defined function"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", ""),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", ""),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", ""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func functionConfigWithLoggingLevels(database string, schema string, name string, logLevel string, traceLevel string, metricLevel string) string {
+	return fmt.Sprintf(`
+resource "snowflake_function" "f" {
+  database        = "%[1]s"
+  schema          = "%[2]s"
+  name            = "%[3]s"
+  return_type     = "VARCHAR"
+  return_behavior = "IMMUTABLE"
+  statement       = "SELECT PARAM"
+  log_level       = "%[4]s"
+  trace_level     = "%[5]s"
+  metric_level    = "%[6]s"
+
+  arguments {
+    name = "PARAM"
+    type = "VARCHAR"
+  }
+}
+`, database, schema, name, logLevel, traceLevel, metricLevel)
+}
+
 // TODO [SNOW-1348103]: do not trim the data type (e.g. NUMBER(10, 2) -> NUMBER loses the information as shown in this test); finish the test
 // proves https://github.com/Snowflake-Labs/terraform-provider-snowflake/issues/2735
 func TestAcc_Function_gh2735(t *testing.T) {
@@ -0,0 +1,215 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositorySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the git repository; must be unique for the schema in which the git repository is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the git repository.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the git repository.",
+	},
+	"origin": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the origin URL of the remote git repository (e.g. `https://github.com/my-account/my-repository.git`).",
+	},
+	"api_integration": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		Description:      "Specifies the name of the API integration (with `API_PROVIDER = git_https_api`) containing information about the remote git repository.",
+	},
+	"git_credentials": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Qualified name (`\"db\".\"schema\".\"secret_name\"`) of the secret containing the credentials to use for authenticating with the remote git repository.",
+	},
+	"fetch_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value; changing it triggers `ALTER GIT REPOSITORY ... FETCH` which fetches the latest content of the remote git repository (e.g. set it to a commit hash or a timestamp).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the git repository.",
+	},
+	"last_fetched_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time of the last fetch from the remote git repository.",
+	},
+}
+
+// GitRepository returns a pointer to the resource representing a git repository.
+func GitRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage git repository objects. The content of the repository is available on the repository stage (e.g. `@db.schema.repository/branches/main/`). For more information, check [git repository documentation](https://docs.snowflake.com/en/developer-guide/git/git-overview).",
+
+		CreateContext: CreateContextGitRepository,
+		ReadContext:   ReadContextGitRepository,
+		UpdateContext: UpdateContextGitRepository,
+		DeleteContext: DeleteContextGitRepository,
+
+		Schema: gitRepositorySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateGitRepositoryRequest(id, d.Get("origin").(string), sdk.NewAccountObjectIdentifier(d.Get("api_integration").(string)))
+	if v, ok := d.GetOk("git_credentials"); ok {
+		request.WithGitCredentials(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.GitRepositories.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextGitRepository(ctx, d, meta)
+}
+
+func ReadContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve git repository. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	var gitCredentials, comment, lastFetchedAt string
+	if gitRepository.GitCredentials != nil {
+		gitCredentials = *gitRepository.GitCredentials
+	}
+	if gitRepository.Comment != nil {
+		comment = *gitRepository.Comment
+	}
+	if gitRepository.LastFetchedAt != nil {
+		lastFetchedAt = gitRepository.LastFetchedAt.String()
+	}
+
+	toSet := map[string]any{
+		"name":            gitRepository.Name,
+		"database":        gitRepository.DatabaseName,
+		"schema":          gitRepository.SchemaName,
+		"origin":          gitRepository.Origin,
+		"api_integration": gitRepository.ApiIntegration,
+		"git_credentials": gitCredentials,
+		"comment":         comment,
+		"last_fetched_at": lastFetchedAt,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewGitRepositorySetRequest(), sdk.NewGitRepositoryUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("api_integration") {
+		set.WithApiIntegration(sdk.NewAccountObjectIdentifier(d.Get("api_integration").(string)))
+		runSet = true
+	}
+	if d.HasChange("git_credentials") {
+		if v, ok := d.GetOk("git_credentials"); ok {
+			set.WithGitCredentials(sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.(string)))
+			runSet = true
+		} else {
+			unset.WithGitCredentials(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// fetch after the other changes, so that the new credentials or integration are already used
+	if d.HasChange("fetch_trigger") {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching git repository %v err = %w", d.Id(), err))
+		}
+	}
+
+	return ReadContextGitRepository(ctx, d, meta)
+}
+
+func DeleteContextGitRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitRepository_basic(t *testing.T) {
+	apiIntegrationId, apiIntegrationCleanup := acc.TestClient().ApiIntegration.CreateGitApiIntegration(t)
+	t.Cleanup(apiIntegrationCleanup)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.GitRepository),
+		Steps: []resource.TestStep{
+			{
+				Config: gitRepositoryConfig(id, apiIntegrationId, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "origin", helpers.GitRepositoryOrigin),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "api_integration", apiIntegrationId.Name()),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "git_credentials", ""),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_git_repository.test", "last_fetched_at"),
+				),
+			},
+			// set the comment and fetch in place
+			{
+				Config: gitRepositoryConfig(id, apiIntegrationId, "2", "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_git_repository.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "fetch_trigger", "2"),
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "comment", "some comment"),
+				),
+			},
+			// unset the comment
+			{
+				Config: gitRepositoryConfig(id, apiIntegrationId, "2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_git_repository.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_git_repository.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_trigger"},
+			},
+		},
+	})
+}
+
+func gitRepositoryConfig(id sdk.SchemaObjectIdentifier, apiIntegrationId sdk.AccountObjectIdentifier, fetchTrigger string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_git_repository" "test" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	origin          = "%[4]s"
+	api_integration = "%[5]s"
+	fetch_trigger   = "%[6]s"
+	comment         = "%[7]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), helpers.GitRepositoryOrigin, apiIntegrationId.Name(), fetchTrigger, comment)
+}
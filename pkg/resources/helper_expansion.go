@@ -2,6 +2,8 @@ package resources
 
 import (
 	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 // borrowed from https://github.com/terraform-providers/terraform-provider-aws/blob/master/aws/structure.go#L924:6
@@ -41,6 +43,44 @@ func expandStringListAllowEmpty(configured []interface{}) []string {
 	return vs
 }
 
+func expandAccountObjectIdentifiers(configured []interface{}) []sdk.AccountObjectIdentifier {
+	names := expandStringList(configured)
+	ids := make([]sdk.AccountObjectIdentifier, len(names))
+	for i, name := range names {
+		ids[i] = sdk.NewAccountObjectIdentifier(name)
+	}
+	return ids
+}
+
+func expandSchemaObjectIdentifiers(configured []interface{}) []sdk.SchemaObjectIdentifier {
+	names := expandStringList(configured)
+	ids := make([]sdk.SchemaObjectIdentifier, len(names))
+	for i, name := range names {
+		ids[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name)
+	}
+	return ids
+}
+
+// accountObjectIdentifiersFromList returns names of the account objects listed in the value returned by Snowflake (e.g. "[A, B]").
+func accountObjectIdentifiersFromList(value string) []string {
+	names := sdk.ParseCommaSeparatedStringArray(value)
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = sdk.NewAccountObjectIdentifier(name).Name()
+	}
+	return result
+}
+
+// schemaObjectIdentifiersFromList returns fully qualified names of the schema objects listed in the value returned by Snowflake (e.g. "[DB.SCHEMA.A, DB.SCHEMA.B]").
+func schemaObjectIdentifiersFromList(value string) []string {
+	names := sdk.ParseCommaSeparatedStringArray(value)
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(name).FullyQualifiedName()
+	}
+	return result
+}
+
 func expandObjectIdentifier(objectIdentifier interface{}) (string, string, string) {
 	objectIdentifierMap := objectIdentifier.([]interface{})[0].(map[string]interface{})
 	objectName := objectIdentifierMap["name"].(string)
@@ -3,6 +3,7 @@ package resources
 import (
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,3 +27,29 @@ func TestExpandBlankStringList(t *testing.T) {
 
 	r.Equal(0, len(out))
 }
+
+func TestExpandSchemaObjectIdentifiers(t *testing.T) {
+	r := require.New(t)
+
+	in := []interface{}{`"db"."schema"."a"`, "db.schema.b"}
+	out := expandSchemaObjectIdentifiers(in)
+
+	r.Equal([]sdk.SchemaObjectIdentifier{
+		sdk.NewSchemaObjectIdentifier("db", "schema", "a"),
+		sdk.NewSchemaObjectIdentifier("db", "schema", "b"),
+	}, out)
+}
+
+func TestSchemaObjectIdentifiersFromList(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{`"DB"."SCHEMA"."A"`, `"DB"."SCHEMA"."B"`}, schemaObjectIdentifiersFromList("[DB.SCHEMA.A, DB.SCHEMA.B]"))
+	r.Empty(schemaObjectIdentifiersFromList(""))
+}
+
+func TestAccountObjectIdentifiersFromList(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"A", "B"}, accountObjectIdentifiersFromList("[A, B]"))
+	r.Empty(accountObjectIdentifiersFromList("[]"))
+}
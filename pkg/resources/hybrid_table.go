@@ -0,0 +1,819 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var hybridTableConstraintColumnsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Required:    true,
+	ForceNew:    true,
+	MinItems:    1,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+	Description: "Columns of the hybrid table that the constraint consists of.",
+}
+
+var hybridTableConstraintNameSchema = &schema.Schema{
+	Type:     schema.TypeString,
+	Optional: true,
+	Computed: true,
+	ForceNew: true,
+	// constraint names are not quoted, so Snowflake returns them upper-cased
+	DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+		return strings.EqualFold(oldValue, newValue)
+	},
+	Description: "Name of the constraint. When not specified, the name generated by Snowflake is used.",
+}
+
+var hybridTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the hybrid table; must be unique for the database and schema in which the hybrid table is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the hybrid table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the hybrid table.",
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "Definitions of the columns of the hybrid table. Columns can be added, dropped and commented in place; changing the type, nullability or default of an existing column recreates the table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Column name.",
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Column type, e.g. NUMBER(10, 0).",
+					ValidateFunc:     dataTypeValidateFunc,
+					DiffSuppressFunc: dataTypeDiffSuppressFunc,
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether this column can contain null values.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Expression used as the default value of the column, e.g. `CURRENT_TIMESTAMP()` or `'unknown'`. The value is not read back from Snowflake.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column comment.",
+				},
+			},
+		},
+	},
+	"primary_key": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Definition of the primary key of the hybrid table. Every hybrid table requires an enforced primary key.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":    hybridTableConstraintNameSchema,
+				"columns": hybridTableConstraintColumnsSchema,
+			},
+		},
+	},
+	"unique_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Definitions of the enforced unique keys of the hybrid table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":    hybridTableConstraintNameSchema,
+				"columns": hybridTableConstraintColumnsSchema,
+			},
+		},
+	},
+	"foreign_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Definitions of the enforced foreign keys of the hybrid table. Referenced tables have to be hybrid tables as well.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":    hybridTableConstraintNameSchema,
+				"columns": hybridTableConstraintColumnsSchema,
+				"references": {
+					Type:        schema.TypeList,
+					Required:    true,
+					ForceNew:    true,
+					MaxItems:    1,
+					Description: "The table and columns that the foreign key references.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table_id": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								DiffSuppressFunc: suppressIdentifierQuoting,
+								Description:      "Fully qualified name of the referenced hybrid table, e.g. `snowflake_hybrid_table.parent.qualified_name`.",
+							},
+							"columns": {
+								Type:        schema.TypeList,
+								Required:    true,
+								ForceNew:    true,
+								MinItems:    1,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Columns of the referenced table.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"index": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Definitions of the secondary indexes of the hybrid table. Indexes are created and dropped in place with CREATE INDEX and DROP INDEX.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					Description:      "Name of the index.",
+				},
+				"columns": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Columns of the hybrid table that the index is built on.",
+				},
+				"include_columns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional columns stored in the index to avoid table lookups.",
+				},
+			},
+		},
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntBetween(-1, 90),
+		Description:  "Specifies the retention period for the hybrid table so that Time Travel actions can be performed on historical data. The default value of -1 means the value is inherited from the schema.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the hybrid table.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the hybrid table.",
+	},
+}
+
+// HybridTable returns a pointer to the resource representing a hybrid table.
+func HybridTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage hybrid table objects. For more information, check [hybrid table documentation](https://docs.snowflake.com/en/user-guide/tables-hybrid).",
+
+		CreateContext: CreateContextHybridTable,
+		ReadContext:   ReadContextHybridTable,
+		UpdateContext: UpdateContextHybridTable,
+		DeleteContext: DeleteContextHybridTable,
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("column", hybridTableColumnsRequireRecreation),
+		),
+
+		Schema: hybridTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// hybridTableColumnsRequireRecreation returns true when the type, nullability or default of an existing column changes,
+// because hybrid tables don't support altering them in place.
+func hybridTableColumnsRequireRecreation(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+	if d.Id() == "" {
+		return false
+	}
+	o, n := d.GetChange("column")
+	_, _, changed := hybridTableColumnsDiff(hybridTableColumnsFromList(o.([]any)), hybridTableColumnsFromList(n.([]any)))
+	for _, c := range changed {
+		if c.changedDataType || c.changedNullable || c.changedDefault {
+			return true
+		}
+	}
+	return false
+}
+
+type hybridTableColumn struct {
+	name         string
+	dataType     string
+	nullable     bool
+	defaultValue string
+	comment      string
+}
+
+type changedHybridTableColumn struct {
+	newColumn       hybridTableColumn
+	changedDataType bool
+	changedNullable bool
+	changedDefault  bool
+	changedComment  bool
+}
+
+func hybridTableColumnsFromList(v []any) []hybridTableColumn {
+	columns := make([]hybridTableColumn, 0, len(v))
+	for _, raw := range v {
+		m := raw.(map[string]any)
+		columns = append(columns, hybridTableColumn{
+			name:         m["name"].(string),
+			dataType:     m["type"].(string),
+			nullable:     m["nullable"].(bool),
+			defaultValue: m["default"].(string),
+			comment:      m["comment"].(string),
+		})
+	}
+	return columns
+}
+
+// hybridTableColumnsDiff matches the columns by name and returns the columns to drop, the columns to add and the columns with changed properties.
+func hybridTableColumnsDiff(oldColumns []hybridTableColumn, newColumns []hybridTableColumn) (removed []hybridTableColumn, added []hybridTableColumn, changed []changedHybridTableColumn) {
+	oldByName := make(map[string]hybridTableColumn, len(oldColumns))
+	for _, c := range oldColumns {
+		oldByName[c.name] = c
+	}
+	newByName := make(map[string]hybridTableColumn, len(newColumns))
+	for _, c := range newColumns {
+		newByName[c.name] = c
+	}
+
+	for _, c := range oldColumns {
+		if _, ok := newByName[c.name]; !ok {
+			removed = append(removed, c)
+		}
+	}
+	for _, c := range newColumns {
+		oldColumn, ok := oldByName[c.name]
+		if !ok {
+			added = append(added, c)
+			continue
+		}
+		change := changedHybridTableColumn{
+			newColumn:       c,
+			changedDataType: !dataTypeDiffSuppressFunc("", oldColumn.dataType, c.dataType, nil),
+			changedNullable: oldColumn.nullable != c.nullable,
+			changedDefault:  oldColumn.defaultValue != c.defaultValue,
+			changedComment:  oldColumn.comment != c.comment,
+		}
+		if change.changedDataType || change.changedNullable || change.changedDefault || change.changedComment {
+			changed = append(changed, change)
+		}
+	}
+	return removed, added, changed
+}
+
+type hybridTableIndex struct {
+	name           string
+	columns        []string
+	includeColumns []string
+}
+
+func hybridTableIndexesFromList(v []any) []hybridTableIndex {
+	indexes := make([]hybridTableIndex, 0, len(v))
+	for _, raw := range v {
+		m := raw.(map[string]any)
+		indexes = append(indexes, hybridTableIndex{
+			name:           m["name"].(string),
+			columns:        expandStringList(m["columns"].([]any)),
+			includeColumns: expandStringList(m["include_columns"].([]any)),
+		})
+	}
+	return indexes
+}
+
+// hybridTableIndexesDiff matches the indexes by name and returns the indexes to drop and the indexes to create.
+// Indexes can't be altered, so a changed index is both dropped and created.
+func hybridTableIndexesDiff(oldIndexes []hybridTableIndex, newIndexes []hybridTableIndex) (removed []hybridTableIndex, added []hybridTableIndex) {
+	oldByName := make(map[string]hybridTableIndex, len(oldIndexes))
+	for _, i := range oldIndexes {
+		oldByName[i.name] = i
+	}
+	newByName := make(map[string]hybridTableIndex, len(newIndexes))
+	for _, i := range newIndexes {
+		newByName[i.name] = i
+	}
+
+	for _, i := range oldIndexes {
+		if newIndex, ok := newByName[i.name]; !ok || !hybridTableIndexesEqual(i, newIndex) {
+			removed = append(removed, i)
+		}
+	}
+	for _, i := range newIndexes {
+		if oldIndex, ok := oldByName[i.name]; !ok || !hybridTableIndexesEqual(oldIndex, i) {
+			added = append(added, i)
+		}
+	}
+	return removed, added
+}
+
+func hybridTableIndexesEqual(a hybridTableIndex, b hybridTableIndex) bool {
+	return fmt.Sprint(a.columns) == fmt.Sprint(b.columns) && fmt.Sprint(a.includeColumns) == fmt.Sprint(b.includeColumns)
+}
+
+func hybridTableQuotedColumnNames(names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf(`"%s"`, name)
+	}
+	return quoted
+}
+
+func hybridTableColumnsToRequests(columns []hybridTableColumn) []sdk.HybridTableColumnRequest {
+	requests := make([]sdk.HybridTableColumnRequest, len(columns))
+	for i, c := range columns {
+		request := sdk.NewHybridTableColumnRequest(fmt.Sprintf(`"%s"`, c.name), sdk.DataType(c.dataType))
+		if !c.nullable {
+			request.WithNotNull(true)
+		}
+		if c.defaultValue != "" {
+			request.WithDefaultValue(sdk.ColumnDefaultValue{Expression: sdk.String(c.defaultValue)})
+		}
+		if c.comment != "" {
+			request.WithComment(c.comment)
+		}
+		requests[i] = *request
+	}
+	return requests
+}
+
+func hybridTableConstraintsFromConfig(d *schema.ResourceData) ([]sdk.OutOfLineConstraint, error) {
+	constraints := make([]sdk.OutOfLineConstraint, 0)
+	newConstraint := func(constraintType sdk.ColumnConstraintType, m map[string]any) sdk.OutOfLineConstraint {
+		constraint := sdk.OutOfLineConstraint{
+			Type:    constraintType,
+			Columns: hybridTableQuotedColumnNames(expandStringList(m["columns"].([]any))),
+		}
+		if name := m["name"].(string); name != "" {
+			constraint.Name = sdk.String(name)
+		}
+		return constraint
+	}
+
+	for _, raw := range d.Get("primary_key").([]any) {
+		constraints = append(constraints, newConstraint(sdk.ColumnConstraintTypePrimaryKey, raw.(map[string]any)))
+	}
+	for _, raw := range d.Get("unique_key").([]any) {
+		constraints = append(constraints, newConstraint(sdk.ColumnConstraintTypeUnique, raw.(map[string]any)))
+	}
+	for _, raw := range d.Get("foreign_key").([]any) {
+		m := raw.(map[string]any)
+		references := m["references"].([]any)[0].(map[string]any)
+		tableId := references["table_id"].(string)
+		referencedId, err := helpers.DecodeSnowflakeParameterID(tableId)
+		if err != nil {
+			return nil, fmt.Errorf("table id is incorrect: %s, err: %w", tableId, err)
+		}
+		referencedTableId, ok := referencedId.(sdk.SchemaObjectIdentifier)
+		if !ok {
+			return nil, fmt.Errorf("table id is incorrect: %s", tableId)
+		}
+		constraint := newConstraint(sdk.ColumnConstraintTypeForeignKey, m)
+		constraint.ForeignKey = &sdk.OutOfLineForeignKey{
+			TableName:   referencedTableId,
+			ColumnNames: hybridTableQuotedColumnNames(expandStringList(references["columns"].([]any))),
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+func hybridTableIndexesToRequests(indexes []hybridTableIndex) []sdk.HybridTableOutOfLineIndexRequest {
+	requests := make([]sdk.HybridTableOutOfLineIndexRequest, len(indexes))
+	for i, index := range indexes {
+		request := sdk.NewHybridTableOutOfLineIndexRequest(index.name, hybridTableQuotedColumnNames(index.columns))
+		if len(index.includeColumns) > 0 {
+			request.WithIncludeColumns(hybridTableQuotedColumnNames(index.includeColumns))
+		}
+		requests[i] = *request
+	}
+	return requests
+}
+
+// hybridTableColumnsToList converts the described columns; defaults are taken from the current state, because Snowflake returns them in a normalized form.
+func hybridTableColumnsToList(details []sdk.TableColumnDetails, current []hybridTableColumn) []any {
+	defaults := make(map[string]string, len(current))
+	for _, c := range current {
+		defaults[c.name] = c.defaultValue
+	}
+	columns := make([]any, 0, len(details))
+	for _, c := range details {
+		if c.Kind != "COLUMN" {
+			continue
+		}
+		var comment string
+		if c.Comment != nil {
+			comment = *c.Comment
+		}
+		columns = append(columns, map[string]any{
+			"name":     c.Name,
+			"type":     string(c.Type),
+			"nullable": c.IsNullable,
+			"default":  defaults[c.Name],
+			"comment":  comment,
+		})
+	}
+	return columns
+}
+
+// hybridTableIndexesToList converts the secondary indexes of the table. Indexes backing the constraints are skipped:
+// unique ones are recognized by the flag and non-unique ones (created for foreign keys) are kept only when already managed or during import.
+func hybridTableIndexesToList(indexes []sdk.HybridTableIndex, current []hybridTableIndex, importing bool) []any {
+	managed := make(map[string]bool, len(current))
+	for _, i := range current {
+		managed[i.name] = true
+	}
+	result := make([]any, 0, len(indexes))
+	for _, i := range indexes {
+		if i.IsUnique || (!importing && !managed[i.Name]) {
+			continue
+		}
+		result = append(result, map[string]any{
+			"name":            i.Name,
+			"columns":         i.Columns,
+			"include_columns": i.IncludedColumns,
+		})
+	}
+	return result
+}
+
+type hybridTableConstraint struct {
+	name              string
+	columns           []string
+	referencedTableId string
+	referencedColumns []string
+}
+
+func hybridTableConstraintsFromList(v []any) []hybridTableConstraint {
+	constraints := make([]hybridTableConstraint, 0, len(v))
+	for _, raw := range v {
+		m := raw.(map[string]any)
+		constraint := hybridTableConstraint{
+			name:    m["name"].(string),
+			columns: expandStringList(m["columns"].([]any)),
+		}
+		if references, ok := m["references"].([]any); ok && len(references) > 0 {
+			r := references[0].(map[string]any)
+			constraint.referencedTableId = r["table_id"].(string)
+			constraint.referencedColumns = expandStringList(r["columns"].([]any))
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints
+}
+
+// hybridTableKeysFromRows groups the columns returned by SHOW PRIMARY KEYS or SHOW UNIQUE KEYS by the constraint name.
+func hybridTableKeysFromRows(rows []sdk.TableKeyColumn) []hybridTableConstraint {
+	rows = slices.Clone(rows)
+	slices.SortStableFunc(rows, func(a, b sdk.TableKeyColumn) int { return a.KeySequence - b.KeySequence })
+	constraints := make([]hybridTableConstraint, 0)
+	positions := make(map[string]int)
+	for _, row := range rows {
+		position, ok := positions[row.ConstraintName]
+		if !ok {
+			position = len(constraints)
+			positions[row.ConstraintName] = position
+			constraints = append(constraints, hybridTableConstraint{name: row.ConstraintName})
+		}
+		constraints[position].columns = append(constraints[position].columns, row.ColumnName)
+	}
+	return constraints
+}
+
+// hybridTableForeignKeysFromRows groups the columns returned by SHOW IMPORTED KEYS by the foreign key name.
+func hybridTableForeignKeysFromRows(rows []sdk.TableImportedKeyColumn) []hybridTableConstraint {
+	rows = slices.Clone(rows)
+	slices.SortStableFunc(rows, func(a, b sdk.TableImportedKeyColumn) int { return a.KeySequence - b.KeySequence })
+	constraints := make([]hybridTableConstraint, 0)
+	positions := make(map[string]int)
+	for _, row := range rows {
+		position, ok := positions[row.FkName]
+		if !ok {
+			position = len(constraints)
+			positions[row.FkName] = position
+			constraints = append(constraints, hybridTableConstraint{name: row.FkName, referencedTableId: row.PkTableId().FullyQualifiedName()})
+		}
+		constraints[position].columns = append(constraints[position].columns, row.FkColumnName)
+		constraints[position].referencedColumns = append(constraints[position].referencedColumns, row.PkColumnName)
+	}
+	return constraints
+}
+
+// hybridTableConstraintsToList converts the constraints read from Snowflake keeping the order of the constraints from the current state.
+// Constraints are matched by name or, when the name is not known yet, by columns; the remaining constraints are appended at the end.
+func hybridTableConstraintsToList(constraints []hybridTableConstraint, current []hybridTableConstraint, withReferences bool) []any {
+	ordered := make([]hybridTableConstraint, 0, len(constraints))
+	used := make([]bool, len(constraints))
+	for _, c := range current {
+		for i, constraint := range constraints {
+			if used[i] {
+				continue
+			}
+			if (c.name != "" && strings.EqualFold(c.name, constraint.name)) || (c.name == "" && slices.Equal(c.columns, constraint.columns)) {
+				ordered = append(ordered, constraint)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, constraint := range constraints {
+		if !used[i] {
+			ordered = append(ordered, constraint)
+		}
+	}
+
+	result := make([]any, len(ordered))
+	for i, c := range ordered {
+		m := map[string]any{
+			"name":    c.name,
+			"columns": c.columns,
+		}
+		if withReferences {
+			m["references"] = []any{
+				map[string]any{
+					"table_id": c.referencedTableId,
+					"columns":  c.referencedColumns,
+				},
+			}
+		}
+		result[i] = m
+	}
+	return result
+}
+
+func CreateContextHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	constraints, err := hybridTableConstraintsFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	columnsConstraintsAndIndexes := sdk.NewHybridTableColumnsConstraintsAndIndexesRequest().
+		WithColumns(hybridTableColumnsToRequests(hybridTableColumnsFromList(d.Get("column").([]any)))).
+		WithOutOfLineConstraint(constraints)
+	if indexes := hybridTableIndexesFromList(d.Get("index").([]any)); len(indexes) > 0 {
+		columnsConstraintsAndIndexes.WithOutOfLineIndex(hybridTableIndexesToRequests(indexes))
+	}
+
+	request := sdk.NewCreateHybridTableRequest(id, *columnsConstraintsAndIndexes)
+	if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+		request.WithDataRetentionTimeInDays(v)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.HybridTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextHybridTable(ctx, d, meta)
+}
+
+func ReadContextHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve hybrid table. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexes, err := client.HybridTableIndexes.Show(ctx, sdk.NewShowHybridTableIndexRequest().WithInTable(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryKeys, err := client.Tables.ShowPrimaryKeys(ctx, sdk.NewShowTablePrimaryKeysRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	uniqueKeys, err := client.Tables.ShowUniqueKeys(ctx, sdk.NewShowTableUniqueKeysRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	foreignKeys, err := client.Tables.ShowImportedKeys(ctx, sdk.NewShowTableImportedKeysRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var comment string
+	if hybridTable.Comment != nil {
+		comment = *hybridTable.Comment
+	}
+	// every hybrid table has a primary key, so an empty primary key in state means the resource is being imported
+	importing := len(d.Get("primary_key").([]any)) == 0
+
+	toSet := map[string]any{
+		"name":           hybridTable.Name,
+		"database":       hybridTable.DatabaseName,
+		"schema":         hybridTable.SchemaName,
+		"column":         hybridTableColumnsToList(columns, hybridTableColumnsFromList(d.Get("column").([]any))),
+		"primary_key":    hybridTableConstraintsToList(hybridTableKeysFromRows(primaryKeys), hybridTableConstraintsFromList(d.Get("primary_key").([]any)), false),
+		"unique_key":     hybridTableConstraintsToList(hybridTableKeysFromRows(uniqueKeys), hybridTableConstraintsFromList(d.Get("unique_key").([]any)), false),
+		"foreign_key":    hybridTableConstraintsToList(hybridTableForeignKeysFromRows(foreignKeys), hybridTableConstraintsFromList(d.Get("foreign_key").([]any)), true),
+		"index":          hybridTableIndexesToList(indexes, hybridTableIndexesFromList(d.Get("index").([]any)), importing),
+		"comment":        comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming hybrid table %v err = %w", d.Id(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	// indexes are dropped before the columns, because a column used by an index can't be dropped
+	var addedIndexes []hybridTableIndex
+	if d.HasChange("index") {
+		o, n := d.GetChange("index")
+		var removedIndexes []hybridTableIndex
+		removedIndexes, addedIndexes = hybridTableIndexesDiff(hybridTableIndexesFromList(o.([]any)), hybridTableIndexesFromList(n.([]any)))
+		for _, i := range removedIndexes {
+			indexId := sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), i.name)
+			if err := client.HybridTableIndexes.Drop(ctx, sdk.NewDropHybridTableIndexRequest(indexId).WithIfExists(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping index %s of hybrid table %v err = %w", i.name, d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("column") {
+		o, n := d.GetChange("column")
+		removed, added, changed := hybridTableColumnsDiff(hybridTableColumnsFromList(o.([]any)), hybridTableColumnsFromList(n.([]any)))
+
+		if len(removed) > 0 {
+			names := make([]string, len(removed))
+			for i, c := range removed {
+				names[i] = c.name
+			}
+			if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithDropColumns(*sdk.NewHybridTableDropColumnsRequest(hybridTableQuotedColumnNames(names)))); err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping columns of hybrid table %v err = %w", d.Id(), err))
+			}
+		}
+
+		for _, c := range added {
+			if !c.nullable {
+				return diag.FromErr(fmt.Errorf("column %s can't be added to hybrid table %v as not nullable", c.name, d.Id()))
+			}
+			addRequest := sdk.NewHybridTableAddColumnRequest(fmt.Sprintf(`"%s"`, c.name), sdk.DataType(c.dataType))
+			if c.defaultValue != "" {
+				addRequest.WithDefaultValue(sdk.ColumnDefaultValue{Expression: sdk.String(c.defaultValue)})
+			}
+			if c.comment != "" {
+				addRequest.WithComment(c.comment)
+			}
+			if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithAddColumn(*addRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding column %s to hybrid table %v err = %w", c.name, d.Id(), err))
+			}
+		}
+
+		for _, c := range changed {
+			if !c.changedComment {
+				continue
+			}
+			alterRequest := sdk.NewHybridTableAlterColumnRequest(fmt.Sprintf(`"%s"`, c.newColumn.name))
+			if c.newColumn.comment == "" {
+				alterRequest.WithUnsetComment(true)
+			} else {
+				alterRequest.WithComment(c.newColumn.comment)
+			}
+			if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithAlterColumn(*alterRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error changing comment of column %s of hybrid table %v err = %w", c.newColumn.name, d.Id(), err))
+			}
+		}
+	}
+
+	for _, i := range addedIndexes {
+		request := sdk.NewCreateHybridTableIndexRequest(i.name, id, hybridTableQuotedColumnNames(i.columns))
+		if len(i.includeColumns) > 0 {
+			request.WithIncludeColumns(hybridTableQuotedColumnNames(i.includeColumns))
+		}
+		if err := client.HybridTableIndexes.Create(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating index %s of hybrid table %v err = %w", i.name, d.Id(), err))
+		}
+	}
+
+	set := sdk.NewHybridTableSetRequest()
+	unset := sdk.NewHybridTableUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("data_retention_time_in_days") {
+		if v := d.Get("data_retention_time_in_days").(int); v != -1 {
+			set.WithDataRetentionTimeInDays(v)
+			runSet = true
+		} else {
+			unset.WithDataRetentionTimeInDays(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextHybridTable(ctx, d, meta)
+}
+
+func DeleteContextHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
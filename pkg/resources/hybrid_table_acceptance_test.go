@@ -0,0 +1,244 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_HybridTable_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			{
+				Config: hybridTableConfig(id, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					acc.AssertThat(t,
+						resourceassert.HybridTableResource(t, "snowflake_hybrid_table.test").
+							HasName(id.Name()).
+							HasDatabase(id.DatabaseName()).
+							HasSchema(id.SchemaName()).
+							HasColumnCount(2).
+							HasPrimaryKeyCount(1).
+							HasUniqueKeyCount(1).
+							HasIndexCount(0).
+							HasComment("").
+							HasQualifiedName(id.FullyQualifiedName()),
+						objectassert.HybridTable(t, id).
+							HasName(id.Name()).
+							HasDatabaseName(id.DatabaseName()).
+							HasSchemaName(id.SchemaName()),
+					),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.0.name", "ID"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.0.nullable", "false"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.1.name", "EMAIL"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "primary_key.0.columns.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "primary_key.0.columns.0", "ID"),
+					resource.TestCheckResourceAttrSet("snowflake_hybrid_table.test", "primary_key.0.name"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "unique_key.0.columns.0", "EMAIL"),
+					resource.TestCheckResourceAttrSet("snowflake_hybrid_table.test", "unique_key.0.name"),
+				),
+			},
+			// add a column and an index and set the comment in place
+			{
+				Config: hybridTableConfig(id, `
+	column {
+		name    = "NAME"
+		type    = "VARCHAR(100)"
+		comment = "name column"
+	}
+	index {
+		name            = "IDX_NAME"
+		columns         = ["NAME"]
+		include_columns = ["EMAIL"]
+	}
+`, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_hybrid_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.#", "3"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.2.name", "NAME"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.2.comment", "name column"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.0.name", "IDX_NAME"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.0.columns.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.0.columns.0", "NAME"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.0.include_columns.0", "EMAIL"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "comment", "some comment"),
+				),
+			},
+			// drop the index and the column and unset the comment
+			{
+				Config: hybridTableConfig(id, "", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_hybrid_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "index.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "comment", ""),
+				),
+			},
+			// rename
+			{
+				Config: hybridTableConfig(newId, "", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_hybrid_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.test", "name", newId.Name()),
+				),
+			},
+			{
+				ResourceName:            "snowflake_hybrid_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_retention_time_in_days"},
+			},
+		},
+	})
+}
+
+func TestAcc_HybridTable_foreignKey(t *testing.T) {
+	parentId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	childId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			{
+				Config: hybridTableForeignKeyConfig(parentId, childId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "foreign_key.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "foreign_key.0.name", "FK_PARENT"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "foreign_key.0.columns.0", "PARENT_ID"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "foreign_key.0.references.0.table_id", parentId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "foreign_key.0.references.0.columns.0", "ID"),
+					resource.TestCheckResourceAttr("snowflake_hybrid_table.child", "index.#", "1"),
+				),
+			},
+			// the indexes backing the foreign key are not treated as drift
+			{
+				Config: hybridTableForeignKeyConfig(parentId, childId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// the constraints are read back, so the imported table is not recreated
+			{
+				ResourceName:            "snowflake_hybrid_table.child",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_retention_time_in_days"},
+			},
+		},
+	})
+}
+
+func hybridTableConfig(id sdk.SchemaObjectIdentifier, additional string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_hybrid_table" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	comment  = "%[5]s"
+
+	column {
+		name     = "ID"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+	column {
+		name = "EMAIL"
+		type = "VARCHAR(200)"
+	}
+%[4]s
+	primary_key {
+		columns = ["ID"]
+	}
+	unique_key {
+		columns = ["EMAIL"]
+	}
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), additional, comment)
+}
+
+func hybridTableForeignKeyConfig(parentId sdk.SchemaObjectIdentifier, childId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_hybrid_table" "parent" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name     = "ID"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+	primary_key {
+		columns = ["ID"]
+	}
+}
+
+resource "snowflake_hybrid_table" "child" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[4]s"
+
+	column {
+		name     = "ID"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+	column {
+		name = "PARENT_ID"
+		type = "NUMBER(38,0)"
+	}
+	primary_key {
+		columns = ["ID"]
+	}
+	foreign_key {
+		name    = "FK_PARENT"
+		columns = ["PARENT_ID"]
+		references {
+			table_id = snowflake_hybrid_table.parent.qualified_name
+			columns  = ["ID"]
+		}
+	}
+	index {
+		name    = "IDX_PARENT"
+		columns = ["PARENT_ID"]
+	}
+}
+`, parentId.DatabaseName(), parentId.SchemaName(), parentId.Name(), childId.Name())
+}
@@ -0,0 +1,207 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHybridTableColumnsDiff(t *testing.T) {
+	id := hybridTableColumn{name: "ID", dataType: "NUMBER(38,0)", nullable: false}
+	data := hybridTableColumn{name: "DATA", dataType: "VARCHAR", nullable: true}
+	dataWithComment := data
+	dataWithComment.comment = "payload"
+	dataWithDefault := data
+	dataWithDefault.defaultValue = "'unknown'"
+	idWithSynonymType := id
+	idWithSynonymType.dataType = "NUMBER"
+
+	testCases := []struct {
+		name            string
+		old             []hybridTableColumn
+		new             []hybridTableColumn
+		expectedRemoved []hybridTableColumn
+		expectedAdded   []hybridTableColumn
+		expectedChanged []changedHybridTableColumn
+	}{
+		{
+			name: "no changes",
+			old:  []hybridTableColumn{id, data},
+			new:  []hybridTableColumn{id, data},
+		},
+		{
+			name:          "add column",
+			old:           []hybridTableColumn{id},
+			new:           []hybridTableColumn{id, data},
+			expectedAdded: []hybridTableColumn{data},
+		},
+		{
+			name:            "remove column",
+			old:             []hybridTableColumn{id, data},
+			new:             []hybridTableColumn{id},
+			expectedRemoved: []hybridTableColumn{data},
+		},
+		{
+			name: "equivalent data type",
+			old:  []hybridTableColumn{id},
+			new:  []hybridTableColumn{idWithSynonymType},
+		},
+		{
+			name:            "change comment",
+			old:             []hybridTableColumn{id, data},
+			new:             []hybridTableColumn{id, dataWithComment},
+			expectedChanged: []changedHybridTableColumn{{newColumn: dataWithComment, changedComment: true}},
+		},
+		{
+			name:            "change default",
+			old:             []hybridTableColumn{id, data},
+			new:             []hybridTableColumn{id, dataWithDefault},
+			expectedChanged: []changedHybridTableColumn{{newColumn: dataWithDefault, changedDefault: true}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			removed, added, changed := hybridTableColumnsDiff(tc.old, tc.new)
+			assert.Equal(t, tc.expectedRemoved, removed)
+			assert.Equal(t, tc.expectedAdded, added)
+			assert.Equal(t, tc.expectedChanged, changed)
+		})
+	}
+}
+
+func TestHybridTableIndexesDiff(t *testing.T) {
+	byName := hybridTableIndex{name: "IDX_NAME", columns: []string{"NAME"}}
+	byNameWithInclude := hybridTableIndex{name: "IDX_NAME", columns: []string{"NAME"}, includeColumns: []string{"ID"}}
+	byParent := hybridTableIndex{name: "IDX_PARENT", columns: []string{"PARENT_ID"}}
+
+	testCases := []struct {
+		name            string
+		old             []hybridTableIndex
+		new             []hybridTableIndex
+		expectedRemoved []hybridTableIndex
+		expectedAdded   []hybridTableIndex
+	}{
+		{
+			name: "no changes",
+			old:  []hybridTableIndex{byName, byParent},
+			new:  []hybridTableIndex{byName, byParent},
+		},
+		{
+			name:          "add index",
+			old:           []hybridTableIndex{byName},
+			new:           []hybridTableIndex{byName, byParent},
+			expectedAdded: []hybridTableIndex{byParent},
+		},
+		{
+			name:            "remove index",
+			old:             []hybridTableIndex{byName, byParent},
+			new:             []hybridTableIndex{byParent},
+			expectedRemoved: []hybridTableIndex{byName},
+		},
+		{
+			name: "reorder indexes",
+			old:  []hybridTableIndex{byName, byParent},
+			new:  []hybridTableIndex{byParent, byName},
+		},
+		{
+			name:            "change include columns",
+			old:             []hybridTableIndex{byName},
+			new:             []hybridTableIndex{byNameWithInclude},
+			expectedRemoved: []hybridTableIndex{byName},
+			expectedAdded:   []hybridTableIndex{byNameWithInclude},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			removed, added := hybridTableIndexesDiff(tc.old, tc.new)
+			assert.Equal(t, tc.expectedRemoved, removed)
+			assert.Equal(t, tc.expectedAdded, added)
+		})
+	}
+}
+
+func TestHybridTableKeysFromRows(t *testing.T) {
+	rows := []sdk.TableKeyColumn{
+		{ConstraintName: "UQ_NAME", ColumnName: "LAST_NAME", KeySequence: 2},
+		{ConstraintName: "UQ_EMAIL", ColumnName: "EMAIL", KeySequence: 1},
+		{ConstraintName: "UQ_NAME", ColumnName: "FIRST_NAME", KeySequence: 1},
+	}
+
+	constraints := hybridTableKeysFromRows(rows)
+
+	assert.Equal(t, []hybridTableConstraint{
+		{name: "UQ_EMAIL", columns: []string{"EMAIL"}},
+		{name: "UQ_NAME", columns: []string{"FIRST_NAME", "LAST_NAME"}},
+	}, constraints)
+}
+
+func TestHybridTableForeignKeysFromRows(t *testing.T) {
+	rows := []sdk.TableImportedKeyColumn{
+		{FkName: "FK_PARENT", FkColumnName: "PARENT_VERSION", PkDatabaseName: "DB", PkSchemaName: "SCH", PkTableName: "PARENT", PkColumnName: "VERSION", KeySequence: 2},
+		{FkName: "FK_PARENT", FkColumnName: "PARENT_ID", PkDatabaseName: "DB", PkSchemaName: "SCH", PkTableName: "PARENT", PkColumnName: "ID", KeySequence: 1},
+	}
+
+	constraints := hybridTableForeignKeysFromRows(rows)
+
+	assert.Equal(t, []hybridTableConstraint{
+		{
+			name:              "FK_PARENT",
+			columns:           []string{"PARENT_ID", "PARENT_VERSION"},
+			referencedTableId: `"DB"."SCH"."PARENT"`,
+			referencedColumns: []string{"ID", "VERSION"},
+		},
+	}, constraints)
+}
+
+func TestHybridTableConstraintsToList(t *testing.T) {
+	email := hybridTableConstraint{name: "SYS_CONSTRAINT_1", columns: []string{"EMAIL"}}
+	name := hybridTableConstraint{name: "UQ_NAME", columns: []string{"NAME"}}
+
+	testCases := []struct {
+		name          string
+		read          []hybridTableConstraint
+		current       []hybridTableConstraint
+		expectedNames []string
+	}{
+		{
+			name:          "import",
+			read:          []hybridTableConstraint{email, name},
+			expectedNames: []string{"SYS_CONSTRAINT_1", "UQ_NAME"},
+		},
+		{
+			name:          "order from state matched by name",
+			read:          []hybridTableConstraint{email, name},
+			current:       []hybridTableConstraint{{name: "uq_name", columns: []string{"NAME"}}, {name: "SYS_CONSTRAINT_1", columns: []string{"EMAIL"}}},
+			expectedNames: []string{"UQ_NAME", "SYS_CONSTRAINT_1"},
+		},
+		{
+			name:          "order from state matched by columns",
+			read:          []hybridTableConstraint{email, name},
+			current:       []hybridTableConstraint{{columns: []string{"NAME"}}, {columns: []string{"EMAIL"}}},
+			expectedNames: []string{"UQ_NAME", "SYS_CONSTRAINT_1"},
+		},
+		{
+			name:          "constraint missing in Snowflake",
+			read:          []hybridTableConstraint{email},
+			current:       []hybridTableConstraint{{name: "UQ_NAME", columns: []string{"NAME"}}, {columns: []string{"EMAIL"}}},
+			expectedNames: []string{"SYS_CONSTRAINT_1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			list := hybridTableConstraintsToList(tc.read, tc.current, false)
+			names := make([]string, len(list))
+			for i, raw := range list {
+				names[i] = raw.(map[string]any)["name"].(string)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
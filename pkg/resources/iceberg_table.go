@@ -0,0 +1,569 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// icebergTableSnowflakeCatalog is the catalog of iceberg tables managed by Snowflake.
+const icebergTableSnowflakeCatalog = "SNOWFLAKE"
+
+var icebergTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the iceberg table; must be unique for the database and schema in which the iceberg table is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the iceberg table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the iceberg table.",
+	},
+	"catalog": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     icebergTableSnowflakeCatalog,
+		Description: fmt.Sprintf("Specifies the catalog for the iceberg table: `%[1]s` for tables that use Snowflake as the catalog or the name of a catalog integration for externally cataloged tables. Changing the catalog of an externally cataloged table to `%[1]s` converts it to a Snowflake-managed table, any other change recreates the table.", icebergTableSnowflakeCatalog),
+	},
+	"external_volume": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Specifies the external volume for the iceberg table. When not specified, the external volume set on the schema, database or account level is used.",
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("Definitions of the columns of the iceberg table. Required for tables that use `%s` as the catalog; for externally cataloged tables the columns are derived from the catalog and can't be set.", icebergTableSnowflakeCatalog),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Column name.",
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Column type, e.g. NUMBER(10, 0).",
+					ValidateFunc:     dataTypeValidateFunc,
+					DiffSuppressFunc: dataTypeDiffSuppressFunc,
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether this column can contain null values.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column comment.",
+				},
+			},
+		},
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the iceberg table.",
+	},
+	"base_location": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("Specifies the path to a directory where Snowflake can write data and metadata files for the table (relative to the external volume location). Required for tables that use `%s` as the catalog; used for Delta tables with an object storage catalog integration.", icebergTableSnowflakeCatalog),
+	},
+	"storage_serialization_policy": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.IcebergTableStorageSerializationPolicyCompatible), string(sdk.IcebergTableStorageSerializationPolicyOptimized)}, false),
+		Description:  fmt.Sprintf("Specifies the storage serialization policy for tables that use `%s` as the catalog. Valid values are (case-sensitive): %s.", icebergTableSnowflakeCatalog, []string{string(sdk.IcebergTableStorageSerializationPolicyCompatible), string(sdk.IcebergTableStorageSerializationPolicyOptimized)}),
+	},
+	"catalog_table_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies the table name as recognized by the external catalog (e.g. AWS Glue Data Catalog or a remote Iceberg REST catalog).",
+	},
+	"catalog_namespace": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies the namespace (e.g. AWS Glue database) of the table in the external catalog. When not specified, the default namespace of the catalog integration is used.",
+	},
+	"metadata_file_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the relative path of the Iceberg metadata file to use for column definitions (for tables with an object storage catalog integration). Changing it refreshes the table metadata from the new file in place.",
+	},
+	"auto_refresh": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether Snowflake should automatically poll the external catalog for metadata updates. Supported only for externally cataloged tables.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the iceberg table.",
+	},
+	"iceberg_table_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the iceberg table (MANAGED for tables that use Snowflake as the catalog, UNMANAGED for externally cataloged tables).",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the iceberg table.",
+	},
+}
+
+// IcebergTable returns a pointer to the resource representing an iceberg table.
+func IcebergTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage iceberg table objects. For more information, check [iceberg table documentation](https://docs.snowflake.com/en/user-guide/tables-iceberg).",
+
+		CreateContext: CreateContextIcebergTable,
+		ReadContext:   ReadContextIcebergTable,
+		UpdateContext: UpdateContextIcebergTable,
+		DeleteContext: DeleteContextIcebergTable,
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("catalog", icebergTableRequiresRecreation),
+			customdiff.ForceNewIf("base_location", icebergTableRequiresRecreation),
+			customdiff.ForceNewIf("catalog_table_name", icebergTableRequiresRecreation),
+			customdiff.ForceNewIf("catalog_namespace", icebergTableRequiresRecreation),
+		),
+
+		Schema: icebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// icebergTableRequiresRecreation returns false only when an externally cataloged table is converted to a Snowflake-managed one,
+// because the conversion (ALTER ICEBERG TABLE ... CONVERT TO MANAGED) is done in place.
+func icebergTableRequiresRecreation(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+	if d.Id() == "" {
+		return false
+	}
+	oldCatalog, newCatalog := d.GetChange("catalog")
+	return !(oldCatalog.(string) != icebergTableSnowflakeCatalog && newCatalog.(string) == icebergTableSnowflakeCatalog)
+}
+
+type icebergTableColumn struct {
+	name     string
+	dataType string
+	nullable bool
+	comment  string
+}
+
+type changedIcebergTableColumn struct {
+	newColumn       icebergTableColumn
+	changedDataType bool
+	changedNullable bool
+	changedComment  bool
+}
+
+func icebergTableColumnsFromList(v []any) []icebergTableColumn {
+	columns := make([]icebergTableColumn, 0, len(v))
+	for _, raw := range v {
+		m := raw.(map[string]any)
+		columns = append(columns, icebergTableColumn{
+			name:     m["name"].(string),
+			dataType: m["type"].(string),
+			nullable: m["nullable"].(bool),
+			comment:  m["comment"].(string),
+		})
+	}
+	return columns
+}
+
+// icebergTableColumnsDiff matches the columns by name and returns the columns to drop, the columns to add and the columns with changed properties.
+func icebergTableColumnsDiff(oldColumns []icebergTableColumn, newColumns []icebergTableColumn) (removed []icebergTableColumn, added []icebergTableColumn, changed []changedIcebergTableColumn) {
+	oldByName := make(map[string]icebergTableColumn, len(oldColumns))
+	for _, c := range oldColumns {
+		oldByName[c.name] = c
+	}
+	newByName := make(map[string]icebergTableColumn, len(newColumns))
+	for _, c := range newColumns {
+		newByName[c.name] = c
+	}
+
+	for _, c := range oldColumns {
+		if _, ok := newByName[c.name]; !ok {
+			removed = append(removed, c)
+		}
+	}
+	for _, c := range newColumns {
+		oldColumn, ok := oldByName[c.name]
+		if !ok {
+			added = append(added, c)
+			continue
+		}
+		change := changedIcebergTableColumn{
+			newColumn:       c,
+			changedDataType: !dataTypeDiffSuppressFunc("", oldColumn.dataType, c.dataType, nil),
+			changedNullable: oldColumn.nullable != c.nullable,
+			changedComment:  oldColumn.comment != c.comment,
+		}
+		if change.changedDataType || change.changedNullable || change.changedComment {
+			changed = append(changed, change)
+		}
+	}
+	return removed, added, changed
+}
+
+func icebergTableQuotedColumnName(name string) string {
+	return fmt.Sprintf(`"%s"`, name)
+}
+
+func icebergTableColumnsToRequests(columns []icebergTableColumn) []sdk.IcebergTableColumnRequest {
+	requests := make([]sdk.IcebergTableColumnRequest, len(columns))
+	for i, c := range columns {
+		request := sdk.NewIcebergTableColumnRequest(icebergTableQuotedColumnName(c.name), sdk.DataType(c.dataType))
+		if !c.nullable {
+			request.WithNotNull(true)
+		}
+		if c.comment != "" {
+			request.WithComment(c.comment)
+		}
+		requests[i] = *request
+	}
+	return requests
+}
+
+func icebergTableColumnsToList(details []sdk.IcebergTableColumnDetails) []any {
+	columns := make([]any, 0, len(details))
+	for _, c := range details {
+		if c.Kind != "COLUMN" {
+			continue
+		}
+		var comment string
+		if c.Comment != nil {
+			comment = *c.Comment
+		}
+		columns = append(columns, map[string]any{
+			"name":     c.Name,
+			"type":     string(c.Type),
+			"nullable": c.IsNullable,
+			"comment":  comment,
+		})
+	}
+	return columns
+}
+
+func CreateContextIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	var err error
+	if catalog := d.Get("catalog").(string); catalog == icebergTableSnowflakeCatalog {
+		err = createIcebergTableWithSnowflakeCatalog(ctx, d, client, id)
+	} else {
+		err = createIcebergTableWithExternalCatalog(ctx, d, client, id, catalog)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextIcebergTable(ctx, d, meta)
+}
+
+func createIcebergTableWithSnowflakeCatalog(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
+	columns := icebergTableColumnsFromList(d.Get("column").([]any))
+	if len(columns) == 0 {
+		return fmt.Errorf("at least one column is required for iceberg tables that use %s as the catalog", icebergTableSnowflakeCatalog)
+	}
+	baseLocation, ok := d.GetOk("base_location")
+	if !ok {
+		return fmt.Errorf("base_location is required for iceberg tables that use %s as the catalog", icebergTableSnowflakeCatalog)
+	}
+	for _, key := range []string{"catalog_table_name", "catalog_namespace", "metadata_file_path"} {
+		if _, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s can't be set for iceberg tables that use %s as the catalog", key, icebergTableSnowflakeCatalog)
+		}
+	}
+	if d.Get("auto_refresh").(bool) {
+		return fmt.Errorf("auto_refresh can't be set for iceberg tables that use %s as the catalog", icebergTableSnowflakeCatalog)
+	}
+
+	request := sdk.NewCreateIcebergTableRequest(id, icebergTableColumnsToRequests(columns), baseLocation.(string))
+	if v, ok := d.GetOk("external_volume"); ok {
+		request.WithExternalVolume(v.(string))
+	}
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+	if v, ok := d.GetOk("storage_serialization_policy"); ok {
+		request.WithStorageSerializationPolicy(sdk.IcebergTableStorageSerializationPolicy(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	return client.IcebergTables.Create(ctx, request)
+}
+
+func createIcebergTableWithExternalCatalog(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier, catalog string) error {
+	if len(d.Get("column").([]any)) > 0 {
+		return errors.New("columns can't be set for externally cataloged iceberg tables, they are derived from the catalog")
+	}
+	for _, key := range []string{"cluster_by", "storage_serialization_policy"} {
+		if _, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s can be set only for iceberg tables that use %s as the catalog", key, icebergTableSnowflakeCatalog)
+		}
+	}
+
+	request := sdk.NewCreateWithExternalCatalogIcebergTableRequest(id, catalog)
+	if v, ok := d.GetOk("external_volume"); ok {
+		request.WithExternalVolume(v.(string))
+	}
+	if v, ok := d.GetOk("catalog_table_name"); ok {
+		request.WithCatalogTableName(v.(string))
+	}
+	if v, ok := d.GetOk("catalog_namespace"); ok {
+		request.WithCatalogNamespace(v.(string))
+	}
+	if v, ok := d.GetOk("metadata_file_path"); ok {
+		request.WithMetadataFilePath(v.(string))
+	}
+	if v, ok := d.GetOk("base_location"); ok {
+		request.WithBaseLocation(v.(string))
+	}
+	if d.Get("auto_refresh").(bool) {
+		request.WithAutoRefresh(true)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	return client.IcebergTables.CreateWithExternalCatalog(ctx, request)
+}
+
+func ReadContextIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve iceberg table. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	columns, err := client.IcebergTables.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	catalog := icebergTable.CatalogName
+	if strings.EqualFold(catalog, icebergTableSnowflakeCatalog) {
+		catalog = icebergTableSnowflakeCatalog
+	}
+	var comment string
+	if icebergTable.Comment != nil {
+		comment = *icebergTable.Comment
+	}
+
+	toSet := map[string]any{
+		"name":                         icebergTable.Name,
+		"database":                     icebergTable.DatabaseName,
+		"schema":                       icebergTable.SchemaName,
+		"catalog":                      catalog,
+		"external_volume":              icebergTable.ExternalVolumeName,
+		"column":                       icebergTableColumnsToList(columns),
+		"base_location":                icebergTable.BaseLocation,
+		"storage_serialization_policy": icebergTable.StorageSerializationPolicy,
+		"catalog_table_name":           icebergTable.CatalogTableName,
+		"catalog_namespace":            icebergTable.CatalogNamespace,
+		"comment":                      comment,
+		"iceberg_table_type":           icebergTable.IcebergTableType,
+		"qualified_name":               id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming iceberg table %v err = %w", d.Id(), err))
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	if d.HasChange("catalog") {
+		convertRequest := sdk.NewIcebergTableConvertToManagedRequest()
+		if v, ok := d.GetOk("base_location"); ok {
+			convertRequest.WithBaseLocation(v.(string))
+		}
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithConvertToManaged(*convertRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error converting iceberg table %v to Snowflake-managed err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("metadata_file_path") {
+		if v, ok := d.GetOk("metadata_file_path"); ok {
+			refreshRequest := sdk.NewIcebergTableRefreshRequest().WithMetadataFileRelativePath(v.(string))
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRefresh(*refreshRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error refreshing iceberg table %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("column") {
+		o, n := d.GetChange("column")
+		removed, added, changed := icebergTableColumnsDiff(icebergTableColumnsFromList(o.([]any)), icebergTableColumnsFromList(n.([]any)))
+
+		if len(removed) > 0 {
+			names := make([]string, len(removed))
+			for i, c := range removed {
+				names[i] = icebergTableQuotedColumnName(c.name)
+			}
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropColumns(*sdk.NewIcebergTableDropColumnsRequest(names))); err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping columns of iceberg table %v err = %w", d.Id(), err))
+			}
+		}
+
+		for _, c := range added {
+			addRequest := sdk.NewIcebergTableAddColumnRequest(icebergTableQuotedColumnName(c.name), sdk.DataType(c.dataType))
+			if c.comment != "" {
+				addRequest.WithComment(c.comment)
+			}
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAddColumn(*addRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding column %s to iceberg table %v err = %w", c.name, d.Id(), err))
+			}
+			if !c.nullable {
+				alterRequest := sdk.NewIcebergTableAlterColumnRequest(icebergTableQuotedColumnName(c.name)).WithSetNotNull(true)
+				if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumn(*alterRequest)); err != nil {
+					return diag.FromErr(fmt.Errorf("error changing nullability of column %s of iceberg table %v err = %w", c.name, d.Id(), err))
+				}
+			}
+		}
+
+		for _, c := range changed {
+			columnName := icebergTableQuotedColumnName(c.newColumn.name)
+			if c.changedDataType {
+				alterRequest := sdk.NewIcebergTableAlterColumnRequest(columnName).WithSetDataType(sdk.DataType(c.newColumn.dataType))
+				if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumn(*alterRequest)); err != nil {
+					return diag.FromErr(fmt.Errorf("error changing data type of column %s of iceberg table %v err = %w", c.newColumn.name, d.Id(), err))
+				}
+			}
+			if c.changedNullable {
+				alterRequest := sdk.NewIcebergTableAlterColumnRequest(columnName)
+				if c.newColumn.nullable {
+					alterRequest.WithDropNotNull(true)
+				} else {
+					alterRequest.WithSetNotNull(true)
+				}
+				if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumn(*alterRequest)); err != nil {
+					return diag.FromErr(fmt.Errorf("error changing nullability of column %s of iceberg table %v err = %w", c.newColumn.name, d.Id(), err))
+				}
+			}
+			if c.changedComment {
+				alterRequest := sdk.NewIcebergTableAlterColumnRequest(columnName)
+				if c.newColumn.comment == "" {
+					alterRequest.WithUnsetComment(true)
+				} else {
+					alterRequest.WithComment(c.newColumn.comment)
+				}
+				if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithAlterColumn(*alterRequest)); err != nil {
+					return diag.FromErr(fmt.Errorf("error changing comment of column %s of iceberg table %v err = %w", c.newColumn.name, d.Id(), err))
+				}
+			}
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		request := sdk.NewAlterIcebergTableRequest(id)
+		if v, ok := d.GetOk("cluster_by"); ok {
+			request.WithClusterBy(expandStringList(v.([]any)))
+		} else {
+			request.WithDropClusteringKey(true)
+		}
+		if err := client.IcebergTables.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error changing clustering of iceberg table %v err = %w", d.Id(), err))
+		}
+	}
+
+	set := sdk.NewIcebergTableSetRequest()
+	runSet := false
+	if d.HasChange("auto_refresh") {
+		set.WithAutoRefresh(d.Get("auto_refresh").(bool))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnset(*sdk.NewIcebergTableUnsetRequest().WithComment(true))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if runSet {
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextIcebergTable(ctx, d, meta)
+}
+
+func DeleteContextIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
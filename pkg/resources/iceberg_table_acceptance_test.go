@@ -0,0 +1,189 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_IcebergTable_snowflakeCatalog(t *testing.T) {
+	externalVolume := testenvs.GetOrSkipTest(t, testenvs.IcebergExternalVolume)
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.IcebergTable),
+		Steps: []resource.TestStep{
+			{
+				Config: icebergTableConfig(id, externalVolume, `
+	column {
+		name     = "ID"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					acc.AssertThat(t,
+						resourceassert.IcebergTableResource(t, "snowflake_iceberg_table.test").
+							HasName(id.Name()).
+							HasDatabase(id.DatabaseName()).
+							HasSchema(id.SchemaName()).
+							HasCatalog("SNOWFLAKE").
+							HasExternalVolume(externalVolume).
+							HasBaseLocation("base/location").
+							HasColumnCount(1).
+							HasComment("").
+							HasQualifiedName(id.FullyQualifiedName()),
+						objectassert.IcebergTable(t, id).
+							HasName(id.Name()).
+							HasCatalogName("SNOWFLAKE").
+							HasExternalVolumeName(externalVolume),
+					),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.name", "ID"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.nullable", "false"),
+				),
+			},
+			// add a column and set the comment in place
+			{
+				Config: icebergTableConfig(id, externalVolume, `
+	column {
+		name     = "ID"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+	column {
+		name    = "DATA"
+		type    = "VARCHAR"
+		comment = "payload"
+	}
+`, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_iceberg_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.1.name", "DATA"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.1.comment", "payload"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "comment", "some comment"),
+				),
+			},
+			// drop a column, change nullability and unset the comment
+			{
+				Config: icebergTableConfig(id, externalVolume, `
+	column {
+		name = "ID"
+		type = "NUMBER(38,0)"
+	}
+`, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_iceberg_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.nullable", "true"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "comment", ""),
+				),
+			},
+			// rename
+			{
+				Config: icebergTableConfig(newId, externalVolume, `
+	column {
+		name = "ID"
+		type = "NUMBER(38,0)"
+	}
+`, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_iceberg_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "name", newId.Name()),
+				),
+			},
+			{
+				ResourceName:      "snowflake_iceberg_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_IcebergTable_columnDrift(t *testing.T) {
+	externalVolume := testenvs.GetOrSkipTest(t, testenvs.IcebergExternalVolume)
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	columns := `
+	column {
+		name = "ID"
+		type = "NUMBER(38,0)"
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.IcebergTable),
+		Steps: []resource.TestStep{
+			{
+				Config: icebergTableConfig(id, externalVolume, columns, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.#", "1"),
+				),
+			},
+			// a column added outside of terraform is detected and dropped
+			{
+				PreConfig: func() {
+					acc.TestClient().IcebergTable.Alter(t, sdk.NewAlterIcebergTableRequest(id).
+						WithAddColumn(*sdk.NewIcebergTableAddColumnRequest("EXTERNAL", sdk.DataTypeVARCHAR)),
+					)
+				},
+				Config: icebergTableConfig(id, externalVolume, columns, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_iceberg_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.name", "ID"),
+				),
+			},
+		},
+	})
+}
+
+func icebergTableConfig(id sdk.SchemaObjectIdentifier, externalVolume string, columns string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_iceberg_table" "test" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	external_volume = "%[4]s"
+	base_location   = "base/location"
+	comment         = "%[6]s"
+%[5]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), externalVolume, columns, comment)
+}
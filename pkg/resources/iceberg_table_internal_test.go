@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIcebergTableColumnsDiff(t *testing.T) {
+	id := icebergTableColumn{name: "ID", dataType: "NUMBER(38,0)", nullable: false}
+	data := icebergTableColumn{name: "DATA", dataType: "VARCHAR", nullable: true}
+	dataWithComment := data
+	dataWithComment.comment = "payload"
+	idWithSynonymType := id
+	idWithSynonymType.dataType = "NUMBER"
+	idNullable := id
+	idNullable.nullable = true
+
+	testCases := []struct {
+		name            string
+		old             []icebergTableColumn
+		new             []icebergTableColumn
+		expectedRemoved []icebergTableColumn
+		expectedAdded   []icebergTableColumn
+		expectedChanged []changedIcebergTableColumn
+	}{
+		{
+			name: "no changes",
+			old:  []icebergTableColumn{id, data},
+			new:  []icebergTableColumn{id, data},
+		},
+		{
+			name:          "add column",
+			old:           []icebergTableColumn{id},
+			new:           []icebergTableColumn{id, data},
+			expectedAdded: []icebergTableColumn{data},
+		},
+		{
+			name:            "remove column",
+			old:             []icebergTableColumn{id, data},
+			new:             []icebergTableColumn{data},
+			expectedRemoved: []icebergTableColumn{id},
+		},
+		{
+			name: "reorder columns",
+			old:  []icebergTableColumn{id, data},
+			new:  []icebergTableColumn{data, id},
+		},
+		{
+			name: "equivalent data type",
+			old:  []icebergTableColumn{id},
+			new:  []icebergTableColumn{idWithSynonymType},
+		},
+		{
+			name:            "change comment",
+			old:             []icebergTableColumn{id, data},
+			new:             []icebergTableColumn{id, dataWithComment},
+			expectedChanged: []changedIcebergTableColumn{{newColumn: dataWithComment, changedComment: true}},
+		},
+		{
+			name:            "change nullability",
+			old:             []icebergTableColumn{id},
+			new:             []icebergTableColumn{idNullable},
+			expectedChanged: []changedIcebergTableColumn{{newColumn: idNullable, changedNullable: true}},
+		},
+		{
+			name:            "rename column",
+			old:             []icebergTableColumn{id},
+			new:             []icebergTableColumn{{name: "NEW_ID", dataType: "NUMBER(38,0)"}},
+			expectedRemoved: []icebergTableColumn{id},
+			expectedAdded:   []icebergTableColumn{{name: "NEW_ID", dataType: "NUMBER(38,0)"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			removed, added, changed := icebergTableColumnsDiff(tc.old, tc.new)
+			assert.Equal(t, tc.expectedRemoved, removed)
+			assert.Equal(t, tc.expectedAdded, added)
+			assert.Equal(t, tc.expectedChanged, changed)
+		})
+	}
+}
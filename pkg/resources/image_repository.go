@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var imageRepositorySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the image repository; must be unique for the schema in which the image repository is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the image repository.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the image repository.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the image repository.",
+	},
+	"repository_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "URL of the image repository (without the protocol), used to tag and push images with docker, e.g. `docker push <repository_url>/<image_name>:<tag>`.",
+	},
+}
+
+// ImageRepository returns a pointer to the resource representing an image repository.
+func ImageRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage image repository objects. For more information, check [image repository documentation](https://docs.snowflake.com/en/developer-guide/snowpark-container-services/working-with-registry-repository).",
+
+		CreateContext: CreateContextImageRepository,
+		ReadContext:   ReadContextImageRepository,
+		UpdateContext: UpdateContextImageRepository,
+		DeleteContext: DeleteContextImageRepository,
+
+		Schema: imageRepositorySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextImageRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateImageRepositoryRequest(id)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.ImageRepositories.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextImageRepository(ctx, d, meta)
+}
+
+func ReadContextImageRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	imageRepository, err := client.ImageRepositories.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve image repository. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	var comment string
+	if imageRepository.Comment != nil {
+		comment = *imageRepository.Comment
+	}
+
+	toSet := map[string]any{
+		"name":           imageRepository.Name,
+		"database":       imageRepository.DatabaseName,
+		"schema":         imageRepository.SchemaName,
+		"comment":        comment,
+		"repository_url": imageRepository.RepositoryUrl,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextImageRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("comment") {
+		request := sdk.NewAlterImageRepositoryRequest(id)
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithSet(*sdk.NewImageRepositorySetRequest().WithComment(v.(string)))
+		} else {
+			request.WithUnset(*sdk.NewImageRepositoryUnsetRequest().WithComment(true))
+		}
+		if err := client.ImageRepositories.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextImageRepository(ctx, d, meta)
+}
+
+func DeleteContextImageRepository(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.ImageRepositories.Drop(ctx, sdk.NewDropImageRepositoryRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ImageRepository_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ImageRepository),
+		Steps: []resource.TestStep{
+			{
+				Config: imageRepositoryConfig(id, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_image_repository.test", "repository_url"),
+				),
+			},
+			// set the comment in place
+			{
+				Config: imageRepositoryConfig(id, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_image_repository.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "comment", "some comment"),
+				),
+			},
+			// unset the comment
+			{
+				Config: imageRepositoryConfig(id, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_image_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func imageRepositoryConfig(id sdk.SchemaObjectIdentifier, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_image_repository" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	comment  = "%[4]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), comment)
+}
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	logLevelValues = []string{
+		string(sdk.LogLevelTrace),
+		string(sdk.LogLevelDebug),
+		string(sdk.LogLevelInfo),
+		string(sdk.LogLevelWarn),
+		string(sdk.LogLevelError),
+		string(sdk.LogLevelFatal),
+		string(sdk.LogLevelOff),
+	}
+	traceLevelValues = []string{
+		string(sdk.TraceLevelAlways),
+		string(sdk.TraceLevelOnEvent),
+		string(sdk.TraceLevelOff),
+	}
+	metricLevelValues = []string{
+		string(sdk.MetricLevelAll),
+		string(sdk.MetricLevelNone),
+	}
+)
+
+// loggingLevelParameters maps the logging level attributes to the object parameters they are read from.
+var loggingLevelParameters = map[string]sdk.ObjectParameter{
+	"log_level":    sdk.ObjectParameterLogLevel,
+	"trace_level":  sdk.ObjectParameterTraceLevel,
+	"metric_level": sdk.ObjectParameterMetricLevel,
+}
+
+func logLevelSchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(logLevelValues, false),
+		Description:  fmt.Sprintf("Specifies the severity level of messages that should be ingested and made available in the active event table for the %s. Valid values are: %s. When not set, the value is inherited.", objectName, strings.Join(logLevelValues, " | ")),
+	}
+}
+
+func traceLevelSchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(traceLevelValues, false),
+		Description:  fmt.Sprintf("Controls how trace events are ingested into the event table for the %s. Valid values are: %s. When not set, the value is inherited.", objectName, strings.Join(traceLevelValues, " | ")),
+	}
+}
+
+func metricLevelSchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(metricLevelValues, false),
+		Description:  fmt.Sprintf("Controls whether metrics data is emitted to the event table for the %s. Valid values are: %s. When not set, the value is inherited.", objectName, strings.Join(metricLevelValues, " | ")),
+	}
+}
+
+// readLoggingLevels sets the logging level attributes to the values set on the object itself; values inherited from the parent objects or the account are read as empty.
+func readLoggingLevels(ctx context.Context, client *sdk.Client, d *schema.ResourceData, object sdk.Object) error {
+	for attribute, parameter := range loggingLevelParameters {
+		p, err := client.Parameters.ShowObjectParameter(ctx, parameter, object)
+		if err != nil {
+			return err
+		}
+		value := ""
+		if p.Level == sdk.ParameterType(object.ObjectType) {
+			value = p.Value
+		}
+		if err := d.Set(attribute, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
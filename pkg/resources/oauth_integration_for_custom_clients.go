@@ -0,0 +1,282 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var oauthIntegrationClientTypes = []string{
+	string(sdk.OauthSecurityIntegrationClientTypePublic),
+	string(sdk.OauthSecurityIntegrationClientTypeConfidential),
+}
+
+var oauthIntegrationForCustomClientsSchema = oauthSecurityIntegrationSchema(map[string]*schema.Schema{
+	"oauth_client_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(oauthIntegrationClientTypes, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("Specifies the type of client being registered. Snowflake supports both confidential and public clients. Valid values are (case-insensitive): %v.", oauthIntegrationClientTypes),
+	},
+	"oauth_redirect_uri": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the client URI. After a user is authenticated, the web browser is redirected to this URI.",
+	},
+	"oauth_allow_non_tls_redirect_uri": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, allows setting oauth_redirect_uri to a URI not protected by TLS.",
+	},
+	"oauth_enforce_pkce": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Boolean that specifies whether Proof Key for Code Exchange (PKCE) should be required for the integration.",
+	},
+	"pre_authorized_roles_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A set of Snowflake roles that a user does not need to explicitly consent to using after authenticating.",
+	},
+	"network_policy": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies an existing network policy. This network policy controls network traffic that is attempting to exchange an authorization code for an access or refresh token or to use a refresh token to obtain a new access token.",
+	},
+	"oauth_client_rsa_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: ignoreTrimSpaceSuppressFunc,
+		Description:      "Specifies a Base64-encoded RSA public key, without the -----BEGIN PUBLIC KEY----- and -----END PUBLIC KEY----- headers. Snowflake returns only the key fingerprint (available in `oauth_client_rsa_public_key_fp`), so external changes of the key are not detected.",
+	},
+	"oauth_client_rsa_public_key_2": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: ignoreTrimSpaceSuppressFunc,
+		Description:      "Specifies a Base64-encoded RSA public key, without the -----BEGIN PUBLIC KEY----- and -----END PUBLIC KEY----- headers. Snowflake returns only the key fingerprint (available in `oauth_client_rsa_public_key_2_fp`), so external changes of the key are not detected.",
+	},
+	"oauth_client_rsa_public_key_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fingerprint of the first RSA public key.",
+	},
+	"oauth_client_rsa_public_key_2_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fingerprint of the second RSA public key.",
+	},
+	"oauth_client_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Client ID of the integration. The client secrets can be retrieved with the SYSTEM$SHOW_OAUTH_CLIENT_SECRETS function.",
+	},
+	"oauth_authorization_endpoint": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Authorization endpoint of the integration.",
+	},
+	"oauth_token_endpoint": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Token endpoint of the integration.",
+	},
+})
+
+// oauthIntegrationForCustomClientsProperties maps the resource attributes to the properties returned by DESCRIBE SECURITY INTEGRATION.
+var oauthIntegrationForCustomClientsProperties = map[string]string{
+	"oauth_client_type":                "OAUTH_CLIENT_TYPE",
+	"oauth_redirect_uri":               "OAUTH_REDIRECT_URI",
+	"oauth_allow_non_tls_redirect_uri": "OAUTH_ALLOW_NON_TLS_REDIRECT_URI",
+	"oauth_enforce_pkce":               "OAUTH_ENFORCE_PKCE",
+	"oauth_issue_refresh_tokens":       "OAUTH_ISSUE_REFRESH_TOKENS",
+	"oauth_refresh_token_validity":     "OAUTH_REFRESH_TOKEN_VALIDITY",
+	"oauth_use_secondary_roles":        "OAUTH_USE_SECONDARY_ROLES",
+	"pre_authorized_roles_list":        "PRE_AUTHORIZED_ROLES_LIST",
+	"network_policy":                   "NETWORK_POLICY",
+	"oauth_client_rsa_public_key_fp":   "OAUTH_CLIENT_RSA_PUBLIC_KEY_FP",
+	"oauth_client_rsa_public_key_2_fp": "OAUTH_CLIENT_RSA_PUBLIC_KEY_2_FP",
+	"oauth_client_id":                  "OAUTH_CLIENT_ID",
+	"oauth_authorization_endpoint":     "OAUTH_AUTHORIZATION_ENDPOINT",
+	"oauth_token_endpoint":             "OAUTH_TOKEN_ENDPOINT",
+}
+
+// OauthIntegrationForCustomClients returns a pointer to the resource representing an OAuth security integration for custom clients.
+func OauthIntegrationForCustomClients() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage OAuth security integration for custom clients objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake).",
+
+		CreateContext: CreateContextOauthIntegrationForCustomClients,
+		ReadContext:   ReadContextOauthIntegrationForCustomClients,
+		UpdateContext: UpdateContextOauthIntegrationForCustomClients,
+		DeleteContext: DeleteContextSecurityIntegration,
+
+		Schema: oauthIntegrationForCustomClientsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextOauthIntegrationForCustomClients(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateOauthForCustomClientsSecurityIntegrationRequest(
+		id,
+		sdk.OauthSecurityIntegrationClientTypeOption(strings.ToUpper(d.Get("oauth_client_type").(string))),
+		d.Get("oauth_redirect_uri").(string),
+	).
+		WithEnabled(d.Get("enabled").(bool)).
+		WithOauthAllowNonTlsRedirectUri(d.Get("oauth_allow_non_tls_redirect_uri").(bool)).
+		WithOauthEnforcePkce(d.Get("oauth_enforce_pkce").(bool)).
+		WithOauthIssueRefreshTokens(d.Get("oauth_issue_refresh_tokens").(bool)).
+		WithOauthUseSecondaryRoles(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(strings.ToUpper(d.Get("oauth_use_secondary_roles").(string))))
+
+	if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+		request.WithOauthRefreshTokenValidity(v.(int))
+	}
+	if v, ok := d.GetOk("pre_authorized_roles_list"); ok {
+		request.WithPreAuthorizedRolesList(*sdk.NewPreAuthorizedRolesListRequest().WithPreAuthorizedRolesList(expandSecurityIntegrationRoles(v.(*schema.Set).List())))
+	}
+	if v, ok := d.GetOk("blocked_roles_list"); ok {
+		request.WithBlockedRolesList(*sdk.NewBlockedRolesListRequest().WithBlockedRolesList(expandSecurityIntegrationRoles(v.(*schema.Set).List())))
+	}
+	if v, ok := d.GetOk("network_policy"); ok {
+		request.WithNetworkPolicy(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("oauth_client_rsa_public_key"); ok {
+		request.WithOauthClientRsaPublicKey(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_client_rsa_public_key_2"); ok {
+		request.WithOauthClientRsaPublicKey2(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.SecurityIntegrations.CreateOauthForCustomClients(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextOauthIntegrationForCustomClients(ctx, d, meta)
+}
+
+func ReadContextOauthIntegrationForCustomClients(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	values, diags := readSecurityIntegrationCommon(ctx, d, meta)
+	if values == nil {
+		return diags
+	}
+
+	if err := setSecurityIntegrationProperties(d, oauthIntegrationForCustomClientsSchema, values, oauthIntegrationForCustomClientsProperties); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setOauthBlockedRolesList(d, values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextOauthIntegrationForCustomClients(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewOauthForCustomClientsIntegrationSetRequest(), sdk.NewOauthForCustomClientsIntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("oauth_redirect_uri") {
+		runSet = true
+		set.WithOauthRedirectUri(d.Get("oauth_redirect_uri").(string))
+	}
+	if d.HasChange("oauth_allow_non_tls_redirect_uri") {
+		runSet = true
+		set.WithOauthAllowNonTlsRedirectUri(d.Get("oauth_allow_non_tls_redirect_uri").(bool))
+	}
+	if d.HasChange("oauth_enforce_pkce") {
+		runSet = true
+		set.WithOauthEnforcePkce(d.Get("oauth_enforce_pkce").(bool))
+	}
+	if d.HasChange("oauth_issue_refresh_tokens") {
+		runSet = true
+		set.WithOauthIssueRefreshTokens(d.Get("oauth_issue_refresh_tokens").(bool))
+	}
+	if d.HasChange("oauth_refresh_token_validity") {
+		if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+			runSet = true
+			set.WithOauthRefreshTokenValidity(v.(int))
+		}
+	}
+	if d.HasChange("oauth_use_secondary_roles") {
+		runSet = true
+		set.WithOauthUseSecondaryRoles(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(strings.ToUpper(d.Get("oauth_use_secondary_roles").(string))))
+	}
+	if d.HasChange("pre_authorized_roles_list") {
+		runSet = true
+		set.WithPreAuthorizedRolesList(*sdk.NewPreAuthorizedRolesListRequest().WithPreAuthorizedRolesList(expandSecurityIntegrationRoles(d.Get("pre_authorized_roles_list").(*schema.Set).List())))
+	}
+	if d.HasChange("blocked_roles_list") {
+		runSet = true
+		set.WithBlockedRolesList(*sdk.NewBlockedRolesListRequest().WithBlockedRolesList(expandSecurityIntegrationRoles(d.Get("blocked_roles_list").(*schema.Set).List())))
+	}
+	if d.HasChange("network_policy") {
+		if v := d.Get("network_policy").(string); v != "" {
+			runSet = true
+			set.WithNetworkPolicy(sdk.NewAccountObjectIdentifier(v))
+		} else {
+			runUnset = true
+			unset.WithNetworkPolicy(true)
+		}
+	}
+	if d.HasChange("oauth_client_rsa_public_key") {
+		if v := d.Get("oauth_client_rsa_public_key").(string); v != "" {
+			runSet = true
+			set.WithOauthClientRsaPublicKey(v)
+		} else {
+			runUnset = true
+			unset.WithOauthClientRsaPublicKey(true)
+		}
+	}
+	if d.HasChange("oauth_client_rsa_public_key_2") {
+		if v := d.Get("oauth_client_rsa_public_key_2").(string); v != "" {
+			runSet = true
+			set.WithOauthClientRsaPublicKey2(v)
+		} else {
+			runUnset = true
+			unset.WithOauthClientRsaPublicKey2(true)
+		}
+	}
+	if d.HasChange("comment") {
+		runSet = true
+		set.WithComment(d.Get("comment").(string))
+	}
+
+	if runSet {
+		if err := client.SecurityIntegrations.AlterOauthForCustomClients(ctx, sdk.NewAlterOauthForCustomClientsSecurityIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.SecurityIntegrations.AlterOauthForCustomClients(ctx, sdk.NewAlterOauthForCustomClientsSecurityIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextOauthIntegrationForCustomClients(ctx, d, meta)
+}
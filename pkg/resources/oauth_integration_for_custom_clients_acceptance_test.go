@@ -0,0 +1,128 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OauthIntegrationForCustomClients_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	preAuthorizedRole, preAuthorizedRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(preAuthorizedRoleCleanup)
+	blockedRole, blockedRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(blockedRoleCleanup)
+	networkPolicy, networkPolicyCleanup := acc.TestClient().NetworkPolicy.CreateNetworkPolicy(t)
+	t.Cleanup(networkPolicyCleanup)
+	key := random.GenerateRSAPublicKey(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.OauthIntegrationForCustomClients),
+		Steps: []resource.TestStep{
+			{
+				Config: oauthIntegrationForCustomClientsBasicConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_client_type", "CONFIDENTIAL"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_redirect_uri", "https://example.com"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_allow_non_tls_redirect_uri", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_enforce_pkce", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "pre_authorized_roles_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "network_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_client_rsa_public_key_fp", ""),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "integration_type", "OAUTH - CUSTOM"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_custom_clients.test", "oauth_client_id"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_custom_clients.test", "oauth_authorization_endpoint"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_custom_clients.test", "oauth_token_endpoint"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_custom_clients.test", "created_on"),
+				),
+			},
+			{
+				Config: oauthIntegrationForCustomClientsCompleteConfig(id.Name(), preAuthorizedRole.Name, blockedRole.Name, networkPolicy.Name, key),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_oauth_integration_for_custom_clients.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_redirect_uri", "http://example.com/callback"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_allow_non_tls_redirect_uri", "true"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_enforce_pkce", "true"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_use_secondary_roles", "IMPLICIT"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "pre_authorized_roles_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_oauth_integration_for_custom_clients.test", "pre_authorized_roles_list.*", preAuthorizedRole.Name),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "blocked_roles_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_oauth_integration_for_custom_clients.test", "blocked_roles_list.*", blockedRole.Name),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "network_policy", networkPolicy.Name),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_custom_clients.test", "oauth_client_rsa_public_key_fp"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_oauth_integration_for_custom_clients.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_client_rsa_public_key"},
+			},
+			// unset optional fields
+			{
+				Config: oauthIntegrationForCustomClientsBasicConfig(id.Name()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_oauth_integration_for_custom_clients.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "pre_authorized_roles_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "blocked_roles_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "network_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_custom_clients.test", "oauth_client_rsa_public_key_fp", ""),
+				),
+			},
+		},
+	})
+}
+
+func oauthIntegrationForCustomClientsBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_oauth_integration_for_custom_clients" "test" {
+	name               = "%[1]s"
+	oauth_client_type  = "CONFIDENTIAL"
+	oauth_redirect_uri = "https://example.com"
+}
+`, name)
+}
+
+func oauthIntegrationForCustomClientsCompleteConfig(name string, preAuthorizedRole string, blockedRole string, networkPolicy string, key string) string {
+	return fmt.Sprintf(`
+resource "snowflake_oauth_integration_for_custom_clients" "test" {
+	name                             = "%[1]s"
+	oauth_client_type                = "CONFIDENTIAL"
+	oauth_redirect_uri               = "http://example.com/callback"
+	enabled                          = true
+	oauth_allow_non_tls_redirect_uri = true
+	oauth_enforce_pkce               = true
+	oauth_use_secondary_roles        = "IMPLICIT"
+	pre_authorized_roles_list        = ["%[2]s"]
+	blocked_roles_list               = ["%[3]s"]
+	network_policy                   = "%[4]s"
+	oauth_client_rsa_public_key      = <<EOT
+%[5]sEOT
+	comment                          = "foo"
+}
+`, name, preAuthorizedRole, blockedRole, networkPolicy, key)
+}
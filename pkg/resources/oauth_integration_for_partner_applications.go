@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var oauthIntegrationPartnerClients = []string{
+	string(sdk.OauthSecurityIntegrationClientLooker),
+	string(sdk.OauthSecurityIntegrationClientTableauDesktop),
+	string(sdk.OauthSecurityIntegrationClientTableauServer),
+}
+
+var oauthIntegrationForPartnerApplicationsSchema = oauthSecurityIntegrationSchema(map[string]*schema.Schema{
+	"oauth_client": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(oauthIntegrationPartnerClients, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("Creates an OAuth interface between Snowflake and a partner application. Valid values are (case-insensitive): %v.", oauthIntegrationPartnerClients),
+	},
+	"oauth_redirect_uri": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the client URI. After a user is authenticated, the web browser is redirected to this URI. The field should be only set when `oauth_client = \"LOOKER\"`.",
+	},
+})
+
+// oauthIntegrationForPartnerApplicationsProperties maps the resource attributes to the properties returned by DESCRIBE SECURITY INTEGRATION.
+var oauthIntegrationForPartnerApplicationsProperties = map[string]string{
+	"oauth_redirect_uri":           "OAUTH_REDIRECT_URI",
+	"oauth_issue_refresh_tokens":   "OAUTH_ISSUE_REFRESH_TOKENS",
+	"oauth_refresh_token_validity": "OAUTH_REFRESH_TOKEN_VALIDITY",
+	"oauth_use_secondary_roles":    "OAUTH_USE_SECONDARY_ROLES",
+}
+
+// OauthIntegrationForPartnerApplications returns a pointer to the resource representing an OAuth security integration for partner applications.
+func OauthIntegrationForPartnerApplications() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage OAuth security integration for partner applications objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake).",
+
+		CreateContext: CreateContextOauthIntegrationForPartnerApplications,
+		ReadContext:   ReadContextOauthIntegrationForPartnerApplications,
+		UpdateContext: UpdateContextOauthIntegrationForPartnerApplications,
+		DeleteContext: DeleteContextSecurityIntegration,
+
+		Schema: oauthIntegrationForPartnerApplicationsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextOauthIntegrationForPartnerApplications(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateOauthForPartnerApplicationsSecurityIntegrationRequest(id, sdk.OauthSecurityIntegrationClientOption(strings.ToUpper(d.Get("oauth_client").(string)))).
+		WithEnabled(d.Get("enabled").(bool)).
+		WithOauthIssueRefreshTokens(d.Get("oauth_issue_refresh_tokens").(bool)).
+		WithOauthUseSecondaryRoles(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(strings.ToUpper(d.Get("oauth_use_secondary_roles").(string))))
+
+	if v, ok := d.GetOk("oauth_redirect_uri"); ok {
+		request.WithOauthRedirectUri(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+		request.WithOauthRefreshTokenValidity(v.(int))
+	}
+	if v, ok := d.GetOk("blocked_roles_list"); ok {
+		request.WithBlockedRolesList(*sdk.NewBlockedRolesListRequest().WithBlockedRolesList(expandSecurityIntegrationRoles(v.(*schema.Set).List())))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.SecurityIntegrations.CreateOauthForPartnerApplications(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextOauthIntegrationForPartnerApplications(ctx, d, meta)
+}
+
+func ReadContextOauthIntegrationForPartnerApplications(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	values, diags := readSecurityIntegrationCommon(ctx, d, meta)
+	if values == nil {
+		return diags
+	}
+
+	if err := d.Set("oauth_client", strings.TrimPrefix(d.Get("integration_type").(string), "OAUTH - ")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setSecurityIntegrationProperties(d, oauthIntegrationForPartnerApplicationsSchema, values, oauthIntegrationForPartnerApplicationsProperties); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setOauthBlockedRolesList(d, values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextOauthIntegrationForPartnerApplications(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set := sdk.NewOauthForPartnerApplicationsIntegrationSetRequest()
+	var runSet bool
+
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("oauth_redirect_uri") {
+		runSet = true
+		set.WithOauthRedirectUri(d.Get("oauth_redirect_uri").(string))
+	}
+	if d.HasChange("oauth_issue_refresh_tokens") {
+		runSet = true
+		set.WithOauthIssueRefreshTokens(d.Get("oauth_issue_refresh_tokens").(bool))
+	}
+	if d.HasChange("oauth_refresh_token_validity") {
+		if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+			runSet = true
+			set.WithOauthRefreshTokenValidity(v.(int))
+		}
+	}
+	if d.HasChange("oauth_use_secondary_roles") {
+		runSet = true
+		set.WithOauthUseSecondaryRoles(sdk.OauthSecurityIntegrationUseSecondaryRolesOption(strings.ToUpper(d.Get("oauth_use_secondary_roles").(string))))
+	}
+	if d.HasChange("blocked_roles_list") {
+		runSet = true
+		set.WithBlockedRolesList(*sdk.NewBlockedRolesListRequest().WithBlockedRolesList(expandSecurityIntegrationRoles(d.Get("blocked_roles_list").(*schema.Set).List())))
+	}
+	if d.HasChange("comment") {
+		runSet = true
+		set.WithComment(d.Get("comment").(string))
+	}
+
+	if runSet {
+		if err := client.SecurityIntegrations.AlterOauthForPartnerApplications(ctx, sdk.NewAlterOauthForPartnerApplicationsSecurityIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextOauthIntegrationForPartnerApplications(ctx, d, meta)
+}
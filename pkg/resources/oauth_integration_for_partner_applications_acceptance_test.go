@@ -0,0 +1,89 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OauthIntegrationForPartnerApplications_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.OauthIntegrationForPartnerApplications),
+		Steps: []resource.TestStep{
+			{
+				Config: oauthIntegrationForPartnerApplicationsBasicConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_client", "TABLEAU_DESKTOP"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_issue_refresh_tokens", "true"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_partner_applications.test", "oauth_refresh_token_validity"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_use_secondary_roles", "NONE"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "blocked_roles_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "integration_type", "OAUTH - TABLEAU_DESKTOP"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration_for_partner_applications.test", "created_on"),
+				),
+			},
+			{
+				Config: oauthIntegrationForPartnerApplicationsCompleteConfig(id.Name(), role.Name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_oauth_integration_for_partner_applications.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_issue_refresh_tokens", "false"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_refresh_token_validity", "86400"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "oauth_use_secondary_roles", "IMPLICIT"),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "blocked_roles_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_oauth_integration_for_partner_applications.test", "blocked_roles_list.*", role.Name),
+					resource.TestCheckResourceAttr("snowflake_oauth_integration_for_partner_applications.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_oauth_integration_for_partner_applications.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func oauthIntegrationForPartnerApplicationsBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_oauth_integration_for_partner_applications" "test" {
+	name         = "%[1]s"
+	oauth_client = "TABLEAU_DESKTOP"
+}
+`, name)
+}
+
+func oauthIntegrationForPartnerApplicationsCompleteConfig(name string, blockedRole string) string {
+	return fmt.Sprintf(`
+resource "snowflake_oauth_integration_for_partner_applications" "test" {
+	name                         = "%[1]s"
+	oauth_client                 = "TABLEAU_DESKTOP"
+	enabled                      = true
+	oauth_issue_refresh_tokens   = false
+	oauth_refresh_token_validity = 86400
+	oauth_use_secondary_roles    = "IMPLICIT"
+	blocked_roles_list           = ["%[2]s"]
+	comment                      = "foo"
+}
+`, name, blockedRole)
+}
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var packagesPolicyLanguages = []string{string(sdk.PackagesPolicyLanguagePython)}
+
+var packagesPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the packages policy; must be unique for the schema in which the packages policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the packages policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the packages policy.",
+	},
+	"language": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      string(sdk.PackagesPolicyLanguagePython),
+		ValidateFunc: validation.StringInSlice(packagesPolicyLanguages, false),
+		Description:  fmt.Sprintf("Specifies the language the packages policy applies to. Valid values are (case-sensitive): %v.", packagesPolicyLanguages),
+	},
+	"allowlist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of package specs that are allowed, e.g. `numpy` or `pandas==1.5.3`. When not set, the Snowflake default (`*`, all packages) applies.",
+	},
+	"blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of package specs that are not allowed.",
+	},
+	"additional_creation_blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of package specs that are not allowed at creation time of functions and procedures, on top of the ones in `blocklist`.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the packages policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the packages policy.",
+	},
+}
+
+// PackagesPolicy returns a pointer to the resource representing a packages policy.
+func PackagesPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage packages policy objects, which define allowlists and blocklists of Python packages for UDFs and stored procedures. For more information, check [packages policy documentation](https://docs.snowflake.com/en/developer-guide/udf/python/packages-policy).",
+
+		CreateContext: CreateContextPackagesPolicy,
+		ReadContext:   ReadContextPackagesPolicy,
+		UpdateContext: UpdateContextPackagesPolicy,
+		DeleteContext: DeleteContextPackagesPolicy,
+
+		Schema: packagesPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguage(d.Get("language").(string)))
+	if v, ok := d.GetOk("allowlist"); ok {
+		request.WithAllowlist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("blocklist"); ok {
+		request.WithBlocklist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("additional_creation_blocklist"); ok {
+		request.WithAdditionalCreationBlocklist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.PackagesPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextPackagesPolicy(ctx, d, meta)
+}
+
+func ReadContextPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	packagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve packages policy. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.PackagesPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowlist := sdk.ParseCommaSeparatedStringArray(description.Allowlist)
+	// Snowflake reports the default allowlist (all packages) when nothing was set, so keep the attribute empty in that case to avoid a permanent diff.
+	if d.Get("allowlist").(*schema.Set).Len() == 0 && slices.Equal(allowlist, []string{"*"}) {
+		allowlist = []string{}
+	}
+
+	toSet := map[string]any{
+		"name":                          packagesPolicy.Name,
+		"database":                      packagesPolicy.DatabaseName,
+		"schema":                        packagesPolicy.SchemaName,
+		"language":                      description.Language,
+		"allowlist":                     allowlist,
+		"blocklist":                     sdk.ParseCommaSeparatedStringArray(description.Blocklist),
+		"additional_creation_blocklist": sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist),
+		"comment":                       packagesPolicy.Comment,
+		"qualified_name":                id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewPackagesPolicySetRequest(), sdk.NewPackagesPolicyUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("allowlist") {
+		if v, ok := d.GetOk("allowlist"); ok {
+			set.WithAllowlist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithAllowlist(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("blocklist") {
+		if v, ok := d.GetOk("blocklist"); ok {
+			set.WithBlocklist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithBlocklist(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("additional_creation_blocklist") {
+		if v, ok := d.GetOk("additional_creation_blocklist"); ok {
+			set.WithAdditionalCreationBlocklist(expandPackagesPolicyPackages(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithAdditionalCreationBlocklist(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextPackagesPolicy(ctx, d, meta)
+}
+
+func DeleteContextPackagesPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandPackagesPolicyPackages(specs []any) []sdk.PackagesPolicyPackage {
+	result := make([]sdk.PackagesPolicyPackage, len(specs))
+	for i, spec := range specs {
+		result[i] = sdk.PackagesPolicyPackage{Spec: spec.(string)}
+	}
+	return result
+}
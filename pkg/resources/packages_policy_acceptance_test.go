@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PackagesPolicy_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.PackagesPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: packagesPolicyBasicConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "language", "PYTHON"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "allowlist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "blocklist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "additional_creation_blocklist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "qualified_name", id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: packagesPolicyCompleteConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_packages_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "allowlist.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_packages_policy.test", "allowlist.*", "numpy"),
+					resource.TestCheckTypeSetElemAttr("snowflake_packages_policy.test", "allowlist.*", "pandas==1.5.3"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "blocklist.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_packages_policy.test", "blocklist.*", "requests"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "additional_creation_blocklist.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_packages_policy.test", "additional_creation_blocklist.*", "scipy"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_packages_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields
+			{
+				Config: packagesPolicyBasicConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_packages_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "allowlist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "blocklist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "additional_creation_blocklist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func packagesPolicyBasicConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_packages_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func packagesPolicyCompleteConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_packages_policy" "test" {
+	database                      = "%[1]s"
+	schema                        = "%[2]s"
+	name                          = "%[3]s"
+	language                      = "PYTHON"
+	allowlist                     = ["numpy", "pandas==1.5.3"]
+	blocklist                     = ["requests"]
+	additional_creation_blocklist = ["scipy"]
+	comment                       = "foo"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
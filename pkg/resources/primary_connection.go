@@ -0,0 +1,210 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var primaryConnectionSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the connection; must be unique for the account.",
+	},
+	"enable_failover_to_accounts": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the accounts to which failover of the connection is enabled; secondary connections in these accounts can be promoted to serve as the primary connection. Expected in the form `<org_name>.<account_name>` (uppercase, as returned by `SHOW CONNECTIONS`).",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the connection.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether the connection is the primary connection in the replication group.",
+	},
+	"primary": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fully qualified name of the primary connection (`<org_name>.<account_name>.<connection_name>`).",
+	},
+	"connection_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Connection URL that clients use to connect to Snowflake through the connection.",
+	},
+}
+
+// PrimaryConnection returns a pointer to the resource representing a primary connection.
+func PrimaryConnection() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage primary connections used for client redirect. To create a replica of the connection in another account, use the `snowflake_secondary_connection` resource. For more information, check [client redirect documentation](https://docs.snowflake.com/en/user-guide/client-redirect).",
+
+		CreateContext: CreateContextPrimaryConnection,
+		ReadContext:   ReadContextPrimaryConnection,
+		UpdateContext: UpdateContextPrimaryConnection,
+		DeleteContext: DeleteContextConnection,
+
+		Schema: primaryConnectionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextPrimaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateConnectionRequest(id)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+	if err := client.Connections.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if v, ok := d.GetOk("enable_failover_to_accounts"); ok {
+		accounts := connectionAccountIdentifiers(v.(*schema.Set).List())
+		if err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithEnableConnectionFailover(*sdk.NewEnableConnectionFailoverRequest(accounts))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextPrimaryConnection(ctx, d, meta)
+}
+
+func ReadContextPrimaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	connection, err := client.Connections.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve primary connection. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// failover_allowed_to_accounts contains also the account in which the primary connection is located
+	currentAccount := sdk.NewAccountIdentifier(connection.OrganizationName, connection.AccountName)
+	enableFailoverToAccounts := make([]string, 0, len(connection.FailoverAllowedToAccounts))
+	for _, account := range connection.FailoverAllowedToAccounts {
+		if account.Name() != currentAccount.Name() {
+			enableFailoverToAccounts = append(enableFailoverToAccounts, account.Name())
+		}
+	}
+
+	var comment string
+	if connection.Comment != nil {
+		comment = *connection.Comment
+	}
+
+	toSet := map[string]any{
+		"name":                        connection.Name,
+		"enable_failover_to_accounts": enableFailoverToAccounts,
+		"comment":                     comment,
+		"is_primary":                  connection.IsPrimary,
+		"primary":                     connectionPrimaryName(connection),
+		"connection_url":              connection.ConnectionUrl,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextPrimaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChange("enable_failover_to_accounts") {
+		o, n := d.GetChange("enable_failover_to_accounts")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if len(removed) > 0 {
+			request := sdk.NewDisableConnectionFailoverRequest().WithToAccounts(connectionAccountIdentifiers(removed))
+			if err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithDisableConnectionFailover(*request)); err != nil {
+				return diag.FromErr(fmt.Errorf("error disabling failover for connection %v err = %w", d.Id(), err))
+			}
+		}
+		if len(added) > 0 {
+			request := sdk.NewEnableConnectionFailoverRequest(connectionAccountIdentifiers(added))
+			if err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithEnableConnectionFailover(*request)); err != nil {
+				return diag.FromErr(fmt.Errorf("error enabling failover for connection %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if diags := updateConnectionComment(ctx, d, client, id); diags != nil {
+		return diags
+	}
+
+	return ReadContextPrimaryConnection(ctx, d, meta)
+}
+
+// DeleteContextConnection is shared between primary and secondary connection resources.
+func DeleteContextConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.Connections.Drop(ctx, sdk.NewDropConnectionRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateConnectionComment(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.AccountObjectIdentifier) diag.Diagnostics {
+	if !d.HasChange("comment") {
+		return nil
+	}
+	request := sdk.NewAlterConnectionRequest(id)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithSet(*sdk.NewConnectionSetRequest().WithComment(v.(string)))
+	} else {
+		request.WithUnset(*sdk.NewConnectionUnsetRequest().WithComment(true))
+	}
+	if err := client.Connections.Alter(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func connectionAccountIdentifiers(accounts []any) []sdk.AccountIdentifier {
+	result := make([]sdk.AccountIdentifier, len(accounts))
+	for i, account := range accounts {
+		result[i] = sdk.NewAccountIdentifierFromFullyQualifiedName(account.(string))
+	}
+	return result
+}
+
+// connectionPrimaryName returns the primary connection name in the form used by the as_replica_of field (<org_name>.<account_name>.<connection_name>).
+func connectionPrimaryName(connection *sdk.Connection) string {
+	return fmt.Sprintf("%s.%s", connection.Primary.AccountIdentifier().Name(), connection.Primary.Name())
+}
@@ -0,0 +1,81 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PrimaryConnection_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	secondaryAccountId := acc.SecondaryTestClient().Account.GetAccountIdentifier(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.PrimaryConnection),
+		Steps: []resource.TestStep{
+			{
+				Config: primaryConnectionConfig(id, nil, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "enable_failover_to_accounts.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "is_primary", "true"),
+					resource.TestCheckResourceAttrSet("snowflake_primary_connection.test", "primary"),
+					resource.TestCheckResourceAttrSet("snowflake_primary_connection.test", "connection_url"),
+				),
+			},
+			// enable failover and set the comment in place
+			{
+				Config: primaryConnectionConfig(id, []sdk.AccountIdentifier{secondaryAccountId}, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_primary_connection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "enable_failover_to_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_primary_connection.test", "enable_failover_to_accounts.*", secondaryAccountId.Name()),
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "comment", "some comment"),
+				),
+			},
+			// disable failover and unset the comment
+			{
+				Config: primaryConnectionConfig(id, nil, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "enable_failover_to_accounts.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_primary_connection.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_primary_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func primaryConnectionConfig(id sdk.AccountObjectIdentifier, enableFailoverToAccounts []sdk.AccountIdentifier, comment string) string {
+	accounts := ""
+	for _, account := range enableFailoverToAccounts {
+		accounts += fmt.Sprintf("%q, ", account.Name())
+	}
+	return fmt.Sprintf(`
+resource "snowflake_primary_connection" "test" {
+	name                        = "%[1]s"
+	enable_failover_to_accounts = [%[2]s]
+	comment                     = "%[3]s"
+}
+`, id.Name(), accounts, comment)
+}
@@ -142,6 +142,9 @@ var procedureSchema = map[string]*schema.Schema{
 		Default:     "user-defined procedure",
 		Description: "Specifies a comment for the procedure.",
 	},
+	"log_level":    logLevelSchema("procedure"),
+	"trace_level":  traceLevelSchema("procedure"),
+	"metric_level": metricLevelSchema("procedure"),
 	"runtime_version": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -283,6 +286,9 @@ func createJavaProcedure(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	sid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argTypes)
 	d.SetId(sid.FullyQualifiedName())
+	if err := updateProcedureLoggingLevels(ctx, client, sid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -335,6 +341,9 @@ func createJavaScriptProcedure(ctx context.Context, d *schema.ResourceData, meta
 	}
 	sid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argTypes)
 	d.SetId(sid.FullyQualifiedName())
+	if err := updateProcedureLoggingLevels(ctx, client, sid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -397,6 +406,9 @@ func createScalaProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	sid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argTypes)
 	d.SetId(sid.FullyQualifiedName())
+	if err := updateProcedureLoggingLevels(ctx, client, sid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -448,6 +460,9 @@ func createSQLProcedure(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	sid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argTypes)
 	d.SetId(sid.FullyQualifiedName())
+	if err := updateProcedureLoggingLevels(ctx, client, sid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -518,6 +533,9 @@ func createPythonProcedure(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	sid := sdk.NewSchemaObjectIdentifierWithArguments(database, schema, name, argTypes)
 	d.SetId(sid.FullyQualifiedName())
+	if err := updateProcedureLoggingLevels(ctx, client, sid, d); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -649,6 +667,10 @@ func ReadContextProcedure(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if err := readLoggingLevels(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeProcedure, Name: id}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -694,6 +716,10 @@ func UpdateContextProcedure(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if err := updateProcedureLoggingLevels(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextProcedure(ctx, d, meta)
 }
 
@@ -794,3 +820,41 @@ func parseProcedureSQLReturnsRequest(s string) (*sdk.ProcedureSQLReturnsRequest,
 	}
 	return returns, nil
 }
+
+// updateProcedureLoggingLevels sets or unsets the changed logging levels; it is used both on create and on update.
+func updateProcedureLoggingLevels(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	if d.HasChange("log_level") {
+		request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("log_level").(string); v != "" {
+			request.WithSetLogLevel(sdk.String(v))
+		} else {
+			request.WithUnsetLogLevel(sdk.Bool(true))
+		}
+		if err := client.Procedures.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("trace_level") {
+		request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("trace_level").(string); v != "" {
+			request.WithSetTraceLevel(sdk.String(v))
+		} else {
+			request.WithUnsetTraceLevel(sdk.Bool(true))
+		}
+		if err := client.Procedures.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("metric_level") {
+		request := sdk.NewAlterProcedureRequest(id.WithoutArguments(), id.Arguments())
+		if v := d.Get("metric_level").(string); v != "" {
+			request.WithSetMetricLevel(sdk.String(v))
+		} else {
+			request.WithUnsetMetricLevel(sdk.Bool(true))
+		}
+		if err := client.Procedures.Alter(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -257,6 +257,87 @@ resource "snowflake_procedure" "p" {
 `, database, schema, name)
 }
 
+func TestAcc_Procedure_loggingLevels(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	resourceName := "snowflake_procedure.p"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Procedure),
+		Steps: []resource.TestStep{
+			// set
+			{
+				Config: procedureConfigWithLoggingLevels(acc.TestDatabaseName, acc.TestSchemaName, name, "INFO", "ON_EVENT", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", "ON_EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", "ALL"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"null_input_behavior"},
+			},
+			// change
+			{
+				Config: procedureConfigWithLoggingLevels(acc.TestDatabaseName, acc.TestSchemaName, name, "ERROR", "ALWAYS", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", "ALWAYS"),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", "NONE"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// unset
+			{
+				Config: procedureConfig(acc.TestDatabaseName, acc.TestSchemaName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "log_level", ""),
+					resource.TestCheckResourceAttr(resourceName, "trace_level", ""),
+					resource.TestCheckResourceAttr(resourceName, "metric_level", ""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func procedureConfigWithLoggingLevels(database string, schema string, name string, logLevel string, traceLevel string, metricLevel string) string {
+	return fmt.Sprintf(`
+resource "snowflake_procedure" "p" {
+  database     = "%[1]s"
+  schema       = "%[2]s"
+  name         = "%[3]s"
+  language     = "JAVASCRIPT"
+  return_type  = "VARCHAR"
+  log_level    = "%[4]s"
+  trace_level  = "%[5]s"
+  metric_level = "%[6]s"
+  statement    = <<EOT
+    return "Hi"
+  EOT
+}
+`, database, schema, name, logLevel, traceLevel, metricLevel)
+}
+
 func TestAcc_Procedure_proveArgsPermanentDiff(t *testing.T) {
 	name := acc.TestClient().Ids.Alpha()
 	resourceName := "snowflake_procedure.p"
@@ -0,0 +1,161 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the projection policy; must be unique for the schema in which the projection policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the projection policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the projection policy.",
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the SQL expression that determines the projection constraint, e.g. `PROJECTION_CONSTRAINT(ALLOW => false)`.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the projection policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the projection policy.",
+	},
+}
+
+// ProjectionPolicy returns a pointer to the resource representing a projection policy.
+func ProjectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage projection policy objects. For more information, check [projection policy documentation](https://docs.snowflake.com/en/user-guide/projection-policies).",
+
+		CreateContext: CreateContextProjectionPolicy,
+		ReadContext:   ReadContextProjectionPolicy,
+		UpdateContext: UpdateContextProjectionPolicy,
+		DeleteContext: DeleteContextProjectionPolicy,
+
+		Schema: projectionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateProjectionPolicyRequest(id, d.Get("body").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.ProjectionPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextProjectionPolicy(ctx, d, meta)
+}
+
+func ReadContextProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve projection policy. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.ProjectionPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toSet := map[string]any{
+		"name":           projectionPolicy.Name,
+		"database":       projectionPolicy.DatabaseName,
+		"schema":         projectionPolicy.SchemaName,
+		"body":           description.Body,
+		"comment":        projectionPolicy.Comment,
+		"qualified_name": id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("body") {
+		if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("comment") {
+		request := sdk.NewAlterProjectionPolicyRequest(id)
+		if v, ok := d.GetOk("comment"); ok {
+			request.WithSetComment(v.(string))
+		} else {
+			request.WithUnsetComment(true)
+		}
+		if err := client.ProjectionPolicies.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextProjectionPolicy(ctx, d, meta)
+}
+
+func DeleteContextProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
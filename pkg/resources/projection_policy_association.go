@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var projectionPolicyAssociationSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("Type of the object containing the column the projection policy is attached to. Valid values are: %s.", strings.Join(policyAssociationObjectTypes, " | ")),
+		ValidateFunc: validation.StringInSlice(policyAssociationObjectTypes, false),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name (`database.schema.object`) of the table or view containing the column.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"column": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The column to attach the projection policy to.",
+	},
+	"projection_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Fully qualified name (`database.schema.policyname`) of the projection policy to attach.",
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+}
+
+func ProjectionPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a projection policy to a table or view column.",
+		Create:      CreateProjectionPolicyAssociation,
+		Read:        ReadProjectionPolicyAssociation,
+		Delete:      DeleteProjectionPolicyAssociation,
+		Schema:      projectionPolicyAssociationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateProjectionPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(d.Get("object_type").(string))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("object_name").(string))
+	column := d.Get("column").(string)
+	projectionPolicy := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("projection_policy").(string))
+
+	var err error
+	switch objectType {
+	case sdk.ObjectTypeTable:
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectName).WithColumnAction(
+			sdk.NewTableColumnActionRequest().WithSetProjectionPolicy(sdk.NewTableColumnAlterSetProjectionPolicyActionRequest(column, projectionPolicy)),
+		))
+	case sdk.ObjectTypeView:
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectName).WithSetProjectionPolicyOnColumn(
+			sdk.NewViewSetColumnProjectionPolicyRequest(column, projectionPolicy),
+		))
+	default:
+		err = fmt.Errorf("unsupported object type for projection policy association: %s", objectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(string(objectType), objectName.FullyQualifiedName(), column, projectionPolicy.FullyQualifiedName()))
+
+	return ReadProjectionPolicyAssociation(d, meta)
+}
+
+func ReadProjectionPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return fmt.Errorf("required id format 'object_type|object_name|column|projection_policy', but got: '%s'", d.Id())
+	}
+
+	// Note: there is no alphanumeric id for an association, so we retrieve the projection policies attached to the columns of a certain object.
+	objectType := sdk.ObjectType(strings.ToUpper(parts[0]))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(parts[1])
+	column := parts[2]
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(objectName, sdk.PolicyEntityDomain(objectType)))
+	if err != nil {
+		return err
+	}
+
+	projectionPolicyReferences := make([]sdk.PolicyReference, 0)
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == "PROJECTION_POLICY" && policyReference.RefColumnName != nil && strings.EqualFold(*policyReference.RefColumnName, column) {
+			projectionPolicyReferences = append(projectionPolicyReferences, policyReference)
+		}
+	}
+
+	// Note: this should never happen, but just in case: so far, Snowflake only allows one Projection Policy per column.
+	if len(projectionPolicyReferences) > 1 {
+		return fmt.Errorf("internal error: multiple projection policy references attached to a column. This should never happen")
+	}
+
+	// Note: this means the resource has been deleted outside of Terraform.
+	if len(projectionPolicyReferences) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("object_type", string(objectType)); err != nil {
+		return err
+	}
+	if err := d.Set("object_name", objectName.FullyQualifiedName()); err != nil {
+		return err
+	}
+	if err := d.Set("column", column); err != nil {
+		return err
+	}
+	if err := d.Set(
+		"projection_policy",
+		sdk.NewSchemaObjectIdentifier(
+			*projectionPolicyReferences[0].PolicyDb,
+			*projectionPolicyReferences[0].PolicySchema,
+			projectionPolicyReferences[0].PolicyName,
+		).FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteProjectionPolicyAssociation(d *schema.ResourceData, meta any) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(d.Get("object_type").(string))
+	objectName := sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(d.Get("object_name").(string))
+	column := d.Get("column").(string)
+
+	var err error
+	switch objectType {
+	case sdk.ObjectTypeTable:
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(objectName).WithColumnAction(
+			sdk.NewTableColumnActionRequest().WithUnsetProjectionPolicy(sdk.NewTableColumnAlterUnsetProjectionPolicyActionRequest(column)),
+		))
+	case sdk.ObjectTypeView:
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(objectName).WithUnsetProjectionPolicyOnColumn(
+			sdk.NewViewUnsetColumnProjectionPolicyRequest(column),
+		))
+	default:
+		err = fmt.Errorf("unsupported object type for projection policy association: %s", objectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ProjectionPolicyAssociation_table(t *testing.T) {
+	table, tableCleanup := acc.TestClient().Table.CreateTable(t)
+	t.Cleanup(tableCleanup)
+	projectionPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckProjectionPolicyAssociationDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: projectionPolicyAssociationConfig(projectionPolicyId, "TABLE", table.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "object_type", "TABLE"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "object_name", table.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "column", "id"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "projection_policy", projectionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "id", fmt.Sprintf("TABLE|%s|id|%s", table.ID().FullyQualifiedName(), projectionPolicyId.FullyQualifiedName())),
+				),
+			},
+			{
+				ResourceName:      "snowflake_projection_policy_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectionPolicyAssociation_view(t *testing.T) {
+	table, tableCleanup := acc.TestClient().Table.CreateTable(t)
+	t.Cleanup(tableCleanup)
+	view, viewCleanup := acc.TestClient().View.CreateView(t, fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName()))
+	t.Cleanup(viewCleanup)
+	projectionPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckProjectionPolicyAssociationDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: projectionPolicyAssociationConfig(projectionPolicyId, "VIEW", view.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "object_type", "VIEW"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "object_name", view.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "column", "id"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy_association.test", "projection_policy", projectionPolicyId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func projectionPolicyAssociationConfig(projectionPolicyId sdk.SchemaObjectIdentifier, objectType string, objectId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_projection_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+resource "snowflake_projection_policy_association" "test" {
+	object_type       = "%[4]s"
+	object_name       = %[5]q
+	column            = "id"
+	projection_policy = snowflake_projection_policy.test.qualified_name
+}
+`, projectionPolicyId.DatabaseName(), projectionPolicyId.SchemaName(), projectionPolicyId.Name(), objectType, objectId.FullyQualifiedName())
+}
@@ -0,0 +1,380 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var replicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the replication group; must be unique for the account.",
+	},
+	"object_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of objects for which you are enabling replication from the source account to the target account. The following object types are supported: \"ACCOUNT PARAMETERS\", \"DATABASES\", \"INTEGRATIONS\", \"NETWORK POLICIES\", \"RESOURCE MONITORS\", \"ROLES\", \"SHARES\", \"USERS\", \"WAREHOUSES\". Required when `from_replica` is not set.",
+	},
+	"allowed_databases": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the database or list of databases for which you are enabling replication from the source account to the target account. The `object_types` list must include DATABASES to set this parameter.",
+	},
+	"allowed_shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the share or list of shares for which you are enabling replication from the source account to the target account. The `object_types` list must include SHARES to set this parameter.",
+	},
+	"allowed_integration_types": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Type(s) of integrations for which you are enabling replication from the source account to the target account. The `object_types` list must include INTEGRATIONS to set this parameter. The following integration types are supported: \"SECURITY INTEGRATIONS\", \"API INTEGRATIONS\", \"STORAGE INTEGRATIONS\", \"EXTERNAL ACCESS INTEGRATIONS\", \"NOTIFICATION INTEGRATIONS\".",
+	},
+	"allowed_accounts": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form `<org_name>.<target_account_name>`. Required when `from_replica` is not set.",
+	},
+	"ignore_edition_check": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Allows replicating objects to accounts on lower editions.",
+	},
+	"replication_schedule": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"from_replica"},
+		Description:   "Specifies the schedule for refreshing secondary replication groups, either as an interval (e.g. `10 MINUTE`) or as a cron expression (e.g. `USING CRON 0 0 10-20 * TUE,THU UTC`).",
+	},
+	"from_replica": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"object_types", "allowed_databases", "allowed_shares", "allowed_integration_types", "allowed_accounts", "ignore_edition_check", "replication_schedule"},
+		Description:   "Specifies the fully qualified name of the primary replication group from which the secondary replication group is created. Expected in the form `<org_name>.<source_account_name>.<replication_group_name>`.",
+	},
+	"suspended": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Suspends (`true`) or resumes (`false`) the scheduled refresh of the secondary replication group. Can be set only together with `from_replica`.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether the replication group is the primary group.",
+	},
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group.
+func ReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage replication groups. In contrast to failover groups, replication groups are available in all editions, but the secondary replication group can't be promoted to serve as the primary group. Use the `from_replica` field to create a secondary replication group in the target account. For more information, check [replication groups documentation](https://docs.snowflake.com/en/user-guide/account-replication-intro#replication-groups-and-failover-groups).",
+
+		CreateContext: CreateContextReplicationGroup,
+		ReadContext:   ReadContextReplicationGroup,
+		UpdateContext: UpdateContextReplicationGroup,
+		DeleteContext: DeleteContextReplicationGroup,
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	if v, ok := d.GetOk("from_replica"); ok {
+		primaryId := sdk.NewExternalObjectIdentifierFromFullyQualifiedName(v.(string))
+		if err := client.ReplicationGroups.CreateSecondary(ctx, id, primaryId, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(id))
+
+		if d.Get("suspended").(bool) {
+			if err := client.ReplicationGroups.AlterTarget(ctx, id, &sdk.AlterTargetReplicationGroupOptions{Suspend: sdk.Bool(true)}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return ReadContextReplicationGroup(ctx, d, meta)
+	}
+
+	objectTypes := replicationGroupObjectTypes(d.Get("object_types").(*schema.Set).List())
+	if len(objectTypes) == 0 {
+		return diag.FromErr(errors.New("object_types is required when not creating from a replica"))
+	}
+	allowedAccounts := replicationGroupAccounts(d.Get("allowed_accounts").(*schema.Set).List())
+	if len(allowedAccounts) == 0 {
+		return diag.FromErr(errors.New("allowed_accounts is required when not creating from a replica"))
+	}
+
+	opts := &sdk.CreateReplicationGroupOptions{
+		AllowedDatabases:        replicationGroupAccountObjects(d.Get("allowed_databases").(*schema.Set).List()),
+		AllowedShares:           replicationGroupAccountObjects(d.Get("allowed_shares").(*schema.Set).List()),
+		AllowedIntegrationTypes: replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set).List()),
+	}
+	if d.Get("ignore_edition_check").(bool) {
+		opts.IgnoreEditionCheck = sdk.Bool(true)
+	}
+	if v, ok := d.GetOk("replication_schedule"); ok {
+		opts.ReplicationSchedule = sdk.String(v.(string))
+	}
+
+	if err := client.ReplicationGroups.Create(ctx, id, objectTypes, allowedAccounts, opts); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextReplicationGroup(ctx, d, meta)
+}
+
+func ReadContextReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve replication group. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", replicationGroup.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_primary", replicationGroup.IsPrimary); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the secondary replication group mirrors the configuration of the primary one, so only its own state is read
+	if !replicationGroup.IsPrimary {
+		// from_replica is read only on import to not force the recreation because of the different casing in the config
+		if _, ok := d.GetOk("from_replica"); !ok {
+			if err := d.Set("from_replica", fmt.Sprintf("%s.%s", replicationGroup.Primary.AccountIdentifier().Name(), replicationGroup.Primary.Name())); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := d.Set("suspended", replicationGroup.SecondaryState == sdk.FailoverGroupSecondaryStateSuspended); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectTypes := make([]string, len(replicationGroup.ObjectTypes))
+	for i, objectType := range replicationGroup.ObjectTypes {
+		objectTypes[i] = string(objectType)
+	}
+	allowedIntegrationTypes := make([]string, len(replicationGroup.AllowedIntegrationTypes))
+	for i, integrationType := range replicationGroup.AllowedIntegrationTypes {
+		allowedIntegrationTypes[i] = string(integrationType)
+	}
+	allowedAccounts := make([]string, len(replicationGroup.AllowedAccounts))
+	for i, account := range replicationGroup.AllowedAccounts {
+		allowedAccounts[i] = account.Name()
+	}
+	allowedDatabases := make([]string, len(databases))
+	for i, database := range databases {
+		allowedDatabases[i] = database.Name()
+	}
+	allowedShares := make([]string, len(shares))
+	for i, share := range shares {
+		allowedShares[i] = share.Name()
+	}
+
+	toSet := map[string]any{
+		"object_types":              objectTypes,
+		"allowed_integration_types": allowedIntegrationTypes,
+		"allowed_accounts":          allowedAccounts,
+		"allowed_databases":         allowedDatabases,
+		"allowed_shares":            allowedShares,
+		"replication_schedule":      replicationGroup.ReplicationSchedule,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChange("suspended") {
+		opts := &sdk.AlterTargetReplicationGroupOptions{Resume: sdk.Bool(true)}
+		if d.Get("suspended").(bool) {
+			opts = &sdk.AlterTargetReplicationGroupOptions{Suspend: sdk.Bool(true)}
+		}
+		if err := client.ReplicationGroups.AlterTarget(ctx, id, opts); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// object types and integration types have to be set together, because INTEGRATIONS must be in object types when setting the integration types
+	if d.HasChanges("object_types", "allowed_integration_types") {
+		set := &sdk.ReplicationGroupSet{
+			ObjectTypes:             replicationGroupObjectTypes(d.Get("object_types").(*schema.Set).List()),
+			AllowedIntegrationTypes: replicationGroupIntegrationTypes(d.Get("allowed_integration_types").(*schema.Set).List()),
+		}
+		if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Set: set}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("replication_schedule") {
+		opts := &sdk.AlterSourceReplicationGroupOptions{Unset: &sdk.ReplicationGroupUnset{ReplicationSchedule: sdk.Bool(true)}}
+		if v, ok := d.GetOk("replication_schedule"); ok {
+			opts = &sdk.AlterSourceReplicationGroupOptions{Set: &sdk.ReplicationGroupSet{ReplicationSchedule: sdk.String(v.(string))}}
+		}
+		if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("allowed_databases") {
+		added, removed := replicationGroupSetDiff(d, "allowed_databases")
+		if len(removed) > 0 {
+			opts := &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedDatabases: replicationGroupAccountObjects(removed)}}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing allowed databases for replication group %v err = %w", d.Id(), err))
+			}
+		}
+		if len(added) > 0 {
+			opts := &sdk.AlterSourceReplicationGroupOptions{Add: &sdk.ReplicationGroupAdd{AllowedDatabases: replicationGroupAccountObjects(added)}}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding allowed databases for replication group %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("allowed_shares") {
+		added, removed := replicationGroupSetDiff(d, "allowed_shares")
+		if len(removed) > 0 {
+			opts := &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedShares: replicationGroupAccountObjects(removed)}}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing allowed shares for replication group %v err = %w", d.Id(), err))
+			}
+		}
+		if len(added) > 0 {
+			opts := &sdk.AlterSourceReplicationGroupOptions{Add: &sdk.ReplicationGroupAdd{AllowedShares: replicationGroupAccountObjects(added)}}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding allowed shares for replication group %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("allowed_accounts") {
+		added, removed := replicationGroupSetDiff(d, "allowed_accounts")
+		if len(removed) > 0 {
+			opts := &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedAccounts: replicationGroupAccounts(removed)}}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, opts); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing allowed accounts for replication group %v err = %w", d.Id(), err))
+			}
+		}
+		if len(added) > 0 {
+			add := &sdk.ReplicationGroupAdd{AllowedAccounts: replicationGroupAccounts(added)}
+			if d.Get("ignore_edition_check").(bool) {
+				add.IgnoreEditionCheck = sdk.Bool(true)
+			}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Add: add}); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding allowed accounts for replication group %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	return ReadContextReplicationGroup(ctx, d, meta)
+}
+
+func DeleteContextReplicationGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.ReplicationGroups.Drop(ctx, id, &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func replicationGroupSetDiff(d *schema.ResourceData, key string) (added []any, removed []any) {
+	o, n := d.GetChange(key)
+	oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+	return newSet.Difference(oldSet).List(), oldSet.Difference(newSet).List()
+}
+
+func replicationGroupObjectTypes(values []any) []sdk.PluralObjectType {
+	objectTypes := make([]sdk.PluralObjectType, len(values))
+	for i, v := range values {
+		objectTypes[i] = sdk.PluralObjectType(v.(string))
+	}
+	return objectTypes
+}
+
+func replicationGroupIntegrationTypes(values []any) []sdk.IntegrationType {
+	integrationTypes := make([]sdk.IntegrationType, len(values))
+	for i, v := range values {
+		integrationTypes[i] = sdk.IntegrationType(v.(string))
+	}
+	return integrationTypes
+}
+
+func replicationGroupAccountObjects(values []any) []sdk.AccountObjectIdentifier {
+	ids := make([]sdk.AccountObjectIdentifier, len(values))
+	for i, v := range values {
+		ids[i] = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	return ids
+}
+
+func replicationGroupAccounts(values []any) []sdk.AccountIdentifier {
+	accounts := make([]sdk.AccountIdentifier, len(values))
+	for i, v := range values {
+		accounts[i] = sdk.NewAccountIdentifierFromFullyQualifiedName(v.(string))
+	}
+	return accounts
+}
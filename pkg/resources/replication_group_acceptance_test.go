@@ -0,0 +1,140 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ReplicationGroup_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	secondaryAccountId := acc.SecondaryTestClient().Account.GetAccountIdentifier(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ReplicationGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupConfig(id, secondaryAccountId, `"ROLES"`, `[]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "allowed_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_replication_group.test", "allowed_accounts.*", secondaryAccountId.Name()),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "allowed_databases.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "replication_schedule", ""),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "is_primary", "true"),
+				),
+			},
+			// add the database and the schedule in place
+			{
+				Config: replicationGroupConfig(id, secondaryAccountId, `"ROLES", "DATABASES"`, fmt.Sprintf(`["%s"]`, acc.TestDatabaseName), "10 MINUTE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_replication_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "object_types.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "allowed_databases.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_replication_group.test", "allowed_databases.*", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "replication_schedule", "10 MINUTE"),
+				),
+			},
+			// remove the database and unset the schedule
+			{
+				Config: replicationGroupConfig(id, secondaryAccountId, `"ROLES"`, `[]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "allowed_databases.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "replication_schedule", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_replication_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+			},
+		},
+	})
+}
+
+func TestAcc_ReplicationGroup_fromReplica(t *testing.T) {
+	accountId := acc.TestClient().Account.GetAccountIdentifier(t)
+	secondaryAccountId := acc.SecondaryTestClient().Account.GetAccountIdentifier(t)
+
+	// the primary replication group is located in the secondary account and the replica is managed by the resource
+	primaryReplicationGroup, primaryReplicationGroupCleanup := acc.SecondaryTestClient().ReplicationGroup.CreateWithOptions(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{accountId}, nil)
+	t.Cleanup(primaryReplicationGroupCleanup)
+	id := primaryReplicationGroup.ID()
+	fromReplica := fmt.Sprintf("%s.%s", secondaryAccountId.Name(), id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ReplicationGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupFromReplicaConfig(id, fromReplica, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "from_replica", fromReplica),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "suspended", "false"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "is_primary", "false"),
+				),
+			},
+			{
+				Config: replicationGroupFromReplicaConfig(id, fromReplica, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_replication_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.test", "suspended", "true"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_replication_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func replicationGroupConfig(id sdk.AccountObjectIdentifier, allowedAccount sdk.AccountIdentifier, objectTypes string, allowedDatabases string, replicationSchedule string) string {
+	return fmt.Sprintf(`
+resource "snowflake_replication_group" "test" {
+	name                 = "%[1]s"
+	object_types         = [%[2]s]
+	allowed_accounts     = ["%[3]s"]
+	allowed_databases    = %[4]s
+	replication_schedule = "%[5]s"
+}
+`, id.Name(), objectTypes, allowedAccount.Name(), allowedDatabases, replicationSchedule)
+}
+
+func replicationGroupFromReplicaConfig(id sdk.AccountObjectIdentifier, fromReplica string, suspended bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_replication_group" "test" {
+	name         = "%[1]s"
+	from_replica = "%[2]s"
+	suspended    = %[3]t
+}
+`, id.Name(), fromReplica, suspended)
+}
@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	saml2IntegrationProviders              = []string{"OKTA", "ADFS", "CUSTOM"}
+	saml2IntegrationDefaultNameidFormat    = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	saml2IntegrationRequestedNameidFormats = []string{
+		"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+		saml2IntegrationDefaultNameidFormat,
+		"urn:oasis:names:tc:SAML:1.1:nameid-format:X509SubjectName",
+		"urn:oasis:names:tc:SAML:1.1:nameid-format:WindowsDomainQualifiedName",
+		"urn:oasis:names:tc:SAML:2.0:nameid-format:kerberos",
+		"urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+		"urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+	}
+)
+
+var saml2IntegrationSchema = securityIntegrationSchema(map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this security integration is enabled or disabled.",
+	},
+	"saml2_issuer": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The string containing the IdP EntityID / Issuer.",
+	},
+	"saml2_sso_url": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The string containing the IdP SSO URL, where the user should be redirected by Snowflake (the Service Provider) with a SAML AuthnRequest message.",
+	},
+	"saml2_provider": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.StringInSlice(saml2IntegrationProviders, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("The string describing the IdP. Valid values are (case-insensitive): %v.", saml2IntegrationProviders),
+	},
+	"saml2_x509_cert": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: ignoreTrimSpaceSuppressFunc,
+		Description:      "The Base64 encoded IdP signing certificate without the leading `-----BEGIN CERTIFICATE-----` and ending `-----END CERTIFICATE-----` markers.",
+	},
+	"allowed_user_domains": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of email domains that can authenticate with a SAML2 security integration. The security integration is recreated when the list is cleared.",
+	},
+	"allowed_email_patterns": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of regular expressions that email addresses are matched against to authenticate with a SAML2 security integration. The security integration is recreated when the list is cleared.",
+	},
+	"saml2_sp_initiated_login_page_label": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The string containing the label to display after the Log In With button on the login page.",
+	},
+	"saml2_enable_sp_initiated": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "The Boolean indicating if the Log In With button will be shown on the login page.",
+	},
+	"saml2_snowflake_x509_cert": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		DiffSuppressFunc: ignoreTrimSpaceSuppressFunc,
+		Description:      "The Base64 encoded self-signed certificate generated by Snowflake for Encrypted SAML Assertions and Signed SAML Requests. You must have at least one of these features (encrypted SAML assertions or signed SAML responses) enabled in your Snowflake account to access the certificate value.",
+	},
+	"saml2_sign_request": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "The Boolean indicating whether SAML requests are signed.",
+	},
+	"saml2_requested_nameid_format": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      saml2IntegrationDefaultNameidFormat,
+		ValidateFunc: validation.StringInSlice(saml2IntegrationRequestedNameidFormats, false),
+		Description:  fmt.Sprintf("The SAML NameID format allows Snowflake to set an expectation of the identifying attribute of the user (i.e. SAML Subject) in the SAML assertion from the IdP to ensure a valid authentication to Snowflake. Valid values are (case-sensitive): %v.", saml2IntegrationRequestedNameidFormats),
+	},
+	"saml2_post_logout_redirect_url": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The endpoint to which Snowflake redirects users after clicking the Log Out button in the classic Snowflake web interface.",
+	},
+	"saml2_force_authn": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "The Boolean indicating whether users, during the initial authentication flow, are forced to authenticate again to access Snowflake.",
+	},
+	"saml2_snowflake_issuer_url": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The string containing the EntityID / Issuer for the Snowflake service provider. Defaults to the account URL when not specified.",
+	},
+	"saml2_snowflake_acs_url": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The string containing the Snowflake Assertion Consumer Service URL to which the IdP will send its SAML authentication response back to Snowflake. Defaults to the account URL when not specified.",
+	},
+	"saml2_snowflake_metadata": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Metadata created by Snowflake to provide to SAML2 provider.",
+	},
+	"saml2_digest_methods_used": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Digest methods used by the security integration.",
+	},
+	"saml2_signature_methods_used": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Signature methods used by the security integration.",
+	},
+})
+
+// saml2IntegrationProperties maps the resource attributes to the properties returned by DESCRIBE SECURITY INTEGRATION.
+var saml2IntegrationProperties = map[string]string{
+	"saml2_issuer":                        "SAML2_ISSUER",
+	"saml2_sso_url":                       "SAML2_SSO_URL",
+	"saml2_provider":                      "SAML2_PROVIDER",
+	"saml2_x509_cert":                     "SAML2_X509_CERT",
+	"allowed_user_domains":                "ALLOWED_USER_DOMAINS",
+	"allowed_email_patterns":              "ALLOWED_EMAIL_PATTERNS",
+	"saml2_sp_initiated_login_page_label": "SAML2_SP_INITIATED_LOGIN_PAGE_LABEL",
+	"saml2_enable_sp_initiated":           "SAML2_ENABLE_SP_INITIATED",
+	"saml2_snowflake_x509_cert":           "SAML2_SNOWFLAKE_X509_CERT",
+	"saml2_sign_request":                  "SAML2_SIGN_REQUEST",
+	"saml2_requested_nameid_format":       "SAML2_REQUESTED_NAMEID_FORMAT",
+	"saml2_post_logout_redirect_url":      "SAML2_POST_LOGOUT_REDIRECT_URL",
+	"saml2_force_authn":                   "SAML2_FORCE_AUTHN",
+	"saml2_snowflake_issuer_url":          "SAML2_SNOWFLAKE_ISSUER_URL",
+	"saml2_snowflake_acs_url":             "SAML2_SNOWFLAKE_ACS_URL",
+	"saml2_snowflake_metadata":            "SAML2_SNOWFLAKE_METADATA",
+	"saml2_digest_methods_used":           "SAML2_DIGEST_METHODS_USED",
+	"saml2_signature_methods_used":        "SAML2_SIGNATURE_METHODS_USED",
+}
+
+// Saml2Integration returns a pointer to the resource representing a SAML2 security integration.
+func Saml2Integration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage SAML2 security integration objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-saml2).",
+
+		CreateContext: CreateContextSaml2Integration,
+		ReadContext:   ReadContextSaml2Integration,
+		UpdateContext: UpdateContextSaml2Integration,
+		DeleteContext: DeleteContextSecurityIntegration,
+
+		Schema: saml2IntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Allowed user domains and email patterns can't be set to an empty list in place.
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("allowed_user_domains", securityIntegrationSetCleared),
+			customdiff.ForceNewIfChange("allowed_email_patterns", securityIntegrationSetCleared),
+		),
+	}
+}
+
+func CreateContextSaml2Integration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateSaml2SecurityIntegrationRequest(
+		id,
+		d.Get("enabled").(bool),
+		d.Get("saml2_issuer").(string),
+		d.Get("saml2_sso_url").(string),
+		d.Get("saml2_provider").(string),
+		d.Get("saml2_x509_cert").(string),
+	).
+		WithSaml2EnableSpInitiated(d.Get("saml2_enable_sp_initiated").(bool)).
+		WithSaml2SignRequest(d.Get("saml2_sign_request").(bool)).
+		WithSaml2RequestedNameidFormat(d.Get("saml2_requested_nameid_format").(string)).
+		WithSaml2ForceAuthn(d.Get("saml2_force_authn").(bool))
+
+	if v, ok := d.GetOk("allowed_user_domains"); ok {
+		request.WithAllowedUserDomains(expandSaml2UserDomains(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("allowed_email_patterns"); ok {
+		request.WithAllowedEmailPatterns(expandSaml2EmailPatterns(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("saml2_sp_initiated_login_page_label"); ok {
+		request.WithSaml2SpInitiatedLoginPageLabel(v.(string))
+	}
+	if v, ok := d.GetOk("saml2_snowflake_x509_cert"); ok {
+		request.WithSaml2SnowflakeX509Cert(v.(string))
+	}
+	if v, ok := d.GetOk("saml2_post_logout_redirect_url"); ok {
+		request.WithSaml2PostLogoutRedirectUrl(v.(string))
+	}
+	if v, ok := d.GetOk("saml2_snowflake_issuer_url"); ok {
+		request.WithSaml2SnowflakeIssuerUrl(v.(string))
+	}
+	if v, ok := d.GetOk("saml2_snowflake_acs_url"); ok {
+		request.WithSaml2SnowflakeAcsUrl(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.SecurityIntegrations.CreateSaml2(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSaml2Integration(ctx, d, meta)
+}
+
+func ReadContextSaml2Integration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	values, diags := readSecurityIntegrationCommon(ctx, d, meta)
+	if values == nil {
+		return diags
+	}
+
+	if err := setSecurityIntegrationProperties(d, saml2IntegrationSchema, values, saml2IntegrationProperties); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextSaml2Integration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewSaml2IntegrationSetRequest(), sdk.NewSaml2IntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("saml2_issuer") {
+		runSet = true
+		set.WithSaml2Issuer(d.Get("saml2_issuer").(string))
+	}
+	if d.HasChange("saml2_sso_url") {
+		runSet = true
+		set.WithSaml2SsoUrl(d.Get("saml2_sso_url").(string))
+	}
+	if d.HasChange("saml2_provider") {
+		runSet = true
+		set.WithSaml2Provider(d.Get("saml2_provider").(string))
+	}
+	if d.HasChange("saml2_x509_cert") {
+		runSet = true
+		set.WithSaml2X509Cert(d.Get("saml2_x509_cert").(string))
+	}
+	if d.HasChange("allowed_user_domains") {
+		runSet = true
+		set.WithAllowedUserDomains(expandSaml2UserDomains(d.Get("allowed_user_domains").(*schema.Set).List()))
+	}
+	if d.HasChange("allowed_email_patterns") {
+		runSet = true
+		set.WithAllowedEmailPatterns(expandSaml2EmailPatterns(d.Get("allowed_email_patterns").(*schema.Set).List()))
+	}
+	if d.HasChange("saml2_sp_initiated_login_page_label") {
+		runSet = true
+		set.WithSaml2SpInitiatedLoginPageLabel(d.Get("saml2_sp_initiated_login_page_label").(string))
+	}
+	if d.HasChange("saml2_enable_sp_initiated") {
+		runSet = true
+		set.WithSaml2EnableSpInitiated(d.Get("saml2_enable_sp_initiated").(bool))
+	}
+	if d.HasChange("saml2_snowflake_x509_cert") {
+		if v := d.Get("saml2_snowflake_x509_cert").(string); v != "" {
+			runSet = true
+			set.WithSaml2SnowflakeX509Cert(v)
+		}
+	}
+	if d.HasChange("saml2_sign_request") {
+		runSet = true
+		set.WithSaml2SignRequest(d.Get("saml2_sign_request").(bool))
+	}
+	if d.HasChange("saml2_requested_nameid_format") {
+		runSet = true
+		set.WithSaml2RequestedNameidFormat(d.Get("saml2_requested_nameid_format").(string))
+	}
+	if d.HasChange("saml2_post_logout_redirect_url") {
+		if v := d.Get("saml2_post_logout_redirect_url").(string); v != "" {
+			runSet = true
+			set.WithSaml2PostLogoutRedirectUrl(v)
+		} else {
+			runUnset = true
+			unset.WithSaml2PostLogoutRedirectUrl(true)
+		}
+	}
+	if d.HasChange("saml2_force_authn") {
+		runSet = true
+		set.WithSaml2ForceAuthn(d.Get("saml2_force_authn").(bool))
+	}
+	if d.HasChange("saml2_snowflake_issuer_url") {
+		if v := d.Get("saml2_snowflake_issuer_url").(string); v != "" {
+			runSet = true
+			set.WithSaml2SnowflakeIssuerUrl(v)
+		}
+	}
+	if d.HasChange("saml2_snowflake_acs_url") {
+		if v := d.Get("saml2_snowflake_acs_url").(string); v != "" {
+			runSet = true
+			set.WithSaml2SnowflakeAcsUrl(v)
+		}
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			runSet = true
+			set.WithComment(v)
+		} else {
+			runUnset = true
+			unset.WithComment(true)
+		}
+	}
+
+	if runSet {
+		if err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.SecurityIntegrations.AlterSaml2(ctx, sdk.NewAlterSaml2SecurityIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextSaml2Integration(ctx, d, meta)
+}
+
+func expandSaml2UserDomains(configured []any) []sdk.UserDomain {
+	domains := expandStringList(configured)
+	result := make([]sdk.UserDomain, len(domains))
+	for i, domain := range domains {
+		result[i] = sdk.UserDomain{Domain: domain}
+	}
+	return result
+}
+
+func expandSaml2EmailPatterns(configured []any) []sdk.EmailPattern {
+	patterns := expandStringList(configured)
+	result := make([]sdk.EmailPattern, len(patterns))
+	for i, pattern := range patterns {
+		result[i] = sdk.EmailPattern{Pattern: pattern}
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Saml2Integration_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	issuer := acc.TestClient().Ids.Alpha()
+	cert := random.GenerateX509(t)
+	issuerURL := acc.TestClient().Context.IssuerURL(t)
+	acsURL := acc.TestClient().Context.ACSURL(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Saml2SecurityIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: saml2IntegrationBasicConfig(id.Name(), issuer, cert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_issuer", issuer),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_sso_url", "https://example.com"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_provider", "CUSTOM"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_requested_nameid_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_force_authn", "false"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "allowed_user_domains.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "integration_type", "SAML2"),
+					resource.TestCheckResourceAttrSet("snowflake_saml2_integration.test", "saml2_snowflake_issuer_url"),
+					resource.TestCheckResourceAttrSet("snowflake_saml2_integration.test", "saml2_snowflake_acs_url"),
+					resource.TestCheckResourceAttrSet("snowflake_saml2_integration.test", "saml2_snowflake_metadata"),
+					resource.TestCheckResourceAttrSet("snowflake_saml2_integration.test", "created_on"),
+				),
+			},
+			// set all optional fields
+			{
+				Config: saml2IntegrationCompleteConfig(id.Name(), issuer, cert, issuerURL, acsURL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_saml2_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "allowed_user_domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_saml2_integration.test", "allowed_user_domains.*", "example.com"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "allowed_email_patterns.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_saml2_integration.test", "allowed_email_patterns.*", "^(.+dev)@example.com$"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_sp_initiated_login_page_label", "label"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_enable_sp_initiated", "true"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_sign_request", "true"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_requested_nameid_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_post_logout_redirect_url", "http://example.com/logout"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_force_authn", "true"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_snowflake_issuer_url", issuerURL),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_snowflake_acs_url", acsURL),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_saml2_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields; clearing the lists recreates the integration
+			{
+				Config: saml2IntegrationBasicConfig(id.Name(), issuer, cert),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_saml2_integration.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "allowed_user_domains.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "saml2_post_logout_redirect_url", ""),
+					resource.TestCheckResourceAttr("snowflake_saml2_integration.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func saml2IntegrationBasicConfig(name string, issuer string, cert string) string {
+	return fmt.Sprintf(`
+resource "snowflake_saml2_integration" "test" {
+	name            = "%[1]s"
+	enabled         = false
+	saml2_issuer    = "%[2]s"
+	saml2_sso_url   = "https://example.com"
+	saml2_provider  = "CUSTOM"
+	saml2_x509_cert = <<EOT
+%[3]sEOT
+}
+`, name, issuer, cert)
+}
+
+func saml2IntegrationCompleteConfig(name string, issuer string, cert string, issuerURL string, acsURL string) string {
+	return fmt.Sprintf(`
+resource "snowflake_saml2_integration" "test" {
+	name            = "%[1]s"
+	enabled         = true
+	saml2_issuer    = "%[2]s"
+	saml2_sso_url   = "https://example.com"
+	saml2_provider  = "CUSTOM"
+	saml2_x509_cert = <<EOT
+%[3]sEOT
+
+	allowed_user_domains                = ["example.com"]
+	allowed_email_patterns              = ["^(.+dev)@example.com$"]
+	saml2_sp_initiated_login_page_label = "label"
+	saml2_enable_sp_initiated           = true
+	saml2_sign_request                  = true
+	saml2_requested_nameid_format       = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	saml2_post_logout_redirect_url      = "http://example.com/logout"
+	saml2_force_authn                   = true
+	saml2_snowflake_issuer_url          = "%[4]s"
+	saml2_snowflake_acs_url             = "%[5]s"
+	comment                             = "foo"
+}
+`, name, issuer, cert, issuerURL, acsURL)
+}
@@ -54,7 +54,10 @@ var schemaSchema = map[string]*schema.Schema{
 		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the schema, as well as specifying the default Time Travel retention time for all tables created in the schema. Default value for this field is set to -1, which is a fallback to use Snowflake default.",
 		ValidateFunc: validation.IntBetween(-1, 90),
 	},
-	"tag": tagReferenceSchema,
+	"log_level":    logLevelSchema("schema"),
+	"trace_level":  traceLevelSchema("schema"),
+	"metric_level": metricLevelSchema("schema"),
+	"tag":          tagReferenceSchema,
 }
 
 // Schema returns a pointer to the resource representing a schema.
@@ -91,13 +94,18 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 		createReq.DataRetentionTimeInDays = dataRetentionTimeInDays
 	}
 
-	err := client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier(database, name), createReq)
+	id := sdk.NewDatabaseObjectIdentifier(database, name)
+	err := client.Schemas.Create(ctx, id, createReq)
 	if err != nil {
 		return fmt.Errorf("error creating schema %v err = %w", name, err)
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(database, name))
 
+	if err := updateSchemaLoggingLevels(ctx, client, id, d); err != nil {
+		return fmt.Errorf("error setting logging levels on schema %v err = %w", name, err)
+	}
+
 	return ReadSchema(d, meta)
 }
 
@@ -171,6 +179,10 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := readLoggingLevels(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeSchema, Name: id}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -256,6 +268,10 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := updateSchemaLoggingLevels(ctx, client, id, d); err != nil {
+		return fmt.Errorf("error updating logging levels on %v err = %w", d.Id(), err)
+	}
+
 	if d.HasChange("tag") {
 		unsetTags, setTags := GetTagsDiff(d, "tag")
 
@@ -296,3 +312,40 @@ func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// updateSchemaLoggingLevels sets or unsets the changed logging levels; it is used both on create and on update.
+func updateSchemaLoggingLevels(ctx context.Context, client *sdk.Client, id sdk.DatabaseObjectIdentifier, d *schema.ResourceData) error {
+	set, unset := &sdk.SchemaSet{}, &sdk.SchemaUnset{}
+	if d.HasChange("log_level") {
+		if v := d.Get("log_level").(string); v != "" {
+			set.LogLevel = sdk.Pointer(sdk.LogLevel(v))
+		} else {
+			unset.LogLevel = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("trace_level") {
+		if v := d.Get("trace_level").(string); v != "" {
+			set.TraceLevel = sdk.Pointer(sdk.TraceLevel(v))
+		} else {
+			unset.TraceLevel = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("metric_level") {
+		if v := d.Get("metric_level").(string); v != "" {
+			set.MetricLevel = sdk.Pointer(sdk.MetricLevel(v))
+		} else {
+			unset.MetricLevel = sdk.Bool(true)
+		}
+	}
+	if set.LogLevel != nil || set.TraceLevel != nil || set.MetricLevel != nil {
+		if err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+	if unset.LogLevel != nil || unset.TraceLevel != nil || unset.MetricLevel != nil {
+		if err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{Unset: unset}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -422,3 +423,90 @@ func checkDatabaseAndSchemaDataRetentionTime(t *testing.T, schemaId sdk.Database
 		return nil
 	}
 }
+
+func TestAcc_Schema_loggingLevels(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	resourceName := "snowflake_schema.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			// set
+			{
+				Config: schemaConfigWithLoggingLevels(name, "INFO", "ON_EVENT", "ALL"),
+				Check: acc.AssertThat(t,
+					resourceassert.SchemaResource(t, resourceName).
+						HasLogLevel("INFO").
+						HasTraceLevel("ON_EVENT").
+						HasMetricLevel("ALL"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_retention_days"},
+			},
+			// change
+			{
+				Config: schemaConfigWithLoggingLevels(name, "ERROR", "ALWAYS", "NONE"),
+				Check: acc.AssertThat(t,
+					resourceassert.SchemaResource(t, resourceName).
+						HasLogLevel("ERROR").
+						HasTraceLevel("ALWAYS").
+						HasMetricLevel("NONE"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// unset
+			{
+				Config: schemaConfigWithoutLoggingLevels(name),
+				Check: acc.AssertThat(t,
+					resourceassert.SchemaResource(t, resourceName).
+						HasLogLevel("").
+						HasTraceLevel("").
+						HasMetricLevel(""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func schemaConfigWithLoggingLevels(name string, logLevel string, traceLevel string, metricLevel string) string {
+	return fmt.Sprintf(`
+resource "snowflake_schema" "test" {
+  database     = "%[1]s"
+  name         = "%[2]s"
+  log_level    = "%[3]s"
+  trace_level  = "%[4]s"
+  metric_level = "%[5]s"
+}
+`, acc.TestDatabaseName, name, logLevel, traceLevel, metricLevel)
+}
+
+func schemaConfigWithoutLoggingLevels(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_schema" "test" {
+  database = "%[1]s"
+  name     = "%[2]s"
+}
+`, acc.TestDatabaseName, name)
+}
@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	scimSecurityIntegrationScimClients = []string{
+		string(sdk.ScimSecurityIntegrationScimClientOkta),
+		string(sdk.ScimSecurityIntegrationScimClientAzure),
+		string(sdk.ScimSecurityIntegrationScimClientGeneric),
+	}
+	scimSecurityIntegrationRunAsRoles = []string{
+		string(sdk.ScimSecurityIntegrationRunAsRoleOktaProvisioner),
+		string(sdk.ScimSecurityIntegrationRunAsRoleAadProvisioner),
+		string(sdk.ScimSecurityIntegrationRunAsRoleGenericScimProvisioner),
+	}
+)
+
+var scimSecurityIntegrationSchema = securityIntegrationSchema(map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether the security integration is enabled.",
+	},
+	"scim_client": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(scimSecurityIntegrationScimClients, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("Specifies the client type for the SCIM integration. Valid values are (case-insensitive): %v.", scimSecurityIntegrationScimClients),
+	},
+	"run_as_role": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice(scimSecurityIntegrationRunAsRoles, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM. Valid values are (case-insensitive): %v.", scimSecurityIntegrationRunAsRoles),
+	},
+	"network_policy": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies an existing network policy that controls SCIM network traffic.",
+	},
+	"sync_password": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to enable or disable the synchronization of a user password from an Okta SCIM client as part of the API request to Snowflake.",
+	},
+})
+
+// scimSecurityIntegrationProperties maps the resource attributes to the properties returned by DESCRIBE SECURITY INTEGRATION.
+var scimSecurityIntegrationProperties = map[string]string{
+	"run_as_role":    "RUN_AS_ROLE",
+	"network_policy": "NETWORK_POLICY",
+	"sync_password":  "SYNC_PASSWORD",
+}
+
+// ScimSecurityIntegration returns a pointer to the resource representing a SCIM security integration.
+func ScimSecurityIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage SCIM security integration objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-scim).",
+
+		CreateContext: CreateContextScimSecurityIntegration,
+		ReadContext:   ReadContextScimSecurityIntegration,
+		UpdateContext: UpdateContextScimSecurityIntegration,
+		DeleteContext: DeleteContextSecurityIntegration,
+
+		Schema: scimSecurityIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextScimSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateScimSecurityIntegrationRequest(
+		id,
+		d.Get("enabled").(bool),
+		sdk.ScimSecurityIntegrationScimClientOption(strings.ToUpper(d.Get("scim_client").(string))),
+		sdk.ScimSecurityIntegrationRunAsRoleOption(strings.ToUpper(d.Get("run_as_role").(string))),
+	).WithSyncPassword(d.Get("sync_password").(bool))
+
+	if v, ok := d.GetOk("network_policy"); ok {
+		request.WithNetworkPolicy(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.SecurityIntegrations.CreateScim(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextScimSecurityIntegration(ctx, d, meta)
+}
+
+func ReadContextScimSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	values, diags := readSecurityIntegrationCommon(ctx, d, meta)
+	if values == nil {
+		return diags
+	}
+
+	if err := d.Set("scim_client", strings.TrimPrefix(d.Get("integration_type").(string), "SCIM - ")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setSecurityIntegrationProperties(d, scimSecurityIntegrationSchema, values, scimSecurityIntegrationProperties); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextScimSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set, unset := sdk.NewScimIntegrationSetRequest(), sdk.NewScimIntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("enabled") {
+		runSet = true
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("network_policy") {
+		if v := d.Get("network_policy").(string); v != "" {
+			runSet = true
+			set.WithNetworkPolicy(sdk.NewAccountObjectIdentifier(v))
+		} else {
+			runUnset = true
+			unset.WithNetworkPolicy(true)
+		}
+	}
+	if d.HasChange("sync_password") {
+		runSet = true
+		set.WithSyncPassword(d.Get("sync_password").(bool))
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); v != "" {
+			runSet = true
+			set.WithComment(v)
+		} else {
+			runUnset = true
+			unset.WithComment(true)
+		}
+	}
+
+	if runSet {
+		if err := client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.SecurityIntegrations.AlterScim(ctx, sdk.NewAlterScimSecurityIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextScimSecurityIntegration(ctx, d, meta)
+}
@@ -0,0 +1,102 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ScimSecurityIntegration_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	networkPolicy, networkPolicyCleanup := acc.TestClient().NetworkPolicy.CreateNetworkPolicy(t)
+	t.Cleanup(networkPolicyCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ScimSecurityIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: scimSecurityIntegrationBasicConfig(id.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "scim_client", "GENERIC"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "run_as_role", "GENERIC_SCIM_PROVISIONER"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "network_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "sync_password", "true"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "integration_type", "SCIM - GENERIC"),
+					resource.TestCheckResourceAttrSet("snowflake_scim_security_integration.test", "created_on"),
+				),
+			},
+			{
+				Config: scimSecurityIntegrationCompleteConfig(id.Name(), networkPolicy.Name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_scim_security_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "network_policy", networkPolicy.Name),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "sync_password", "false"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_scim_security_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields
+			{
+				Config: scimSecurityIntegrationBasicConfig(id.Name()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_scim_security_integration.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "network_policy", ""),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "sync_password", "true"),
+					resource.TestCheckResourceAttr("snowflake_scim_security_integration.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func scimSecurityIntegrationBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_scim_security_integration" "test" {
+	name        = "%[1]s"
+	enabled     = false
+	scim_client = "GENERIC"
+	run_as_role = "GENERIC_SCIM_PROVISIONER"
+}
+`, name)
+}
+
+func scimSecurityIntegrationCompleteConfig(name string, networkPolicy string) string {
+	return fmt.Sprintf(`
+resource "snowflake_scim_security_integration" "test" {
+	name           = "%[1]s"
+	enabled        = true
+	scim_client    = "GENERIC"
+	run_as_role    = "GENERIC_SCIM_PROVISIONER"
+	network_policy = "%[2]s"
+	sync_password  = false
+	comment        = "foo"
+}
+`, name, networkPolicy)
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secondaryConnectionSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the connection; must be unique for the account. It has to match the name of the primary connection.",
+	},
+	"as_replica_of": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the fully qualified name of the primary connection from which the secondary connection is replicated. Expected in the form `<org_name>.<account_name>.<connection_name>`.",
+	},
+	"promote_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value; changing it triggers `ALTER CONNECTION ... PRIMARY` which promotes the secondary connection to serve as the primary connection (the previous primary connection becomes secondary). Setting it on creation doesn't promote the connection. Failing back by promoting the connection in another account doesn't cause the next apply to promote this connection again.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the connection.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether the connection is currently the primary connection.",
+	},
+	"primary": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fully qualified name of the current primary connection (`<org_name>.<account_name>.<connection_name>`).",
+	},
+	"connection_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Connection URL that clients use to connect to Snowflake through the connection.",
+	},
+}
+
+// SecondaryConnection returns a pointer to the resource representing a secondary connection.
+func SecondaryConnection() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage secondary connections (replicas of a primary connection located in another account) used for client redirect. For more information, check [client redirect documentation](https://docs.snowflake.com/en/user-guide/client-redirect).",
+
+		CreateContext: CreateContextSecondaryConnection,
+		ReadContext:   ReadContextSecondaryConnection,
+		UpdateContext: UpdateContextSecondaryConnection,
+		DeleteContext: DeleteContextConnection,
+
+		Schema: secondaryConnectionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSecondaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateConnectionRequest(id).WithAsReplicaOf(sdk.NewExternalObjectIdentifierFromFullyQualifiedName(d.Get("as_replica_of").(string)))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+	if err := client.Connections.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSecondaryConnection(ctx, d, meta)
+}
+
+func ReadContextSecondaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	connection, err := client.Connections.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve secondary connection. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// as_replica_of is read only on import, because the primary changes after promoting any of the connections
+	if _, ok := d.GetOk("as_replica_of"); !ok {
+		if err := d.Set("as_replica_of", connectionPrimaryName(connection)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var comment string
+	if connection.Comment != nil {
+		comment = *connection.Comment
+	}
+
+	toSet := map[string]any{
+		"name":           connection.Name,
+		"is_primary":     connection.IsPrimary,
+		"comment":        comment,
+		"primary":        connectionPrimaryName(connection),
+		"connection_url": connection.ConnectionUrl,
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextSecondaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if d.HasChange("promote_trigger") {
+		if err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithPrimary(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error promoting connection %v to primary err = %w", d.Id(), err))
+		}
+	}
+
+	if diags := updateConnectionComment(ctx, d, client, id); diags != nil {
+		return diags
+	}
+
+	return ReadContextSecondaryConnection(ctx, d, meta)
+}
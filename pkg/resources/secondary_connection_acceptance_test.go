@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecondaryConnection_basic(t *testing.T) {
+	accountId := acc.TestClient().Account.GetAccountIdentifier(t)
+	secondaryAccountId := acc.SecondaryTestClient().Account.GetAccountIdentifier(t)
+
+	// the primary connection is located in the secondary account and the replica is managed by the resource
+	primaryConnection, primaryConnectionCleanup := acc.SecondaryTestClient().Connection.Create(t)
+	t.Cleanup(primaryConnectionCleanup)
+	id := primaryConnection.ID()
+	acc.SecondaryTestClient().Connection.Alter(t, sdk.NewAlterConnectionRequest(id).WithEnableConnectionFailover(
+		*sdk.NewEnableConnectionFailoverRequest([]sdk.AccountIdentifier{accountId}),
+	))
+
+	asReplicaOf := fmt.Sprintf("%s.%s", secondaryAccountId.Name(), id.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecondaryConnection),
+		Steps: []resource.TestStep{
+			{
+				Config: secondaryConnectionConfig(id, asReplicaOf, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "as_replica_of", asReplicaOf),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "is_primary", "false"),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "primary", asReplicaOf),
+					resource.TestCheckResourceAttrSet("snowflake_secondary_connection.test", "connection_url"),
+				),
+			},
+			// promote the connection and set the comment in place
+			{
+				Config: secondaryConnectionConfig(id, asReplicaOf, "1", "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secondary_connection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "is_primary", "true"),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "comment", "some comment"),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "primary", fmt.Sprintf("%s.%s", accountId.Name(), id.Name())),
+				),
+			},
+			// failing back to the connection in the secondary account doesn't promote the connection again
+			{
+				PreConfig: func() {
+					acc.SecondaryTestClient().Connection.Alter(t, sdk.NewAlterConnectionRequest(id).WithPrimary(true))
+				},
+				Config: secondaryConnectionConfig(id, asReplicaOf, "1", "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secondary_connection.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "is_primary", "false"),
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "primary", asReplicaOf),
+				),
+			},
+			// promote the connection again
+			{
+				Config: secondaryConnectionConfig(id, asReplicaOf, "2", "some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "is_primary", "true"),
+				),
+			},
+			// the primary connection can be dropped only after dropping its secondary connections
+			{
+				PreConfig: func() { acc.SecondaryTestClient().Connection.DropFunc(t, id)() },
+				Config:    secondaryConnectionConfig(id, asReplicaOf, "2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_connection.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secondary_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"as_replica_of", "promote_trigger"},
+			},
+		},
+	})
+}
+
+func secondaryConnectionConfig(id sdk.AccountObjectIdentifier, asReplicaOf string, promoteTrigger string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secondary_connection" "test" {
+	name            = "%[1]s"
+	as_replica_of   = "%[2]s"
+	promote_trigger = "%[3]s"
+	comment         = "%[4]s"
+}
+`, id.Name(), asReplicaOf, promoteTrigger, comment)
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretCommonSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the database and schema in which the secret is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the secret.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the secret.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"secret_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the secret (PASSWORD, OAUTH2 or GENERIC_STRING).",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the secret.",
+	},
+}
+
+// secretSchema returns the schema of a secret resource built from the attributes common to all secret types and the given type-specific ones.
+func secretSchema(specific map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(secretCommonSchema)+len(specific))
+	for k, v := range secretCommonSchema {
+		result[k] = v
+	}
+	for k, v := range specific {
+		result[k] = v
+	}
+	return result
+}
+
+func secretIdFromData(d *schema.ResourceData) sdk.SchemaObjectIdentifier {
+	return sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+}
+
+// readSecretCommon sets the attributes shared by all secret resources and returns secret details for the type-specific ones.
+// When the secret no longer exists, the resource is removed from the state and nil details are returned together with a warning.
+// When the secret is of a different type than expectedType (e.g. after importing a secret with the wrong resource), an error is returned.
+func readSecretCommon(ctx context.Context, d *schema.ResourceData, meta any, expectedType sdk.SecretType) (*sdk.SecretDetails, diag.Diagnostics) {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	secret, err := client.Secrets.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve secret. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return nil, diag.FromErr(err)
+	}
+
+	if secret.SecretType != expectedType {
+		return nil, diag.FromErr(fmt.Errorf("secret %s is of type %s, but this resource manages secrets of type %s", id.FullyQualifiedName(), secret.SecretType, expectedType))
+	}
+
+	details, err := client.Secrets.Describe(ctx, id)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("database", id.DatabaseName()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("schema", id.SchemaName()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	var comment string
+	if secret.Comment != nil {
+		comment = *secret.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("secret_type", string(secret.SecretType)); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return details, nil
+}
+
+// updateSecretComment handles the comment change common to all secret resources.
+func updateSecretComment(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
+	if !d.HasChange("comment") {
+		return nil
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		return client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithSet(*sdk.NewSecretSetRequest().WithComment(v.(string))))
+	}
+	return client.Secrets.Alter(ctx, sdk.NewAlterSecretRequest(id).WithUnset(*sdk.NewSecretUnsetRequest().WithComment(true)))
+}
+
+func DeleteContextSecret(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
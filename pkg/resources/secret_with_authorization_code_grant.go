@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithAuthorizationCodeGrantSchema = secretSchema(map[string]*schema.Schema{
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the API_AUTHENTICATION security integration that uses the OAuth authorization code grant flow.",
+	},
+	"oauth_refresh_token": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires.",
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the timestamp as a string when the OAuth refresh token expires. Accepted string formats: YYYY-MM-DD, YYYY-MM-DD HH:MI, YYYY-MM-DD HH:MI:SS.",
+	},
+})
+
+// SecretWithAuthorizationCodeGrant returns a pointer to the resource representing a secret of type OAUTH2 using the authorization code grant flow.
+func SecretWithAuthorizationCodeGrant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage secret objects with OAuth authorization code grant flow. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
+
+		CreateContext: CreateContextSecretWithAuthorizationCodeGrant,
+		ReadContext:   ReadContextSecretWithAuthorizationCodeGrant,
+		UpdateContext: UpdateContextSecretWithAuthorizationCodeGrant,
+		DeleteContext: DeleteContextSecret,
+
+		Schema: secretWithAuthorizationCodeGrantSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := secretIdFromData(d)
+
+	apiIntegration := sdk.NewAccountObjectIdentifier(d.Get("api_authentication").(string))
+	refreshToken := d.Get("oauth_refresh_token").(string)
+	refreshTokenExpiryTime := d.Get("oauth_refresh_token_expiry_time").(string)
+	request := sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(id, refreshToken, refreshTokenExpiryTime, apiIntegration)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.Secrets.CreateWithOAuthAuthorizationCodeFlow(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSecretWithAuthorizationCodeGrant(ctx, d, meta)
+}
+
+func ReadContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	details, diags := readSecretCommon(ctx, d, meta, sdk.SecretTypeOAuth2)
+	if details == nil {
+		return diags
+	}
+
+	// oauth_refresh_token is never returned by Snowflake and oauth_refresh_token_expiry_time is returned in a different
+	// format than the accepted input formats, so both are kept as configured.
+	if details.IntegrationName != nil {
+		if err := d.Set("api_authentication", *details.IntegrationName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextSecretWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChanges("oauth_refresh_token", "oauth_refresh_token_expiry_time") {
+		set := sdk.NewSetForOAuthAuthorizationFlowRequest()
+		if d.HasChange("oauth_refresh_token") {
+			set.WithOauthRefreshToken(d.Get("oauth_refresh_token").(string))
+		}
+		if d.HasChange("oauth_refresh_token_expiry_time") {
+			set.WithOauthRefreshTokenExpiryTime(d.Get("oauth_refresh_token_expiry_time").(string))
+		}
+		request := sdk.NewAlterSecretRequest(id).WithSet(*sdk.NewSecretSetRequest().WithSetForOAuthAuthorizationFlow(*set))
+		if err := client.Secrets.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateSecretComment(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadContextSecretWithAuthorizationCodeGrant(ctx, d, meta)
+}
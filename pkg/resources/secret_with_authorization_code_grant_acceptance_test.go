@@ -0,0 +1,75 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithAuthorizationCodeGrant_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	integrationId, integrationCleanup := acc.TestClient().SecurityIntegration.CreateApiAuthenticationWithAuthorizationCodeGrantFlow(t)
+	t.Cleanup(integrationCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecretWithAuthorizationCodeGrant),
+		Steps: []resource.TestStep{
+			{
+				Config: secretWithAuthorizationCodeGrantConfig(id.Name(), integrationId.Name(), "foo", "2030-01-02 10:00:00"),
+				Check: acc.AssertThat(t,
+					resourceassert.SecretWithAuthorizationCodeGrantResource(t, "snowflake_secret_with_authorization_code_grant.test").
+						HasName(id.Name()).
+						HasDatabase(acc.TestDatabaseName).
+						HasSchema(acc.TestSchemaName).
+						HasApiAuthentication(integrationId.Name()).
+						HasOauthRefreshToken("foo").
+						HasOauthRefreshTokenExpiryTime("2030-01-02 10:00:00").
+						HasSecretType("OAUTH2"),
+				),
+			},
+			// change refresh token and its expiry time
+			{
+				Config: secretWithAuthorizationCodeGrantConfig(id.Name(), integrationId.Name(), "bar", "2031-01-02 10:00:00"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secret_with_authorization_code_grant.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret_with_authorization_code_grant.test", "oauth_refresh_token", "bar"),
+					resource.TestCheckResourceAttr("snowflake_secret_with_authorization_code_grant.test", "oauth_refresh_token_expiry_time", "2031-01-02 10:00:00"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secret_with_authorization_code_grant.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_refresh_token", "oauth_refresh_token_expiry_time"},
+			},
+		},
+	})
+}
+
+func secretWithAuthorizationCodeGrantConfig(name string, integrationName string, refreshToken string, expiryTime string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret_with_authorization_code_grant" "test" {
+	name                            = "%[1]s"
+	database                        = "%[2]s"
+	schema                          = "%[3]s"
+	api_authentication              = "%[4]s"
+	oauth_refresh_token             = "%[5]s"
+	oauth_refresh_token_expiry_time = "%[6]s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, integrationName, refreshToken, expiryTime)
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithBasicAuthenticationSchema = secretSchema(map[string]*schema.Schema{
+	"username": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the username value to store in the secret.",
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the password value to store in the secret.",
+	},
+})
+
+// SecretWithBasicAuthentication returns a pointer to the resource representing a secret of type PASSWORD.
+func SecretWithBasicAuthentication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage secret objects with basic authentication. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
+
+		CreateContext: CreateContextSecretWithBasicAuthentication,
+		ReadContext:   ReadContextSecretWithBasicAuthentication,
+		UpdateContext: UpdateContextSecretWithBasicAuthentication,
+		DeleteContext: DeleteContextSecret,
+
+		Schema: secretWithBasicAuthenticationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSecretWithBasicAuthentication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := secretIdFromData(d)
+
+	request := sdk.NewCreateWithBasicAuthenticationSecretRequest(id, d.Get("username").(string), d.Get("password").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.Secrets.CreateWithBasicAuthentication(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSecretWithBasicAuthentication(ctx, d, meta)
+}
+
+func ReadContextSecretWithBasicAuthentication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	details, diags := readSecretCommon(ctx, d, meta, sdk.SecretTypePassword)
+	if details == nil {
+		return diags
+	}
+
+	// password is never returned by Snowflake, so it is kept as configured.
+	if details.Username != nil {
+		if err := d.Set("username", *details.Username); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextSecretWithBasicAuthentication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChanges("username", "password") {
+		set := sdk.NewSetForBasicAuthenticationRequest()
+		if d.HasChange("username") {
+			set.WithUsername(d.Get("username").(string))
+		}
+		if d.HasChange("password") {
+			set.WithPassword(d.Get("password").(string))
+		}
+		request := sdk.NewAlterSecretRequest(id).WithSet(*sdk.NewSecretSetRequest().WithSetForBasicAuthentication(*set))
+		if err := client.Secrets.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateSecretComment(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadContextSecretWithBasicAuthentication(ctx, d, meta)
+}
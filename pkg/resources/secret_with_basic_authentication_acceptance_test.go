@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithBasicAuthentication_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecretWithBasicAuthentication),
+		Steps: []resource.TestStep{
+			{
+				Config: secretWithBasicAuthenticationConfig(id.Name(), "foo", "bar", "some comment"),
+				Check: acc.AssertThat(t,
+					resourceassert.SecretWithBasicAuthenticationResource(t, "snowflake_secret_with_basic_authentication.test").
+						HasName(id.Name()).
+						HasDatabase(acc.TestDatabaseName).
+						HasSchema(acc.TestSchemaName).
+						HasUsername("foo").
+						HasPassword("bar").
+						HasComment("some comment").
+						HasSecretType("PASSWORD"),
+				),
+			},
+			// change username and password
+			{
+				Config: secretWithBasicAuthenticationConfig(id.Name(), "new_foo", "new_bar", "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secret_with_basic_authentication.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret_with_basic_authentication.test", "username", "new_foo"),
+					resource.TestCheckResourceAttr("snowflake_secret_with_basic_authentication.test", "password", "new_bar"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secret_with_basic_authentication.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func secretWithBasicAuthenticationConfig(name string, username string, password string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret_with_basic_authentication" "test" {
+	name     = "%[1]s"
+	database = "%[2]s"
+	schema   = "%[3]s"
+	username = "%[4]s"
+	password = "%[5]s"
+	comment  = "%[6]s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, username, password, comment)
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithClientCredentialsSchema = secretSchema(map[string]*schema.Schema{
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the API_AUTHENTICATION security integration that uses the OAuth client credentials flow.",
+	},
+	"oauth_scopes": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies a list of scopes to use when making a request from the OAuth server by a role with USAGE on the integration during the OAuth client credentials flow.",
+	},
+})
+
+// SecretWithClientCredentials returns a pointer to the resource representing a secret of type OAUTH2 using the client credentials flow.
+func SecretWithClientCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage secret objects with OAuth client credentials flow. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
+
+		CreateContext: CreateContextSecretWithClientCredentials,
+		ReadContext:   ReadContextSecretWithClientCredentials,
+		UpdateContext: UpdateContextSecretWithClientCredentials,
+		DeleteContext: DeleteContextSecret,
+
+		Schema: secretWithClientCredentialsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSecretWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := secretIdFromData(d)
+
+	apiIntegration := sdk.NewAccountObjectIdentifier(d.Get("api_authentication").(string))
+	request := sdk.NewCreateWithOAuthClientCredentialsFlowSecretRequest(id, apiIntegration, expandSecretScopes(d.Get("oauth_scopes").(*schema.Set).List()))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.Secrets.CreateWithOAuthClientCredentialsFlow(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSecretWithClientCredentials(ctx, d, meta)
+}
+
+func ReadContextSecretWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	details, diags := readSecretCommon(ctx, d, meta, sdk.SecretTypeOAuth2)
+	if details == nil {
+		return diags
+	}
+
+	if details.IntegrationName != nil {
+		if err := d.Set("api_authentication", *details.IntegrationName); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("oauth_scopes", details.OauthScopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextSecretWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("oauth_scopes") {
+		scopes := expandSecretScopes(d.Get("oauth_scopes").(*schema.Set).List())
+		request := sdk.NewAlterSecretRequest(id).WithSet(*sdk.NewSecretSetRequest().
+			WithSetForOAuthClientCredentialsFlow(*sdk.NewSetForOAuthClientCredentialsFlowRequest(scopes)),
+		)
+		if err := client.Secrets.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateSecretComment(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadContextSecretWithClientCredentials(ctx, d, meta)
+}
+
+func expandSecretScopes(configured []any) []sdk.SecretScope {
+	scopes := expandStringList(configured)
+	result := make([]sdk.SecretScope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = sdk.SecretScope{Scope: scope}
+	}
+	return result
+}
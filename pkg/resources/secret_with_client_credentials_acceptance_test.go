@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithClientCredentials_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	integrationId, integrationCleanup := acc.TestClient().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(integrationCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecretWithClientCredentials),
+		Steps: []resource.TestStep{
+			{
+				Config: secretWithClientCredentialsConfig(id.Name(), integrationId.Name(), `["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					acc.AssertThat(t,
+						resourceassert.SecretWithClientCredentialsResource(t, "snowflake_secret_with_client_credentials.test").
+							HasName(id.Name()).
+							HasDatabase(acc.TestDatabaseName).
+							HasSchema(acc.TestSchemaName).
+							HasApiAuthentication(integrationId.Name()).
+							HasOauthScopesCount(2).
+							HasSecretType("OAUTH2"),
+					),
+					resource.TestCheckTypeSetElemAttr("snowflake_secret_with_client_credentials.test", "oauth_scopes.*", "foo"),
+					resource.TestCheckTypeSetElemAttr("snowflake_secret_with_client_credentials.test", "oauth_scopes.*", "bar"),
+				),
+			},
+			// change scopes
+			{
+				Config: secretWithClientCredentialsConfig(id.Name(), integrationId.Name(), `["baz"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secret_with_client_credentials.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret_with_client_credentials.test", "oauth_scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_secret_with_client_credentials.test", "oauth_scopes.*", "baz"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_secret_with_client_credentials.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func secretWithClientCredentialsConfig(name string, integrationName string, scopes string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret_with_client_credentials" "test" {
+	name               = "%[1]s"
+	database           = "%[2]s"
+	schema             = "%[3]s"
+	api_authentication = "%[4]s"
+	oauth_scopes       = %[5]s
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, integrationName, scopes)
+}
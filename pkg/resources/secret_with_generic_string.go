@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretWithGenericStringSchema = secretSchema(map[string]*schema.Schema{
+	"secret_string": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the string to store in the secret. The string can be an API token or a string of sensitive value that can be used in the handler code of a UDF or stored procedure.",
+	},
+})
+
+// SecretWithGenericString returns a pointer to the resource representing a secret of type GENERIC_STRING.
+func SecretWithGenericString() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage secret objects with generic string. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
+
+		CreateContext: CreateContextSecretWithGenericString,
+		ReadContext:   ReadContextSecretWithGenericString,
+		UpdateContext: UpdateContextSecretWithGenericString,
+		DeleteContext: DeleteContextSecret,
+
+		Schema: secretWithGenericStringSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSecretWithGenericString(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := secretIdFromData(d)
+
+	request := sdk.NewCreateWithGenericStringSecretRequest(id, d.Get("secret_string").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.Secrets.CreateWithGenericString(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSecretWithGenericString(ctx, d, meta)
+}
+
+func ReadContextSecretWithGenericString(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// secret_string is never returned by Snowflake, so it is kept as configured.
+	_, diags := readSecretCommon(ctx, d, meta, sdk.SecretTypeGenericString)
+	return diags
+}
+
+func UpdateContextSecretWithGenericString(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("secret_string") {
+		request := sdk.NewAlterSecretRequest(id).WithSet(*sdk.NewSecretSetRequest().
+			WithSetForGenericString(*sdk.NewSetForGenericStringRequest(d.Get("secret_string").(string))),
+		)
+		if err := client.Secrets.Alter(ctx, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateSecretComment(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadContextSecretWithGenericString(ctx, d, meta)
+}
@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SecretWithGenericString_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecretWithGenericString),
+		Steps: []resource.TestStep{
+			{
+				Config: secretWithGenericStringConfig(id.Name(), "foo", "some comment"),
+				Check: acc.AssertThat(t,
+					resourceassert.SecretWithGenericStringResource(t, "snowflake_secret_with_generic_string.test").
+						HasName(id.Name()).
+						HasDatabase(acc.TestDatabaseName).
+						HasSchema(acc.TestSchemaName).
+						HasSecretString("foo").
+						HasComment("some comment").
+						HasSecretType("GENERIC_STRING").
+						HasQualifiedName(id.FullyQualifiedName()),
+				),
+			},
+			// change secret string and unset comment
+			{
+				Config: secretWithGenericStringConfig(id.Name(), "bar", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_secret_with_generic_string.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret_with_generic_string.test", "secret_string", "bar"),
+					resource.TestCheckResourceAttr("snowflake_secret_with_generic_string.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secret_with_generic_string.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_string"},
+			},
+		},
+	})
+}
+
+func TestAcc_SecretWithGenericString_importSecretOfDifferentType(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SecretWithGenericString),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					_, secretCleanup := acc.TestClient().Secret.CreateWithBasicAuthentication(t, id, "foo", "bar")
+					t.Cleanup(secretCleanup)
+				},
+				Config:        secretWithGenericStringConfig(id.Name(), "foo", ""),
+				ResourceName:  "snowflake_secret_with_generic_string.test",
+				ImportState:   true,
+				ImportStateId: helpers.EncodeSnowflakeID(id),
+				ExpectError:   regexp.MustCompile("is of type PASSWORD, but this resource manages secrets of type GENERIC_STRING"),
+			},
+		},
+	})
+}
+
+func secretWithGenericStringConfig(name string, secretString string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_secret_with_generic_string" "test" {
+	name          = "%[1]s"
+	database      = "%[2]s"
+	schema        = "%[3]s"
+	secret_string = "%[4]s"
+	comment       = "%[5]s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, secretString, comment)
+}
@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var securityIntegrationCommonSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the security integration. The name should be unique among security integrations in your account.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the security integration.",
+	},
+	"integration_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the security integration as returned by SHOW SECURITY INTEGRATIONS, e.g. `SAML2` or `OAUTH - CUSTOM`.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the security integration was created.",
+	},
+}
+
+// securityIntegrationSchema returns the schema of a security integration resource built from the attributes common to all security integration types and the given type-specific ones.
+// Every type-specific schema has to contain the `enabled` attribute which is set by readSecurityIntegrationCommon.
+func securityIntegrationSchema(specific map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(securityIntegrationCommonSchema)+len(specific))
+	for k, v := range securityIntegrationCommonSchema {
+		result[k] = v
+	}
+	for k, v := range specific {
+		result[k] = v
+	}
+	return result
+}
+
+// readSecurityIntegrationCommon sets the attributes shared by all security integration resources and returns the DESCRIBE SECURITY INTEGRATION output
+// as a map of property names to values for the type-specific ones.
+// When the security integration no longer exists, the resource is removed from the state and nil properties are returned together with a warning.
+func readSecurityIntegrationCommon(ctx context.Context, d *schema.ResourceData, meta any) (map[string]string, diag.Diagnostics) {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve security integration. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return nil, diag.FromErr(err)
+	}
+
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	values := make(map[string]string, len(properties))
+	for _, property := range properties {
+		values[property.Name] = property.Value
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("comment", integration.Comment); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("integration_type", integration.IntegrationType); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return values, nil
+}
+
+// setSecurityIntegrationProperties sets the given attributes (mapped to DESCRIBE SECURITY INTEGRATION property names) converting the values to the attribute types from the resource schema.
+// Properties missing from the output are skipped.
+func setSecurityIntegrationProperties(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, values map[string]string, attributes map[string]string) error {
+	for attribute, property := range attributes {
+		value, ok := values[property]
+		if !ok {
+			continue
+		}
+		var err error
+		switch resourceSchema[attribute].Type {
+		case schema.TypeBool:
+			var b bool
+			if b, err = strconv.ParseBool(value); err == nil {
+				err = d.Set(attribute, b)
+			}
+		case schema.TypeInt:
+			var i int
+			if i, err = strconv.Atoi(value); err == nil {
+				err = d.Set(attribute, i)
+			}
+		case schema.TypeSet, schema.TypeList:
+			err = d.Set(attribute, sdk.ParseCommaSeparatedStringArray(value))
+		default:
+			err = d.Set(attribute, value)
+		}
+		if err != nil {
+			return fmt.Errorf("setting %s from %s property: %w", attribute, property, err)
+		}
+	}
+	return nil
+}
+
+var oauthIntegrationUseSecondaryRoles = []string{
+	string(sdk.OauthSecurityIntegrationUseSecondaryRolesImplicit),
+	string(sdk.OauthSecurityIntegrationUseSecondaryRolesNone),
+}
+
+// oauthSecurityIntegrationCommonSchema holds the attributes shared by the OAuth security integrations for partner applications and custom clients.
+var oauthSecurityIntegrationCommonSchema = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether this OAuth integration is enabled or disabled.",
+	},
+	"oauth_issue_refresh_tokens": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to allow the client to exchange a refresh token for an access token when the current access token has expired.",
+	},
+	"oauth_refresh_token_validity": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies how long refresh tokens should be valid (in seconds). OAUTH_ISSUE_REFRESH_TOKENS must be set to TRUE. Snowflake default is used when not specified.",
+	},
+	"oauth_use_secondary_roles": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          string(sdk.OauthSecurityIntegrationUseSecondaryRolesNone),
+		ValidateFunc:     validation.StringInSlice(oauthIntegrationUseSecondaryRoles, true),
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      fmt.Sprintf("Specifies whether default secondary roles set in the user properties are activated by default in the session being opened. Valid values are (case-insensitive): %v.", oauthIntegrationUseSecondaryRoles),
+	},
+	"blocked_roles_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A set of Snowflake roles that a user cannot explicitly consent to using after authenticating. Privileged roles (e.g. ACCOUNTADMIN) added to the list by Snowflake are not tracked unless configured explicitly.",
+	},
+}
+
+// oauthSecurityIntegrationSchema returns the schema of an OAuth security integration resource built from the attributes common to all security integrations, the ones common to OAuth integrations and the given type-specific ones.
+func oauthSecurityIntegrationSchema(specific map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(oauthSecurityIntegrationCommonSchema)+len(specific))
+	for k, v := range oauthSecurityIntegrationCommonSchema {
+		result[k] = v
+	}
+	for k, v := range specific {
+		result[k] = v
+	}
+	return securityIntegrationSchema(result)
+}
+
+// oauthPrivilegedRoles are added to the blocked roles list by Snowflake when the OAUTH_ADD_PRIVILEGED_ROLES_TO_BLOCKED_LIST account parameter is enabled (the default).
+var oauthPrivilegedRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "GLOBALORGADMIN", "SECURITYADMIN"}
+
+// setOauthBlockedRolesList sets blocked_roles_list from the DESCRIBE output skipping privileged roles added implicitly by Snowflake, unless they are configured explicitly.
+func setOauthBlockedRolesList(d *schema.ResourceData, values map[string]string) error {
+	value, ok := values["BLOCKED_ROLES_LIST"]
+	if !ok {
+		return nil
+	}
+	configured := expandStringList(d.Get("blocked_roles_list").(*schema.Set).List())
+	roles := make([]string, 0)
+	for _, role := range sdk.ParseCommaSeparatedStringArray(value) {
+		if slices.Contains(oauthPrivilegedRoles, strings.ToUpper(role)) && !slices.Contains(configured, role) {
+			continue
+		}
+		roles = append(roles, role)
+	}
+	return d.Set("blocked_roles_list", roles)
+}
+
+func expandSecurityIntegrationRoles(configured []any) []sdk.AccountObjectIdentifier {
+	roles := expandStringList(configured)
+	result := make([]sdk.AccountObjectIdentifier, len(roles))
+	for i, role := range roles {
+		result[i] = sdk.NewAccountObjectIdentifier(role)
+	}
+	return result
+}
+
+// securityIntegrationSetCleared is used to recreate the security integration when a list that can't be emptied with ALTER is cleared.
+func securityIntegrationSetCleared(_ context.Context, old, new, _ any) bool {
+	return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+}
+
+func DeleteContextSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	if err := client.SecurityIntegrations.Drop(ctx, sdk.NewDropSecurityIntegrationRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
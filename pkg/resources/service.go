@@ -0,0 +1,448 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+var serviceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the service; must be unique for the schema in which the service is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the service.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the service.",
+	},
+	"compute_pool": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		Description:      "Specifies the name of the compute pool in your account on which to run the service.",
+	},
+	"specification": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ExactlyOneOf:     []string{"specification", "specification_file"},
+		DiffSuppressFunc: suppressServiceSpecificationDiff,
+		Description:      "Specifies the service specification (in YAML) inline. Changing the specification updates the service in place. The specification is compared semantically, so formatting changes are ignored. The specification is read back from Snowflake, which fills in the defaults; the configured specification is kept in the state as long as Snowflake contains all of its values, otherwise (e.g. after import) the specification returned by Snowflake is stored and the service is updated with the configured one.",
+	},
+	"specification_file": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"specification", "specification_file"},
+		RequiredWith: []string{"stage"},
+		Description:  "Specifies the path to the service specification file on the stage given in `stage`. Changing the file updates the service in place.",
+	},
+	"stage": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"specification_file"},
+		Description:  "Specifies the stage location (e.g. `@database.schema.stage/path`) where the service specification file is stored.",
+	},
+	"min_instances": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the minimum number of service instances to run.",
+	},
+	"max_instances": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the maximum number of service instances to run; must be greater than or equal to `min_instances`.",
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the names of the external access integrations that allow the service to access external sites.",
+	},
+	"query_warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		Description:      "Warehouse to use if a service container connects to Snowflake to execute a query but does not explicitly specify a warehouse to use.",
+	},
+	"auto_resume": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to automatically resume the service when a service function or ingress is called.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the service.",
+	},
+	"dns_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Snowflake-assigned DNS name of the service, used by other services to communicate with this service.",
+	},
+	"endpoint": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Endpoints exposed by the service as returned by SHOW ENDPOINTS IN SERVICE.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the endpoint.",
+				},
+				"port": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Port of the endpoint.",
+				},
+				"port_range": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Port range of the endpoint.",
+				},
+				"protocol": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Protocol of the endpoint (e.g. HTTP, TCP).",
+				},
+				"is_public": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the endpoint is accessible from outside of Snowflake.",
+				},
+				"ingress_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Ingress URL of a public endpoint.",
+				},
+			},
+		},
+	},
+}
+
+// Service returns a pointer to the resource representing a service.
+func Service() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage service objects. For more information, check [service documentation](https://docs.snowflake.com/en/developer-guide/snowpark-container-services/working-with-services).",
+
+		CreateContext: CreateContextService,
+		ReadContext:   ReadContextService,
+		UpdateContext: UpdateContextService,
+		DeleteContext: DeleteContextService,
+
+		Schema: serviceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func serviceFromSpecification(d *schema.ResourceData) *sdk.ServiceFromSpecificationRequest {
+	request := sdk.NewServiceFromSpecificationRequest()
+	if v, ok := d.GetOk("specification"); ok {
+		return request.WithSpecification(v.(string))
+	}
+	return request.
+		WithStage(d.Get("stage").(string)).
+		WithSpecificationFile(d.Get("specification_file").(string))
+}
+
+// suppressServiceSpecificationDiff compares the specification semantically, so that reformatting the YAML doesn't cause a diff;
+// the specification taken from the file is not compared at all.
+func suppressServiceSpecificationDiff(_, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return d.Get("specification_file").(string) != ""
+	}
+	return serviceSpecificationsEqual(old, new)
+}
+
+// serviceSpecificationsEqual reports whether both specifications contain the same values regardless of the formatting.
+func serviceSpecificationsEqual(a string, b string) bool {
+	return serviceSpecificationContains(a, b) && serviceSpecificationContains(b, a)
+}
+
+// serviceSpecificationContains reports whether all the values of the expected specification are present in the actual one.
+func serviceSpecificationContains(actual string, expected string) bool {
+	var actualValue, expectedValue any
+	if err := yaml.Unmarshal([]byte(actual), &actualValue); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		return false
+	}
+	return yamlValueContains(actualValue, expectedValue)
+}
+
+func yamlValueContains(actual any, expected any) bool {
+	switch expected := expected.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range expected {
+			if !yamlValueContains(actual[key], value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok || len(actual) != len(expected) {
+			return false
+		}
+		for i := range expected {
+			if !yamlValueContains(actual[i], expected[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return fmt.Sprint(actual) == fmt.Sprint(expected)
+	}
+}
+
+func serviceExternalAccessIntegrations(d *schema.ResourceData) []sdk.AccountObjectIdentifier {
+	names := expandStringList(d.Get("external_access_integrations").(*schema.Set).List())
+	integrations := make([]sdk.AccountObjectIdentifier, len(names))
+	for i, name := range names {
+		integrations[i] = sdk.NewAccountObjectIdentifier(name)
+	}
+	return integrations
+}
+
+func serviceEndpointsToList(endpoints []sdk.ServiceEndpoint) []any {
+	result := make([]any, len(endpoints))
+	for i, endpoint := range endpoints {
+		var port int
+		var portRange, ingressUrl string
+		if endpoint.Port != nil {
+			port = *endpoint.Port
+		}
+		if endpoint.PortRange != nil {
+			portRange = *endpoint.PortRange
+		}
+		if endpoint.IngressUrl != nil {
+			ingressUrl = *endpoint.IngressUrl
+		}
+		result[i] = map[string]any{
+			"name":        endpoint.Name,
+			"port":        port,
+			"port_range":  portRange,
+			"protocol":    endpoint.Protocol,
+			"is_public":   endpoint.IsPublic,
+			"ingress_url": ingressUrl,
+		}
+	}
+	return result
+}
+
+func CreateContextService(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	minInstances, maxInstances := d.Get("min_instances").(int), d.Get("max_instances").(int)
+	if minInstances > maxInstances {
+		return diag.FromErr(fmt.Errorf("min_instances (%d) can't be greater than max_instances (%d)", minInstances, maxInstances))
+	}
+
+	request := sdk.NewCreateServiceRequest(id, sdk.NewAccountObjectIdentifier(d.Get("compute_pool").(string))).
+		WithFromSpecification(*serviceFromSpecification(d)).
+		WithMinInstances(minInstances).
+		WithMaxInstances(maxInstances).
+		WithAutoResume(d.Get("auto_resume").(bool))
+	if integrations := serviceExternalAccessIntegrations(d); len(integrations) > 0 {
+		request.WithExternalAccessIntegrations(integrations)
+	}
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		request.WithQueryWarehouse(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(v.(string))
+	}
+
+	if err := client.Services.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextService(ctx, d, meta)
+}
+
+func ReadContextService(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	service, err := client.Services.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve service. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	endpoints, err := client.ServiceEndpoints.Show(ctx, sdk.NewShowServiceEndpointRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the specification from the file is not read back, because the file contents is not known to the provider
+	if _, ok := d.GetOk("specification_file"); !ok {
+		details, err := client.Services.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// DESCRIBE SERVICE returns the specification with the defaults filled in, so the specification from the state is kept
+		// as long as the described one contains all of its values; otherwise (after import or a change made outside of Terraform)
+		// the described specification is set and compared with the configuration
+		if current := d.Get("specification").(string); current == "" || !serviceSpecificationContains(details.Spec, current) {
+			if err := d.Set("specification", details.Spec); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	var queryWarehouse, comment string
+	if service.QueryWarehouse != nil {
+		queryWarehouse = *service.QueryWarehouse
+	}
+	if service.Comment != nil {
+		comment = *service.Comment
+	}
+
+	toSet := map[string]any{
+		"name":                         service.Name,
+		"database":                     service.DatabaseName,
+		"schema":                       service.SchemaName,
+		"compute_pool":                 service.ComputePool,
+		"min_instances":                service.MinInstances,
+		"max_instances":                service.MaxInstances,
+		"external_access_integrations": service.ExternalAccessIntegrations,
+		"query_warehouse":              queryWarehouse,
+		"auto_resume":                  service.AutoResume,
+		"comment":                      comment,
+		"dns_name":                     service.DnsName,
+		"endpoint":                     serviceEndpointsToList(endpoints),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextService(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChanges("specification", "specification_file", "stage") {
+		if err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithFromSpecification(*serviceFromSpecification(d))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating specification of service %v err = %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChanges("min_instances", "max_instances") {
+		minInstances, maxInstances := d.Get("min_instances").(int), d.Get("max_instances").(int)
+		if minInstances > maxInstances {
+			return diag.FromErr(fmt.Errorf("min_instances (%d) can't be greater than max_instances (%d)", minInstances, maxInstances))
+		}
+	}
+
+	set, unset := sdk.NewServiceSetRequest(), sdk.NewServiceUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("min_instances") {
+		set.WithMinInstances(d.Get("min_instances").(int))
+		runSet = true
+	}
+	if d.HasChange("max_instances") {
+		set.WithMaxInstances(d.Get("max_instances").(int))
+		runSet = true
+	}
+	if d.HasChange("auto_resume") {
+		set.WithAutoResume(d.Get("auto_resume").(bool))
+		runSet = true
+	}
+	if d.HasChange("external_access_integrations") {
+		if integrations := serviceExternalAccessIntegrations(d); len(integrations) > 0 {
+			set.WithExternalAccessIntegrations(integrations)
+			runSet = true
+		} else {
+			unset.WithExternalAccessIntegrations(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("query_warehouse") {
+		if v, ok := d.GetOk("query_warehouse"); ok {
+			set.WithQueryWarehouse(sdk.NewAccountObjectIdentifier(v.(string)))
+			runSet = true
+		} else {
+			unset.WithQueryWarehouse(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextService(ctx, d, meta)
+}
+
+func DeleteContextService(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.Services.Drop(ctx, sdk.NewDropServiceRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,141 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/assertions/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Service_basic(t *testing.T) {
+	// services can be only created from images that were pushed to one of the image repositories in the account
+	image := testenvs.GetOrSkipTest(t, testenvs.ServiceImage)
+
+	computePool, computePoolCleanup := acc.TestClient().ComputePool.Create(t)
+	t.Cleanup(computePoolCleanup)
+	acc.TestClient().ComputePool.Alter(t, sdk.NewAlterComputePoolRequest(computePool.ID()).WithSet(*sdk.NewComputePoolSetRequest().WithAutoResume(true)))
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	specification := helpers.ServiceSpecification(image)
+	changedSpecification := strings.ReplaceAll(specification, "8080", "8081")
+	specificationWithoutPublic := strings.ReplaceAll(changedSpecification, "    public: true\n", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Service),
+		Steps: []resource.TestStep{
+			{
+				Config: serviceConfig(id, computePool.ID(), specification, 1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					acc.AssertThat(t,
+						resourceassert.ServiceResource(t, "snowflake_service.test").
+							HasName(id.Name()).
+							HasDatabase(id.DatabaseName()).
+							HasSchema(id.SchemaName()).
+							HasComputePool(computePool.Name).
+							HasMinInstances(1).
+							HasMaxInstances(1).
+							HasAutoResume(true).
+							HasComment("").
+							HasEndpointCount(1),
+						objectassert.Service(t, id).
+							HasName(id.Name()).
+							HasComputePool(computePool.Name).
+							HasMinInstances(1).
+							HasMaxInstances(1).
+							HasAutoResume(true),
+					),
+					resource.TestCheckResourceAttrSet("snowflake_service.test", "dns_name"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.0.name", "api"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.0.port", "8080"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.0.is_public", "true"),
+				),
+			},
+			// change the properties in place
+			{
+				Config: serviceConfig(id, computePool.ID(), specification, 2, "some comment"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_service.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_service.test", "max_instances", "2"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "comment", "some comment"),
+				),
+			},
+			// change the specification in place
+			{
+				Config: serviceConfig(id, computePool.ID(), changedSpecification, 2, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_service.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_service.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.0.port", "8081"),
+				),
+			},
+			// removing a key from the specification updates the service in place
+			{
+				Config: serviceConfig(id, computePool.ID(), specificationWithoutPublic, 2, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_service.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_service.test", "endpoint.0.is_public", "false"),
+				),
+			},
+			// the configured specification is kept in the state, so there is no diff
+			{
+				Config: serviceConfig(id, computePool.ID(), specificationWithoutPublic, 2, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:            "snowflake_service.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specification"},
+			},
+		},
+	})
+}
+
+func serviceConfig(id sdk.SchemaObjectIdentifier, computePoolId sdk.AccountObjectIdentifier, specification string, maxInstances int, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_service" "test" {
+	database      = "%[1]s"
+	schema        = "%[2]s"
+	name          = "%[3]s"
+	compute_pool  = "%[4]s"
+	specification = <<-EOT
+%[5]sEOT
+	min_instances = 1
+	max_instances = %[6]d
+	comment       = "%[7]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), computePoolId.Name(), specification, maxInstances, comment)
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceSpecificationContains(t *testing.T) {
+	configured := `
+spec:
+  containers:
+  - name: example
+    image: /db/schema/repository/image:latest
+  endpoints:
+  - name: example
+    port: 8080
+`
+	described := `spec:
+  containers:
+  - name: "example"
+    image: "/db/schema/repository/image:latest"
+    resources:
+      limits:
+        memory: "6Gi"
+  endpoints:
+  - name: "example"
+    port: 8080
+    public: false
+    protocol: "HTTP"
+`
+
+	testCases := []struct {
+		name     string
+		actual   string
+		expected string
+		contains bool
+	}{
+		{name: "same specification", actual: configured, expected: configured, contains: true},
+		{name: "defaults filled in and formatting changed", actual: described, expected: configured, contains: true},
+		{name: "changed value", actual: described, expected: strings.ReplaceAll(configured, "8080", "8081"), contains: false},
+		{name: "missing value", actual: configured, expected: described, contains: false},
+		{name: "different number of list elements", actual: described, expected: configured + "  - name: other\n    port: 8081\n", contains: false},
+		{name: "invalid yaml", actual: described, expected: "spec: [", contains: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.contains, serviceSpecificationContains(tc.actual, tc.expected))
+		})
+	}
+}
+
+func TestServiceSpecificationsEqual(t *testing.T) {
+	configured := `
+spec:
+  containers:
+  - name: example
+    image: /db/schema/repository/image:latest
+  endpoints:
+  - name: example
+    port: 8080
+    public: true
+`
+	reformatted := `spec:
+  containers:
+  - image: "/db/schema/repository/image:latest"
+    name: "example"
+  endpoints:
+  - name: "example"
+    public: true
+    port: 8080
+`
+
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "same specification", a: configured, b: configured, expected: true},
+		{name: "formatting changed", a: configured, b: reformatted, expected: true},
+		{name: "changed value", a: configured, b: strings.ReplaceAll(configured, "8080", "8081"), expected: false},
+		{name: "removed key", a: configured, b: strings.ReplaceAll(configured, "    public: true\n", ""), expected: false},
+		{name: "added key", a: strings.ReplaceAll(configured, "    public: true\n", ""), b: configured, expected: false},
+		{name: "invalid yaml", a: configured, b: "spec: [", expected: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, serviceSpecificationsEqual(tc.a, tc.b))
+		})
+	}
+}
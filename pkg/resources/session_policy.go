@@ -0,0 +1,225 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var sessionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the session policy; must be unique for the schema in which the session policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the session policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the session policy.",
+	},
+	"session_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		ValidateFunc: validation.IntBetween(5, 240),
+		Description:  "Specifies the number of minutes in which a session can be idle before the user must authenticate again (for Snowflake clients and programmatic clients). Valid values are from 5 to 240.",
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		ValidateFunc: validation.IntBetween(5, 240),
+		Description:  "Specifies the number of minutes in which a Snowsight or Classic Console session can be idle before the user must authenticate again. Valid values are from 5 to 240.",
+	},
+	"allowed_secondary_roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the roles that a user can use as secondary roles in a session. Use `[\"ALL\"]` to allow all roles granted to the user. When not set, the Snowflake default (all roles) applies.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the session policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the session policy.",
+	},
+}
+
+// SessionPolicy returns a pointer to the resource representing a session policy.
+func SessionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage session policy objects. For more information, check [session policy documentation](https://docs.snowflake.com/en/user-guide/session-policies).",
+
+		CreateContext: CreateContextSessionPolicy,
+		ReadContext:   ReadContextSessionPolicy,
+		UpdateContext: UpdateContextSessionPolicy,
+		DeleteContext: DeleteContextSessionPolicy,
+
+		Schema: sessionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateSessionPolicyRequest(id).
+		WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int))).
+		WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+	if v, ok := d.GetOk("allowed_secondary_roles"); ok {
+		request.WithAllowedSecondaryRoles(expandSessionPolicySecondaryRoles(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.SessionPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextSessionPolicy(ctx, d, meta)
+}
+
+func ReadContextSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	sessionPolicy, err := client.SessionPolicies.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve session policy. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	description, err := client.SessionPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Snowflake reports the default ('ALL') when nothing was set, so keep the attribute empty in that case to avoid a permanent diff.
+	allowedSecondaryRoles := description.AllowedSecondaryRoles
+	if d.Get("allowed_secondary_roles").(*schema.Set).Len() == 0 && slices.Equal(allowedSecondaryRoles, []string{"ALL"}) {
+		allowedSecondaryRoles = []string{}
+	}
+
+	toSet := map[string]any{
+		"name":                         sessionPolicy.Name,
+		"database":                     sessionPolicy.DatabaseName,
+		"schema":                       sessionPolicy.SchemaName,
+		"session_idle_timeout_mins":    description.SessionIdleTimeoutMins,
+		"session_ui_idle_timeout_mins": description.SessionUIIdleTimeoutMins,
+		"allowed_secondary_roles":      allowedSecondaryRoles,
+		"comment":                      sessionPolicy.Comment,
+		"qualified_name":               id.FullyQualifiedName(),
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func UpdateContextSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewSessionPolicySetRequest(), sdk.NewSessionPolicyUnsetRequest()
+	runSet, runUnset := false, false
+	if d.HasChange("session_idle_timeout_mins") {
+		set.WithSessionIdleTimeoutMins(sdk.Int(d.Get("session_idle_timeout_mins").(int)))
+		runSet = true
+	}
+	if d.HasChange("session_ui_idle_timeout_mins") {
+		set.WithSessionUiIdleTimeoutMins(sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)))
+		runSet = true
+	}
+	if d.HasChange("allowed_secondary_roles") {
+		if v, ok := d.GetOk("allowed_secondary_roles"); ok {
+			set.WithAllowedSecondaryRoles(expandSessionPolicySecondaryRoles(v.(*schema.Set).List()))
+			runSet = true
+		} else {
+			unset.WithAllowedSecondaryRoles(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if runUnset {
+		if err := client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextSessionPolicy(ctx, d, meta)
+}
+
+func DeleteContextSessionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.SessionPolicies.Drop(ctx, sdk.NewDropSessionPolicyRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandSessionPolicySecondaryRoles(roles []any) *sdk.SessionPolicySecondaryRolesRequest {
+	request := sdk.NewSessionPolicySecondaryRolesRequest()
+	ids := make([]sdk.AccountObjectIdentifier, 0, len(roles))
+	for _, role := range roles {
+		if strings.EqualFold(role.(string), "ALL") {
+			return request.WithAll(sdk.Bool(true))
+		}
+		ids = append(ids, sdk.NewAccountObjectIdentifier(role.(string)))
+	}
+	return request.WithRoles(ids)
+}
@@ -0,0 +1,132 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_SessionPolicy_basic(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SessionPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyBasicConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_idle_timeout_mins", "240"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_ui_idle_timeout_mins", "240"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "allowed_secondary_roles.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "comment", ""),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "qualified_name", id.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: sessionPolicyCompleteConfig(id, role.Name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_session_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_ui_idle_timeout_mins", "15"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "allowed_secondary_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_session_policy.test", "allowed_secondary_roles.*", role.Name),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_session_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// unset optional fields
+			{
+				Config: sessionPolicyBasicConfig(id),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_session_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_idle_timeout_mins", "240"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "session_ui_idle_timeout_mins", "240"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "allowed_secondary_roles.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_SessionPolicy_allSecondaryRoles(t *testing.T) {
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.SessionPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyCompleteConfig(id, "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.test", "allowed_secondary_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_session_policy.test", "allowed_secondary_roles.*", "ALL"),
+				),
+			},
+			{
+				Config: sessionPolicyCompleteConfig(id, "ALL"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func sessionPolicyBasicConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_session_policy" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func sessionPolicyCompleteConfig(id sdk.SchemaObjectIdentifier, secondaryRole string) string {
+	return fmt.Sprintf(`
+resource "snowflake_session_policy" "test" {
+	database                     = "%[1]s"
+	schema                       = "%[2]s"
+	name                         = "%[3]s"
+	session_idle_timeout_mins    = 30
+	session_ui_idle_timeout_mins = 15
+	allowed_secondary_roles      = ["%[4]s"]
+	comment                      = "foo"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), secondaryRole)
+}
@@ -0,0 +1,272 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the streamlit.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the streamlit.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the streamlit.",
+	},
+	"root_location": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the full path to the named stage containing the Streamlit Python files, media files, and the environment.yml file, e.g. `@\"db\".\"schema\".\"stage\"/dir`.",
+	},
+	"main_file": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the filename of the Streamlit Python application. This filename is relative to the value of `root_location`.",
+	},
+	"query_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the warehouse where SQL queries issued by the Streamlit application are run.",
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "External access integrations connected to the Streamlit.",
+	},
+	"title": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a title for the Streamlit app to display in Snowsight.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the streamlit.",
+	},
+	"url_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Unique identifier of the Streamlit app used in its URL.",
+	},
+}
+
+// Streamlit returns a pointer to the resource representing a streamlit.
+func Streamlit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage streamlit objects. For more information, check [streamlit documentation](https://docs.snowflake.com/en/sql-reference/sql/create-streamlit).",
+
+		CreateContext: CreateContextStreamlit,
+		ReadContext:   ReadContextStreamlit,
+		UpdateContext: UpdateContextStreamlit,
+		DeleteContext: DeleteContextStreamlit,
+
+		Schema: streamlitSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateStreamlitRequest(id, d.Get("root_location").(string), d.Get("main_file").(string))
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		request.WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string))))
+	}
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		request.WithExternalAccessIntegrations(expandStreamlitExternalAccessIntegrations(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		request.WithTitle(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Streamlits.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContextStreamlit(ctx, d, meta)
+}
+
+func ReadContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	streamlit, err := client.Streamlits.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve streamlit. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	streamlitDetail, err := client.Streamlits.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Snowflake returns the root location with the quoted stage identifier, so the configured value is kept when it points to the same location.
+	rootLocation := streamlitDetail.RootLocation
+	if current := d.Get("root_location").(string); strings.EqualFold(strings.ReplaceAll(current, `"`, ""), strings.ReplaceAll(rootLocation, `"`, "")) {
+		rootLocation = current
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("database", id.DatabaseName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema", id.SchemaName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("root_location", rootLocation); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("main_file", streamlitDetail.MainFile); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query_warehouse", streamlit.QueryWarehouse); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("external_access_integrations", streamlitDetail.ExternalAccessIntegrations); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("title", streamlit.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("comment", streamlit.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url_id", streamlit.UrlId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), d.Get("name").(string))
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithRenameTo(&newId)); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+
+	set, setChanged := sdk.NewStreamlitSetRequest(nil, nil), false
+	unset, unsetChanged := sdk.NewStreamlitUnsetRequest(), false
+
+	if d.HasChange("root_location") {
+		set.RootLocation = sdk.String(d.Get("root_location").(string))
+		setChanged = true
+	}
+	if d.HasChange("main_file") {
+		set.MainFile = sdk.String(d.Get("main_file").(string))
+		setChanged = true
+	}
+	if d.HasChange("query_warehouse") {
+		if v, ok := d.GetOk("query_warehouse"); ok {
+			set.WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string))))
+			setChanged = true
+		} else {
+			unset.WithQueryWarehouse(sdk.Bool(true))
+			unsetChanged = true
+		}
+	}
+	if d.HasChange("external_access_integrations") {
+		// the empty list is passed explicitly to remove all the integrations with EXTERNAL_ACCESS_INTEGRATIONS = ()
+		integrations := expandStreamlitExternalAccessIntegrations(d.Get("external_access_integrations").(*schema.Set).List())
+		set.WithExternalAccessIntegrations(sdk.NewStreamlitExternalAccessIntegrationsRequest().WithExternalAccessIntegrations(integrations))
+		setChanged = true
+	}
+	if d.HasChange("title") {
+		if v, ok := d.GetOk("title"); ok {
+			set.WithTitle(sdk.String(v.(string)))
+			setChanged = true
+		} else {
+			unset.WithTitle(sdk.Bool(true))
+			unsetChanged = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(sdk.String(v.(string)))
+			setChanged = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			unsetChanged = true
+		}
+	}
+
+	if setChanged {
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if unsetChanged {
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithUnset(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextStreamlit(ctx, d, meta)
+}
+
+func DeleteContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.Streamlits.Drop(ctx, sdk.NewDropStreamlitRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandStreamlitExternalAccessIntegrations(integrations []any) []sdk.AccountObjectIdentifier {
+	result := make([]sdk.AccountObjectIdentifier, len(integrations))
+	for i, integration := range integrations {
+		result[i] = sdk.NewAccountObjectIdentifier(integration.(string))
+	}
+	return result
+}
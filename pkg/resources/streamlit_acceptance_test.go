@@ -0,0 +1,130 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Streamlit_basic(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+	newName := acc.TestClient().Ids.Alpha()
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	networkRule, networkRuleCleanup := acc.TestClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	externalAccessIntegration, externalAccessIntegrationCleanup := acc.TestClient().ExternalAccessIntegration.Create(t, networkRule.ID())
+	t.Cleanup(externalAccessIntegrationCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Streamlit),
+		Steps: []resource.TestStep{
+			{
+				Config: streamlitConfig(name, stage.Location()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "root_location", stage.Location()),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "main_file", "streamlit_app.py"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "query_warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "external_access_integrations.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "title", ""),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "comment", ""),
+					resource.TestCheckResourceAttrSet("snowflake_streamlit.test", "url_id"),
+				),
+			},
+			{
+				Config: streamlitCompleteConfig(name, stage.Location(), externalAccessIntegration.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_streamlit.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "main_file", "app.py"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "query_warehouse", acc.TestWarehouseName),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "external_access_integrations.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_streamlit.test", "external_access_integrations.*", externalAccessIntegration.ID().Name()),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "title", "title"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "comment", "foo"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_streamlit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// rename
+			{
+				Config: streamlitCompleteConfig(newName, stage.Location(), externalAccessIntegration.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_streamlit.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "name", newName),
+				),
+			},
+			// unset optional fields
+			{
+				Config: streamlitConfig(newName, stage.Location()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_streamlit.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "main_file", "streamlit_app.py"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "query_warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "external_access_integrations.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "title", ""),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func streamlitConfig(name string, rootLocation string) string {
+	return fmt.Sprintf(`
+resource "snowflake_streamlit" "test" {
+	name          = "%[1]s"
+	database      = "%[2]s"
+	schema        = "%[3]s"
+	root_location = %[4]q
+	main_file     = "streamlit_app.py"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, rootLocation)
+}
+
+func streamlitCompleteConfig(name string, rootLocation string, externalAccessIntegrationId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_streamlit" "test" {
+	name                         = "%[1]s"
+	database                     = "%[2]s"
+	schema                       = "%[3]s"
+	root_location                = %[4]q
+	main_file                    = "app.py"
+	query_warehouse              = "%[5]s"
+	external_access_integrations = ["%[6]s"]
+	title                        = "title"
+	comment                      = "foo"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, rootLocation, acc.TestWarehouseName, externalAccessIntegrationId.Name())
+}
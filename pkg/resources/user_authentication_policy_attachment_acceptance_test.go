@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserAuthenticationPolicyAttachment(t *testing.T) {
+	userId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	authenticationPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newAuthenticationPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckUserAuthenticationPolicyAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: userAuthenticationPolicyAttachmentConfig(userId.Name(), authenticationPolicyId.DatabaseName(), authenticationPolicyId.SchemaName(), authenticationPolicyId.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "user_name", userId.Name()),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "authentication_policy_name", authenticationPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), authenticationPolicyId.FullyQualifiedName())),
+				),
+			},
+			// changing the policy recreates the attachment
+			{
+				Config: userAuthenticationPolicyAttachmentConfig(userId.Name(), newAuthenticationPolicyId.DatabaseName(), newAuthenticationPolicyId.SchemaName(), newAuthenticationPolicyId.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "user_name", userId.Name()),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "authentication_policy_name", newAuthenticationPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.apa", "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), newAuthenticationPolicyId.FullyQualifiedName())),
+				),
+			},
+			{
+				ResourceName:      "snowflake_user_authentication_policy_attachment.apa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userAuthenticationPolicyAttachmentConfig(userName, databaseName, schemaName, authenticationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%[1]s"
+}
+
+resource "snowflake_authentication_policy" "ap" {
+	database = "%[2]s"
+	schema   = "%[3]s"
+	name     = "%[4]s"
+}
+
+resource "snowflake_user_authentication_policy_attachment" "apa" {
+	authentication_policy_name = snowflake_authentication_policy.ap.qualified_name
+	user_name                  = snowflake_user.user.name
+}
+`, userName, databaseName, schemaName, authenticationPolicyName)
+}
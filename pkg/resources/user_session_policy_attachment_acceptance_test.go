@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserSessionPolicyAttachment(t *testing.T) {
+	userId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	sessionPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newSessionPolicyId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckUserSessionPolicyAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: userSessionPolicyAttachmentConfig(userId.Name(), sessionPolicyId.DatabaseName(), sessionPolicyId.SchemaName(), sessionPolicyId.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "user_name", userId.Name()),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "session_policy_name", sessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), sessionPolicyId.FullyQualifiedName())),
+				),
+			},
+			// changing the policy recreates the attachment
+			{
+				Config: userSessionPolicyAttachmentConfig(userId.Name(), newSessionPolicyId.DatabaseName(), newSessionPolicyId.SchemaName(), newSessionPolicyId.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "user_name", userId.Name()),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "session_policy_name", newSessionPolicyId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "id", fmt.Sprintf("%s|%s", userId.FullyQualifiedName(), newSessionPolicyId.FullyQualifiedName())),
+				),
+			},
+			{
+				ResourceName:      "snowflake_user_session_policy_attachment.spa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userSessionPolicyAttachmentConfig(userName, databaseName, schemaName, sessionPolicyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%[1]s"
+}
+
+resource "snowflake_session_policy" "sp" {
+	database = "%[2]s"
+	schema   = "%[3]s"
+	name     = "%[4]s"
+}
+
+resource "snowflake_user_session_policy_attachment" "spa" {
+	session_policy_name = snowflake_session_policy.sp.qualified_name
+	user_name           = snowflake_user.user.name
+}
+`, userName, databaseName, schemaName, sessionPolicyName)
+}
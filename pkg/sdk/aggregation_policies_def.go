@@ -0,0 +1,102 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var AggregationPoliciesDef = g.NewInterface(
+	"AggregationPolicies",
+	"AggregationPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-aggregation-policy",
+		g.NewQueryStruct("CreateAggregationPolicy").
+			Create().
+			OrReplace().
+			SQL("AGGREGATION POLICY").
+			IfNotExists().
+			Name().
+			PredefinedQueryStructField("returnsAggregationConstraint", "bool", g.StaticOptions().SQL("AS () RETURNS AGGREGATION_CONSTRAINT")).
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-aggregation-policy",
+		g.NewQueryStruct("AlterAggregationPolicy").
+			Alter().
+			SQL("AGGREGATION POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-aggregation-policy",
+		g.NewQueryStruct("DropAggregationPolicy").
+			Drop().
+			SQL("AGGREGATION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies",
+		g.DbStruct("showAggregationPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			Text("comment").
+			Text("options").
+			Text("owner_role_type"),
+		g.PlainStruct("AggregationPolicy").
+			DeriveMapping().
+			Text("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Kind").
+			Text("Owner").
+			Text("Comment").
+			Text("Options").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowAggregationPolicies").
+			Show().
+			SQL("AGGREGATION POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-aggregation-policy",
+		g.DbStruct("describeAggregationPolicyDBRow").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body"),
+		g.PlainStruct("AggregationPolicyDescription").
+			DeriveMapping().
+			Text("Name").
+			Text("Signature").
+			Text("ReturnType").
+			Text("Body"),
+		g.NewQueryStruct("DescribeAggregationPolicy").
+			Describe().
+			SQL("AGGREGATION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
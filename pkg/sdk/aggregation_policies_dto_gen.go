@@ -0,0 +1,44 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateAggregationPolicyOptions]   = new(CreateAggregationPolicyRequest)
+	_ optionsProvider[AlterAggregationPolicyOptions]    = new(AlterAggregationPolicyRequest)
+	_ optionsProvider[DropAggregationPolicyOptions]     = new(DropAggregationPolicyRequest)
+	_ optionsProvider[ShowAggregationPolicyOptions]     = new(ShowAggregationPolicyRequest)
+	_ optionsProvider[DescribeAggregationPolicyOptions] = new(DescribeAggregationPolicyRequest)
+)
+
+type CreateAggregationPolicyRequest struct {
+	OrReplace   *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterAggregationPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	RenameTo     *SchemaObjectIdentifier `validate:"validIdentifierIfSet,exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetBody      *string                 `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetTags      []TagAssociation        `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	UnsetTags    []ObjectIdentifier      `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetComment   *string                 `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	UnsetComment *bool                   `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+}
+
+type DropAggregationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowAggregationPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeAggregationPolicyRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
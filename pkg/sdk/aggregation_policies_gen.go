@@ -0,0 +1,106 @@
+package sdk
+
+import "context"
+
+type AggregationPolicies interface {
+	Create(ctx context.Context, request *CreateAggregationPolicyRequest) error
+	Alter(ctx context.Context, request *AlterAggregationPolicyRequest) error
+	Drop(ctx context.Context, request *DropAggregationPolicyRequest) error
+	Show(ctx context.Context, request *ShowAggregationPolicyRequest) ([]AggregationPolicy, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicyDescription, error)
+}
+
+// CreateAggregationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-aggregation-policy.
+type CreateAggregationPolicyOptions struct {
+	create                       bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace                    *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	aggregationPolicy            bool                   `ddl:"static" sql:"AGGREGATION POLICY"`
+	IfNotExists                  *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                         SchemaObjectIdentifier `ddl:"identifier"`
+	returnsAggregationConstraint bool                   `ddl:"static" sql:"AS () RETURNS AGGREGATION_CONSTRAINT"`
+	body                         string                 `ddl:"parameter,no_quotes,no_equals" sql:"->"`
+	Comment                      *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterAggregationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-aggregation-policy.
+type AlterAggregationPolicyOptions struct {
+	alter             bool                    `ddl:"static" sql:"ALTER"`
+	aggregationPolicy bool                    `ddl:"static" sql:"AGGREGATION POLICY"`
+	IfExists          *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo          *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetBody           *string                 `ddl:"parameter,no_quotes,no_equals" sql:"SET BODY ->"`
+	SetTags           []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags         []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	SetComment        *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment      *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+// DropAggregationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-aggregation-policy.
+type DropAggregationPolicyOptions struct {
+	drop              bool                   `ddl:"static" sql:"DROP"`
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"`
+	IfExists          *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowAggregationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies.
+type ShowAggregationPolicyOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`
+	aggregationPolicies bool  `ddl:"static" sql:"AGGREGATION POLICIES"`
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+	In                  *In   `ddl:"keyword" sql:"IN"`
+}
+
+type showAggregationPolicyDBRow struct {
+	CreatedOn     string `db:"created_on"`
+	Name          string `db:"name"`
+	DatabaseName  string `db:"database_name"`
+	SchemaName    string `db:"schema_name"`
+	Kind          string `db:"kind"`
+	Owner         string `db:"owner"`
+	Comment       string `db:"comment"`
+	Options       string `db:"options"`
+	OwnerRoleType string `db:"owner_role_type"`
+}
+
+type AggregationPolicy struct {
+	CreatedOn     string
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Kind          string
+	Owner         string
+	Comment       string
+	Options       string
+	OwnerRoleType string
+}
+
+// DescribeAggregationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-aggregation-policy.
+type DescribeAggregationPolicyOptions struct {
+	describe          bool                   `ddl:"static" sql:"DESCRIBE"`
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"`
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type describeAggregationPolicyDBRow struct {
+	Name       string `db:"name"`
+	Signature  string `db:"signature"`
+	ReturnType string `db:"return_type"`
+	Body       string `db:"body"`
+}
+
+type AggregationPolicyDescription struct {
+	Name       string
+	Signature  string
+	ReturnType string
+	Body       string
+}
+
+// custom:begin additional
+func (v *AggregationPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// custom:end additional
@@ -0,0 +1,275 @@
+package sdk
+
+import "testing"
+
+func TestAggregationPolicies_Create(t *testing.T) {
+	// custom:begin CreateAggregationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateAggregationPolicyOptions
+	defaultOpts := func() *CreateAggregationPolicyOptions {
+		return &CreateAggregationPolicyOptions{
+			name: id,
+			body: "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+		}
+	}
+	// custom:end CreateAggregationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateAggregationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateAggregationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: [opts.body] should be set", func(t *testing.T) {
+		// custom:begin CreateAggregationPolicyOptions: validation (value set)
+		opts := defaultOpts()
+		opts.body = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateAggregationPolicyOptions", "body"))
+		// custom:end CreateAggregationPolicyOptions: validation (value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateAggregationPolicyOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAggregationPolicyOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateAggregationPolicyOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateAggregationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", id.FullyQualifiedName())
+		// custom:end CreateAggregationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateAggregationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5) COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateAggregationPolicyOptions: all options
+	})
+
+	// custom:begin CreateAggregationPolicyOptions: additional test cases
+	// custom:end CreateAggregationPolicyOptions: additional test cases
+}
+
+func TestAggregationPolicies_Alter(t *testing.T) {
+	// custom:begin AlterAggregationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterAggregationPolicyOptions
+	defaultOpts := func() *AlterAggregationPolicyOptions {
+		return &AlterAggregationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterAggregationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterAggregationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterAggregationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		// custom:begin AlterAggregationPolicyOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterAggregationPolicyOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		// custom:begin AlterAggregationPolicyOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+		// custom:end AlterAggregationPolicyOptions: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterAggregationPolicyOptions: basic
+		opts := defaultOpts()
+		opts.SetComment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterAggregationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterAggregationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetBody = String("AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY IF EXISTS %s SET BODY -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", id.FullyQualifiedName())
+		// custom:end AlterAggregationPolicyOptions: all options
+	})
+
+	// custom:begin AlterAggregationPolicyOptions: additional test cases
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set and unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+
+		opts = defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AGGREGATION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+	// custom:end AlterAggregationPolicyOptions: additional test cases
+}
+
+func TestAggregationPolicies_Drop(t *testing.T) {
+	// custom:begin DropAggregationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropAggregationPolicyOptions
+	defaultOpts := func() *DropAggregationPolicyOptions {
+		return &DropAggregationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DropAggregationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropAggregationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropAggregationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropAggregationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP AGGREGATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DropAggregationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropAggregationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP AGGREGATION POLICY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropAggregationPolicyOptions: all options
+	})
+
+	// custom:begin DropAggregationPolicyOptions: additional test cases
+	// custom:end DropAggregationPolicyOptions: additional test cases
+}
+
+func TestAggregationPolicies_Show(t *testing.T) {
+	// custom:begin ShowAggregationPolicyOptions: default options
+	// Minimal valid ShowAggregationPolicyOptions
+	defaultOpts := func() *ShowAggregationPolicyOptions {
+		return &ShowAggregationPolicyOptions{}
+	}
+	// custom:end ShowAggregationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowAggregationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AGGREGATION POLICIES")
+		// custom:end ShowAggregationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowAggregationPolicyOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AGGREGATION POLICIES LIKE 'some pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+		// custom:end ShowAggregationPolicyOptions: all options
+	})
+
+	// custom:begin ShowAggregationPolicyOptions: additional test cases
+	// custom:end ShowAggregationPolicyOptions: additional test cases
+}
+
+func TestAggregationPolicies_Describe(t *testing.T) {
+	// custom:begin DescribeAggregationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeAggregationPolicyOptions
+	defaultOpts := func() *DescribeAggregationPolicyOptions {
+		return &DescribeAggregationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeAggregationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeAggregationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeAggregationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeAggregationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeAggregationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AGGREGATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeAggregationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeAggregationPolicyOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AGGREGATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeAggregationPolicyOptions: all options
+	})
+
+	// custom:begin DescribeAggregationPolicyOptions: additional test cases
+	// custom:end DescribeAggregationPolicyOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ AggregationPolicies = (*aggregationPolicies)(nil)
+
+type aggregationPolicies struct {
+	client *Client
+}
+
+func (v *aggregationPolicies) Create(ctx context.Context, request *CreateAggregationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) Alter(ctx context.Context, request *AlterAggregationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) Drop(ctx context.Context, request *DropAggregationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) Show(ctx context.Context, request *ShowAggregationPolicyRequest) ([]AggregationPolicy, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showAggregationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showAggregationPolicyDBRow, AggregationPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *aggregationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error) {
+	// custom:begin ShowByID
+	aggregationPolicies, err := v.Show(ctx, NewShowAggregationPolicyRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(aggregationPolicies, func(r AggregationPolicy) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *aggregationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicyDescription, error) {
+	opts := &DescribeAggregationPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeAggregationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateAggregationPolicyRequest) toOpts() *CreateAggregationPolicyOptions {
+	opts := &CreateAggregationPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterAggregationPolicyRequest) toOpts() *AlterAggregationPolicyOptions {
+	opts := &AlterAggregationPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropAggregationPolicyRequest) toOpts() *DropAggregationPolicyOptions {
+	opts := &DropAggregationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowAggregationPolicyRequest) toOpts() *ShowAggregationPolicyOptions {
+	opts := &ShowAggregationPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showAggregationPolicyDBRow) convert() *AggregationPolicy {
+	aggregationPolicy := AggregationPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Comment:       r.Comment,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	return &aggregationPolicy
+}
+
+func (r *DescribeAggregationPolicyRequest) toOpts() *DescribeAggregationPolicyOptions {
+	opts := &DescribeAggregationPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeAggregationPolicyDBRow) convert() *AggregationPolicyDescription {
+	aggregationPolicyDescription := AggregationPolicyDescription{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+	return &aggregationPolicyDescription
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,86 @@
+package sdk
+
+var (
+	_ validatable = new(CreateAggregationPolicyOptions)
+	_ validatable = new(AlterAggregationPolicyOptions)
+	_ validatable = new(DropAggregationPolicyOptions)
+	_ validatable = new(ShowAggregationPolicyOptions)
+	_ validatable = new(DescribeAggregationPolicyOptions)
+)
+
+func (opts *CreateAggregationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.body) {
+		errs = append(errs, errNotSet("CreateAggregationPolicyOptions", "body"))
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateAggregationPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateAggregationPolicyOptions: additional validations
+	// custom:end CreateAggregationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterAggregationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.SetBody, opts.SetTags, opts.UnsetTags, opts.SetComment, opts.UnsetComment) {
+		errs = append(errs, errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	}
+	// custom:begin AlterAggregationPolicyOptions: additional validations
+	// custom:end AlterAggregationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropAggregationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropAggregationPolicyOptions: additional validations
+	// custom:end DropAggregationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowAggregationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowAggregationPolicyOptions: additional validations
+	// custom:end ShowAggregationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeAggregationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeAggregationPolicyOptions: additional validations
+	// custom:end DescribeAggregationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,45 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// ApplicationPackageVersionsDef covers the versions and patches of an application package, they can be only listed.
+// Versions and patches are added and dropped with ALTER APPLICATION PACKAGE (see ApplicationPackagesDef).
+var ApplicationPackageVersionsDef = g.NewInterface(
+	"ApplicationPackageVersions",
+	"ApplicationPackageVersion",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-versions",
+		g.DbStruct("applicationPackageVersionRow").
+			Text("version").
+			Number("patch").
+			OptionalText("label").
+			OptionalText("comment").
+			Text("created_on").
+			OptionalText("dropped_on").
+			OptionalText("log_level").
+			OptionalText("trace_level").
+			Text("state").
+			OptionalText("review_status"),
+		g.PlainStruct("ApplicationPackageVersion").
+			DeriveMapping().
+			Text("Version").
+			Number("Patch").
+			OptionalText("Label").
+			OptionalText("Comment").
+			Text("CreatedOn").
+			OptionalText("DroppedOn").
+			OptionalText("LogLevel").
+			OptionalText("TraceLevel").
+			Text("State").
+			OptionalText("ReviewStatus"),
+		g.NewQueryStruct("ShowApplicationPackageVersions").
+			Show().
+			SQL("VERSIONS").
+			OptionalLike().
+			Identifier("InApplicationPackage", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("IN APPLICATION PACKAGE").Required()).
+			WithValidation(g.ValidIdentifier, "InApplicationPackage"),
+	)
@@ -0,0 +1,12 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[ShowApplicationPackageVersionOptions] = new(ShowApplicationPackageVersionRequest)
+)
+
+type ShowApplicationPackageVersionRequest struct {
+	Like                 *Like
+	InApplicationPackage AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ApplicationPackageVersions interface {
+	Show(ctx context.Context, request *ShowApplicationPackageVersionRequest) ([]ApplicationPackageVersion, error)
+}
+
+// ShowApplicationPackageVersionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-versions.
+type ShowApplicationPackageVersionOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	versions             bool                    `ddl:"static" sql:"VERSIONS"`
+	Like                 *Like                   `ddl:"keyword" sql:"LIKE"`
+	InApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"IN APPLICATION PACKAGE"`
+}
+
+type applicationPackageVersionRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    string         `db:"created_on"`
+	DroppedOn    sql.NullString `db:"dropped_on"`
+	LogLevel     sql.NullString `db:"log_level"`
+	TraceLevel   sql.NullString `db:"trace_level"`
+	State        string         `db:"state"`
+	ReviewStatus sql.NullString `db:"review_status"`
+}
+
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        *string
+	Comment      *string
+	CreatedOn    string
+	DroppedOn    *string
+	LogLevel     *string
+	TraceLevel   *string
+	State        string
+	ReviewStatus *string
+}
+
+// custom:begin additional
+// custom:end additional
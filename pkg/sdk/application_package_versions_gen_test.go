@@ -0,0 +1,50 @@
+package sdk
+
+import "testing"
+
+func TestApplicationPackageVersions_Show(t *testing.T) {
+	// custom:begin ShowApplicationPackageVersionOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid ShowApplicationPackageVersionOptions
+	defaultOpts := func() *ShowApplicationPackageVersionOptions {
+		return &ShowApplicationPackageVersionOptions{
+			InApplicationPackage: id,
+		}
+	}
+	// custom:end ShowApplicationPackageVersionOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowApplicationPackageVersionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InApplicationPackage]", func(t *testing.T) {
+		// custom:begin ShowApplicationPackageVersionOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InApplicationPackage = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowApplicationPackageVersionOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowApplicationPackageVersionOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW VERSIONS IN APPLICATION PACKAGE %s", id.FullyQualifiedName())
+		// custom:end ShowApplicationPackageVersionOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowApplicationPackageVersionOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW VERSIONS LIKE 'some pattern' IN APPLICATION PACKAGE %s", id.FullyQualifiedName())
+		// custom:end ShowApplicationPackageVersionOptions: all options
+	})
+
+	// custom:begin ShowApplicationPackageVersionOptions: additional test cases
+	// custom:end ShowApplicationPackageVersionOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
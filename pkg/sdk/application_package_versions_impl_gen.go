@@ -0,0 +1,61 @@
+package sdk
+
+import "context"
+
+var _ ApplicationPackageVersions = (*applicationPackageVersions)(nil)
+
+type applicationPackageVersions struct {
+	client *Client
+}
+
+func (v *applicationPackageVersions) Show(ctx context.Context, request *ShowApplicationPackageVersionRequest) ([]ApplicationPackageVersion, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageVersionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageVersionRow, ApplicationPackageVersion](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowApplicationPackageVersionRequest) toOpts() *ShowApplicationPackageVersionOptions {
+	opts := &ShowApplicationPackageVersionOptions{
+		Like:                 r.Like,
+		InApplicationPackage: r.InApplicationPackage,
+	}
+	return opts
+}
+
+func (r applicationPackageVersionRow) convert() *ApplicationPackageVersion {
+	applicationPackageVersion := ApplicationPackageVersion{
+		Version:   r.Version,
+		Patch:     r.Patch,
+		CreatedOn: r.CreatedOn,
+		State:     r.State,
+	}
+	if r.Label.Valid {
+		applicationPackageVersion.Label = String(r.Label.String)
+	}
+	if r.Comment.Valid {
+		applicationPackageVersion.Comment = String(r.Comment.String)
+	}
+	if r.DroppedOn.Valid {
+		applicationPackageVersion.DroppedOn = String(r.DroppedOn.String)
+	}
+	if r.LogLevel.Valid {
+		applicationPackageVersion.LogLevel = String(r.LogLevel.String)
+	}
+	if r.TraceLevel.Valid {
+		applicationPackageVersion.TraceLevel = String(r.TraceLevel.String)
+	}
+	if r.ReviewStatus.Valid {
+		applicationPackageVersion.ReviewStatus = String(r.ReviewStatus.String)
+	}
+	return &applicationPackageVersion
+}
+
+// custom:begin additional
+// custom:end additional
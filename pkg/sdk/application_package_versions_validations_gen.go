@@ -0,0 +1,21 @@
+package sdk
+
+var (
+	_ validatable = new(ShowApplicationPackageVersionOptions)
+)
+
+func (opts *ShowApplicationPackageVersionOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.InApplicationPackage) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowApplicationPackageVersionOptions: additional validations
+	// custom:end ShowApplicationPackageVersionOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,196 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type AuthenticationMethodsOption string
+
+const (
+	AuthenticationMethodsAll      AuthenticationMethodsOption = "ALL"
+	AuthenticationMethodsSaml     AuthenticationMethodsOption = "SAML"
+	AuthenticationMethodsPassword AuthenticationMethodsOption = "PASSWORD"
+	AuthenticationMethodsOauth    AuthenticationMethodsOption = "OAUTH"
+	AuthenticationMethodsKeyPair  AuthenticationMethodsOption = "KEYPAIR"
+)
+
+var AllAuthenticationMethods = []AuthenticationMethodsOption{
+	AuthenticationMethodsAll,
+	AuthenticationMethodsSaml,
+	AuthenticationMethodsPassword,
+	AuthenticationMethodsOauth,
+	AuthenticationMethodsKeyPair,
+}
+
+type MfaAuthenticationMethodsOption string
+
+const (
+	MfaAuthenticationMethodsAll      MfaAuthenticationMethodsOption = "ALL"
+	MfaAuthenticationMethodsSaml     MfaAuthenticationMethodsOption = "SAML"
+	MfaAuthenticationMethodsPassword MfaAuthenticationMethodsOption = "PASSWORD"
+)
+
+var AllMfaAuthenticationMethods = []MfaAuthenticationMethodsOption{
+	MfaAuthenticationMethodsAll,
+	MfaAuthenticationMethodsSaml,
+	MfaAuthenticationMethodsPassword,
+}
+
+type MfaEnrollmentOption string
+
+const (
+	MfaEnrollmentRequired MfaEnrollmentOption = "REQUIRED"
+	MfaEnrollmentOptional MfaEnrollmentOption = "OPTIONAL"
+)
+
+var AllMfaEnrollmentOptions = []MfaEnrollmentOption{
+	MfaEnrollmentRequired,
+	MfaEnrollmentOptional,
+}
+
+type ClientTypesOption string
+
+const (
+	ClientTypesAll         ClientTypesOption = "ALL"
+	ClientTypesSnowflakeUi ClientTypesOption = "SNOWFLAKE_UI"
+	ClientTypesDrivers     ClientTypesOption = "DRIVERS"
+	ClientTypesSnowSql     ClientTypesOption = "SNOWSQL"
+)
+
+var AllClientTypes = []ClientTypesOption{
+	ClientTypesAll,
+	ClientTypesSnowflakeUi,
+	ClientTypesDrivers,
+	ClientTypesSnowSql,
+}
+
+var (
+	authenticationMethodsDef = g.NewQueryStruct("AuthenticationMethods").
+					PredefinedQueryStructField("Method", "AuthenticationMethodsOption", g.KeywordOptions().SingleQuotes().Required())
+	mfaAuthenticationMethodsDef = g.NewQueryStruct("MfaAuthenticationMethods").
+					PredefinedQueryStructField("Method", "MfaAuthenticationMethodsOption", g.KeywordOptions().SingleQuotes().Required())
+	clientTypesDef = g.NewQueryStruct("ClientTypes").
+			PredefinedQueryStructField("ClientType", "ClientTypesOption", g.KeywordOptions().SingleQuotes().Required())
+	securityIntegrationsOptionDef = g.NewQueryStruct("SecurityIntegrationsOption").
+					Text("Name", g.KeywordOptions().SingleQuotes().Required())
+)
+
+var AuthenticationPoliciesDef = g.NewInterface(
+	"AuthenticationPolicies",
+	"AuthenticationPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-authentication-policy",
+		g.NewQueryStruct("CreateAuthenticationPolicy").
+			Create().
+			OrReplace().
+			SQL("AUTHENTICATION POLICY").
+			IfNotExists().
+			Name().
+			ListAssignment("AUTHENTICATION_METHODS", "AuthenticationMethods", g.ParameterOptions().Parentheses()).
+			ListAssignment("MFA_AUTHENTICATION_METHODS", "MfaAuthenticationMethods", g.ParameterOptions().Parentheses()).
+			PredefinedQueryStructField("MfaEnrollment", "*MfaEnrollmentOption", g.ParameterOptions().SQL("MFA_ENROLLMENT")).
+			ListAssignment("CLIENT_TYPES", "ClientTypes", g.ParameterOptions().Parentheses()).
+			ListAssignment("SECURITY_INTEGRATIONS", "SecurityIntegrationsOption", g.ParameterOptions().Parentheses()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		authenticationMethodsDef,
+		mfaAuthenticationMethodsDef,
+		clientTypesDef,
+		securityIntegrationsOptionDef,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-authentication-policy",
+		g.NewQueryStruct("AlterAuthenticationPolicy").
+			Alter().
+			SQL("AUTHENTICATION POLICY").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("AuthenticationPolicySet").
+					ListAssignment("AUTHENTICATION_METHODS", "AuthenticationMethods", g.ParameterOptions().Parentheses()).
+					ListAssignment("MFA_AUTHENTICATION_METHODS", "MfaAuthenticationMethods", g.ParameterOptions().Parentheses()).
+					PredefinedQueryStructField("MfaEnrollment", "*MfaEnrollmentOption", g.ParameterOptions().SQL("MFA_ENROLLMENT")).
+					ListAssignment("CLIENT_TYPES", "ClientTypes", g.ParameterOptions().Parentheses()).
+					ListAssignment("SECURITY_INTEGRATIONS", "SecurityIntegrationsOption", g.ParameterOptions().Parentheses()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("AuthenticationPolicyUnset").
+					OptionalSQL("CLIENT_TYPES").
+					OptionalSQL("AUTHENTICATION_METHODS").
+					OptionalSQL("SECURITY_INTEGRATIONS").
+					OptionalSQL("MFA_AUTHENTICATION_METHODS").
+					OptionalSQL("MFA_ENROLLMENT").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "ClientTypes", "AuthenticationMethods", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-authentication-policy",
+		g.NewQueryStruct("DropAuthenticationPolicy").
+			Drop().
+			SQL("AUTHENTICATION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-authentication-policies",
+		g.DbStruct("showAuthenticationPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("comment").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			Text("owner_role_type").
+			Text("options"),
+		g.PlainStruct("AuthenticationPolicy").
+			DeriveMapping().
+			Text("CreatedOn").
+			Text("Name").
+			Text("Comment").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			Text("OwnerRoleType").
+			Text("Options"),
+		g.NewQueryStruct("ShowAuthenticationPolicies").
+			Show().
+			SQL("AUTHENTICATION POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-authentication-policy",
+		g.DbStruct("describeAuthenticationPolicyDBRow").
+			Text("property").
+			Text("value").
+			Text("default").
+			Text("description"),
+		g.PlainStruct("AuthenticationPolicyDescription").
+			DeriveMapping().
+			Text("Property").
+			Text("Value").
+			Text("Default").
+			Text("Description"),
+		g.NewQueryStruct("DescribeAuthenticationPolicy").
+			Describe().
+			SQL("AUTHENTICATION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
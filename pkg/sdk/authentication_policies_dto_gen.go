@@ -0,0 +1,63 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateAuthenticationPolicyOptions]   = new(CreateAuthenticationPolicyRequest)
+	_ optionsProvider[AlterAuthenticationPolicyOptions]    = new(AlterAuthenticationPolicyRequest)
+	_ optionsProvider[DropAuthenticationPolicyOptions]     = new(DropAuthenticationPolicyRequest)
+	_ optionsProvider[ShowAuthenticationPolicyOptions]     = new(ShowAuthenticationPolicyRequest)
+	_ optionsProvider[DescribeAuthenticationPolicyOptions] = new(DescribeAuthenticationPolicyRequest)
+)
+
+type CreateAuthenticationPolicyRequest struct {
+	OrReplace                *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists              *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	AuthenticationMethods    []AuthenticationMethods
+	MfaAuthenticationMethods []MfaAuthenticationMethods
+	MfaEnrollment            *MfaEnrollmentOption
+	ClientTypes              []ClientTypes
+	SecurityIntegrations     []SecurityIntegrationsOption
+	Comment                  *string
+}
+
+type AlterAuthenticationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier            `validate:"validIdentifier"` // required
+	Set      *AuthenticationPolicySetRequest   `validate:"exactlyOneValueSet=Set|Unset|RenameTo"`
+	Unset    *AuthenticationPolicyUnsetRequest `validate:"exactlyOneValueSet=Set|Unset|RenameTo"`
+	RenameTo *SchemaObjectIdentifier           `validate:"validIdentifierIfSet,exactlyOneValueSet=Set|Unset|RenameTo"`
+}
+
+type AuthenticationPolicySetRequest struct {
+	AuthenticationMethods    []AuthenticationMethods      `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+	MfaAuthenticationMethods []MfaAuthenticationMethods   `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+	MfaEnrollment            *MfaEnrollmentOption         `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+	ClientTypes              []ClientTypes                `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+	SecurityIntegrations     []SecurityIntegrationsOption `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+	Comment                  *string                      `validate:"atLeastOneValueSet=AuthenticationMethods|MfaAuthenticationMethods|MfaEnrollment|ClientTypes|SecurityIntegrations|Comment"`
+}
+
+type AuthenticationPolicyUnsetRequest struct {
+	ClientTypes              *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+	AuthenticationMethods    *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+	SecurityIntegrations     *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+	MfaAuthenticationMethods *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+	MfaEnrollment            *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+	Comment                  *bool `validate:"atLeastOneValueSet=ClientTypes|AuthenticationMethods|SecurityIntegrations|MfaAuthenticationMethods|MfaEnrollment|Comment"`
+}
+
+type DropAuthenticationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowAuthenticationPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeAuthenticationPolicyRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
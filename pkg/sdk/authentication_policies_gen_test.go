@@ -0,0 +1,296 @@
+package sdk
+
+import "testing"
+
+func TestAuthenticationPolicies_Create(t *testing.T) {
+	// custom:begin CreateAuthenticationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateAuthenticationPolicyOptions
+	defaultOpts := func() *CreateAuthenticationPolicyOptions {
+		return &CreateAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end CreateAuthenticationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateAuthenticationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateAuthenticationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateAuthenticationPolicyOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAuthenticationPolicyOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateAuthenticationPolicyOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateAuthenticationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+		// custom:end CreateAuthenticationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateAuthenticationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.AuthenticationMethods = []AuthenticationMethods{{Method: AuthenticationMethodsPassword}, {Method: AuthenticationMethodsSaml}}
+		opts.MfaAuthenticationMethods = []MfaAuthenticationMethods{{Method: MfaAuthenticationMethodsPassword}}
+		opts.MfaEnrollment = Pointer(MfaEnrollmentRequired)
+		opts.ClientTypes = []ClientTypes{{ClientType: ClientTypesSnowflakeUi}, {ClientType: ClientTypesDrivers}}
+		opts.SecurityIntegrations = []SecurityIntegrationsOption{{Name: "my_saml_integration"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AUTHENTICATION POLICY %s AUTHENTICATION_METHODS = ('PASSWORD', 'SAML') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = REQUIRED CLIENT_TYPES = ('SNOWFLAKE_UI', 'DRIVERS') SECURITY_INTEGRATIONS = ('my_saml_integration') COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateAuthenticationPolicyOptions: all options
+	})
+
+	// custom:begin CreateAuthenticationPolicyOptions: additional test cases
+	t.Run("if not exists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.AuthenticationMethods = []AuthenticationMethods{{Method: AuthenticationMethodsKeyPair}}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AUTHENTICATION POLICY IF NOT EXISTS %s AUTHENTICATION_METHODS = ('KEYPAIR')", id.FullyQualifiedName())
+	})
+	// custom:end CreateAuthenticationPolicyOptions: additional test cases
+}
+
+func TestAuthenticationPolicies_Alter(t *testing.T) {
+	// custom:begin AlterAuthenticationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterAuthenticationPolicyOptions
+	defaultOpts := func() *AlterAuthenticationPolicyOptions {
+		return &AlterAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterAuthenticationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &AuthenticationPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterAuthenticationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterAuthenticationPolicyOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RenameTo] should be present", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterAuthenticationPolicyOptions", "Set", "Unset", "RenameTo"))
+		// custom:end AlterAuthenticationPolicyOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.AuthenticationMethods opts.Set.MfaAuthenticationMethods opts.Set.MfaEnrollment opts.Set.ClientTypes opts.Set.SecurityIntegrations opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+		// custom:end AlterAuthenticationPolicyOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.ClientTypes opts.Unset.AuthenticationMethods opts.Unset.SecurityIntegrations opts.Unset.MfaAuthenticationMethods opts.Unset.MfaEnrollment opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &AuthenticationPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "Comment"))
+		// custom:end AlterAuthenticationPolicyOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions: basic
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{Comment: String("some comment")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterAuthenticationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterAuthenticationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &AuthenticationPolicySet{
+			AuthenticationMethods:    []AuthenticationMethods{{Method: AuthenticationMethodsAll}},
+			MfaAuthenticationMethods: []MfaAuthenticationMethods{{Method: MfaAuthenticationMethodsSaml}, {Method: MfaAuthenticationMethodsPassword}},
+			MfaEnrollment:            Pointer(MfaEnrollmentOptional),
+			ClientTypes:              []ClientTypes{{ClientType: ClientTypesSnowSql}},
+			SecurityIntegrations:     []SecurityIntegrationsOption{{Name: "ALL"}},
+			Comment:                  String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s SET AUTHENTICATION_METHODS = ('ALL') MFA_AUTHENTICATION_METHODS = ('SAML', 'PASSWORD') MFA_ENROLLMENT = OPTIONAL CLIENT_TYPES = ('SNOWSQL') SECURITY_INTEGRATIONS = ('ALL') COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterAuthenticationPolicyOptions: all options
+	})
+
+	// custom:begin AlterAuthenticationPolicyOptions: additional test cases
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &AuthenticationPolicyUnset{
+			ClientTypes:              Bool(true),
+			AuthenticationMethods:    Bool(true),
+			SecurityIntegrations:     Bool(true),
+			MfaAuthenticationMethods: Bool(true),
+			MfaEnrollment:            Bool(true),
+			Comment:                  Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s UNSET CLIENT_TYPES, AUTHENTICATION_METHODS, SECURITY_INTEGRATIONS, MFA_AUTHENTICATION_METHODS, MFA_ENROLLMENT, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+	// custom:end AlterAuthenticationPolicyOptions: additional test cases
+}
+
+func TestAuthenticationPolicies_Drop(t *testing.T) {
+	// custom:begin DropAuthenticationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropAuthenticationPolicyOptions
+	defaultOpts := func() *DropAuthenticationPolicyOptions {
+		return &DropAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DropAuthenticationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropAuthenticationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropAuthenticationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropAuthenticationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DropAuthenticationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropAuthenticationPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP AUTHENTICATION POLICY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropAuthenticationPolicyOptions: all options
+	})
+
+	// custom:begin DropAuthenticationPolicyOptions: additional test cases
+	// custom:end DropAuthenticationPolicyOptions: additional test cases
+}
+
+func TestAuthenticationPolicies_Show(t *testing.T) {
+	// custom:begin ShowAuthenticationPolicyOptions: default options
+	// Minimal valid ShowAuthenticationPolicyOptions
+	defaultOpts := func() *ShowAuthenticationPolicyOptions {
+		return &ShowAuthenticationPolicyOptions{}
+	}
+	// custom:end ShowAuthenticationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowAuthenticationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AUTHENTICATION POLICIES")
+		// custom:end ShowAuthenticationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowAuthenticationPolicyOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AUTHENTICATION POLICIES LIKE 'some pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+		// custom:end ShowAuthenticationPolicyOptions: all options
+	})
+
+	// custom:begin ShowAuthenticationPolicyOptions: additional test cases
+	// custom:end ShowAuthenticationPolicyOptions: additional test cases
+}
+
+func TestAuthenticationPolicies_Describe(t *testing.T) {
+	// custom:begin DescribeAuthenticationPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeAuthenticationPolicyOptions
+	defaultOpts := func() *DescribeAuthenticationPolicyOptions {
+		return &DescribeAuthenticationPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeAuthenticationPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeAuthenticationPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeAuthenticationPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeAuthenticationPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeAuthenticationPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeAuthenticationPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeAuthenticationPolicyOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeAuthenticationPolicyOptions: all options
+	})
+
+	// custom:begin DescribeAuthenticationPolicyOptions: additional test cases
+	// custom:end DescribeAuthenticationPolicyOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,167 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ AuthenticationPolicies = (*authenticationPolicies)(nil)
+
+type authenticationPolicies struct {
+	client *Client
+}
+
+func (v *authenticationPolicies) Create(ctx context.Context, request *CreateAuthenticationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Alter(ctx context.Context, request *AlterAuthenticationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Drop(ctx context.Context, request *DropAuthenticationPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *authenticationPolicies) Show(ctx context.Context, request *ShowAuthenticationPolicyRequest) ([]AuthenticationPolicy, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showAuthenticationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showAuthenticationPolicyDBRow, AuthenticationPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *authenticationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicy, error) {
+	// custom:begin ShowByID
+	authenticationPolicies, err := v.Show(ctx, NewShowAuthenticationPolicyRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(authenticationPolicies, func(r AuthenticationPolicy) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *authenticationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]AuthenticationPolicyDescription, error) {
+	opts := &DescribeAuthenticationPolicyOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[describeAuthenticationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[describeAuthenticationPolicyDBRow, AuthenticationPolicyDescription](rows), nil
+}
+
+func (r *CreateAuthenticationPolicyRequest) toOpts() *CreateAuthenticationPolicyOptions {
+	opts := &CreateAuthenticationPolicyOptions{
+		OrReplace:                r.OrReplace,
+		IfNotExists:              r.IfNotExists,
+		name:                     r.name,
+		AuthenticationMethods:    r.AuthenticationMethods,
+		MfaAuthenticationMethods: r.MfaAuthenticationMethods,
+		MfaEnrollment:            r.MfaEnrollment,
+		ClientTypes:              r.ClientTypes,
+		SecurityIntegrations:     r.SecurityIntegrations,
+		Comment:                  r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterAuthenticationPolicyRequest) toOpts() *AlterAuthenticationPolicyOptions {
+	opts := &AlterAuthenticationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		RenameTo: r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &AuthenticationPolicySet{
+			AuthenticationMethods:    r.Set.AuthenticationMethods,
+			MfaAuthenticationMethods: r.Set.MfaAuthenticationMethods,
+			MfaEnrollment:            r.Set.MfaEnrollment,
+			ClientTypes:              r.Set.ClientTypes,
+			SecurityIntegrations:     r.Set.SecurityIntegrations,
+			Comment:                  r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &AuthenticationPolicyUnset{
+			ClientTypes:              r.Unset.ClientTypes,
+			AuthenticationMethods:    r.Unset.AuthenticationMethods,
+			SecurityIntegrations:     r.Unset.SecurityIntegrations,
+			MfaAuthenticationMethods: r.Unset.MfaAuthenticationMethods,
+			MfaEnrollment:            r.Unset.MfaEnrollment,
+			Comment:                  r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropAuthenticationPolicyRequest) toOpts() *DropAuthenticationPolicyOptions {
+	opts := &DropAuthenticationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowAuthenticationPolicyRequest) toOpts() *ShowAuthenticationPolicyOptions {
+	opts := &ShowAuthenticationPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showAuthenticationPolicyDBRow) convert() *AuthenticationPolicy {
+	authenticationPolicy := AuthenticationPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		Comment:       r.Comment,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Owner:         r.Owner,
+		OwnerRoleType: r.OwnerRoleType,
+		Options:       r.Options,
+	}
+	return &authenticationPolicy
+}
+
+func (r *DescribeAuthenticationPolicyRequest) toOpts() *DescribeAuthenticationPolicyOptions {
+	opts := &DescribeAuthenticationPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeAuthenticationPolicyDBRow) convert() *AuthenticationPolicyDescription {
+	authenticationPolicyDescription := AuthenticationPolicyDescription{
+		Property:    r.Property,
+		Value:       r.Value,
+		Default:     r.Default,
+		Description: r.Description,
+	}
+	return &authenticationPolicyDescription
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,93 @@
+package sdk
+
+var (
+	_ validatable = new(CreateAuthenticationPolicyOptions)
+	_ validatable = new(AlterAuthenticationPolicyOptions)
+	_ validatable = new(DropAuthenticationPolicyOptions)
+	_ validatable = new(ShowAuthenticationPolicyOptions)
+	_ validatable = new(DescribeAuthenticationPolicyOptions)
+)
+
+func (opts *CreateAuthenticationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateAuthenticationPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateAuthenticationPolicyOptions: additional validations
+	// custom:end CreateAuthenticationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterAuthenticationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("AlterAuthenticationPolicyOptions", "Set", "Unset", "RenameTo"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.AuthenticationMethods, opts.Set.MfaAuthenticationMethods, opts.Set.MfaEnrollment, opts.Set.ClientTypes, opts.Set.SecurityIntegrations, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.ClientTypes, opts.Unset.AuthenticationMethods, opts.Unset.SecurityIntegrations, opts.Unset.MfaAuthenticationMethods, opts.Unset.MfaEnrollment, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "Comment"))
+		}
+	}
+	// custom:begin AlterAuthenticationPolicyOptions: additional validations
+	// custom:end AlterAuthenticationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropAuthenticationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropAuthenticationPolicyOptions: additional validations
+	// custom:end DropAuthenticationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowAuthenticationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowAuthenticationPolicyOptions: additional validations
+	// custom:end ShowAuthenticationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeAuthenticationPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeAuthenticationPolicyOptions: additional validations
+	// custom:end DescribeAuthenticationPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
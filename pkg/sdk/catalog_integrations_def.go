@@ -0,0 +1,230 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type CatalogIntegrationCatalogSource string
+
+var (
+	CatalogIntegrationCatalogSourceGlue        CatalogIntegrationCatalogSource = "GLUE"
+	CatalogIntegrationCatalogSourceObjectStore CatalogIntegrationCatalogSource = "OBJECT_STORE"
+	CatalogIntegrationCatalogSourcePolaris     CatalogIntegrationCatalogSource = "POLARIS"
+	CatalogIntegrationCatalogSourceIcebergRest CatalogIntegrationCatalogSource = "ICEBERG_REST"
+)
+
+type CatalogIntegrationTableFormat string
+
+var (
+	CatalogIntegrationTableFormatIceberg CatalogIntegrationTableFormat = "ICEBERG"
+	CatalogIntegrationTableFormatDelta   CatalogIntegrationTableFormat = "DELTA"
+)
+
+type CatalogIntegrationCatalogApiType string
+
+var (
+	CatalogIntegrationCatalogApiTypePublic               CatalogIntegrationCatalogApiType = "PUBLIC"
+	CatalogIntegrationCatalogApiTypeAwsApiGateway        CatalogIntegrationCatalogApiType = "AWS_API_GATEWAY"
+	CatalogIntegrationCatalogApiTypeAwsPrivateApiGateway CatalogIntegrationCatalogApiType = "AWS_PRIVATE_API_GATEWAY"
+	CatalogIntegrationCatalogApiTypeAwsGlue              CatalogIntegrationCatalogApiType = "AWS_GLUE"
+)
+
+var CatalogIntegrationOAuthScopeDef = g.NewQueryStruct("CatalogIntegrationOAuthScope").Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+
+var catalogIntegrationOAuthDef = g.NewQueryStruct("CatalogIntegrationOAuth").
+	PredefinedQueryStructField("authenticationType", "bool", g.StaticOptions().SQL("TYPE = OAUTH")).
+	OptionalTextAssignment("OAUTH_TOKEN_URI", g.ParameterOptions().SingleQuotes()).
+	TextAssignment("OAUTH_CLIENT_ID", g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes().Required()).
+	ListAssignment("OAUTH_ALLOWED_SCOPES", "CatalogIntegrationOAuthScope", g.ParameterOptions().Parentheses().Required())
+
+var catalogIntegrationIcebergRestAuthenticationDef = g.NewQueryStruct("CatalogIntegrationIcebergRestAuthentication").
+	OptionalQueryStructField("OAuth", catalogIntegrationOAuthDef, g.KeywordOptions()).
+	OptionalQueryStructField(
+		"Bearer",
+		g.NewQueryStruct("CatalogIntegrationBearer").
+			PredefinedQueryStructField("authenticationType", "bool", g.StaticOptions().SQL("TYPE = BEARER")).
+			TextAssignment("BEARER_TOKEN", g.ParameterOptions().SingleQuotes().Required()),
+		g.KeywordOptions(),
+	).
+	OptionalQueryStructField(
+		"SigV4",
+		g.NewQueryStruct("CatalogIntegrationSigV4").
+			PredefinedQueryStructField("authenticationType", "bool", g.StaticOptions().SQL("TYPE = SIGV4")).
+			TextAssignment("SIGV4_IAM_ROLE", g.ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("SIGV4_SIGNING_REGION", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("SIGV4_EXTERNAL_ID", g.ParameterOptions().SingleQuotes()),
+		g.KeywordOptions(),
+	).
+	WithValidation(g.ExactlyOneValueSet, "OAuth", "Bearer", "SigV4")
+
+var CatalogIntegrationsDef = g.NewInterface(
+	"CatalogIntegrations",
+	"CatalogIntegration",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration",
+		g.NewQueryStruct("CreateCatalogIntegration").
+			Create().
+			OrReplace().
+			SQL("CATALOG INTEGRATION").
+			IfNotExists().
+			Name().
+			OptionalQueryStructField(
+				"GlueCatalogSourceParams",
+				g.NewQueryStruct("GlueCatalogSourceParams").
+					PredefinedQueryStructField("catalogSource", "bool", g.StaticOptions().SQL("CATALOG_SOURCE = GLUE")).
+					PredefinedQueryStructField("tableFormat", "bool", g.StaticOptions().SQL("TABLE_FORMAT = ICEBERG")).
+					TextAssignment("GLUE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
+					TextAssignment("GLUE_CATALOG_ID", g.ParameterOptions().SingleQuotes().Required()).
+					OptionalTextAssignment("GLUE_REGION", g.ParameterOptions().SingleQuotes()).
+					OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()),
+				g.KeywordOptions(),
+			).
+			OptionalQueryStructField(
+				"ObjectStoreCatalogSourceParams",
+				g.NewQueryStruct("ObjectStoreCatalogSourceParams").
+					PredefinedQueryStructField("catalogSource", "bool", g.StaticOptions().SQL("CATALOG_SOURCE = OBJECT_STORE")).
+					Assignment("TABLE_FORMAT", g.KindOfT[CatalogIntegrationTableFormat](), g.ParameterOptions().NoQuotes().Required()),
+				g.KeywordOptions(),
+			).
+			OptionalQueryStructField(
+				"PolarisCatalogSourceParams",
+				g.NewQueryStruct("PolarisCatalogSourceParams").
+					PredefinedQueryStructField("catalogSource", "bool", g.StaticOptions().SQL("CATALOG_SOURCE = POLARIS")).
+					PredefinedQueryStructField("tableFormat", "bool", g.StaticOptions().SQL("TABLE_FORMAT = ICEBERG")).
+					OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+					QueryStructField(
+						"RestConfig",
+						g.NewQueryStruct("PolarisRestConfig").
+							TextAssignment("CATALOG_URI", g.ParameterOptions().SingleQuotes().Required()).
+							TextAssignment("CATALOG_NAME", g.ParameterOptions().SingleQuotes().Required()),
+						g.ListOptions().Parentheses().NoComma().SQL("REST_CONFIG =").Required(),
+					).
+					QueryStructField(
+						"RestAuthentication",
+						catalogIntegrationOAuthDef,
+						g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION =").Required(),
+					),
+				g.KeywordOptions(),
+			).
+			OptionalQueryStructField(
+				"IcebergRestCatalogSourceParams",
+				g.NewQueryStruct("IcebergRestCatalogSourceParams").
+					PredefinedQueryStructField("catalogSource", "bool", g.StaticOptions().SQL("CATALOG_SOURCE = ICEBERG_REST")).
+					PredefinedQueryStructField("tableFormat", "bool", g.StaticOptions().SQL("TABLE_FORMAT = ICEBERG")).
+					OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+					QueryStructField(
+						"RestConfig",
+						g.NewQueryStruct("IcebergRestRestConfig").
+							TextAssignment("CATALOG_URI", g.ParameterOptions().SingleQuotes().Required()).
+							OptionalTextAssignment("PREFIX", g.ParameterOptions().SingleQuotes()).
+							OptionalTextAssignment("CATALOG_NAME", g.ParameterOptions().SingleQuotes()).
+							OptionalAssignment("CATALOG_API_TYPE", g.KindOfTPointer[CatalogIntegrationCatalogApiType](), g.ParameterOptions().NoQuotes()),
+						g.ListOptions().Parentheses().NoComma().SQL("REST_CONFIG =").Required(),
+					).
+					QueryStructField(
+						"RestAuthentication",
+						catalogIntegrationIcebergRestAuthenticationDef,
+						g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION =").Required(),
+					),
+				g.KeywordOptions(),
+			).
+			BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+			OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ExactlyOneValueSet, "GlueCatalogSourceParams", "ObjectStoreCatalogSourceParams", "PolarisCatalogSourceParams", "IcebergRestCatalogSourceParams"),
+		CatalogIntegrationOAuthScopeDef,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-catalog-integration",
+		g.NewQueryStruct("AlterCatalogIntegration").
+			Alter().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("CatalogIntegrationSet").
+					OptionalNumberAssignment("REFRESH_INTERVAL_SECONDS", g.ParameterOptions()).
+					OptionalQueryStructField(
+						"RestAuthentication",
+						g.NewQueryStruct("CatalogIntegrationSetRestAuthentication").
+							OptionalTextAssignment("OAUTH_CLIENT_SECRET", g.ParameterOptions().SingleQuotes()).
+							OptionalTextAssignment("BEARER_TOKEN", g.ParameterOptions().SingleQuotes()).
+							WithValidation(g.ExactlyOneValueSet, "OauthClientSecret", "BearerToken"),
+						g.ListOptions().Parentheses().NoComma().SQL("REST_AUTHENTICATION ="),
+					).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "RefreshIntervalSeconds", "RestAuthentication", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("CatalogIntegrationUnset").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "IfExists", "SetTags").
+			WithValidation(g.ConflictingFields, "IfExists", "UnsetTags").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-catalog-integration",
+		g.NewQueryStruct("DropCatalogIntegration").
+			Drop().
+			SQL("CATALOG INTEGRATION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-catalog-integrations",
+		g.DbStruct("showCatalogIntegrationsDbRow").
+			Text("name").
+			Text("type").
+			Text("category").
+			Bool("enabled").
+			OptionalText("comment").
+			Time("created_on"),
+		g.PlainStruct("CatalogIntegration").
+			DeriveMapping().
+			Text("Name").
+			Text("Type").
+			Text("Category").
+			Bool("Enabled").
+			OptionalText("Comment").
+			Time("CreatedOn"),
+		g.NewQueryStruct("ShowCatalogIntegrations").
+			Show().
+			SQL("CATALOG INTEGRATIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-catalog-integration",
+		g.DbStruct("descCatalogIntegrationsDbRow").
+			Text("property").
+			Text("property_type").
+			Text("property_value").
+			Text("property_default"),
+		g.PlainStruct("CatalogIntegrationProperty").
+			DeriveMapping().
+			FieldFrom("Name", "string", "property").
+			FieldFrom("Type", "string", "property_type").
+			FieldFrom("Value", "string", "property_value").
+			FieldFrom("Default", "string", "property_default"),
+		g.NewQueryStruct("DescribeCatalogIntegration").
+			Describe().
+			SQL("CATALOG INTEGRATION").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,119 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateCatalogIntegrationOptions]   = new(CreateCatalogIntegrationRequest)
+	_ optionsProvider[AlterCatalogIntegrationOptions]    = new(AlterCatalogIntegrationRequest)
+	_ optionsProvider[DropCatalogIntegrationOptions]     = new(DropCatalogIntegrationRequest)
+	_ optionsProvider[ShowCatalogIntegrationOptions]     = new(ShowCatalogIntegrationRequest)
+	_ optionsProvider[DescribeCatalogIntegrationOptions] = new(DescribeCatalogIntegrationRequest)
+)
+
+type CreateCatalogIntegrationRequest struct {
+	OrReplace                      *bool                                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                    *bool                                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                           AccountObjectIdentifier                `validate:"validIdentifier"` // required
+	GlueCatalogSourceParams        *GlueCatalogSourceParamsRequest        `validate:"exactlyOneValueSet=GlueCatalogSourceParams|ObjectStoreCatalogSourceParams|PolarisCatalogSourceParams|IcebergRestCatalogSourceParams"`
+	ObjectStoreCatalogSourceParams *ObjectStoreCatalogSourceParamsRequest `validate:"exactlyOneValueSet=GlueCatalogSourceParams|ObjectStoreCatalogSourceParams|PolarisCatalogSourceParams|IcebergRestCatalogSourceParams"`
+	PolarisCatalogSourceParams     *PolarisCatalogSourceParamsRequest     `validate:"exactlyOneValueSet=GlueCatalogSourceParams|ObjectStoreCatalogSourceParams|PolarisCatalogSourceParams|IcebergRestCatalogSourceParams"`
+	IcebergRestCatalogSourceParams *IcebergRestCatalogSourceParamsRequest `validate:"exactlyOneValueSet=GlueCatalogSourceParams|ObjectStoreCatalogSourceParams|PolarisCatalogSourceParams|IcebergRestCatalogSourceParams"`
+	Enabled                        bool                                   // required
+	RefreshIntervalSeconds         *int
+	Comment                        *string
+}
+
+type GlueCatalogSourceParamsRequest struct {
+	GlueAwsRoleArn   string // required
+	GlueCatalogId    string // required
+	GlueRegion       *string
+	CatalogNamespace *string
+}
+
+type ObjectStoreCatalogSourceParamsRequest struct {
+	TableFormat CatalogIntegrationTableFormat // required
+}
+
+type PolarisCatalogSourceParamsRequest struct {
+	CatalogNamespace   *string
+	RestConfig         PolarisRestConfigRequest       // required
+	RestAuthentication CatalogIntegrationOAuthRequest // required
+}
+
+type PolarisRestConfigRequest struct {
+	CatalogUri  string // required
+	CatalogName string // required
+}
+
+type CatalogIntegrationOAuthRequest struct {
+	OauthTokenUri      *string
+	OauthClientId      string                         // required
+	OauthClientSecret  string                         // required
+	OauthAllowedScopes []CatalogIntegrationOAuthScope // required
+}
+
+type IcebergRestCatalogSourceParamsRequest struct {
+	CatalogNamespace   *string
+	RestConfig         IcebergRestRestConfigRequest                       // required
+	RestAuthentication CatalogIntegrationIcebergRestAuthenticationRequest // required
+}
+
+type IcebergRestRestConfigRequest struct {
+	CatalogUri     string // required
+	Prefix         *string
+	CatalogName    *string
+	CatalogApiType *CatalogIntegrationCatalogApiType
+}
+
+type CatalogIntegrationIcebergRestAuthenticationRequest struct {
+	OAuth  *CatalogIntegrationOAuthRequest  `validate:"exactlyOneValueSet=OAuth|Bearer|SigV4"`
+	Bearer *CatalogIntegrationBearerRequest `validate:"exactlyOneValueSet=OAuth|Bearer|SigV4"`
+	SigV4  *CatalogIntegrationSigV4Request  `validate:"exactlyOneValueSet=OAuth|Bearer|SigV4"`
+}
+
+type CatalogIntegrationBearerRequest struct {
+	BearerToken string // required
+}
+
+type CatalogIntegrationSigV4Request struct {
+	Sigv4IamRole       string // required
+	Sigv4SigningRegion *string
+	Sigv4ExternalId    *string
+}
+
+type AlterCatalogIntegrationRequest struct {
+	IfExists  *bool                           `validate:"conflictingFields=IfExists|SetTags,conflictingFields=IfExists|UnsetTags"`
+	name      AccountObjectIdentifier         `validate:"validIdentifier"` // required
+	Set       *CatalogIntegrationSetRequest   `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	Unset     *CatalogIntegrationUnsetRequest `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	SetTags   []TagAssociation                `validate:"conflictingFields=IfExists|SetTags,exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	UnsetTags []ObjectIdentifier              `validate:"conflictingFields=IfExists|UnsetTags,exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+}
+
+type CatalogIntegrationSetRequest struct {
+	RefreshIntervalSeconds *int                                            `validate:"atLeastOneValueSet=RefreshIntervalSeconds|RestAuthentication|Comment"`
+	RestAuthentication     *CatalogIntegrationSetRestAuthenticationRequest `validate:"atLeastOneValueSet=RefreshIntervalSeconds|RestAuthentication|Comment"`
+	Comment                *string                                         `validate:"atLeastOneValueSet=RefreshIntervalSeconds|RestAuthentication|Comment"`
+}
+
+type CatalogIntegrationSetRestAuthenticationRequest struct {
+	OauthClientSecret *string `validate:"exactlyOneValueSet=OauthClientSecret|BearerToken"`
+	BearerToken       *string `validate:"exactlyOneValueSet=OauthClientSecret|BearerToken"`
+}
+
+type CatalogIntegrationUnsetRequest struct {
+	Comment *bool `validate:"atLeastOneValueSet=Comment"`
+}
+
+type DropCatalogIntegrationRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowCatalogIntegrationRequest struct {
+	Like *Like
+}
+
+type DescribeCatalogIntegrationRequest struct {
+	name AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,192 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type CatalogIntegrations interface {
+	Create(ctx context.Context, request *CreateCatalogIntegrationRequest) error
+	Alter(ctx context.Context, request *AlterCatalogIntegrationRequest) error
+	Drop(ctx context.Context, request *DropCatalogIntegrationRequest) error
+	Show(ctx context.Context, request *ShowCatalogIntegrationRequest) ([]CatalogIntegration, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) ([]CatalogIntegrationProperty, error)
+}
+
+// CreateCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-catalog-integration.
+type CreateCatalogIntegrationOptions struct {
+	create                         bool                            `ddl:"static" sql:"CREATE"`
+	OrReplace                      *bool                           `ddl:"keyword" sql:"OR REPLACE"`
+	catalogIntegration             bool                            `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfNotExists                    *bool                           `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                           AccountObjectIdentifier         `ddl:"identifier"`
+	GlueCatalogSourceParams        *GlueCatalogSourceParams        `ddl:"keyword"`
+	ObjectStoreCatalogSourceParams *ObjectStoreCatalogSourceParams `ddl:"keyword"`
+	PolarisCatalogSourceParams     *PolarisCatalogSourceParams     `ddl:"keyword"`
+	IcebergRestCatalogSourceParams *IcebergRestCatalogSourceParams `ddl:"keyword"`
+	Enabled                        bool                            `ddl:"parameter" sql:"ENABLED"`
+	RefreshIntervalSeconds         *int                            `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	Comment                        *string                         `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type CatalogIntegrationOAuthScope struct {
+	Scope string `ddl:"keyword,single_quotes"`
+}
+
+type GlueCatalogSourceParams struct {
+	catalogSource    bool    `ddl:"static" sql:"CATALOG_SOURCE = GLUE"`
+	tableFormat      bool    `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"`
+	GlueAwsRoleArn   string  `ddl:"parameter,single_quotes" sql:"GLUE_AWS_ROLE_ARN"`
+	GlueCatalogId    string  `ddl:"parameter,single_quotes" sql:"GLUE_CATALOG_ID"`
+	GlueRegion       *string `ddl:"parameter,single_quotes" sql:"GLUE_REGION"`
+	CatalogNamespace *string `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+}
+
+type ObjectStoreCatalogSourceParams struct {
+	catalogSource bool                          `ddl:"static" sql:"CATALOG_SOURCE = OBJECT_STORE"`
+	TableFormat   CatalogIntegrationTableFormat `ddl:"parameter,no_quotes" sql:"TABLE_FORMAT"`
+}
+
+type PolarisCatalogSourceParams struct {
+	catalogSource      bool                    `ddl:"static" sql:"CATALOG_SOURCE = POLARIS"`
+	tableFormat        bool                    `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"`
+	CatalogNamespace   *string                 `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	RestConfig         PolarisRestConfig       `ddl:"list,parentheses,no_comma" sql:"REST_CONFIG ="`
+	RestAuthentication CatalogIntegrationOAuth `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+}
+
+type PolarisRestConfig struct {
+	CatalogUri  string `ddl:"parameter,single_quotes" sql:"CATALOG_URI"`
+	CatalogName string `ddl:"parameter,single_quotes" sql:"CATALOG_NAME"`
+}
+
+type CatalogIntegrationOAuth struct {
+	authenticationType bool                           `ddl:"static" sql:"TYPE = OAUTH"`
+	OauthTokenUri      *string                        `ddl:"parameter,single_quotes" sql:"OAUTH_TOKEN_URI"`
+	OauthClientId      string                         `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_ID"`
+	OauthClientSecret  string                         `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+	OauthAllowedScopes []CatalogIntegrationOAuthScope `ddl:"parameter,parentheses" sql:"OAUTH_ALLOWED_SCOPES"`
+}
+
+type IcebergRestCatalogSourceParams struct {
+	catalogSource      bool                                        `ddl:"static" sql:"CATALOG_SOURCE = ICEBERG_REST"`
+	tableFormat        bool                                        `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"`
+	CatalogNamespace   *string                                     `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	RestConfig         IcebergRestRestConfig                       `ddl:"list,parentheses,no_comma" sql:"REST_CONFIG ="`
+	RestAuthentication CatalogIntegrationIcebergRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+}
+
+type IcebergRestRestConfig struct {
+	CatalogUri     string                            `ddl:"parameter,single_quotes" sql:"CATALOG_URI"`
+	Prefix         *string                           `ddl:"parameter,single_quotes" sql:"PREFIX"`
+	CatalogName    *string                           `ddl:"parameter,single_quotes" sql:"CATALOG_NAME"`
+	CatalogApiType *CatalogIntegrationCatalogApiType `ddl:"parameter,no_quotes" sql:"CATALOG_API_TYPE"`
+}
+
+type CatalogIntegrationIcebergRestAuthentication struct {
+	OAuth  *CatalogIntegrationOAuth  `ddl:"keyword"`
+	Bearer *CatalogIntegrationBearer `ddl:"keyword"`
+	SigV4  *CatalogIntegrationSigV4  `ddl:"keyword"`
+}
+
+type CatalogIntegrationBearer struct {
+	authenticationType bool   `ddl:"static" sql:"TYPE = BEARER"`
+	BearerToken        string `ddl:"parameter,single_quotes" sql:"BEARER_TOKEN"`
+}
+
+type CatalogIntegrationSigV4 struct {
+	authenticationType bool    `ddl:"static" sql:"TYPE = SIGV4"`
+	Sigv4IamRole       string  `ddl:"parameter,single_quotes" sql:"SIGV4_IAM_ROLE"`
+	Sigv4SigningRegion *string `ddl:"parameter,single_quotes" sql:"SIGV4_SIGNING_REGION"`
+	Sigv4ExternalId    *string `ddl:"parameter,single_quotes" sql:"SIGV4_EXTERNAL_ID"`
+}
+
+// AlterCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-catalog-integration.
+type AlterCatalogIntegrationOptions struct {
+	alter              bool                     `ddl:"static" sql:"ALTER"`
+	catalogIntegration bool                     `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfExists           *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier  `ddl:"identifier"`
+	Set                *CatalogIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset              *CatalogIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags            []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags          []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type CatalogIntegrationSet struct {
+	RefreshIntervalSeconds *int                                     `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	RestAuthentication     *CatalogIntegrationSetRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+	Comment                *string                                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type CatalogIntegrationSetRestAuthentication struct {
+	OauthClientSecret *string `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+	BearerToken       *string `ddl:"parameter,single_quotes" sql:"BEARER_TOKEN"`
+}
+
+type CatalogIntegrationUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-catalog-integration.
+type DropCatalogIntegrationOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"`
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-catalog-integrations.
+type ShowCatalogIntegrationOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`
+	catalogIntegrations bool  `ddl:"static" sql:"CATALOG INTEGRATIONS"`
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+type showCatalogIntegrationsDbRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+type CatalogIntegration struct {
+	Name      string
+	Type      string
+	Category  string
+	Enabled   bool
+	Comment   *string
+	CreatedOn time.Time
+}
+
+// DescribeCatalogIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-catalog-integration.
+type DescribeCatalogIntegrationOptions struct {
+	describe           bool                    `ddl:"static" sql:"DESCRIBE"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type descCatalogIntegrationsDbRow struct {
+	Property        string `db:"property"`
+	PropertyType    string `db:"property_type"`
+	PropertyValue   string `db:"property_value"`
+	PropertyDefault string `db:"property_default"`
+}
+
+type CatalogIntegrationProperty struct {
+	Name    string
+	Type    string
+	Value   string
+	Default string
+}
+
+// custom:begin additional
+func (v *CatalogIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// custom:end additional
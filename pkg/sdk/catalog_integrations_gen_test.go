@@ -0,0 +1,457 @@
+package sdk
+
+import "testing"
+
+func TestCatalogIntegrations_Create(t *testing.T) {
+	// custom:begin CreateCatalogIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateCatalogIntegrationOptions
+	defaultOpts := func() *CreateCatalogIntegrationOptions {
+		return &CreateCatalogIntegrationOptions{
+			name: id,
+			ObjectStoreCatalogSourceParams: &ObjectStoreCatalogSourceParams{
+				TableFormat: CatalogIntegrationTableFormatIceberg,
+			},
+			Enabled: true,
+		}
+	}
+	oauth := func() CatalogIntegrationOAuth {
+		return CatalogIntegrationOAuth{
+			OauthClientId:      "client_id",
+			OauthClientSecret:  "client_secret",
+			OauthAllowedScopes: []CatalogIntegrationOAuthScope{{Scope: "PRINCIPAL_ROLE:ALL"}},
+		}
+	}
+	// custom:end CreateCatalogIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateCatalogIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateCatalogIntegrationOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateCatalogIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: exactly one field from [opts.GlueCatalogSourceParams opts.ObjectStoreCatalogSourceParams opts.PolarisCatalogSourceParams opts.IcebergRestCatalogSourceParams] should be present", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.GlueCatalogSourceParams = &GlueCatalogSourceParams{
+			GlueAwsRoleArn: "arn:aws:iam::123456789012:role/myrole",
+			GlueCatalogId:  "123456789012",
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateCatalogIntegrationOptions", "GlueCatalogSourceParams", "ObjectStoreCatalogSourceParams", "PolarisCatalogSourceParams", "IcebergRestCatalogSourceParams"))
+		// custom:end CreateCatalogIntegrationOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.IcebergRestCatalogSourceParams.RestAuthentication.OAuth opts.IcebergRestCatalogSourceParams.RestAuthentication.Bearer opts.IcebergRestCatalogSourceParams.RestAuthentication.SigV4] should be present", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions.IcebergRestCatalogSourceParams.RestAuthentication: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.ObjectStoreCatalogSourceParams = nil
+		opts.IcebergRestCatalogSourceParams = &IcebergRestCatalogSourceParams{
+			RestConfig: IcebergRestRestConfig{
+				CatalogUri: "https://example.com/api/catalog",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateCatalogIntegrationOptions.IcebergRestCatalogSourceParams.RestAuthentication", "OAuth", "Bearer", "SigV4"))
+		// custom:end CreateCatalogIntegrationOptions.IcebergRestCatalogSourceParams.RestAuthentication: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = OBJECT_STORE TABLE_FORMAT = ICEBERG ENABLED = true", id.FullyQualifiedName())
+		// custom:end CreateCatalogIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateCatalogIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ObjectStoreCatalogSourceParams = nil
+		opts.GlueCatalogSourceParams = &GlueCatalogSourceParams{
+			GlueAwsRoleArn:   "arn:aws:iam::123456789012:role/myrole",
+			GlueCatalogId:    "123456789012",
+			GlueRegion:       String("us-east-2"),
+			CatalogNamespace: String("my_namespace"),
+		}
+		opts.Enabled = false
+		opts.RefreshIntervalSeconds = Int(60)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE CATALOG INTEGRATION %s CATALOG_SOURCE = GLUE TABLE_FORMAT = ICEBERG GLUE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/myrole' GLUE_CATALOG_ID = '123456789012' GLUE_REGION = 'us-east-2' CATALOG_NAMESPACE = 'my_namespace' ENABLED = false REFRESH_INTERVAL_SECONDS = 60 COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateCatalogIntegrationOptions: all options
+	})
+
+	// custom:begin CreateCatalogIntegrationOptions: additional test cases
+	t.Run("object store with delta table format", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ObjectStoreCatalogSourceParams.TableFormat = CatalogIntegrationTableFormatDelta
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION IF NOT EXISTS %s CATALOG_SOURCE = OBJECT_STORE TABLE_FORMAT = DELTA ENABLED = true", id.FullyQualifiedName())
+	})
+
+	t.Run("polaris", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectStoreCatalogSourceParams = nil
+		opts.PolarisCatalogSourceParams = &PolarisCatalogSourceParams{
+			CatalogNamespace: String("my_namespace"),
+			RestConfig: PolarisRestConfig{
+				CatalogUri:  "https://my_org-my_account.snowflakecomputing.com/polaris/api/catalog",
+				CatalogName: "my_catalog",
+			},
+			RestAuthentication: oauth(),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = POLARIS TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'my_namespace' REST_CONFIG = (CATALOG_URI = 'https://my_org-my_account.snowflakecomputing.com/polaris/api/catalog' CATALOG_NAME = 'my_catalog') REST_AUTHENTICATION = (TYPE = OAUTH OAUTH_CLIENT_ID = 'client_id' OAUTH_CLIENT_SECRET = 'client_secret' OAUTH_ALLOWED_SCOPES = ('PRINCIPAL_ROLE:ALL')) ENABLED = true", id.FullyQualifiedName())
+	})
+
+	t.Run("iceberg rest with oauth", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectStoreCatalogSourceParams = nil
+		restAuthentication := oauth()
+		restAuthentication.OauthTokenUri = String("https://example.com/oauth/tokens")
+		restAuthentication.OauthAllowedScopes = append(restAuthentication.OauthAllowedScopes, CatalogIntegrationOAuthScope{Scope: "catalog"})
+		opts.IcebergRestCatalogSourceParams = &IcebergRestCatalogSourceParams{
+			CatalogNamespace: String("my_namespace"),
+			RestConfig: IcebergRestRestConfig{
+				CatalogUri:     "https://example.com/api/catalog",
+				Prefix:         String("my_prefix"),
+				CatalogName:    String("my_catalog"),
+				CatalogApiType: Pointer(CatalogIntegrationCatalogApiTypePublic),
+			},
+			RestAuthentication: CatalogIntegrationIcebergRestAuthentication{
+				OAuth: &restAuthentication,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = ICEBERG_REST TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'my_namespace' REST_CONFIG = (CATALOG_URI = 'https://example.com/api/catalog' PREFIX = 'my_prefix' CATALOG_NAME = 'my_catalog' CATALOG_API_TYPE = PUBLIC) REST_AUTHENTICATION = (TYPE = OAUTH OAUTH_TOKEN_URI = 'https://example.com/oauth/tokens' OAUTH_CLIENT_ID = 'client_id' OAUTH_CLIENT_SECRET = 'client_secret' OAUTH_ALLOWED_SCOPES = ('PRINCIPAL_ROLE:ALL', 'catalog')) ENABLED = true", id.FullyQualifiedName())
+	})
+
+	t.Run("iceberg rest with bearer token", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectStoreCatalogSourceParams = nil
+		opts.IcebergRestCatalogSourceParams = &IcebergRestCatalogSourceParams{
+			RestConfig: IcebergRestRestConfig{
+				CatalogUri: "https://example.com/api/catalog",
+			},
+			RestAuthentication: CatalogIntegrationIcebergRestAuthentication{
+				Bearer: &CatalogIntegrationBearer{
+					BearerToken: "token",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = ICEBERG_REST TABLE_FORMAT = ICEBERG REST_CONFIG = (CATALOG_URI = 'https://example.com/api/catalog') REST_AUTHENTICATION = (TYPE = BEARER BEARER_TOKEN = 'token') ENABLED = true", id.FullyQualifiedName())
+	})
+
+	t.Run("iceberg rest with sigv4", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ObjectStoreCatalogSourceParams = nil
+		opts.IcebergRestCatalogSourceParams = &IcebergRestCatalogSourceParams{
+			RestConfig: IcebergRestRestConfig{
+				CatalogUri:     "https://glue.us-west-2.amazonaws.com/iceberg",
+				CatalogApiType: Pointer(CatalogIntegrationCatalogApiTypeAwsGlue),
+			},
+			RestAuthentication: CatalogIntegrationIcebergRestAuthentication{
+				SigV4: &CatalogIntegrationSigV4{
+					Sigv4IamRole:       "arn:aws:iam::123456789012:role/myrole",
+					Sigv4SigningRegion: String("us-west-2"),
+					Sigv4ExternalId:    String("external_id"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CATALOG INTEGRATION %s CATALOG_SOURCE = ICEBERG_REST TABLE_FORMAT = ICEBERG REST_CONFIG = (CATALOG_URI = 'https://glue.us-west-2.amazonaws.com/iceberg' CATALOG_API_TYPE = AWS_GLUE) REST_AUTHENTICATION = (TYPE = SIGV4 SIGV4_IAM_ROLE = 'arn:aws:iam::123456789012:role/myrole' SIGV4_SIGNING_REGION = 'us-west-2' SIGV4_EXTERNAL_ID = 'external_id') ENABLED = true", id.FullyQualifiedName())
+	})
+	// custom:end CreateCatalogIntegrationOptions: additional test cases
+}
+
+func TestCatalogIntegrations_Alter(t *testing.T) {
+	// custom:begin AlterCatalogIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterCatalogIntegrationOptions
+	defaultOpts := func() *AlterCatalogIntegrationOptions {
+		return &AlterCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterCatalogIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterCatalogIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.SetTags]", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "SetTags"))
+		// custom:end AlterCatalogIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.UnsetTags]", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "UnsetTags"))
+		// custom:end AlterCatalogIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterCatalogIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+		// custom:end AlterCatalogIntegrationOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.RefreshIntervalSeconds opts.Set.RestAuthentication opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &CatalogIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterCatalogIntegrationOptions.Set", "RefreshIntervalSeconds", "RestAuthentication", "Comment"))
+		// custom:end AlterCatalogIntegrationOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set.RestAuthentication.OauthClientSecret opts.Set.RestAuthentication.BearerToken] should be present", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions.Set.RestAuthentication: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.Set = &CatalogIntegrationSet{
+			RestAuthentication: &CatalogIntegrationSetRestAuthentication{
+				OauthClientSecret: String("client_secret"),
+				BearerToken:       String("token"),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterCatalogIntegrationOptions.Set.RestAuthentication", "OauthClientSecret", "BearerToken"))
+		// custom:end AlterCatalogIntegrationOptions.Set.RestAuthentication: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &CatalogIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterCatalogIntegrationOptions.Unset", "Comment"))
+		// custom:end AlterCatalogIntegrationOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: basic
+		opts := defaultOpts()
+		opts.Set = &CatalogIntegrationSet{
+			Comment: String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CATALOG INTEGRATION %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterCatalogIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterCatalogIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &CatalogIntegrationSet{
+			RefreshIntervalSeconds: Int(120),
+			RestAuthentication: &CatalogIntegrationSetRestAuthentication{
+				OauthClientSecret: String("client_secret"),
+			},
+			Comment: String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CATALOG INTEGRATION IF EXISTS %s SET REFRESH_INTERVAL_SECONDS = 120 REST_AUTHENTICATION = (OAUTH_CLIENT_SECRET = 'client_secret') COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterCatalogIntegrationOptions: all options
+	})
+
+	// custom:begin AlterCatalogIntegrationOptions: additional test cases
+	t.Run("set bearer token", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &CatalogIntegrationSet{
+			RestAuthentication: &CatalogIntegrationSetRestAuthentication{
+				BearerToken: String("token"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CATALOG INTEGRATION %s SET REST_AUTHENTICATION = (BEARER_TOKEN = 'token')", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &CatalogIntegrationUnset{
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CATALOG INTEGRATION %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("tag2"),
+				Value: "value2",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CATALOG INTEGRATION %s SET TAG "tag1" = 'value1', "tag2" = 'value2'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+			NewAccountObjectIdentifier("tag2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CATALOG INTEGRATION %s UNSET TAG "tag1", "tag2"`, id.FullyQualifiedName())
+	})
+	// custom:end AlterCatalogIntegrationOptions: additional test cases
+}
+
+func TestCatalogIntegrations_Drop(t *testing.T) {
+	// custom:begin DropCatalogIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropCatalogIntegrationOptions
+	defaultOpts := func() *DropCatalogIntegrationOptions {
+		return &DropCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end DropCatalogIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropCatalogIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropCatalogIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropCatalogIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP CATALOG INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DropCatalogIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropCatalogIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP CATALOG INTEGRATION IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropCatalogIntegrationOptions: all options
+	})
+
+	// custom:begin DropCatalogIntegrationOptions: additional test cases
+	// custom:end DropCatalogIntegrationOptions: additional test cases
+}
+
+func TestCatalogIntegrations_Show(t *testing.T) {
+	// custom:begin ShowCatalogIntegrationOptions: default options
+	// Minimal valid ShowCatalogIntegrationOptions
+	defaultOpts := func() *ShowCatalogIntegrationOptions {
+		return &ShowCatalogIntegrationOptions{}
+	}
+	// custom:end ShowCatalogIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowCatalogIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CATALOG INTEGRATIONS")
+		// custom:end ShowCatalogIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowCatalogIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CATALOG INTEGRATIONS LIKE 'some pattern'")
+		// custom:end ShowCatalogIntegrationOptions: all options
+	})
+
+	// custom:begin ShowCatalogIntegrationOptions: additional test cases
+	// custom:end ShowCatalogIntegrationOptions: additional test cases
+}
+
+func TestCatalogIntegrations_Describe(t *testing.T) {
+	// custom:begin DescribeCatalogIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeCatalogIntegrationOptions
+	defaultOpts := func() *DescribeCatalogIntegrationOptions {
+		return &DescribeCatalogIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeCatalogIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeCatalogIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeCatalogIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeCatalogIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeCatalogIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE CATALOG INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DescribeCatalogIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeCatalogIntegrationOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE CATALOG INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DescribeCatalogIntegrationOptions: all options
+	})
+
+	// custom:begin DescribeCatalogIntegrationOptions: additional test cases
+	// custom:end DescribeCatalogIntegrationOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ CatalogIntegrations = (*catalogIntegrations)(nil)
+
+type catalogIntegrations struct {
+	client *Client
+}
+
+func (v *catalogIntegrations) Create(ctx context.Context, request *CreateCatalogIntegrationRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) Alter(ctx context.Context, request *AlterCatalogIntegrationRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) Drop(ctx context.Context, request *DropCatalogIntegrationRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *catalogIntegrations) Show(ctx context.Context, request *ShowCatalogIntegrationRequest) ([]CatalogIntegration, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showCatalogIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showCatalogIntegrationsDbRow, CatalogIntegration](dbRows)
+	return resultList, nil
+}
+
+func (v *catalogIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error) {
+	// custom:begin ShowByID
+	catalogIntegrations, err := v.Show(ctx, NewShowCatalogIntegrationRequest().WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(catalogIntegrations, func(r CatalogIntegration) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *catalogIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]CatalogIntegrationProperty, error) {
+	opts := &DescribeCatalogIntegrationOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[descCatalogIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[descCatalogIntegrationsDbRow, CatalogIntegrationProperty](rows), nil
+}
+
+func (r *CreateCatalogIntegrationRequest) toOpts() *CreateCatalogIntegrationOptions {
+	opts := &CreateCatalogIntegrationOptions{
+		OrReplace:              r.OrReplace,
+		IfNotExists:            r.IfNotExists,
+		name:                   r.name,
+		Enabled:                r.Enabled,
+		RefreshIntervalSeconds: r.RefreshIntervalSeconds,
+		Comment:                r.Comment,
+	}
+	if r.GlueCatalogSourceParams != nil {
+		opts.GlueCatalogSourceParams = &GlueCatalogSourceParams{
+			GlueAwsRoleArn:   r.GlueCatalogSourceParams.GlueAwsRoleArn,
+			GlueCatalogId:    r.GlueCatalogSourceParams.GlueCatalogId,
+			GlueRegion:       r.GlueCatalogSourceParams.GlueRegion,
+			CatalogNamespace: r.GlueCatalogSourceParams.CatalogNamespace,
+		}
+	}
+	if r.ObjectStoreCatalogSourceParams != nil {
+		opts.ObjectStoreCatalogSourceParams = &ObjectStoreCatalogSourceParams{
+			TableFormat: r.ObjectStoreCatalogSourceParams.TableFormat,
+		}
+	}
+	if r.PolarisCatalogSourceParams != nil {
+		opts.PolarisCatalogSourceParams = &PolarisCatalogSourceParams{
+			CatalogNamespace: r.PolarisCatalogSourceParams.CatalogNamespace,
+		}
+		opts.PolarisCatalogSourceParams.RestConfig = PolarisRestConfig{
+			CatalogUri:  r.PolarisCatalogSourceParams.RestConfig.CatalogUri,
+			CatalogName: r.PolarisCatalogSourceParams.RestConfig.CatalogName,
+		}
+		opts.PolarisCatalogSourceParams.RestAuthentication = CatalogIntegrationOAuth{
+			OauthTokenUri:      r.PolarisCatalogSourceParams.RestAuthentication.OauthTokenUri,
+			OauthClientId:      r.PolarisCatalogSourceParams.RestAuthentication.OauthClientId,
+			OauthClientSecret:  r.PolarisCatalogSourceParams.RestAuthentication.OauthClientSecret,
+			OauthAllowedScopes: r.PolarisCatalogSourceParams.RestAuthentication.OauthAllowedScopes,
+		}
+	}
+	if r.IcebergRestCatalogSourceParams != nil {
+		opts.IcebergRestCatalogSourceParams = &IcebergRestCatalogSourceParams{
+			CatalogNamespace: r.IcebergRestCatalogSourceParams.CatalogNamespace,
+		}
+		opts.IcebergRestCatalogSourceParams.RestConfig = IcebergRestRestConfig{
+			CatalogUri:     r.IcebergRestCatalogSourceParams.RestConfig.CatalogUri,
+			Prefix:         r.IcebergRestCatalogSourceParams.RestConfig.Prefix,
+			CatalogName:    r.IcebergRestCatalogSourceParams.RestConfig.CatalogName,
+			CatalogApiType: r.IcebergRestCatalogSourceParams.RestConfig.CatalogApiType,
+		}
+		opts.IcebergRestCatalogSourceParams.RestAuthentication = CatalogIntegrationIcebergRestAuthentication{}
+		if r.IcebergRestCatalogSourceParams.RestAuthentication.OAuth != nil {
+			opts.IcebergRestCatalogSourceParams.RestAuthentication.OAuth = &CatalogIntegrationOAuth{
+				OauthTokenUri:      r.IcebergRestCatalogSourceParams.RestAuthentication.OAuth.OauthTokenUri,
+				OauthClientId:      r.IcebergRestCatalogSourceParams.RestAuthentication.OAuth.OauthClientId,
+				OauthClientSecret:  r.IcebergRestCatalogSourceParams.RestAuthentication.OAuth.OauthClientSecret,
+				OauthAllowedScopes: r.IcebergRestCatalogSourceParams.RestAuthentication.OAuth.OauthAllowedScopes,
+			}
+		}
+		if r.IcebergRestCatalogSourceParams.RestAuthentication.Bearer != nil {
+			opts.IcebergRestCatalogSourceParams.RestAuthentication.Bearer = &CatalogIntegrationBearer{
+				BearerToken: r.IcebergRestCatalogSourceParams.RestAuthentication.Bearer.BearerToken,
+			}
+		}
+		if r.IcebergRestCatalogSourceParams.RestAuthentication.SigV4 != nil {
+			opts.IcebergRestCatalogSourceParams.RestAuthentication.SigV4 = &CatalogIntegrationSigV4{
+				Sigv4IamRole:       r.IcebergRestCatalogSourceParams.RestAuthentication.SigV4.Sigv4IamRole,
+				Sigv4SigningRegion: r.IcebergRestCatalogSourceParams.RestAuthentication.SigV4.Sigv4SigningRegion,
+				Sigv4ExternalId:    r.IcebergRestCatalogSourceParams.RestAuthentication.SigV4.Sigv4ExternalId,
+			}
+		}
+	}
+	return opts
+}
+
+func (r *AlterCatalogIntegrationRequest) toOpts() *AlterCatalogIntegrationOptions {
+	opts := &AlterCatalogIntegrationOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &CatalogIntegrationSet{
+			RefreshIntervalSeconds: r.Set.RefreshIntervalSeconds,
+			Comment:                r.Set.Comment,
+		}
+		if r.Set.RestAuthentication != nil {
+			opts.Set.RestAuthentication = &CatalogIntegrationSetRestAuthentication{
+				OauthClientSecret: r.Set.RestAuthentication.OauthClientSecret,
+				BearerToken:       r.Set.RestAuthentication.BearerToken,
+			}
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &CatalogIntegrationUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropCatalogIntegrationRequest) toOpts() *DropCatalogIntegrationOptions {
+	opts := &DropCatalogIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowCatalogIntegrationRequest) toOpts() *ShowCatalogIntegrationOptions {
+	opts := &ShowCatalogIntegrationOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r showCatalogIntegrationsDbRow) convert() *CatalogIntegration {
+	catalogIntegration := CatalogIntegration{
+		Name:      r.Name,
+		Type:      r.Type,
+		Category:  r.Category,
+		Enabled:   r.Enabled,
+		CreatedOn: r.CreatedOn,
+	}
+	if r.Comment.Valid {
+		catalogIntegration.Comment = String(r.Comment.String)
+	}
+	return &catalogIntegration
+}
+
+func (r *DescribeCatalogIntegrationRequest) toOpts() *DescribeCatalogIntegrationOptions {
+	opts := &DescribeCatalogIntegrationOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r descCatalogIntegrationsDbRow) convert() *CatalogIntegrationProperty {
+	catalogIntegrationProperty := CatalogIntegrationProperty{
+		Name:    r.Property,
+		Type:    r.PropertyType,
+		Value:   r.PropertyValue,
+		Default: r.PropertyDefault,
+	}
+	return &catalogIntegrationProperty
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,111 @@
+package sdk
+
+var (
+	_ validatable = new(CreateCatalogIntegrationOptions)
+	_ validatable = new(AlterCatalogIntegrationOptions)
+	_ validatable = new(DropCatalogIntegrationOptions)
+	_ validatable = new(ShowCatalogIntegrationOptions)
+	_ validatable = new(DescribeCatalogIntegrationOptions)
+)
+
+func (opts *CreateCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateCatalogIntegrationOptions", "OrReplace", "IfNotExists"))
+	}
+	if !exactlyOneValueSet(opts.GlueCatalogSourceParams, opts.ObjectStoreCatalogSourceParams, opts.PolarisCatalogSourceParams, opts.IcebergRestCatalogSourceParams) {
+		errs = append(errs, errExactlyOneOf("CreateCatalogIntegrationOptions", "GlueCatalogSourceParams", "ObjectStoreCatalogSourceParams", "PolarisCatalogSourceParams", "IcebergRestCatalogSourceParams"))
+	}
+	if valueSet(opts.IcebergRestCatalogSourceParams) {
+		if valueSet(opts.IcebergRestCatalogSourceParams.RestAuthentication) {
+			if !exactlyOneValueSet(opts.IcebergRestCatalogSourceParams.RestAuthentication.OAuth, opts.IcebergRestCatalogSourceParams.RestAuthentication.Bearer, opts.IcebergRestCatalogSourceParams.RestAuthentication.SigV4) {
+				errs = append(errs, errExactlyOneOf("CreateCatalogIntegrationOptions.IcebergRestCatalogSourceParams.RestAuthentication", "OAuth", "Bearer", "SigV4"))
+			}
+		}
+	}
+	// custom:begin CreateCatalogIntegrationOptions: additional validations
+	// custom:end CreateCatalogIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.IfExists, opts.SetTags) {
+		errs = append(errs, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "SetTags"))
+	}
+	if moreThanOneValueSet(opts.IfExists, opts.UnsetTags) {
+		errs = append(errs, errOneOf("AlterCatalogIntegrationOptions", "IfExists", "UnsetTags"))
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterCatalogIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.RefreshIntervalSeconds, opts.Set.RestAuthentication, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterCatalogIntegrationOptions.Set", "RefreshIntervalSeconds", "RestAuthentication", "Comment"))
+		}
+		if valueSet(opts.Set.RestAuthentication) {
+			if !exactlyOneValueSet(opts.Set.RestAuthentication.OauthClientSecret, opts.Set.RestAuthentication.BearerToken) {
+				errs = append(errs, errExactlyOneOf("AlterCatalogIntegrationOptions.Set.RestAuthentication", "OauthClientSecret", "BearerToken"))
+			}
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterCatalogIntegrationOptions.Unset", "Comment"))
+		}
+	}
+	// custom:begin AlterCatalogIntegrationOptions: additional validations
+	// custom:end AlterCatalogIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropCatalogIntegrationOptions: additional validations
+	// custom:end DropCatalogIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowCatalogIntegrationOptions: additional validations
+	// custom:end ShowCatalogIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeCatalogIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeCatalogIntegrationOptions: additional validations
+	// custom:end DescribeCatalogIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -39,53 +39,75 @@ type Client struct {
 	ReplicationFunctions ReplicationFunctions
 
 	// DDL Commands
-	Accounts                 Accounts
-	Alerts                   Alerts
-	ApiIntegrations          ApiIntegrations
-	ApplicationPackages      ApplicationPackages
-	ApplicationRoles         ApplicationRoles
-	Applications             Applications
-	Comments                 Comments
-	DatabaseRoles            DatabaseRoles
-	Databases                Databases
-	DynamicTables            DynamicTables
-	ExternalFunctions        ExternalFunctions
-	ExternalTables           ExternalTables
-	EventTables              EventTables
-	FailoverGroups           FailoverGroups
-	FileFormats              FileFormats
-	Functions                Functions
-	Grants                   Grants
-	ManagedAccounts          ManagedAccounts
-	MaskingPolicies          MaskingPolicies
-	MaterializedViews        MaterializedViews
-	NetworkPolicies          NetworkPolicies
-	NetworkRules             NetworkRules
-	NotificationIntegrations NotificationIntegrations
-	Parameters               Parameters
-	PasswordPolicies         PasswordPolicies
-	Pipes                    Pipes
-	PolicyReferences         PolicyReferences
-	Procedures               Procedures
-	ResourceMonitors         ResourceMonitors
-	Roles                    Roles
-	RowAccessPolicies        RowAccessPolicies
-	Schemas                  Schemas
-	SecurityIntegrations     SecurityIntegrations
-	Sequences                Sequences
-	SessionPolicies          SessionPolicies
-	Sessions                 Sessions
-	Shares                   Shares
-	Stages                   Stages
-	StorageIntegrations      StorageIntegrations
-	Streamlits               Streamlits
-	Streams                  Streams
-	Tables                   Tables
-	Tags                     Tags
-	Tasks                    Tasks
-	Users                    Users
-	Views                    Views
-	Warehouses               Warehouses
+	Accounts                   Accounts
+	AggregationPolicies        AggregationPolicies
+	Alerts                     Alerts
+	ApiIntegrations            ApiIntegrations
+	ApplicationPackages        ApplicationPackages
+	ApplicationPackageVersions ApplicationPackageVersions
+	ApplicationRoles           ApplicationRoles
+	Applications               Applications
+	AuthenticationPolicies     AuthenticationPolicies
+	CatalogIntegrations        CatalogIntegrations
+	Comments                   Comments
+	ComputePools               ComputePools
+	Connections                Connections
+	DatabaseRoles              DatabaseRoles
+	Databases                  Databases
+	DynamicTables              DynamicTables
+	ExternalAccessIntegrations ExternalAccessIntegrations
+	ExternalFunctions          ExternalFunctions
+	ExternalTables             ExternalTables
+	ExternalVolumes            ExternalVolumes
+	EventTables                EventTables
+	FailoverGroups             FailoverGroups
+	FileFormats                FileFormats
+	Functions                  Functions
+	GitBranches                GitBranches
+	GitRepositories            GitRepositories
+	GitTags                    GitTags
+	Grants                     Grants
+	HybridTables               HybridTables
+	HybridTableIndexes         HybridTableIndexes
+	IcebergTables              IcebergTables
+	ImageRepositories          ImageRepositories
+	ManagedAccounts            ManagedAccounts
+	MaskingPolicies            MaskingPolicies
+	MaterializedViews          MaterializedViews
+	NetworkPolicies            NetworkPolicies
+	NetworkRules               NetworkRules
+	NotificationIntegrations   NotificationIntegrations
+	Parameters                 Parameters
+	PackagesPolicies           PackagesPolicies
+	PasswordPolicies           PasswordPolicies
+	Pipes                      Pipes
+	PolicyReferences           PolicyReferences
+	Procedures                 Procedures
+	ProjectionPolicies         ProjectionPolicies
+	ReleaseDirectives          ReleaseDirectives
+	ReplicationGroups          ReplicationGroups
+	ResourceMonitors           ResourceMonitors
+	Roles                      Roles
+	RowAccessPolicies          RowAccessPolicies
+	Schemas                    Schemas
+	Secrets                    Secrets
+	SecurityIntegrations       SecurityIntegrations
+	Sequences                  Sequences
+	ServiceEndpoints           ServiceEndpoints
+	Services                   Services
+	SessionPolicies            SessionPolicies
+	Sessions                   Sessions
+	Shares                     Shares
+	Stages                     Stages
+	StorageIntegrations        StorageIntegrations
+	Streamlits                 Streamlits
+	Streams                    Streams
+	Tables                     Tables
+	Tags                       Tags
+	Tasks                      Tasks
+	Users                      Users
+	Views                      Views
+	Warehouses                 Warehouses
 }
 
 func (c *Client) GetAccountLocator() string {
@@ -193,24 +215,39 @@ func NewClientFromDB(db *sql.DB) *Client {
 
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
+	c.AggregationPolicies = &aggregationPolicies{client: c}
 	c.Alerts = &alerts{client: c}
 	c.ApiIntegrations = &apiIntegrations{client: c}
 	c.ApplicationPackages = &applicationPackages{client: c}
+	c.ApplicationPackageVersions = &applicationPackageVersions{client: c}
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
+	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.CatalogIntegrations = &catalogIntegrations{client: c}
 	c.Comments = &comments{client: c}
+	c.ComputePools = &computePools{client: c}
+	c.Connections = &connections{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.ConversionFunctions = &conversionFunctions{client: c}
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalTables = &externalTables{client: c}
+	c.ExternalVolumes = &externalVolumes{client: c}
 	c.EventTables = &eventTables{client: c}
 	c.FailoverGroups = &failoverGroups{client: c}
 	c.FileFormats = &fileFormats{client: c}
 	c.Functions = &functions{client: c}
+	c.GitBranches = &gitBranches{client: c}
+	c.GitRepositories = &gitRepositories{client: c}
+	c.GitTags = &gitTags{client: c}
 	c.Grants = &grants{client: c}
+	c.HybridTables = &hybridTables{client: c}
+	c.HybridTableIndexes = &hybridTableIndexes{client: c}
+	c.IcebergTables = &icebergTables{client: c}
+	c.ImageRepositories = &imageRepositories{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
 	c.MaterializedViews = &materializedViews{client: c}
@@ -218,17 +255,24 @@ func (c *Client) initialize() {
 	c.NetworkRules = &networkRules{client: c}
 	c.NotificationIntegrations = &notificationIntegrations{client: c}
 	c.Parameters = &parameters{client: c}
+	c.PackagesPolicies = &packagesPolicies{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.Pipes = &pipes{client: c}
 	c.PolicyReferences = &policyReference{client: c}
 	c.Procedures = &procedures{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
+	c.ReleaseDirectives = &releaseDirectives{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
 	c.RowAccessPolicies = &rowAccessPolicies{client: c}
 	c.Schemas = &schemas{client: c}
+	c.Secrets = &secrets{client: c}
 	c.SecurityIntegrations = &securityIntegrations{client: c}
 	c.Sequences = &sequences{client: c}
+	c.ServiceEndpoints = &serviceEndpoints{client: c}
+	c.Services = &services{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
@@ -241,3 +241,10 @@ const (
 	TraceLevelOnEvent TraceLevel = "ON_EVENT"
 	TraceLevelOff     TraceLevel = "OFF"
 )
+
+type MetricLevel string
+
+const (
+	MetricLevelAll  MetricLevel = "ALL"
+	MetricLevelNone MetricLevel = "NONE"
+)
@@ -0,0 +1,249 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+)
+
+//go:generate go run ./poc/main.go
+
+type ComputePoolInstanceFamily string
+
+const (
+	ComputePoolInstanceFamilyCpuX64XS     ComputePoolInstanceFamily = "CPU_X64_XS"
+	ComputePoolInstanceFamilyCpuX64S      ComputePoolInstanceFamily = "CPU_X64_S"
+	ComputePoolInstanceFamilyCpuX64M      ComputePoolInstanceFamily = "CPU_X64_M"
+	ComputePoolInstanceFamilyCpuX64L      ComputePoolInstanceFamily = "CPU_X64_L"
+	ComputePoolInstanceFamilyHighMemX64S  ComputePoolInstanceFamily = "HIGHMEM_X64_S"
+	ComputePoolInstanceFamilyHighMemX64M  ComputePoolInstanceFamily = "HIGHMEM_X64_M"
+	ComputePoolInstanceFamilyHighMemX64L  ComputePoolInstanceFamily = "HIGHMEM_X64_L"
+	ComputePoolInstanceFamilyHighMemX64SL ComputePoolInstanceFamily = "HIGHMEM_X64_SL"
+	ComputePoolInstanceFamilyGpuNvS       ComputePoolInstanceFamily = "GPU_NV_S"
+	ComputePoolInstanceFamilyGpuNvM       ComputePoolInstanceFamily = "GPU_NV_M"
+	ComputePoolInstanceFamilyGpuNvL       ComputePoolInstanceFamily = "GPU_NV_L"
+	ComputePoolInstanceFamilyGpuNvXS      ComputePoolInstanceFamily = "GPU_NV_XS"
+	ComputePoolInstanceFamilyGpuNvSM      ComputePoolInstanceFamily = "GPU_NV_SM"
+	ComputePoolInstanceFamilyGpuNv2M      ComputePoolInstanceFamily = "GPU_NV_2M"
+	ComputePoolInstanceFamilyGpuNv3M      ComputePoolInstanceFamily = "GPU_NV_3M"
+	ComputePoolInstanceFamilyGpuNvSL      ComputePoolInstanceFamily = "GPU_NV_SL"
+)
+
+var AllComputePoolInstanceFamilies = []ComputePoolInstanceFamily{
+	ComputePoolInstanceFamilyCpuX64XS,
+	ComputePoolInstanceFamilyCpuX64S,
+	ComputePoolInstanceFamilyCpuX64M,
+	ComputePoolInstanceFamilyCpuX64L,
+	ComputePoolInstanceFamilyHighMemX64S,
+	ComputePoolInstanceFamilyHighMemX64M,
+	ComputePoolInstanceFamilyHighMemX64L,
+	ComputePoolInstanceFamilyHighMemX64SL,
+	ComputePoolInstanceFamilyGpuNvS,
+	ComputePoolInstanceFamilyGpuNvM,
+	ComputePoolInstanceFamilyGpuNvL,
+	ComputePoolInstanceFamilyGpuNvXS,
+	ComputePoolInstanceFamilyGpuNvSM,
+	ComputePoolInstanceFamilyGpuNv2M,
+	ComputePoolInstanceFamilyGpuNv3M,
+	ComputePoolInstanceFamilyGpuNvSL,
+}
+
+func ToComputePoolInstanceFamily(s string) (ComputePoolInstanceFamily, error) {
+	s = strings.ToUpper(s)
+	for _, family := range AllComputePoolInstanceFamilies {
+		if string(family) == s {
+			return family, nil
+		}
+	}
+	return "", fmt.Errorf("invalid compute pool instance family: %s", s)
+}
+
+type ComputePoolState string
+
+const (
+	ComputePoolStateIdle      ComputePoolState = "IDLE"
+	ComputePoolStateActive    ComputePoolState = "ACTIVE"
+	ComputePoolStateSuspended ComputePoolState = "SUSPENDED"
+	ComputePoolStateStarting  ComputePoolState = "STARTING"
+	ComputePoolStateStopping  ComputePoolState = "STOPPING"
+	ComputePoolStateResizing  ComputePoolState = "RESIZING"
+)
+
+var ComputePoolsDef = g.NewInterface(
+	"ComputePools",
+	"ComputePool",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-compute-pool",
+		g.NewQueryStruct("CreateComputePool").
+			Create().
+			SQL("COMPUTE POOL").
+			IfNotExists().
+			Name().
+			OptionalIdentifier("ForApplication", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("FOR APPLICATION")).
+			NumberAssignment("MIN_NODES", g.ParameterOptions().Required()).
+			NumberAssignment("MAX_NODES", g.ParameterOptions().Required()).
+			Assignment("INSTANCE_FAMILY", g.KindOfT[ComputePoolInstanceFamily](), g.ParameterOptions().NoQuotes().Required()).
+			OptionalBooleanAssignment("AUTO_RESUME", g.ParameterOptions()).
+			OptionalBooleanAssignment("INITIALLY_SUSPENDED", g.ParameterOptions()).
+			OptionalNumberAssignment("AUTO_SUSPEND_SECS", g.ParameterOptions()).
+			OptionalTags().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "ForApplication"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-compute-pool",
+		g.NewQueryStruct("AlterComputePool").
+			Alter().
+			SQL("COMPUTE POOL").
+			IfExists().
+			Name().
+			OptionalSQL("RESUME").
+			OptionalSQL("SUSPEND").
+			OptionalSQL("STOP ALL").
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ComputePoolSet").
+					OptionalNumberAssignment("MIN_NODES", g.ParameterOptions()).
+					OptionalNumberAssignment("MAX_NODES", g.ParameterOptions()).
+					OptionalBooleanAssignment("AUTO_RESUME", g.ParameterOptions()).
+					OptionalNumberAssignment("AUTO_SUSPEND_SECS", g.ParameterOptions()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "MinNodes", "MaxNodes", "AutoResume", "AutoSuspendSecs", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ComputePoolUnset").
+					OptionalSQL("AUTO_RESUME").
+					OptionalSQL("AUTO_SUSPEND_SECS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "AutoResume", "AutoSuspendSecs", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "StopAll", "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-compute-pool",
+		g.NewQueryStruct("DropComputePool").
+			Drop().
+			SQL("COMPUTE POOL").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-compute-pools",
+		g.DbStruct("computePoolsRow").
+			Text("name").
+			Text("state").
+			Number("min_nodes").
+			Number("max_nodes").
+			Text("instance_family").
+			Number("num_services").
+			Number("num_jobs").
+			Number("auto_suspend_secs").
+			Bool("auto_resume").
+			Number("active_nodes").
+			Number("idle_nodes").
+			Number("target_nodes").
+			Time("created_on").
+			OptionalTime("resumed_on").
+			OptionalTime("updated_on").
+			Text("owner").
+			OptionalText("comment").
+			Bool("is_exclusive").
+			OptionalText("application").
+			OptionalText("error_code").
+			OptionalText("status_message"),
+		g.PlainStruct("ComputePool").
+			DeriveMapping().
+			Text("Name").
+			Converted("State", "ComputePoolState", "state", "ComputePoolState").
+			Number("MinNodes").
+			Number("MaxNodes").
+			Converted("InstanceFamily", "ComputePoolInstanceFamily", "instance_family", "ComputePoolInstanceFamily").
+			Number("NumServices").
+			Number("NumJobs").
+			Number("AutoSuspendSecs").
+			Bool("AutoResume").
+			Number("ActiveNodes").
+			Number("IdleNodes").
+			Number("TargetNodes").
+			Time("CreatedOn").
+			OptionalTime("ResumedOn").
+			OptionalTime("UpdatedOn").
+			Text("Owner").
+			OptionalText("Comment").
+			Bool("IsExclusive").
+			OptionalText("Application").
+			OptionalText("ErrorCode").
+			OptionalText("StatusMessage"),
+		g.NewQueryStruct("ShowComputePools").
+			Show().
+			SQL("COMPUTE POOLS").
+			OptionalLike().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-compute-pool",
+		g.DbStruct("computePoolDetailsRow").
+			Text("name").
+			Text("state").
+			Number("min_nodes").
+			Number("max_nodes").
+			Text("instance_family").
+			Number("num_services").
+			Number("num_jobs").
+			Number("auto_suspend_secs").
+			Bool("auto_resume").
+			Number("active_nodes").
+			Number("idle_nodes").
+			Number("target_nodes").
+			Time("created_on").
+			OptionalTime("resumed_on").
+			OptionalTime("updated_on").
+			Text("owner").
+			OptionalText("comment").
+			Bool("is_exclusive").
+			OptionalText("application").
+			OptionalText("error_code").
+			OptionalText("status_message"),
+		g.PlainStruct("ComputePoolDetails").
+			DeriveMapping().
+			Text("Name").
+			Converted("State", "ComputePoolState", "state", "ComputePoolState").
+			Number("MinNodes").
+			Number("MaxNodes").
+			Converted("InstanceFamily", "ComputePoolInstanceFamily", "instance_family", "ComputePoolInstanceFamily").
+			Number("NumServices").
+			Number("NumJobs").
+			Number("AutoSuspendSecs").
+			Bool("AutoResume").
+			Number("ActiveNodes").
+			Number("IdleNodes").
+			Number("TargetNodes").
+			Time("CreatedOn").
+			OptionalTime("ResumedOn").
+			OptionalTime("UpdatedOn").
+			Text("Owner").
+			OptionalText("Comment").
+			Bool("IsExclusive").
+			OptionalText("Application").
+			OptionalText("ErrorCode").
+			OptionalText("StatusMessage"),
+		g.NewQueryStruct("DescribeComputePool").
+			Describe().
+			SQL("COMPUTE POOL").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
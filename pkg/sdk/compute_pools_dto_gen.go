@@ -0,0 +1,66 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateComputePoolOptions]   = new(CreateComputePoolRequest)
+	_ optionsProvider[AlterComputePoolOptions]    = new(AlterComputePoolRequest)
+	_ optionsProvider[DropComputePoolOptions]     = new(DropComputePoolRequest)
+	_ optionsProvider[ShowComputePoolOptions]     = new(ShowComputePoolRequest)
+	_ optionsProvider[DescribeComputePoolOptions] = new(DescribeComputePoolRequest)
+)
+
+type CreateComputePoolRequest struct {
+	IfNotExists        *bool
+	name               AccountObjectIdentifier   `validate:"validIdentifier"` // required
+	ForApplication     *AccountObjectIdentifier  `validate:"validIdentifierIfSet"`
+	MinNodes           int                       // required
+	MaxNodes           int                       // required
+	InstanceFamily     ComputePoolInstanceFamily // required
+	AutoResume         *bool
+	InitiallySuspended *bool
+	AutoSuspendSecs    *int
+	Tag                []TagAssociation
+	Comment            *string
+}
+
+type AlterComputePoolRequest struct {
+	IfExists  *bool
+	name      AccountObjectIdentifier  `validate:"validIdentifier"` // required
+	Resume    *bool                    `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	Suspend   *bool                    `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	StopAll   *bool                    `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	Set       *ComputePoolSetRequest   `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	Unset     *ComputePoolUnsetRequest `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	SetTags   []TagAssociation         `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+	UnsetTags []ObjectIdentifier       `validate:"exactlyOneValueSet=Resume|Suspend|StopAll|Set|Unset|SetTags|UnsetTags"`
+}
+
+type ComputePoolSetRequest struct {
+	MinNodes        *int    `validate:"atLeastOneValueSet=MinNodes|MaxNodes|AutoResume|AutoSuspendSecs|Comment"`
+	MaxNodes        *int    `validate:"atLeastOneValueSet=MinNodes|MaxNodes|AutoResume|AutoSuspendSecs|Comment"`
+	AutoResume      *bool   `validate:"atLeastOneValueSet=MinNodes|MaxNodes|AutoResume|AutoSuspendSecs|Comment"`
+	AutoSuspendSecs *int    `validate:"atLeastOneValueSet=MinNodes|MaxNodes|AutoResume|AutoSuspendSecs|Comment"`
+	Comment         *string `validate:"atLeastOneValueSet=MinNodes|MaxNodes|AutoResume|AutoSuspendSecs|Comment"`
+}
+
+type ComputePoolUnsetRequest struct {
+	AutoResume      *bool `validate:"atLeastOneValueSet=AutoResume|AutoSuspendSecs|Comment"`
+	AutoSuspendSecs *bool `validate:"atLeastOneValueSet=AutoResume|AutoSuspendSecs|Comment"`
+	Comment         *bool `validate:"atLeastOneValueSet=AutoResume|AutoSuspendSecs|Comment"`
+}
+
+type DropComputePoolRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowComputePoolRequest struct {
+	Like       *Like
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type DescribeComputePoolRequest struct {
+	name AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,349 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePools_Create(t *testing.T) {
+	// custom:begin CreateComputePoolOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateComputePoolOptions
+	defaultOpts := func() *CreateComputePoolOptions {
+		return &CreateComputePoolOptions{
+			name:           id,
+			MinNodes:       1,
+			MaxNodes:       2,
+			InstanceFamily: ComputePoolInstanceFamilyCpuX64XS,
+		}
+	}
+	// custom:end CreateComputePoolOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateComputePoolOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateComputePoolOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateComputePoolOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ForApplication] if set", func(t *testing.T) {
+		// custom:begin CreateComputePoolOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.ForApplication = Pointer(NewAccountObjectIdentifier(""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateComputePoolOptions: validation (valid identifier if set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateComputePoolOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE COMPUTE POOL %s MIN_NODES = 1 MAX_NODES = 2 INSTANCE_FAMILY = CPU_X64_XS", id.FullyQualifiedName())
+		// custom:end CreateComputePoolOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateComputePoolOptions: all options
+		applicationId := randomAccountObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ForApplication = &applicationId
+		opts.InstanceFamily = ComputePoolInstanceFamilyGpuNvS
+		opts.AutoResume = Bool(false)
+		opts.InitiallySuspended = Bool(true)
+		opts.AutoSuspendSecs = Int(600)
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE COMPUTE POOL IF NOT EXISTS %s FOR APPLICATION %s MIN_NODES = 1 MAX_NODES = 2 INSTANCE_FAMILY = GPU_NV_S AUTO_RESUME = false INITIALLY_SUSPENDED = true AUTO_SUSPEND_SECS = 600 TAG (%s = 'v1') COMMENT = 'some comment'", id.FullyQualifiedName(), applicationId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateComputePoolOptions: all options
+	})
+
+	// custom:begin CreateComputePoolOptions: additional test cases
+	// custom:end CreateComputePoolOptions: additional test cases
+}
+
+func TestComputePools_Alter(t *testing.T) {
+	// custom:begin AlterComputePoolOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterComputePoolOptions
+	defaultOpts := func() *AlterComputePoolOptions {
+		return &AlterComputePoolOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterComputePoolOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterComputePoolOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Suspend = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterComputePoolOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.StopAll opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterComputePoolOptions", "Resume", "Suspend", "StopAll", "Set", "Unset", "SetTags", "UnsetTags"))
+
+		opts.Resume = Bool(true)
+		opts.Suspend = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterComputePoolOptions", "Resume", "Suspend", "StopAll", "Set", "Unset", "SetTags", "UnsetTags"))
+		// custom:end AlterComputePoolOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.MinNodes opts.Set.MaxNodes opts.Set.AutoResume opts.Set.AutoSuspendSecs opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &ComputePoolSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterComputePoolOptions.Set", "MinNodes", "MaxNodes", "AutoResume", "AutoSuspendSecs", "Comment"))
+		// custom:end AlterComputePoolOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.AutoResume opts.Unset.AutoSuspendSecs opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &ComputePoolUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterComputePoolOptions.Unset", "AutoResume", "AutoSuspendSecs", "Comment"))
+		// custom:end AlterComputePoolOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions: basic
+		opts := defaultOpts()
+		opts.Suspend = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s SUSPEND", id.FullyQualifiedName())
+		// custom:end AlterComputePoolOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterComputePoolOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &ComputePoolSet{
+			MinNodes:        Int(2),
+			MaxNodes:        Int(3),
+			AutoResume:      Bool(true),
+			AutoSuspendSecs: Int(300),
+			Comment:         String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL IF EXISTS %s SET MIN_NODES = 2 MAX_NODES = 3 AUTO_RESUME = true AUTO_SUSPEND_SECS = 300 COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterComputePoolOptions: all options
+	})
+
+	// custom:begin AlterComputePoolOptions: additional test cases
+	t.Run("resume", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Resume = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s RESUME", id.FullyQualifiedName())
+	})
+
+	t.Run("stop all", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.StopAll = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s STOP ALL", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ComputePoolUnset{
+			AutoResume:      Bool(true),
+			AutoSuspendSecs: Bool(true),
+			Comment:         Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s UNSET AUTO_RESUME, AUTO_SUSPEND_SECS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER COMPUTE POOL %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+	// custom:end AlterComputePoolOptions: additional test cases
+}
+
+func TestComputePools_Drop(t *testing.T) {
+	// custom:begin DropComputePoolOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropComputePoolOptions
+	defaultOpts := func() *DropComputePoolOptions {
+		return &DropComputePoolOptions{
+			name: id,
+		}
+	}
+	// custom:end DropComputePoolOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropComputePoolOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropComputePoolOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropComputePoolOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropComputePoolOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP COMPUTE POOL %s", id.FullyQualifiedName())
+		// custom:end DropComputePoolOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropComputePoolOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP COMPUTE POOL IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropComputePoolOptions: all options
+	})
+
+	// custom:begin DropComputePoolOptions: additional test cases
+	// custom:end DropComputePoolOptions: additional test cases
+}
+
+func TestComputePools_Show(t *testing.T) {
+	// custom:begin ShowComputePoolOptions: default options
+	// Minimal valid ShowComputePoolOptions
+	defaultOpts := func() *ShowComputePoolOptions {
+		return &ShowComputePoolOptions{}
+	}
+	// custom:end ShowComputePoolOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowComputePoolOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowComputePoolOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW COMPUTE POOLS")
+		// custom:end ShowComputePoolOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowComputePoolOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.StartsWith = String("some prefix")
+		opts.Limit = &LimitFrom{Rows: Int(10), From: String("from name")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW COMPUTE POOLS LIKE 'some pattern' STARTS WITH 'some prefix' LIMIT 10 FROM 'from name'")
+		// custom:end ShowComputePoolOptions: all options
+	})
+
+	// custom:begin ShowComputePoolOptions: additional test cases
+	// custom:end ShowComputePoolOptions: additional test cases
+}
+
+func TestComputePools_Describe(t *testing.T) {
+	// custom:begin DescribeComputePoolOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeComputePoolOptions
+	defaultOpts := func() *DescribeComputePoolOptions {
+		return &DescribeComputePoolOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeComputePoolOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeComputePoolOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeComputePoolOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeComputePoolOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeComputePoolOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE COMPUTE POOL %s", id.FullyQualifiedName())
+		// custom:end DescribeComputePoolOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeComputePoolOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE COMPUTE POOL %s", id.FullyQualifiedName())
+		// custom:end DescribeComputePoolOptions: all options
+	})
+
+	// custom:begin DescribeComputePoolOptions: additional test cases
+	// custom:end DescribeComputePoolOptions: additional test cases
+}
+
+// custom:begin additional
+func Test_ToComputePoolInstanceFamily(t *testing.T) {
+	type test struct {
+		input string
+		want  ComputePoolInstanceFamily
+	}
+
+	positiveTests := []test{
+		{input: "CPU_X64_XS", want: ComputePoolInstanceFamilyCpuX64XS},
+		{input: "cpu_x64_xs", want: ComputePoolInstanceFamilyCpuX64XS},
+		{input: "HIGHMEM_X64_SL", want: ComputePoolInstanceFamilyHighMemX64SL},
+		{input: "gpu_NV_3m", want: ComputePoolInstanceFamilyGpuNv3M},
+	}
+
+	negativeTests := []test{
+		{input: "CPU_X64"},
+		{input: "CPU_X64_XS "},
+		{input: "abc"},
+		{input: ""},
+	}
+
+	for _, tc := range positiveTests {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToComputePoolInstanceFamily(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range negativeTests {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToComputePoolInstanceFamily(tc.input)
+			require.Error(t, err)
+			require.Empty(t, got)
+		})
+	}
+}
+
+// custom:end additional
@@ -0,0 +1,222 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ ComputePools = (*computePools)(nil)
+
+type computePools struct {
+	client *Client
+}
+
+func (v *computePools) Create(ctx context.Context, request *CreateComputePoolRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *computePools) Alter(ctx context.Context, request *AlterComputePoolRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *computePools) Drop(ctx context.Context, request *DropComputePoolRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *computePools) Show(ctx context.Context, request *ShowComputePoolRequest) ([]ComputePool, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[computePoolsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[computePoolsRow, ComputePool](dbRows)
+	return resultList, nil
+}
+
+func (v *computePools) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error) {
+	// custom:begin ShowByID
+	computePools, err := v.Show(ctx, NewShowComputePoolRequest().WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(computePools, func(r ComputePool) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *computePools) Describe(ctx context.Context, id AccountObjectIdentifier) (*ComputePoolDetails, error) {
+	opts := &DescribeComputePoolOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[computePoolDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateComputePoolRequest) toOpts() *CreateComputePoolOptions {
+	opts := &CreateComputePoolOptions{
+		IfNotExists:        r.IfNotExists,
+		name:               r.name,
+		ForApplication:     r.ForApplication,
+		MinNodes:           r.MinNodes,
+		MaxNodes:           r.MaxNodes,
+		InstanceFamily:     r.InstanceFamily,
+		AutoResume:         r.AutoResume,
+		InitiallySuspended: r.InitiallySuspended,
+		AutoSuspendSecs:    r.AutoSuspendSecs,
+		Tag:                r.Tag,
+		Comment:            r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterComputePoolRequest) toOpts() *AlterComputePoolOptions {
+	opts := &AlterComputePoolOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Resume:    r.Resume,
+		Suspend:   r.Suspend,
+		StopAll:   r.StopAll,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ComputePoolSet{
+			MinNodes:        r.Set.MinNodes,
+			MaxNodes:        r.Set.MaxNodes,
+			AutoResume:      r.Set.AutoResume,
+			AutoSuspendSecs: r.Set.AutoSuspendSecs,
+			Comment:         r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ComputePoolUnset{
+			AutoResume:      r.Unset.AutoResume,
+			AutoSuspendSecs: r.Unset.AutoSuspendSecs,
+			Comment:         r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropComputePoolRequest) toOpts() *DropComputePoolOptions {
+	opts := &DropComputePoolOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowComputePoolRequest) toOpts() *ShowComputePoolOptions {
+	opts := &ShowComputePoolOptions{
+		Like:       r.Like,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r computePoolsRow) convert() *ComputePool {
+	computePool := ComputePool{
+		Name:            r.Name,
+		State:           ComputePoolState(r.State),
+		MinNodes:        r.MinNodes,
+		MaxNodes:        r.MaxNodes,
+		InstanceFamily:  ComputePoolInstanceFamily(r.InstanceFamily),
+		NumServices:     r.NumServices,
+		NumJobs:         r.NumJobs,
+		AutoSuspendSecs: r.AutoSuspendSecs,
+		AutoResume:      r.AutoResume,
+		ActiveNodes:     r.ActiveNodes,
+		IdleNodes:       r.IdleNodes,
+		TargetNodes:     r.TargetNodes,
+		CreatedOn:       r.CreatedOn,
+		Owner:           r.Owner,
+		IsExclusive:     r.IsExclusive,
+	}
+	if r.ResumedOn.Valid {
+		computePool.ResumedOn = Pointer(r.ResumedOn.Time)
+	}
+	if r.UpdatedOn.Valid {
+		computePool.UpdatedOn = Pointer(r.UpdatedOn.Time)
+	}
+	if r.Comment.Valid {
+		computePool.Comment = String(r.Comment.String)
+	}
+	if r.Application.Valid {
+		computePool.Application = String(r.Application.String)
+	}
+	if r.ErrorCode.Valid {
+		computePool.ErrorCode = String(r.ErrorCode.String)
+	}
+	if r.StatusMessage.Valid {
+		computePool.StatusMessage = String(r.StatusMessage.String)
+	}
+	return &computePool
+}
+
+func (r *DescribeComputePoolRequest) toOpts() *DescribeComputePoolOptions {
+	opts := &DescribeComputePoolOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r computePoolDetailsRow) convert() *ComputePoolDetails {
+	computePoolDetails := ComputePoolDetails{
+		Name:            r.Name,
+		State:           ComputePoolState(r.State),
+		MinNodes:        r.MinNodes,
+		MaxNodes:        r.MaxNodes,
+		InstanceFamily:  ComputePoolInstanceFamily(r.InstanceFamily),
+		NumServices:     r.NumServices,
+		NumJobs:         r.NumJobs,
+		AutoSuspendSecs: r.AutoSuspendSecs,
+		AutoResume:      r.AutoResume,
+		ActiveNodes:     r.ActiveNodes,
+		IdleNodes:       r.IdleNodes,
+		TargetNodes:     r.TargetNodes,
+		CreatedOn:       r.CreatedOn,
+		Owner:           r.Owner,
+		IsExclusive:     r.IsExclusive,
+	}
+	if r.ResumedOn.Valid {
+		computePoolDetails.ResumedOn = Pointer(r.ResumedOn.Time)
+	}
+	if r.UpdatedOn.Valid {
+		computePoolDetails.UpdatedOn = Pointer(r.UpdatedOn.Time)
+	}
+	if r.Comment.Valid {
+		computePoolDetails.Comment = String(r.Comment.String)
+	}
+	if r.Application.Valid {
+		computePoolDetails.Application = String(r.Application.String)
+	}
+	if r.ErrorCode.Valid {
+		computePoolDetails.ErrorCode = String(r.ErrorCode.String)
+	}
+	if r.StatusMessage.Valid {
+		computePoolDetails.StatusMessage = String(r.StatusMessage.String)
+	}
+	return &computePoolDetails
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,90 @@
+package sdk
+
+var (
+	_ validatable = new(CreateComputePoolOptions)
+	_ validatable = new(AlterComputePoolOptions)
+	_ validatable = new(DropComputePoolOptions)
+	_ validatable = new(ShowComputePoolOptions)
+	_ validatable = new(DescribeComputePoolOptions)
+)
+
+func (opts *CreateComputePoolOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.ForApplication != nil && !ValidObjectIdentifier(opts.ForApplication) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin CreateComputePoolOptions: additional validations
+	// custom:end CreateComputePoolOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterComputePoolOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Resume, opts.Suspend, opts.StopAll, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterComputePoolOptions", "Resume", "Suspend", "StopAll", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.MinNodes, opts.Set.MaxNodes, opts.Set.AutoResume, opts.Set.AutoSuspendSecs, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterComputePoolOptions.Set", "MinNodes", "MaxNodes", "AutoResume", "AutoSuspendSecs", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.AutoResume, opts.Unset.AutoSuspendSecs, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterComputePoolOptions.Unset", "AutoResume", "AutoSuspendSecs", "Comment"))
+		}
+	}
+	// custom:begin AlterComputePoolOptions: additional validations
+	// custom:end AlterComputePoolOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropComputePoolOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropComputePoolOptions: additional validations
+	// custom:end DropComputePoolOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowComputePoolOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowComputePoolOptions: additional validations
+	// custom:end ShowComputePoolOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeComputePoolOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeComputePoolOptions: additional validations
+	// custom:end DescribeComputePoolOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,106 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var ConnectionsDef = g.NewInterface(
+	"Connections",
+	"Connection",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-connection",
+		g.NewQueryStruct("CreateConnection").
+			Create().
+			SQL("CONNECTION").
+			IfNotExists().
+			Name().
+			OptionalIdentifier("AsReplicaOf", g.KindOfTPointer[ExternalObjectIdentifier](), g.IdentifierOptions().SQL("AS REPLICA OF")).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "AsReplicaOf"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-connection",
+		g.NewQueryStruct("AlterConnection").
+			Alter().
+			SQL("CONNECTION").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"EnableConnectionFailover",
+				g.NewQueryStruct("EnableConnectionFailover").
+					ListAssignment("TO ACCOUNTS", "AccountIdentifier", g.ParameterOptions().NoEquals().Required()).
+					OptionalSQL("IGNORE EDITION CHECK"),
+				g.KeywordOptions().SQL("ENABLE FAILOVER"),
+			).
+			OptionalQueryStructField(
+				"DisableConnectionFailover",
+				g.NewQueryStruct("DisableConnectionFailover").
+					ListAssignment("TO ACCOUNTS", "AccountIdentifier", g.ParameterOptions().NoEquals()),
+				g.KeywordOptions().SQL("DISABLE FAILOVER"),
+			).
+			OptionalSQL("PRIMARY").
+			OptionalSQL("REFRESH").
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ConnectionSet").
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ConnectionUnset").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "EnableConnectionFailover", "DisableConnectionFailover", "Primary", "Refresh", "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-connection",
+		g.NewQueryStruct("DropConnection").
+			Drop().
+			SQL("CONNECTION").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-connections",
+		g.DbStruct("connectionsRow").
+			OptionalText("region_group").
+			Text("snowflake_region").
+			Time("created_on").
+			Text("account_name").
+			Text("name").
+			OptionalText("comment").
+			Bool("is_primary").
+			Text("primary").
+			OptionalText("failover_allowed_to_accounts").
+			Text("connection_url").
+			Text("organization_name").
+			Text("account_locator"),
+		g.PlainStruct("Connection").
+			DeriveMapping().
+			OptionalText("RegionGroup").
+			Text("SnowflakeRegion").
+			Time("CreatedOn").
+			Text("AccountName").
+			Text("Name").
+			OptionalText("Comment").
+			Bool("IsPrimary").
+			Converted("Primary", "ExternalObjectIdentifier", "primary", "NewExternalObjectIdentifierFromFullyQualifiedName").
+			Converted("FailoverAllowedToAccounts", "[]AccountIdentifier", "failover_allowed_to_accounts", "ParseCommaSeparatedAccountIdentifierArray").
+			Text("ConnectionUrl").
+			Text("OrganizationName").
+			Text("AccountLocator"),
+		g.NewQueryStruct("ShowConnections").
+			Show().
+			SQL("CONNECTIONS").
+			OptionalLike(),
+	).
+	ShowByIdOperation()
@@ -0,0 +1,54 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateConnectionOptions] = new(CreateConnectionRequest)
+	_ optionsProvider[AlterConnectionOptions]  = new(AlterConnectionRequest)
+	_ optionsProvider[DropConnectionOptions]   = new(DropConnectionRequest)
+	_ optionsProvider[ShowConnectionOptions]   = new(ShowConnectionRequest)
+)
+
+type CreateConnectionRequest struct {
+	IfNotExists *bool
+	name        AccountObjectIdentifier   `validate:"validIdentifier"` // required
+	AsReplicaOf *ExternalObjectIdentifier `validate:"validIdentifierIfSet"`
+	Comment     *string
+}
+
+type AlterConnectionRequest struct {
+	IfExists                  *bool
+	name                      AccountObjectIdentifier           `validate:"validIdentifier"` // required
+	EnableConnectionFailover  *EnableConnectionFailoverRequest  `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+	DisableConnectionFailover *DisableConnectionFailoverRequest `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+	Primary                   *bool                             `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+	Refresh                   *bool                             `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+	Set                       *ConnectionSetRequest             `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+	Unset                     *ConnectionUnsetRequest           `validate:"exactlyOneValueSet=EnableConnectionFailover|DisableConnectionFailover|Primary|Refresh|Set|Unset"`
+}
+
+type EnableConnectionFailoverRequest struct {
+	ToAccounts         []AccountIdentifier // required
+	IgnoreEditionCheck *bool
+}
+
+type DisableConnectionFailoverRequest struct {
+	ToAccounts []AccountIdentifier
+}
+
+type ConnectionSetRequest struct {
+	Comment *string `validate:"atLeastOneValueSet=Comment"`
+}
+
+type ConnectionUnsetRequest struct {
+	Comment *bool `validate:"atLeastOneValueSet=Comment"`
+}
+
+type DropConnectionRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowConnectionRequest struct {
+	Like *Like
+}
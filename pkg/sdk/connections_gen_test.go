@@ -0,0 +1,249 @@
+package sdk
+
+import "testing"
+
+func TestConnections_Create(t *testing.T) {
+	// custom:begin CreateConnectionOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateConnectionOptions
+	defaultOpts := func() *CreateConnectionOptions {
+		return &CreateConnectionOptions{
+			name: id,
+		}
+	}
+	// custom:end CreateConnectionOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateConnectionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateConnectionOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateConnectionOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.AsReplicaOf] if set", func(t *testing.T) {
+		// custom:begin CreateConnectionOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.AsReplicaOf = Pointer(NewExternalObjectIdentifier(NewAccountIdentifier("org", "acc"), NewAccountObjectIdentifier("")))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateConnectionOptions: validation (valid identifier if set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateConnectionOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CONNECTION %s", id.FullyQualifiedName())
+		// custom:end CreateConnectionOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateConnectionOptions: all options
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE CONNECTION IF NOT EXISTS %s COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateConnectionOptions: all options
+	})
+
+	// custom:begin CreateConnectionOptions: additional test cases
+	t.Run("as replica of", func(t *testing.T) {
+		primaryId := NewExternalObjectIdentifier(NewAccountIdentifier("org", "acc"), id)
+		opts := defaultOpts()
+		opts.AsReplicaOf = Pointer(primaryId)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE CONNECTION %s AS REPLICA OF "org"."acc".%s`, id.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+	// custom:end CreateConnectionOptions: additional test cases
+}
+
+func TestConnections_Alter(t *testing.T) {
+	// custom:begin AlterConnectionOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterConnectionOptions
+	defaultOpts := func() *AlterConnectionOptions {
+		return &AlterConnectionOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterConnectionOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterConnectionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		opts.Primary = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterConnectionOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.EnableConnectionFailover opts.DisableConnectionFailover opts.Primary opts.Refresh opts.Set opts.Unset] should be present", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterConnectionOptions", "EnableConnectionFailover", "DisableConnectionFailover", "Primary", "Refresh", "Set", "Unset"))
+
+		opts.Primary = Bool(true)
+		opts.Refresh = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterConnectionOptions", "EnableConnectionFailover", "DisableConnectionFailover", "Primary", "Refresh", "Set", "Unset"))
+		// custom:end AlterConnectionOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &ConnectionSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterConnectionOptions.Set", "Comment"))
+		// custom:end AlterConnectionOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &ConnectionUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterConnectionOptions.Unset", "Comment"))
+		// custom:end AlterConnectionOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions: basic
+		opts := defaultOpts()
+		opts.Primary = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CONNECTION %s PRIMARY", id.FullyQualifiedName())
+		// custom:end AlterConnectionOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterConnectionOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.EnableConnectionFailover = &EnableConnectionFailover{
+			ToAccounts:         []AccountIdentifier{NewAccountIdentifier("org", "acc1"), NewAccountIdentifier("org", "acc2")},
+			IgnoreEditionCheck: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION IF EXISTS %s ENABLE FAILOVER TO ACCOUNTS "org"."acc1", "org"."acc2" IGNORE EDITION CHECK`, id.FullyQualifiedName())
+		// custom:end AlterConnectionOptions: all options
+	})
+
+	// custom:begin AlterConnectionOptions: additional test cases
+	t.Run("disable failover", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DisableConnectionFailover = &DisableConnectionFailover{}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CONNECTION %s DISABLE FAILOVER", id.FullyQualifiedName())
+	})
+
+	t.Run("disable failover to accounts", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DisableConnectionFailover = &DisableConnectionFailover{
+			ToAccounts: []AccountIdentifier{NewAccountIdentifier("org", "acc1")},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION %s DISABLE FAILOVER TO ACCOUNTS "org"."acc1"`, id.FullyQualifiedName())
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CONNECTION %s REFRESH", id.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ConnectionSet{Comment: String("some comment")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CONNECTION %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ConnectionUnset{Comment: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER CONNECTION %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+	// custom:end AlterConnectionOptions: additional test cases
+}
+
+func TestConnections_Drop(t *testing.T) {
+	// custom:begin DropConnectionOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropConnectionOptions
+	defaultOpts := func() *DropConnectionOptions {
+		return &DropConnectionOptions{
+			name: id,
+		}
+	}
+	// custom:end DropConnectionOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropConnectionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropConnectionOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropConnectionOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropConnectionOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP CONNECTION %s", id.FullyQualifiedName())
+		// custom:end DropConnectionOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropConnectionOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP CONNECTION IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropConnectionOptions: all options
+	})
+
+	// custom:begin DropConnectionOptions: additional test cases
+	// custom:end DropConnectionOptions: additional test cases
+}
+
+func TestConnections_Show(t *testing.T) {
+	// custom:begin ShowConnectionOptions: default options
+	// Minimal valid ShowConnectionOptions
+	defaultOpts := func() *ShowConnectionOptions {
+		return &ShowConnectionOptions{}
+	}
+	// custom:end ShowConnectionOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowConnectionOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowConnectionOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CONNECTIONS")
+		// custom:end ShowConnectionOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowConnectionOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW CONNECTIONS LIKE 'some pattern'")
+		// custom:end ShowConnectionOptions: all options
+	})
+
+	// custom:begin ShowConnectionOptions: additional test cases
+	// custom:end ShowConnectionOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
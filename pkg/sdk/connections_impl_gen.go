@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ Connections = (*connections)(nil)
+
+type connections struct {
+	client *Client
+}
+
+func (v *connections) Create(ctx context.Context, request *CreateConnectionRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *connections) Alter(ctx context.Context, request *AlterConnectionRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *connections) Drop(ctx context.Context, request *DropConnectionRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *connections) Show(ctx context.Context, request *ShowConnectionRequest) ([]Connection, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[connectionsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[connectionsRow, Connection](dbRows)
+	return resultList, nil
+}
+
+func (v *connections) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Connection, error) {
+	// custom:begin ShowByID
+	// SHOW CONNECTIONS lists also the replicas of the connection in the other accounts of the organization
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := v.Show(ctx, NewShowConnectionRequest().WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(connections, func(r Connection) bool {
+		return r.Name == id.Name() && r.AccountLocator == currentAccount
+	})
+	// custom:end ShowByID
+}
+
+func (r *CreateConnectionRequest) toOpts() *CreateConnectionOptions {
+	opts := &CreateConnectionOptions{
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		AsReplicaOf: r.AsReplicaOf,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterConnectionRequest) toOpts() *AlterConnectionOptions {
+	opts := &AlterConnectionOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		Primary:  r.Primary,
+		Refresh:  r.Refresh,
+	}
+	if r.EnableConnectionFailover != nil {
+		opts.EnableConnectionFailover = &EnableConnectionFailover{
+			ToAccounts:         r.EnableConnectionFailover.ToAccounts,
+			IgnoreEditionCheck: r.EnableConnectionFailover.IgnoreEditionCheck,
+		}
+	}
+	if r.DisableConnectionFailover != nil {
+		opts.DisableConnectionFailover = &DisableConnectionFailover{
+			ToAccounts: r.DisableConnectionFailover.ToAccounts,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &ConnectionSet{
+			Comment: r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ConnectionUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropConnectionRequest) toOpts() *DropConnectionOptions {
+	opts := &DropConnectionOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowConnectionRequest) toOpts() *ShowConnectionOptions {
+	opts := &ShowConnectionOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r connectionsRow) convert() *Connection {
+	connection := Connection{
+		SnowflakeRegion:  r.SnowflakeRegion,
+		CreatedOn:        r.CreatedOn,
+		AccountName:      r.AccountName,
+		Name:             r.Name,
+		IsPrimary:        r.IsPrimary,
+		Primary:          NewExternalObjectIdentifierFromFullyQualifiedName(r.Primary),
+		ConnectionUrl:    r.ConnectionUrl,
+		OrganizationName: r.OrganizationName,
+		AccountLocator:   r.AccountLocator,
+	}
+	if r.RegionGroup.Valid {
+		connection.RegionGroup = String(r.RegionGroup.String)
+	}
+	if r.Comment.Valid {
+		connection.Comment = String(r.Comment.String)
+	}
+	if r.FailoverAllowedToAccounts.Valid {
+		connection.FailoverAllowedToAccounts = ParseCommaSeparatedAccountIdentifierArray(r.FailoverAllowedToAccounts.String)
+	}
+	return &connection
+}
+
+// custom:begin additional
+// custom:end additional
@@ -369,6 +369,8 @@ type DatabaseSet struct {
 	DefaultDDLCollation        *string                  `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
 	LogLevel                   *LogLevel                `ddl:"parameter,single_quotes" sql:"LOG_LEVEL"`
 	TraceLevel                 *TraceLevel              `ddl:"parameter,single_quotes" sql:"TRACE_LEVEL"`
+	MetricLevel                *MetricLevel             `ddl:"parameter,single_quotes" sql:"METRIC_LEVEL"`
+	EventTable                 *SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"EVENT_TABLE"`
 	Comment                    *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
@@ -380,8 +382,11 @@ func (v *DatabaseSet) validate() error {
 	if v.Catalog != nil && !ValidObjectIdentifier(v.Catalog) {
 		errs = append(errs, errInvalidIdentifier("DatabaseSet", "Catalog"))
 	}
-	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ExternalVolume, v.Catalog, v.DefaultDDLCollation, v.LogLevel, v.TraceLevel, v.Comment) {
-		errs = append(errs, errAtLeastOneOf("DatabaseSet", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "Comment"))
+	if v.EventTable != nil && !ValidObjectIdentifier(v.EventTable) {
+		errs = append(errs, errInvalidIdentifier("DatabaseSet", "EventTable"))
+	}
+	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ExternalVolume, v.Catalog, v.DefaultDDLCollation, v.LogLevel, v.TraceLevel, v.MetricLevel, v.EventTable, v.Comment) {
+		errs = append(errs, errAtLeastOneOf("DatabaseSet", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "MetricLevel", "EventTable", "Comment"))
 	}
 	return errors.Join(errs...)
 }
@@ -394,13 +399,15 @@ type DatabaseUnset struct {
 	DefaultDDLCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
 	LogLevel                   *bool `ddl:"keyword" sql:"LOG_LEVEL"`
 	TraceLevel                 *bool `ddl:"keyword" sql:"TRACE_LEVEL"`
+	MetricLevel                *bool `ddl:"keyword" sql:"METRIC_LEVEL"`
+	EventTable                 *bool `ddl:"keyword" sql:"EVENT_TABLE"`
 	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
 func (v *DatabaseUnset) validate() error {
 	var errs []error
-	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ExternalVolume, v.Catalog, v.DefaultDDLCollation, v.LogLevel, v.TraceLevel, v.Comment) {
-		errs = append(errs, errAtLeastOneOf("DatabaseUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "Comment"))
+	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ExternalVolume, v.Catalog, v.DefaultDDLCollation, v.LogLevel, v.TraceLevel, v.MetricLevel, v.EventTable, v.Comment) {
+		errs = append(errs, errAtLeastOneOf("DatabaseUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "MetricLevel", "EventTable", "Comment"))
 	}
 	return errors.Join(errs...)
 }
@@ -255,13 +255,13 @@ func TestDatabasesAlter(t *testing.T) {
 	t.Run("validation: at least one set option", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &DatabaseSet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DatabaseSet", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DatabaseSet", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "MetricLevel", "EventTable", "Comment"))
 	})
 
 	t.Run("validation: at least one unset option", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &DatabaseUnset{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DatabaseUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DatabaseUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "DefaultDDLCollation", "LogLevel", "TraceLevel", "MetricLevel", "EventTable", "Comment"))
 	})
 
 	t.Run("validation: invalid external volume identifier", func(t *testing.T) {
@@ -280,6 +280,14 @@ func TestDatabasesAlter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("DatabaseSet", "Catalog"))
 	})
 
+	t.Run("validation: invalid event table identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DatabaseSet{
+			EventTable: Pointer(NewSchemaObjectIdentifier("", "", "")),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("DatabaseSet", "EventTable"))
+	})
+
 	t.Run("validation: invalid NewName identifier", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.NewName = Pointer(NewAccountObjectIdentifier(""))
@@ -308,6 +316,7 @@ func TestDatabasesAlter(t *testing.T) {
 	t.Run("set", func(t *testing.T) {
 		externalVolumeId := randomAccountObjectIdentifier()
 		catalogId := randomAccountObjectIdentifier()
+		eventTableId := randomSchemaObjectIdentifier()
 		opts := defaultOpts()
 		opts.Set = &DatabaseSet{
 			DataRetentionTimeInDays:    Int(1),
@@ -317,9 +326,11 @@ func TestDatabasesAlter(t *testing.T) {
 			DefaultDDLCollation:        String("en_US"),
 			LogLevel:                   Pointer(LogLevelError),
 			TraceLevel:                 Pointer(TraceLevelOnEvent),
+			MetricLevel:                Pointer(MetricLevelAll),
+			EventTable:                 &eventTableId,
 			Comment:                    String("comment"),
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s SET DATA_RETENTION_TIME_IN_DAYS = 1, MAX_DATA_EXTENSION_TIME_IN_DAYS = 1, EXTERNAL_VOLUME = %s, CATALOG = %s, DEFAULT_DDL_COLLATION = 'en_US', LOG_LEVEL = 'ERROR', TRACE_LEVEL = 'ON_EVENT', COMMENT = 'comment'`, opts.name.FullyQualifiedName(), externalVolumeId.FullyQualifiedName(), catalogId.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s SET DATA_RETENTION_TIME_IN_DAYS = 1, MAX_DATA_EXTENSION_TIME_IN_DAYS = 1, EXTERNAL_VOLUME = %s, CATALOG = %s, DEFAULT_DDL_COLLATION = 'en_US', LOG_LEVEL = 'ERROR', TRACE_LEVEL = 'ON_EVENT', METRIC_LEVEL = 'ALL', EVENT_TABLE = %s, COMMENT = 'comment'`, opts.name.FullyQualifiedName(), externalVolumeId.FullyQualifiedName(), catalogId.FullyQualifiedName(), eventTableId.FullyQualifiedName())
 	})
 
 	t.Run("unset", func(t *testing.T) {
@@ -332,9 +343,11 @@ func TestDatabasesAlter(t *testing.T) {
 			DefaultDDLCollation:        Bool(true),
 			LogLevel:                   Bool(true),
 			TraceLevel:                 Bool(true),
+			MetricLevel:                Bool(true),
+			EventTable:                 Bool(true),
 			Comment:                    Bool(true),
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, EXTERNAL_VOLUME, CATALOG, DEFAULT_DDL_COLLATION, LOG_LEVEL, TRACE_LEVEL, COMMENT`, opts.name.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, EXTERNAL_VOLUME, CATALOG, DEFAULT_DDL_COLLATION, LOG_LEVEL, TRACE_LEVEL, METRIC_LEVEL, EVENT_TABLE, COMMENT`, opts.name.FullyQualifiedName())
 	})
 
 	t.Run("with set tag", func(t *testing.T) {
@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/format"
@@ -14,11 +15,16 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 	"unicode/utf8"
 )
 
+var checkFlag = flag.Bool("check", false, "do not write the output file, instead fail when it is stale")
+
 func main() {
+	flag.Parse()
 	filename := os.Getenv("GOFILE")
 	fmt.Printf("Running generator on %s with args %#v\n", filename, os.Args[1:])
 
@@ -35,6 +41,14 @@ func main() {
 	if errSrcFormat != nil {
 		log.Panicln(errSrcFormat)
 	}
+	if *checkFlag {
+		existing, err := os.ReadFile(gen.outputName)
+		if err != nil || !bytes.Equal(existing, src) {
+			fmt.Printf("Generated file %s is stale\n", gen.outputName)
+			os.Exit(1)
+		}
+		return
+	}
 	if err := os.WriteFile(gen.outputName, src, 0o600); err != nil {
 		log.Panicln(err)
 	}
@@ -111,6 +125,7 @@ func (gen *Generator) generateImports(imports ...string) {
 }
 
 func (gen *Generator) addConstructorsAndBuilderMethods() {
+	var defs []*structDef
 	for _, node := range gen.astFile.Decls {
 		if _, ok := node.(*ast.GenDecl); ok {
 			for _, spec := range node.(*ast.GenDecl).Specs {
@@ -123,14 +138,22 @@ func (gen *Generator) addConstructorsAndBuilderMethods() {
 								fields = append(fields, fs)
 							}
 						}
-						def := newStructDef(spec.(*ast.TypeSpec), fields)
-						gen.generateConstructor(def)
-						gen.generateBuilderMethods(def)
+						defs = append(defs, newStructDef(spec.(*ast.TypeSpec), fields))
 					}
 				}
 			}
 		}
 	}
+
+	validated := validatedStructs(defs)
+	for _, def := range defs {
+		gen.generateConstructor(def)
+		gen.generateBuilderMethods(def)
+		gen.generateFunctionalOptions(def)
+		if slices.Contains(validated, def.name) {
+			gen.generateValidate(def, validated)
+		}
+	}
 }
 
 type structDef struct {
@@ -138,6 +161,26 @@ type structDef struct {
 	fields []*fieldDef
 }
 
+func (d *structDef) requiredFields() []*fieldDef {
+	var requiredFields []*fieldDef
+	for _, field := range d.fields {
+		if field.isRequired {
+			requiredFields = append(requiredFields, field)
+		}
+	}
+	return requiredFields
+}
+
+func (d *structDef) optionalFields() []*fieldDef {
+	var optionalFields []*fieldDef
+	for _, field := range d.fields {
+		if !field.isRequired {
+			optionalFields = append(optionalFields, field)
+		}
+	}
+	return optionalFields
+}
+
 func newStructDef(ts *ast.TypeSpec, fields []*fieldDef) *structDef {
 	return &structDef{
 		name:   ts.Name.Name,
@@ -146,31 +189,40 @@ func newStructDef(ts *ast.TypeSpec, fields []*fieldDef) *structDef {
 }
 
 type fieldDef struct {
-	name       string
-	typeString string
-	isRequired bool
+	name        string
+	typeString  string
+	isRequired  bool
+	validations []string
 }
 
 func (fs *fieldDef) String() string {
-	return fmt.Sprintf("Field: name=%s type=%s is required=%t", fs.name, fs.typeString, fs.isRequired)
+	return fmt.Sprintf("Field: name=%s type=%s is required=%t validations=%v", fs.name, fs.typeString, fs.isRequired, fs.validations)
+}
+
+// canBeUnset checks if the field can be set back to its empty value with Without method
+func (fs *fieldDef) canBeUnset() bool {
+	return strings.HasPrefix(fs.typeString, "*") || strings.HasPrefix(fs.typeString, "[]") || strings.HasPrefix(fs.typeString, "map[")
 }
 
 func newFieldDef(name *ast.Ident, field *ast.Field) *fieldDef {
+	var validations []string
+	if field.Tag != nil {
+		tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+		if v, ok := tag.Lookup("validate"); ok && v != "" {
+			validations = strings.Split(v, ",")
+		}
+	}
 	return &fieldDef{
-		name:       name.Name,
-		typeString: types.ExprString(field.Type),
-		isRequired: strings.TrimSpace(field.Comment.Text()) == "required",
+		name:        name.Name,
+		typeString:  types.ExprString(field.Type),
+		isRequired:  strings.TrimSpace(field.Comment.Text()) == "required",
+		validations: validations,
 	}
 }
 
 func (gen *Generator) generateConstructor(d *structDef) {
 	gen.printf("func New%s(", d.name)
-	var requiredFields []*fieldDef
-	for _, field := range d.fields {
-		if field.isRequired {
-			requiredFields = append(requiredFields, field)
-		}
-	}
+	requiredFields := d.requiredFields()
 	if len(requiredFields) != 0 {
 		gen.printf("\n")
 		for _, field := range requiredFields {
@@ -196,14 +248,7 @@ func (gen *Generator) generateConstructor(d *structDef) {
 }
 
 func (gen *Generator) generateBuilderMethods(d *structDef) {
-	var optionalFields []*fieldDef
-	for _, field := range d.fields {
-		if !field.isRequired {
-			optionalFields = append(optionalFields, field)
-		}
-	}
-
-	for _, field := range optionalFields {
+	for _, field := range d.optionalFields() {
 		gen.printf("func (s *%s) With%s(%s %s) *%s {\n", d.name, toTitle(field.name), field.name, strings.TrimLeft(field.typeString, "*"), d.name)
 
 		switch {
@@ -215,7 +260,172 @@ func (gen *Generator) generateBuilderMethods(d *structDef) {
 		}
 		gen.printf("return s\n")
 		gen.printf("}\n\n")
+
+		if field.canBeUnset() {
+			gen.printf("func (s *%s) Without%s() *%s {\n", d.name, toTitle(field.name), d.name)
+			gen.printf("s.%s = nil\n", field.name)
+			gen.printf("return s\n")
+			gen.printf("}\n\n")
+		}
+	}
+}
+
+// generateFunctionalOptions generates constructor accepting optional fields as functional options, e.g.
+// NewCreatePipeRequestWithOptions(name, copyStatement, CreatePipeRequestWithComment("comment"))
+func (gen *Generator) generateFunctionalOptions(d *structDef) {
+	optionalFields := d.optionalFields()
+	if len(optionalFields) == 0 {
+		return
 	}
+	optionType := fmt.Sprintf("%sOption", d.name)
+	gen.printf("type %s func(*%s)\n\n", optionType, d.name)
+
+	gen.printf("func New%sWithOptions(\n", d.name)
+	requiredFields := d.requiredFields()
+	args := make([]string, 0, len(requiredFields))
+	for _, field := range requiredFields {
+		gen.printf("%s %s,\n", field.name, field.typeString)
+		args = append(args, field.name)
+	}
+	gen.printf("options ...%s,\n", optionType)
+	gen.printf(") *%s {\n", d.name)
+	gen.printf("s := New%s(%s)\n", d.name, strings.Join(args, ", "))
+	gen.printf("for _, option := range options {\n")
+	gen.printf("option(s)\n")
+	gen.printf("}\n")
+	gen.printf("return s\n")
+	gen.printf("}\n\n")
+
+	for _, field := range optionalFields {
+		gen.printf("func %sWith%s(%s %s) %s {\n", d.name, toTitle(field.name), field.name, strings.TrimLeft(field.typeString, "*"), optionType)
+		gen.printf("return func(s *%s) {\n", d.name)
+		gen.printf("s.With%s(%s)\n", toTitle(field.name), field.name)
+		gen.printf("}\n")
+		gen.printf("}\n\n")
+	}
+}
+
+// validatedStructs returns names of the structs that have validations on their fields or contain (directly or not) such structs
+func validatedStructs(defs []*structDef) []string {
+	var validated []string
+	for _, d := range defs {
+		for _, field := range d.fields {
+			if len(field.validations) > 0 && !slices.Contains(validated, d.name) {
+				validated = append(validated, d.name)
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, d := range defs {
+			if slices.Contains(validated, d.name) {
+				continue
+			}
+			for _, field := range d.fields {
+				if slices.Contains(validated, baseType(field.typeString)) {
+					validated = append(validated, d.name)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return validated
+}
+
+// generateValidate generates Validate method based on "validate" struct tags, supported validations are:
+// - validIdentifier, validIdentifierIfSet - field contains valid object identifier
+// - exactlyOneValueSet=<group>, atLeastOneValueSet=<group>, conflictingFields=<group> - checked together for all the fields with the same tag value (e.g. exactlyOneValueSet=Set|Unset)
+// Nested structs with validations are validated when set.
+func (gen *Generator) generateValidate(d *structDef, validated []string) {
+	gen.printf("func (s *%s) Validate() error {\n", d.name)
+	gen.printf("var errs []error\n")
+
+	type group struct {
+		validation string
+		fields     []string
+	}
+	var groups []*group
+	for _, field := range d.fields {
+		for _, validation := range field.validations {
+			validationType, groupName, _ := strings.Cut(validation, "=")
+			switch validationType {
+			case "validIdentifier":
+				gen.printf("if !ValidObjectIdentifier(s.%s) {\n", field.name)
+				gen.printf("errs = append(errs, errInvalidIdentifier(\"%s\", \"%s\"))\n", d.name, field.name)
+				gen.printf("}\n")
+			case "validIdentifierIfSet":
+				gen.printf("if s.%s != nil && !ValidObjectIdentifier(s.%s) {\n", field.name, field.name)
+				gen.printf("errs = append(errs, errInvalidIdentifier(\"%s\", \"%s\"))\n", d.name, field.name)
+				gen.printf("}\n")
+			case "exactlyOneValueSet", "atLeastOneValueSet", "conflictingFields":
+				if groupName == "" {
+					log.Panicf("Validation %s of %s.%s requires group name (e.g. %s=Set|Unset)", validationType, d.name, field.name, validationType)
+				}
+				idx := slices.IndexFunc(groups, func(g *group) bool { return g.validation == validation })
+				if idx == -1 {
+					groups = append(groups, &group{validation: validation})
+					idx = len(groups) - 1
+				}
+				groups[idx].fields = append(groups[idx].fields, field.name)
+			default:
+				log.Panicf("Unknown validation %s on %s.%s", validation, d.name, field.name)
+			}
+		}
+	}
+
+	for _, g := range groups {
+		validationType, _, _ := strings.Cut(g.validation, "=")
+		values := make([]string, len(g.fields))
+		names := make([]string, len(g.fields))
+		for i, f := range g.fields {
+			values[i] = fmt.Sprintf("s.%s", f)
+			names[i] = fmt.Sprintf("%q", f)
+		}
+		var condition, errorFunc string
+		switch validationType {
+		case "exactlyOneValueSet":
+			condition, errorFunc = fmt.Sprintf("!exactlyOneValueSet(%s)", strings.Join(values, ", ")), "errExactlyOneOf"
+		case "atLeastOneValueSet":
+			condition, errorFunc = fmt.Sprintf("!anyValueSet(%s)", strings.Join(values, ", ")), "errAtLeastOneOf"
+		case "conflictingFields":
+			condition, errorFunc = fmt.Sprintf("moreThanOneValueSet(%s)", strings.Join(values, ", ")), "errOneOf"
+		}
+		gen.printf("if %s {\n", condition)
+		gen.printf("errs = append(errs, %s(\"%s\", %s))\n", errorFunc, d.name, strings.Join(names, ", "))
+		gen.printf("}\n")
+	}
+
+	for _, field := range d.fields {
+		if !slices.Contains(validated, baseType(field.typeString)) {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(field.typeString, "*"):
+			gen.printf("if s.%s != nil {\n", field.name)
+			gen.printf("if err := s.%s.Validate(); err != nil {\n", field.name)
+			gen.printf("errs = append(errs, err)\n")
+			gen.printf("}\n")
+			gen.printf("}\n")
+		case strings.HasPrefix(field.typeString, "[]"):
+			gen.printf("for _, v := range s.%s {\n", field.name)
+			gen.printf("if err := v.Validate(); err != nil {\n")
+			gen.printf("errs = append(errs, err)\n")
+			gen.printf("}\n")
+			gen.printf("}\n")
+		default:
+			gen.printf("if err := s.%s.Validate(); err != nil {\n", field.name)
+			gen.printf("errs = append(errs, err)\n")
+			gen.printf("}\n")
+		}
+	}
+
+	gen.printf("return JoinErrors(errs...)\n")
+	gen.printf("}\n\n")
+}
+
+func baseType(typeString string) string {
+	return strings.TrimLeft(typeString, "*[]")
 }
 
 func toTitle(s string) string {
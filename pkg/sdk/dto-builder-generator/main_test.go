@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_generator runs the generator (main.go is excluded from the build, so it is run the same way as by go:generate)
+// on the DTOs from testdata and compares the result with the expected output. After intended changes in the generator
+// the expected output can be updated by running: cd testdata && GOFILE=widgets_dto_gen.go GOPACKAGE=sdk go run ../main.go
+func Test_generator(t *testing.T) {
+	generatorPath, err := filepath.Abs("main.go")
+	require.NoError(t, err)
+
+	runGenerator := func(t *testing.T, dir string, args ...string) error {
+		t.Helper()
+		cmd := exec.Command("go", append([]string{"run", generatorPath}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFILE=widgets_dto_gen.go", "GOPACKAGE=sdk")
+		output, err := cmd.CombinedOutput()
+		t.Log(string(output))
+		return err
+	}
+
+	copyInput := func(t *testing.T) string {
+		t.Helper()
+		dir := t.TempDir()
+		input, err := os.ReadFile(filepath.Join("testdata", "widgets_dto_gen.go"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "widgets_dto_gen.go"), input, 0o600))
+		return dir
+	}
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "widgets_dto_builders_gen.go"))
+	require.NoError(t, err)
+
+	t.Run("constructors, builder methods, functional options and validations", func(t *testing.T) {
+		dir := copyInput(t)
+
+		require.NoError(t, runGenerator(t, dir))
+
+		generated, err := os.ReadFile(filepath.Join(dir, "widgets_dto_builders_gen.go"))
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(generated))
+	})
+
+	t.Run("check: up to date output", func(t *testing.T) {
+		dir := copyInput(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "widgets_dto_builders_gen.go"), expected, 0o600))
+
+		require.NoError(t, runGenerator(t, dir, "-check"))
+	})
+
+	t.Run("check: stale output", func(t *testing.T) {
+		dir := copyInput(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "widgets_dto_builders_gen.go"), []byte("package sdk\n"), 0o600))
+
+		require.Error(t, runGenerator(t, dir, "-check"))
+	})
+
+	t.Run("check: missing output", func(t *testing.T) {
+		dir := copyInput(t)
+
+		require.Error(t, runGenerator(t, dir, "-check"))
+	})
+}
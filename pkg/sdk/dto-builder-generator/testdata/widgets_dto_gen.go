@@ -0,0 +1,40 @@
+package sdk
+
+//go:generate go run ../main.go
+
+type CreateWidgetRequest struct {
+	OrReplace   *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        SchemaObjectIdentifier   `validate:"validIdentifier"` // required
+	Warehouse   *AccountObjectIdentifier `validate:"validIdentifierIfSet"`
+	Columns     []WidgetColumnRequest    // required
+	Tag         []TagAssociation
+	Properties  map[string]string
+	Comment     *string
+}
+
+type WidgetColumnRequest struct {
+	Name    string // required
+	Type    *string
+	Default *WidgetColumnDefaultRequest
+}
+
+type WidgetColumnDefaultRequest struct {
+	Expression *string `validate:"exactlyOneValueSet=Expression|Sequence"`
+	Sequence   *string `validate:"exactlyOneValueSet=Expression|Sequence"`
+}
+
+type AlterWidgetRequest struct {
+	name  SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Set   *WidgetSetRequest      `validate:"exactlyOneValueSet=Set|Unset"`
+	Unset *WidgetUnsetRequest    `validate:"exactlyOneValueSet=Set|Unset"`
+}
+
+type WidgetSetRequest struct {
+	Comment   *string                  `validate:"atLeastOneValueSet=Comment|Warehouse"`
+	Warehouse *AccountObjectIdentifier `validate:"atLeastOneValueSet=Comment|Warehouse"`
+}
+
+type WidgetUnsetRequest struct {
+	Comment *bool
+}
@@ -0,0 +1,58 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateExternalAccessIntegrationOptions]   = new(CreateExternalAccessIntegrationRequest)
+	_ optionsProvider[AlterExternalAccessIntegrationOptions]    = new(AlterExternalAccessIntegrationRequest)
+	_ optionsProvider[DropExternalAccessIntegrationOptions]     = new(DropExternalAccessIntegrationRequest)
+	_ optionsProvider[ShowExternalAccessIntegrationOptions]     = new(ShowExternalAccessIntegrationRequest)
+	_ optionsProvider[DescribeExternalAccessIntegrationOptions] = new(DescribeExternalAccessIntegrationRequest)
+)
+
+type CreateExternalAccessIntegrationRequest struct {
+	OrReplace                            *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                          *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                                 AccountObjectIdentifier  `validate:"validIdentifier"` // required
+	AllowedNetworkRules                  []SchemaObjectIdentifier // required
+	AllowedApiAuthenticationIntegrations []AccountObjectIdentifier
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier
+	Enabled                              bool // required
+	Comment                              *string
+}
+
+type AlterExternalAccessIntegrationRequest struct {
+	IfExists  *bool                                  `validate:"conflictingFields=IfExists|SetTags,conflictingFields=IfExists|UnsetTags"`
+	name      AccountObjectIdentifier                `validate:"validIdentifier"` // required
+	Set       *ExternalAccessIntegrationSetRequest   `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	Unset     *ExternalAccessIntegrationUnsetRequest `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	SetTags   []TagAssociation                       `validate:"conflictingFields=IfExists|SetTags,exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	UnsetTags []ObjectIdentifier                     `validate:"conflictingFields=IfExists|UnsetTags,exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+}
+
+type ExternalAccessIntegrationSetRequest struct {
+	AllowedNetworkRules                  []SchemaObjectIdentifier  `validate:"atLeastOneValueSet=AllowedNetworkRules|AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Enabled|Comment"`
+	AllowedApiAuthenticationIntegrations []AccountObjectIdentifier `validate:"atLeastOneValueSet=AllowedNetworkRules|AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Enabled|Comment"`
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier  `validate:"atLeastOneValueSet=AllowedNetworkRules|AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Enabled|Comment"`
+	Enabled                              *bool                     `validate:"atLeastOneValueSet=AllowedNetworkRules|AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Enabled|Comment"`
+	Comment                              *string                   `validate:"atLeastOneValueSet=AllowedNetworkRules|AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Enabled|Comment"`
+}
+
+type ExternalAccessIntegrationUnsetRequest struct {
+	AllowedApiAuthenticationIntegrations *bool `validate:"atLeastOneValueSet=AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Comment"`
+	AllowedAuthenticationSecrets         *bool `validate:"atLeastOneValueSet=AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Comment"`
+	Comment                              *bool `validate:"atLeastOneValueSet=AllowedApiAuthenticationIntegrations|AllowedAuthenticationSecrets|Comment"`
+}
+
+type DropExternalAccessIntegrationRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowExternalAccessIntegrationRequest struct {
+	Like *Like
+}
+
+type DescribeExternalAccessIntegrationRequest struct {
+	name AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
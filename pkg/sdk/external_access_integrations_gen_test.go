@@ -0,0 +1,330 @@
+package sdk
+
+import "testing"
+
+func TestExternalAccessIntegrations_Create(t *testing.T) {
+	// custom:begin CreateExternalAccessIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+	networkRuleId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateExternalAccessIntegrationOptions
+	defaultOpts := func() *CreateExternalAccessIntegrationOptions {
+		return &CreateExternalAccessIntegrationOptions{
+			name:                id,
+			AllowedNetworkRules: []SchemaObjectIdentifier{networkRuleId},
+			Enabled:             true,
+		}
+	}
+	// custom:end CreateExternalAccessIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateExternalAccessIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateExternalAccessIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateExternalAccessIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalAccessIntegrationOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateExternalAccessIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateExternalAccessIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s) ENABLED = true", id.FullyQualifiedName(), networkRuleId.FullyQualifiedName())
+		// custom:end CreateExternalAccessIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateExternalAccessIntegrationOptions: all options
+		otherNetworkRuleId := randomSchemaObjectIdentifier()
+		integrationId := randomAccountObjectIdentifier()
+		secretId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.AllowedNetworkRules = []SchemaObjectIdentifier{networkRuleId, otherNetworkRuleId}
+		opts.AllowedApiAuthenticationIntegrations = []AccountObjectIdentifier{integrationId}
+		opts.AllowedAuthenticationSecrets = []SchemaObjectIdentifier{secretId}
+		opts.Enabled = false
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s, %s) ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = false COMMENT = 'some comment'",
+			id.FullyQualifiedName(), networkRuleId.FullyQualifiedName(), otherNetworkRuleId.FullyQualifiedName(), integrationId.FullyQualifiedName(), secretId.FullyQualifiedName())
+		// custom:end CreateExternalAccessIntegrationOptions: all options
+	})
+
+	// custom:begin CreateExternalAccessIntegrationOptions: additional test cases
+	t.Run("if not exists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE EXTERNAL ACCESS INTEGRATION IF NOT EXISTS %s ALLOWED_NETWORK_RULES = (%s) ENABLED = true", id.FullyQualifiedName(), networkRuleId.FullyQualifiedName())
+	})
+	// custom:end CreateExternalAccessIntegrationOptions: additional test cases
+}
+
+func TestExternalAccessIntegrations_Alter(t *testing.T) {
+	// custom:begin AlterExternalAccessIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterExternalAccessIntegrationOptions
+	defaultOpts := func() *AlterExternalAccessIntegrationOptions {
+		return &AlterExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterExternalAccessIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		opts.Set = &ExternalAccessIntegrationSet{Enabled: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterExternalAccessIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.SetTags]", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "SetTags"))
+		// custom:end AlterExternalAccessIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.UnsetTags]", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("one"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "UnsetTags"))
+		// custom:end AlterExternalAccessIntegrationOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalAccessIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+		// custom:end AlterExternalAccessIntegrationOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.AllowedNetworkRules opts.Set.AllowedApiAuthenticationIntegrations opts.Set.AllowedAuthenticationSecrets opts.Set.Enabled opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &ExternalAccessIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Set", "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"))
+		// custom:end AlterExternalAccessIntegrationOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.AllowedApiAuthenticationIntegrations opts.Unset.AllowedAuthenticationSecrets opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &ExternalAccessIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Unset", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"))
+		// custom:end AlterExternalAccessIntegrationOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: basic
+		opts := defaultOpts()
+		opts.Set = &ExternalAccessIntegrationSet{Enabled: Bool(false)}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL ACCESS INTEGRATION %s SET ENABLED = false", id.FullyQualifiedName())
+		// custom:end AlterExternalAccessIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterExternalAccessIntegrationOptions: all options
+		networkRuleId := randomSchemaObjectIdentifier()
+		integrationId := randomAccountObjectIdentifier()
+		secretId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &ExternalAccessIntegrationSet{
+			AllowedNetworkRules:                  []SchemaObjectIdentifier{networkRuleId},
+			AllowedApiAuthenticationIntegrations: []AccountObjectIdentifier{integrationId},
+			AllowedAuthenticationSecrets:         []SchemaObjectIdentifier{secretId},
+			Enabled:                              Bool(true),
+			Comment:                              String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL ACCESS INTEGRATION IF EXISTS %s SET ALLOWED_NETWORK_RULES = (%s) ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = true COMMENT = 'some comment'",
+			id.FullyQualifiedName(), networkRuleId.FullyQualifiedName(), integrationId.FullyQualifiedName(), secretId.FullyQualifiedName())
+		// custom:end AlterExternalAccessIntegrationOptions: all options
+	})
+
+	// custom:begin AlterExternalAccessIntegrationOptions: additional test cases
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ExternalAccessIntegrationUnset{
+			AllowedApiAuthenticationIntegrations: Bool(true),
+			AllowedAuthenticationSecrets:         Bool(true),
+			Comment:                              Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL ACCESS INTEGRATION %s UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS, ALLOWED_AUTHENTICATION_SECRETS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL ACCESS INTEGRATION %s SET TAG "name" = 'value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL ACCESS INTEGRATION %s UNSET TAG "name"`, id.FullyQualifiedName())
+	})
+	// custom:end AlterExternalAccessIntegrationOptions: additional test cases
+}
+
+func TestExternalAccessIntegrations_Drop(t *testing.T) {
+	// custom:begin DropExternalAccessIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropExternalAccessIntegrationOptions
+	defaultOpts := func() *DropExternalAccessIntegrationOptions {
+		return &DropExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end DropExternalAccessIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropExternalAccessIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropExternalAccessIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropExternalAccessIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DropExternalAccessIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropExternalAccessIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP INTEGRATION IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropExternalAccessIntegrationOptions: all options
+	})
+
+	// custom:begin DropExternalAccessIntegrationOptions: additional test cases
+	// custom:end DropExternalAccessIntegrationOptions: additional test cases
+}
+
+func TestExternalAccessIntegrations_Show(t *testing.T) {
+	// custom:begin ShowExternalAccessIntegrationOptions: default options
+	// Minimal valid ShowExternalAccessIntegrationOptions
+	defaultOpts := func() *ShowExternalAccessIntegrationOptions {
+		return &ShowExternalAccessIntegrationOptions{}
+	}
+	// custom:end ShowExternalAccessIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowExternalAccessIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL ACCESS INTEGRATIONS")
+		// custom:end ShowExternalAccessIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowExternalAccessIntegrationOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'some pattern'")
+		// custom:end ShowExternalAccessIntegrationOptions: all options
+	})
+
+	// custom:begin ShowExternalAccessIntegrationOptions: additional test cases
+	// custom:end ShowExternalAccessIntegrationOptions: additional test cases
+}
+
+func TestExternalAccessIntegrations_Describe(t *testing.T) {
+	// custom:begin DescribeExternalAccessIntegrationOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeExternalAccessIntegrationOptions
+	defaultOpts := func() *DescribeExternalAccessIntegrationOptions {
+		return &DescribeExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeExternalAccessIntegrationOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeExternalAccessIntegrationOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeExternalAccessIntegrationOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeExternalAccessIntegrationOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE EXTERNAL ACCESS INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DescribeExternalAccessIntegrationOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeExternalAccessIntegrationOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE EXTERNAL ACCESS INTEGRATION %s", id.FullyQualifiedName())
+		// custom:end DescribeExternalAccessIntegrationOptions: all options
+	})
+
+	// custom:begin DescribeExternalAccessIntegrationOptions: additional test cases
+	// custom:end DescribeExternalAccessIntegrationOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
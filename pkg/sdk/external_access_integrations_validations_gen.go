@@ -0,0 +1,96 @@
+package sdk
+
+var (
+	_ validatable = new(CreateExternalAccessIntegrationOptions)
+	_ validatable = new(AlterExternalAccessIntegrationOptions)
+	_ validatable = new(DropExternalAccessIntegrationOptions)
+	_ validatable = new(ShowExternalAccessIntegrationOptions)
+	_ validatable = new(DescribeExternalAccessIntegrationOptions)
+)
+
+func (opts *CreateExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateExternalAccessIntegrationOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateExternalAccessIntegrationOptions: additional validations
+	// custom:end CreateExternalAccessIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.IfExists, opts.SetTags) {
+		errs = append(errs, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "SetTags"))
+	}
+	if moreThanOneValueSet(opts.IfExists, opts.UnsetTags) {
+		errs = append(errs, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "UnsetTags"))
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterExternalAccessIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.AllowedNetworkRules, opts.Set.AllowedApiAuthenticationIntegrations, opts.Set.AllowedAuthenticationSecrets, opts.Set.Enabled, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Set", "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.AllowedApiAuthenticationIntegrations, opts.Unset.AllowedAuthenticationSecrets, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Unset", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"))
+		}
+	}
+	// custom:begin AlterExternalAccessIntegrationOptions: additional validations
+	// custom:end AlterExternalAccessIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropExternalAccessIntegrationOptions: additional validations
+	// custom:end DropExternalAccessIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowExternalAccessIntegrationOptions: additional validations
+	// custom:end ShowExternalAccessIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeExternalAccessIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeExternalAccessIntegrationOptions: additional validations
+	// custom:end DescribeExternalAccessIntegrationOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
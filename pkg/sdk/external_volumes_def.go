@@ -0,0 +1,160 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type S3StorageProvider string
+
+var (
+	S3StorageProviderS3    S3StorageProvider = "S3"
+	S3StorageProviderS3GOV S3StorageProvider = "S3GOV"
+)
+
+type S3EncryptionType string
+
+var (
+	S3EncryptionTypeSseS3  S3EncryptionType = "AWS_SSE_S3"
+	S3EncryptionTypeSseKms S3EncryptionType = "AWS_SSE_KMS"
+	S3EncryptionNone       S3EncryptionType = "NONE"
+)
+
+type GCSEncryptionType string
+
+var (
+	GCSEncryptionTypeSseKms GCSEncryptionType = "GCS_SSE_KMS"
+	GCSEncryptionTypeNone   GCSEncryptionType = "NONE"
+)
+
+var externalVolumeS3StorageLocationDef = g.NewQueryStruct("S3StorageLocationParams").
+	TextAssignment("NAME", g.ParameterOptions().SingleQuotes().Required()).
+	Assignment("STORAGE_PROVIDER", g.KindOfT[S3StorageProvider](), g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("STORAGE_AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("STORAGE_BASE_URL", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalTextAssignment("STORAGE_AWS_EXTERNAL_ID", g.ParameterOptions().SingleQuotes()).
+	OptionalQueryStructField(
+		"Encryption",
+		g.NewQueryStruct("ExternalVolumeS3Encryption").
+			Assignment("TYPE", g.KindOfT[S3EncryptionType](), g.ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("KMS_KEY_ID", g.ParameterOptions().SingleQuotes()),
+		g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
+	)
+
+var externalVolumeGCSStorageLocationDef = g.NewQueryStruct("GCSStorageLocationParams").
+	TextAssignment("NAME", g.ParameterOptions().SingleQuotes().Required()).
+	PredefinedQueryStructField("storageProviderGcs", "bool", g.StaticOptions().SQL("STORAGE_PROVIDER = 'GCS'")).
+	TextAssignment("STORAGE_BASE_URL", g.ParameterOptions().SingleQuotes().Required()).
+	OptionalQueryStructField(
+		"Encryption",
+		g.NewQueryStruct("ExternalVolumeGCSEncryption").
+			Assignment("TYPE", g.KindOfT[GCSEncryptionType](), g.ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("KMS_KEY_ID", g.ParameterOptions().SingleQuotes()),
+		g.ListOptions().Parentheses().NoComma().SQL("ENCRYPTION ="),
+	)
+
+var externalVolumeAzureStorageLocationDef = g.NewQueryStruct("AzureStorageLocationParams").
+	TextAssignment("NAME", g.ParameterOptions().SingleQuotes().Required()).
+	PredefinedQueryStructField("storageProviderAzure", "bool", g.StaticOptions().SQL("STORAGE_PROVIDER = 'AZURE'")).
+	TextAssignment("AZURE_TENANT_ID", g.ParameterOptions().SingleQuotes().Required()).
+	TextAssignment("STORAGE_BASE_URL", g.ParameterOptions().SingleQuotes().Required())
+
+// Each storage location has to be rendered in its own parentheses, so the provider specific parameters are wrapped in
+// list fields with parentheses.
+var externalVolumeStorageLocationDef = g.NewQueryStruct("ExternalVolumeStorageLocation").
+	OptionalQueryStructField("S3StorageLocationParams", externalVolumeS3StorageLocationDef, g.ListOptions().Parentheses().NoComma()).
+	OptionalQueryStructField("GCSStorageLocationParams", externalVolumeGCSStorageLocationDef, g.ListOptions().Parentheses().NoComma()).
+	OptionalQueryStructField("AzureStorageLocationParams", externalVolumeAzureStorageLocationDef, g.ListOptions().Parentheses().NoComma()).
+	WithValidation(g.ExactlyOneValueSet, "S3StorageLocationParams", "GCSStorageLocationParams", "AzureStorageLocationParams")
+
+var ExternalVolumesDef = g.NewInterface(
+	"ExternalVolumes",
+	"ExternalVolume",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-external-volume",
+		g.NewQueryStruct("CreateExternalVolume").
+			Create().
+			OrReplace().
+			SQL("EXTERNAL VOLUME").
+			IfNotExists().
+			Name().
+			ListQueryStructField("StorageLocations", externalVolumeStorageLocationDef, g.ParameterOptions().Parentheses().SQL("STORAGE_LOCATIONS").Required()).
+			OptionalBooleanAssignment("ALLOW_WRITES", g.ParameterOptions()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ValidateValueSet, "StorageLocations"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-external-volume",
+		g.NewQueryStruct("AlterExternalVolume").
+			Alter().
+			SQL("EXTERNAL VOLUME").
+			IfExists().
+			Name().
+			OptionalTextAssignment("REMOVE STORAGE_LOCATION", g.ParameterOptions().SingleQuotes().NoEquals()).
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("AlterExternalVolumeSet").
+					OptionalBooleanAssignment("ALLOW_WRITES", g.ParameterOptions()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "AllowWrites", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"AddStorageLocation",
+				externalVolumeStorageLocationDef,
+				g.ParameterOptions().SQL("ADD STORAGE_LOCATION"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RemoveStorageLocation", "Set", "AddStorageLocation"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-external-volume",
+		g.NewQueryStruct("DropExternalVolume").
+			Drop().
+			SQL("EXTERNAL VOLUME").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-external-volumes",
+		g.DbStruct("showExternalVolumesDbRow").
+			Text("name").
+			Bool("allow_writes").
+			OptionalText("comment"),
+		g.PlainStruct("ExternalVolume").
+			DeriveMapping().
+			Text("Name").
+			Bool("AllowWrites").
+			OptionalText("Comment"),
+		g.NewQueryStruct("ShowExternalVolumes").
+			Show().
+			SQL("EXTERNAL VOLUMES").
+			OptionalLike(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-external-volume",
+		g.DbStruct("descExternalVolumesDbRow").
+			Text("parent_property").
+			Text("property").
+			Text("property_type").
+			Text("property_value").
+			Text("property_default"),
+		g.PlainStruct("ExternalVolumeProperty").
+			DeriveMapping().
+			FieldFrom("Parent", "string", "parent_property").
+			FieldFrom("Name", "string", "property").
+			FieldFrom("Type", "string", "property_type").
+			FieldFrom("Value", "string", "property_value").
+			FieldFrom("Default", "string", "property_default"),
+		g.NewQueryStruct("DescribeExternalVolume").
+			Describe().
+			SQL("EXTERNAL VOLUME").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,83 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateExternalVolumeOptions]   = new(CreateExternalVolumeRequest)
+	_ optionsProvider[AlterExternalVolumeOptions]    = new(AlterExternalVolumeRequest)
+	_ optionsProvider[DropExternalVolumeOptions]     = new(DropExternalVolumeRequest)
+	_ optionsProvider[ShowExternalVolumeOptions]     = new(ShowExternalVolumeRequest)
+	_ optionsProvider[DescribeExternalVolumeOptions] = new(DescribeExternalVolumeRequest)
+)
+
+type CreateExternalVolumeRequest struct {
+	OrReplace        *bool                                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists      *bool                                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name             AccountObjectIdentifier                `validate:"validIdentifier"` // required
+	StorageLocations []ExternalVolumeStorageLocationRequest // required
+	AllowWrites      *bool
+	Comment          *string
+}
+
+type ExternalVolumeStorageLocationRequest struct {
+	S3StorageLocationParams    *S3StorageLocationParamsRequest    `validate:"exactlyOneValueSet=S3StorageLocationParams|GCSStorageLocationParams|AzureStorageLocationParams"`
+	GCSStorageLocationParams   *GCSStorageLocationParamsRequest   `validate:"exactlyOneValueSet=S3StorageLocationParams|GCSStorageLocationParams|AzureStorageLocationParams"`
+	AzureStorageLocationParams *AzureStorageLocationParamsRequest `validate:"exactlyOneValueSet=S3StorageLocationParams|GCSStorageLocationParams|AzureStorageLocationParams"`
+}
+
+type S3StorageLocationParamsRequest struct {
+	Name                 string            // required
+	StorageProvider      S3StorageProvider // required
+	StorageAwsRoleArn    string            // required
+	StorageBaseUrl       string            // required
+	StorageAwsExternalId *string
+	Encryption           *ExternalVolumeS3EncryptionRequest
+}
+
+type ExternalVolumeS3EncryptionRequest struct {
+	Type     S3EncryptionType // required
+	KmsKeyId *string
+}
+
+type GCSStorageLocationParamsRequest struct {
+	Name           string // required
+	StorageBaseUrl string // required
+	Encryption     *ExternalVolumeGCSEncryptionRequest
+}
+
+type ExternalVolumeGCSEncryptionRequest struct {
+	Type     GCSEncryptionType // required
+	KmsKeyId *string
+}
+
+type AzureStorageLocationParamsRequest struct {
+	Name           string // required
+	AzureTenantId  string // required
+	StorageBaseUrl string // required
+}
+
+type AlterExternalVolumeRequest struct {
+	IfExists              *bool
+	name                  AccountObjectIdentifier               `validate:"validIdentifier"` // required
+	RemoveStorageLocation *string                               `validate:"exactlyOneValueSet=RemoveStorageLocation|Set|AddStorageLocation"`
+	Set                   *AlterExternalVolumeSetRequest        `validate:"exactlyOneValueSet=RemoveStorageLocation|Set|AddStorageLocation"`
+	AddStorageLocation    *ExternalVolumeStorageLocationRequest `validate:"exactlyOneValueSet=RemoveStorageLocation|Set|AddStorageLocation"`
+}
+
+type AlterExternalVolumeSetRequest struct {
+	AllowWrites *bool   `validate:"atLeastOneValueSet=AllowWrites|Comment"`
+	Comment     *string `validate:"atLeastOneValueSet=AllowWrites|Comment"`
+}
+
+type DropExternalVolumeRequest struct {
+	IfExists *bool
+	name     AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowExternalVolumeRequest struct {
+	Like *Like
+}
+
+type DescribeExternalVolumeRequest struct {
+	name AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,419 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalVolumes_Create(t *testing.T) {
+	// custom:begin CreateExternalVolumeOptions: default options
+	id := randomAccountObjectIdentifier()
+	s3StorageLocation := ExternalVolumeStorageLocation{
+		S3StorageLocationParams: &S3StorageLocationParams{
+			Name:              "s3-location",
+			StorageProvider:   S3StorageProviderS3,
+			StorageAwsRoleArn: "arn:aws:iam::123456789012:role/myrole",
+			StorageBaseUrl:    "s3://my_example_bucket/",
+		},
+	}
+
+	// Minimal valid CreateExternalVolumeOptions
+	defaultOpts := func() *CreateExternalVolumeOptions {
+		return &CreateExternalVolumeOptions{
+			name:             id,
+			StorageLocations: []ExternalVolumeStorageLocation{s3StorageLocation},
+		}
+	}
+	// custom:end CreateExternalVolumeOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateExternalVolumeOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalVolumeOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateExternalVolumeOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: [opts.StorageLocations] should be set", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions: validation (value set)
+		opts := defaultOpts()
+		opts.StorageLocations = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateExternalVolumeOptions", "StorageLocations"))
+		// custom:end CreateExternalVolumeOptions: validation (value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.StorageLocations.S3StorageLocationParams opts.StorageLocations.GCSStorageLocationParams opts.StorageLocations.AzureStorageLocationParams] should be present", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions.StorageLocations: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.StorageLocations = []ExternalVolumeStorageLocation{
+			s3StorageLocation,
+			{
+				GCSStorageLocationParams: &GCSStorageLocationParams{
+					Name:           "gcs-location",
+					StorageBaseUrl: "gcs://my_example_bucket/",
+				},
+				AzureStorageLocationParams: &AzureStorageLocationParams{
+					Name:           "azure-location",
+					AzureTenantId:  "a123b4c5-1234-123a-a12b-1a23b45678c9",
+					StorageBaseUrl: "azure://exampleacct.blob.core.windows.net/my_container/",
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateExternalVolumeOptions.StorageLocations", "S3StorageLocationParams", "GCSStorageLocationParams", "AzureStorageLocationParams"))
+		// custom:end CreateExternalVolumeOptions.StorageLocations: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE EXTERNAL VOLUME %s STORAGE_LOCATIONS = ((NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/myrole' STORAGE_BASE_URL = 's3://my_example_bucket/'))", id.FullyQualifiedName())
+		// custom:end CreateExternalVolumeOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateExternalVolumeOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.StorageLocations = []ExternalVolumeStorageLocation{
+			{
+				S3StorageLocationParams: &S3StorageLocationParams{
+					Name:                 "s3-location",
+					StorageProvider:      S3StorageProviderS3GOV,
+					StorageAwsRoleArn:    "arn:aws:iam::123456789012:role/myrole",
+					StorageBaseUrl:       "s3://my_example_bucket/",
+					StorageAwsExternalId: String("external_id"),
+					Encryption: &ExternalVolumeS3Encryption{
+						Type:     S3EncryptionTypeSseKms,
+						KmsKeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+					},
+				},
+			},
+			{
+				GCSStorageLocationParams: &GCSStorageLocationParams{
+					Name:           "gcs-location",
+					StorageBaseUrl: "gcs://my_example_bucket/",
+					Encryption: &ExternalVolumeGCSEncryption{
+						Type:     GCSEncryptionTypeSseKms,
+						KmsKeyId: String("gcs-key"),
+					},
+				},
+			},
+			{
+				AzureStorageLocationParams: &AzureStorageLocationParams{
+					Name:           "azure-location",
+					AzureTenantId:  "a123b4c5-1234-123a-a12b-1a23b45678c9",
+					StorageBaseUrl: "azure://exampleacct.blob.core.windows.net/my_container/",
+				},
+			},
+		}
+		opts.AllowWrites = Bool(false)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE EXTERNAL VOLUME %s STORAGE_LOCATIONS = ("+
+			"(NAME = 's3-location' STORAGE_PROVIDER = 'S3GOV' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/myrole' STORAGE_BASE_URL = 's3://my_example_bucket/' STORAGE_AWS_EXTERNAL_ID = 'external_id' ENCRYPTION = (TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '1234abcd-12ab-34cd-56ef-1234567890ab')), "+
+			"(NAME = 'gcs-location' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://my_example_bucket/' ENCRYPTION = (TYPE = 'GCS_SSE_KMS' KMS_KEY_ID = 'gcs-key')), "+
+			"(NAME = 'azure-location' STORAGE_PROVIDER = 'AZURE' AZURE_TENANT_ID = 'a123b4c5-1234-123a-a12b-1a23b45678c9' STORAGE_BASE_URL = 'azure://exampleacct.blob.core.windows.net/my_container/')"+
+			") ALLOW_WRITES = false COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateExternalVolumeOptions: all options
+	})
+
+	// custom:begin CreateExternalVolumeOptions: additional test cases
+	t.Run("if not exists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE EXTERNAL VOLUME IF NOT EXISTS %s STORAGE_LOCATIONS = ((NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/myrole' STORAGE_BASE_URL = 's3://my_example_bucket/'))", id.FullyQualifiedName())
+	})
+	// custom:end CreateExternalVolumeOptions: additional test cases
+}
+
+func TestExternalVolumes_Alter(t *testing.T) {
+	// custom:begin AlterExternalVolumeOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterExternalVolumeOptions
+	defaultOpts := func() *AlterExternalVolumeOptions {
+		return &AlterExternalVolumeOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterExternalVolumeOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		opts.RemoveStorageLocation = String("some-location")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterExternalVolumeOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RemoveStorageLocation opts.Set opts.AddStorageLocation] should be present", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.RemoveStorageLocation = String("some-location")
+		opts.Set = &AlterExternalVolumeSet{AllowWrites: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalVolumeOptions", "RemoveStorageLocation", "Set", "AddStorageLocation"))
+		// custom:end AlterExternalVolumeOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.AllowWrites opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &AlterExternalVolumeSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalVolumeOptions.Set", "AllowWrites", "Comment"))
+		// custom:end AlterExternalVolumeOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.AddStorageLocation.S3StorageLocationParams opts.AddStorageLocation.GCSStorageLocationParams opts.AddStorageLocation.AzureStorageLocationParams] should be present", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions.AddStorageLocation: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalVolumeOptions.AddStorageLocation", "S3StorageLocationParams", "GCSStorageLocationParams", "AzureStorageLocationParams"))
+		// custom:end AlterExternalVolumeOptions.AddStorageLocation: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions: basic
+		opts := defaultOpts()
+		opts.RemoveStorageLocation = String("some-location")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL VOLUME %s REMOVE STORAGE_LOCATION 'some-location'", id.FullyQualifiedName())
+		// custom:end AlterExternalVolumeOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterExternalVolumeOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &AlterExternalVolumeSet{
+			AllowWrites: Bool(true),
+			Comment:     String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL VOLUME IF EXISTS %s SET ALLOW_WRITES = true COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterExternalVolumeOptions: all options
+	})
+
+	// custom:begin AlterExternalVolumeOptions: additional test cases
+	t.Run("add s3 storage location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{
+			S3StorageLocationParams: &S3StorageLocationParams{
+				Name:              "s3-location",
+				StorageProvider:   S3StorageProviderS3,
+				StorageAwsRoleArn: "arn:aws:iam::123456789012:role/myrole",
+				StorageBaseUrl:    "s3://my_example_bucket/",
+				Encryption: &ExternalVolumeS3Encryption{
+					Type: S3EncryptionTypeSseS3,
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL VOLUME %s ADD STORAGE_LOCATION = (NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/myrole' STORAGE_BASE_URL = 's3://my_example_bucket/' ENCRYPTION = (TYPE = 'AWS_SSE_S3'))", id.FullyQualifiedName())
+	})
+
+	t.Run("add gcs storage location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{
+			GCSStorageLocationParams: &GCSStorageLocationParams{
+				Name:           "gcs-location",
+				StorageBaseUrl: "gcs://my_example_bucket/",
+				Encryption: &ExternalVolumeGCSEncryption{
+					Type: GCSEncryptionTypeNone,
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL VOLUME %s ADD STORAGE_LOCATION = (NAME = 'gcs-location' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://my_example_bucket/' ENCRYPTION = (TYPE = 'NONE'))", id.FullyQualifiedName())
+	})
+
+	t.Run("add azure storage location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{
+			AzureStorageLocationParams: &AzureStorageLocationParams{
+				Name:           "azure-location",
+				AzureTenantId:  "a123b4c5-1234-123a-a12b-1a23b45678c9",
+				StorageBaseUrl: "azure://exampleacct.blob.core.windows.net/my_container/",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL VOLUME %s ADD STORAGE_LOCATION = (NAME = 'azure-location' STORAGE_PROVIDER = 'AZURE' AZURE_TENANT_ID = 'a123b4c5-1234-123a-a12b-1a23b45678c9' STORAGE_BASE_URL = 'azure://exampleacct.blob.core.windows.net/my_container/')", id.FullyQualifiedName())
+	})
+	// custom:end AlterExternalVolumeOptions: additional test cases
+}
+
+func TestExternalVolumes_Drop(t *testing.T) {
+	// custom:begin DropExternalVolumeOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropExternalVolumeOptions
+	defaultOpts := func() *DropExternalVolumeOptions {
+		return &DropExternalVolumeOptions{
+			name: id,
+		}
+	}
+	// custom:end DropExternalVolumeOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropExternalVolumeOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropExternalVolumeOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropExternalVolumeOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP EXTERNAL VOLUME %s", id.FullyQualifiedName())
+		// custom:end DropExternalVolumeOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropExternalVolumeOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP EXTERNAL VOLUME IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropExternalVolumeOptions: all options
+	})
+
+	// custom:begin DropExternalVolumeOptions: additional test cases
+	// custom:end DropExternalVolumeOptions: additional test cases
+}
+
+func TestExternalVolumes_Show(t *testing.T) {
+	// custom:begin ShowExternalVolumeOptions: default options
+	// Minimal valid ShowExternalVolumeOptions
+	defaultOpts := func() *ShowExternalVolumeOptions {
+		return &ShowExternalVolumeOptions{}
+	}
+	// custom:end ShowExternalVolumeOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowExternalVolumeOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL VOLUMES")
+		// custom:end ShowExternalVolumeOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowExternalVolumeOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL VOLUMES LIKE 'some pattern'")
+		// custom:end ShowExternalVolumeOptions: all options
+	})
+
+	// custom:begin ShowExternalVolumeOptions: additional test cases
+	// custom:end ShowExternalVolumeOptions: additional test cases
+}
+
+func TestExternalVolumes_Describe(t *testing.T) {
+	// custom:begin DescribeExternalVolumeOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeExternalVolumeOptions
+	defaultOpts := func() *DescribeExternalVolumeOptions {
+		return &DescribeExternalVolumeOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeExternalVolumeOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeExternalVolumeOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeExternalVolumeOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeExternalVolumeOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeExternalVolumeOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE EXTERNAL VOLUME %s", id.FullyQualifiedName())
+		// custom:end DescribeExternalVolumeOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeExternalVolumeOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE EXTERNAL VOLUME %s", id.FullyQualifiedName())
+		// custom:end DescribeExternalVolumeOptions: all options
+	})
+
+	// custom:begin DescribeExternalVolumeOptions: additional test cases
+	// custom:end DescribeExternalVolumeOptions: additional test cases
+}
+
+// custom:begin additional
+func TestParseExternalVolumeStorageLocations(t *testing.T) {
+	t.Run("storage locations are ordered by their index", func(t *testing.T) {
+		properties := []ExternalVolumeProperty{
+			{Parent: "", Name: "ALLOW_WRITES", Type: "Boolean", Value: "true", Default: "true"},
+			{Parent: "STORAGE_LOCATIONS", Name: "STORAGE_LOCATION_2", Type: "String", Value: `{"NAME":"azure-location","STORAGE_PROVIDER":"AZURE","STORAGE_BASE_URL":"azure://exampleacct.blob.core.windows.net/my_container/","AZURE_TENANT_ID":"a123b4c5-1234-123a-a12b-1a23b45678c9"}`},
+			{Parent: "STORAGE_LOCATIONS", Name: "STORAGE_LOCATION_1", Type: "String", Value: `{"NAME":"s3-location","STORAGE_PROVIDER":"S3","STORAGE_BASE_URL":"s3://my_example_bucket/","STORAGE_AWS_ROLE_ARN":"arn:aws:iam::123456789012:role/myrole","ENCRYPTION_TYPE":"AWS_SSE_KMS","ENCRYPTION_KMS_KEY_ID":"1234abcd"}`},
+			{Parent: "STORAGE_LOCATIONS", Name: "ACTIVE", Type: "String", Value: "s3-location"},
+		}
+
+		storageLocations, err := ParseExternalVolumeStorageLocations(properties)
+		require.NoError(t, err)
+		require.Len(t, storageLocations, 2)
+
+		assert.Equal(t, ExternalVolumeStorageLocationDetails{
+			Name:               "s3-location",
+			StorageProvider:    "S3",
+			StorageBaseUrl:     "s3://my_example_bucket/",
+			StorageAwsRoleArn:  "arn:aws:iam::123456789012:role/myrole",
+			EncryptionType:     "AWS_SSE_KMS",
+			EncryptionKmsKeyId: "1234abcd",
+		}, storageLocations[0])
+		assert.Equal(t, ExternalVolumeStorageLocationDetails{
+			Name:            "azure-location",
+			StorageProvider: "AZURE",
+			StorageBaseUrl:  "azure://exampleacct.blob.core.windows.net/my_container/",
+			AzureTenantId:   "a123b4c5-1234-123a-a12b-1a23b45678c9",
+		}, storageLocations[1])
+	})
+
+	t.Run("invalid storage location value", func(t *testing.T) {
+		_, err := ParseExternalVolumeStorageLocations([]ExternalVolumeProperty{
+			{Parent: "STORAGE_LOCATIONS", Name: "STORAGE_LOCATION_1", Value: "not a json"},
+		})
+		require.ErrorContains(t, err, "unable to parse storage location STORAGE_LOCATION_1")
+	})
+}
+
+// custom:end additional
@@ -0,0 +1,274 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ ExternalVolumes = (*externalVolumes)(nil)
+
+type externalVolumes struct {
+	client *Client
+}
+
+func (v *externalVolumes) Create(ctx context.Context, request *CreateExternalVolumeRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Alter(ctx context.Context, request *AlterExternalVolumeRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Drop(ctx context.Context, request *DropExternalVolumeRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalVolumes) Show(ctx context.Context, request *ShowExternalVolumeRequest) ([]ExternalVolume, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showExternalVolumesDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showExternalVolumesDbRow, ExternalVolume](dbRows)
+	return resultList, nil
+}
+
+func (v *externalVolumes) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolume, error) {
+	// custom:begin ShowByID
+	externalVolumes, err := v.Show(ctx, NewShowExternalVolumeRequest().WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(externalVolumes, func(r ExternalVolume) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *externalVolumes) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ExternalVolumeProperty, error) {
+	opts := &DescribeExternalVolumeOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[descExternalVolumesDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[descExternalVolumesDbRow, ExternalVolumeProperty](rows), nil
+}
+
+func (r *CreateExternalVolumeRequest) toOpts() *CreateExternalVolumeOptions {
+	opts := &CreateExternalVolumeOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		AllowWrites: r.AllowWrites,
+		Comment:     r.Comment,
+	}
+	if r.StorageLocations != nil {
+		s := make([]ExternalVolumeStorageLocation, len(r.StorageLocations))
+		for i, v := range r.StorageLocations {
+			s[i] = ExternalVolumeStorageLocation{}
+			if v.S3StorageLocationParams != nil {
+				s[i].S3StorageLocationParams = &S3StorageLocationParams{
+					Name:                 v.S3StorageLocationParams.Name,
+					StorageProvider:      v.S3StorageLocationParams.StorageProvider,
+					StorageAwsRoleArn:    v.S3StorageLocationParams.StorageAwsRoleArn,
+					StorageBaseUrl:       v.S3StorageLocationParams.StorageBaseUrl,
+					StorageAwsExternalId: v.S3StorageLocationParams.StorageAwsExternalId,
+				}
+				if v.S3StorageLocationParams.Encryption != nil {
+					s[i].S3StorageLocationParams.Encryption = &ExternalVolumeS3Encryption{
+						Type:     v.S3StorageLocationParams.Encryption.Type,
+						KmsKeyId: v.S3StorageLocationParams.Encryption.KmsKeyId,
+					}
+				}
+			}
+			if v.GCSStorageLocationParams != nil {
+				s[i].GCSStorageLocationParams = &GCSStorageLocationParams{
+					Name:           v.GCSStorageLocationParams.Name,
+					StorageBaseUrl: v.GCSStorageLocationParams.StorageBaseUrl,
+				}
+				if v.GCSStorageLocationParams.Encryption != nil {
+					s[i].GCSStorageLocationParams.Encryption = &ExternalVolumeGCSEncryption{
+						Type:     v.GCSStorageLocationParams.Encryption.Type,
+						KmsKeyId: v.GCSStorageLocationParams.Encryption.KmsKeyId,
+					}
+				}
+			}
+			if v.AzureStorageLocationParams != nil {
+				s[i].AzureStorageLocationParams = &AzureStorageLocationParams{
+					Name:           v.AzureStorageLocationParams.Name,
+					AzureTenantId:  v.AzureStorageLocationParams.AzureTenantId,
+					StorageBaseUrl: v.AzureStorageLocationParams.StorageBaseUrl,
+				}
+			}
+		}
+		opts.StorageLocations = s
+	}
+	return opts
+}
+
+func (r *AlterExternalVolumeRequest) toOpts() *AlterExternalVolumeOptions {
+	opts := &AlterExternalVolumeOptions{
+		IfExists:              r.IfExists,
+		name:                  r.name,
+		RemoveStorageLocation: r.RemoveStorageLocation,
+	}
+	if r.Set != nil {
+		opts.Set = &AlterExternalVolumeSet{
+			AllowWrites: r.Set.AllowWrites,
+			Comment:     r.Set.Comment,
+		}
+	}
+	if r.AddStorageLocation != nil {
+		opts.AddStorageLocation = &ExternalVolumeStorageLocation{}
+		if r.AddStorageLocation.S3StorageLocationParams != nil {
+			opts.AddStorageLocation.S3StorageLocationParams = &S3StorageLocationParams{
+				Name:                 r.AddStorageLocation.S3StorageLocationParams.Name,
+				StorageProvider:      r.AddStorageLocation.S3StorageLocationParams.StorageProvider,
+				StorageAwsRoleArn:    r.AddStorageLocation.S3StorageLocationParams.StorageAwsRoleArn,
+				StorageBaseUrl:       r.AddStorageLocation.S3StorageLocationParams.StorageBaseUrl,
+				StorageAwsExternalId: r.AddStorageLocation.S3StorageLocationParams.StorageAwsExternalId,
+			}
+			if r.AddStorageLocation.S3StorageLocationParams.Encryption != nil {
+				opts.AddStorageLocation.S3StorageLocationParams.Encryption = &ExternalVolumeS3Encryption{
+					Type:     r.AddStorageLocation.S3StorageLocationParams.Encryption.Type,
+					KmsKeyId: r.AddStorageLocation.S3StorageLocationParams.Encryption.KmsKeyId,
+				}
+			}
+		}
+		if r.AddStorageLocation.GCSStorageLocationParams != nil {
+			opts.AddStorageLocation.GCSStorageLocationParams = &GCSStorageLocationParams{
+				Name:           r.AddStorageLocation.GCSStorageLocationParams.Name,
+				StorageBaseUrl: r.AddStorageLocation.GCSStorageLocationParams.StorageBaseUrl,
+			}
+			if r.AddStorageLocation.GCSStorageLocationParams.Encryption != nil {
+				opts.AddStorageLocation.GCSStorageLocationParams.Encryption = &ExternalVolumeGCSEncryption{
+					Type:     r.AddStorageLocation.GCSStorageLocationParams.Encryption.Type,
+					KmsKeyId: r.AddStorageLocation.GCSStorageLocationParams.Encryption.KmsKeyId,
+				}
+			}
+		}
+		if r.AddStorageLocation.AzureStorageLocationParams != nil {
+			opts.AddStorageLocation.AzureStorageLocationParams = &AzureStorageLocationParams{
+				Name:           r.AddStorageLocation.AzureStorageLocationParams.Name,
+				AzureTenantId:  r.AddStorageLocation.AzureStorageLocationParams.AzureTenantId,
+				StorageBaseUrl: r.AddStorageLocation.AzureStorageLocationParams.StorageBaseUrl,
+			}
+		}
+	}
+	return opts
+}
+
+func (r *DropExternalVolumeRequest) toOpts() *DropExternalVolumeOptions {
+	opts := &DropExternalVolumeOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowExternalVolumeRequest) toOpts() *ShowExternalVolumeOptions {
+	opts := &ShowExternalVolumeOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r showExternalVolumesDbRow) convert() *ExternalVolume {
+	externalVolume := ExternalVolume{
+		Name:        r.Name,
+		AllowWrites: r.AllowWrites,
+	}
+	if r.Comment.Valid {
+		externalVolume.Comment = String(r.Comment.String)
+	}
+	return &externalVolume
+}
+
+func (r *DescribeExternalVolumeRequest) toOpts() *DescribeExternalVolumeOptions {
+	opts := &DescribeExternalVolumeOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r descExternalVolumesDbRow) convert() *ExternalVolumeProperty {
+	externalVolumeProperty := ExternalVolumeProperty{
+		Parent:  r.ParentProperty,
+		Name:    r.Property,
+		Type:    r.PropertyType,
+		Value:   r.PropertyValue,
+		Default: r.PropertyDefault,
+	}
+	return &externalVolumeProperty
+}
+
+// custom:begin additional
+// ExternalVolumeStorageLocationDetails is the content of the STORAGE_LOCATION_<n> property returned by DESCRIBE EXTERNAL VOLUME.
+type ExternalVolumeStorageLocationDetails struct {
+	Name                     string `json:"NAME"`
+	StorageProvider          string `json:"STORAGE_PROVIDER"`
+	StorageBaseUrl           string `json:"STORAGE_BASE_URL"`
+	StorageAwsRoleArn        string `json:"STORAGE_AWS_ROLE_ARN"`
+	StorageAwsIamUserArn     string `json:"STORAGE_AWS_IAM_USER_ARN"`
+	StorageAwsExternalId     string `json:"STORAGE_AWS_EXTERNAL_ID"`
+	StorageGcpServiceAccount string `json:"STORAGE_GCP_SERVICE_ACCOUNT"`
+	AzureTenantId            string `json:"AZURE_TENANT_ID"`
+	AzureMultiTenantAppName  string `json:"AZURE_MULTI_TENANT_APP_NAME"`
+	AzureConsentUrl          string `json:"AZURE_CONSENT_URL"`
+	EncryptionType           string `json:"ENCRYPTION_TYPE"`
+	EncryptionKmsKeyId       string `json:"ENCRYPTION_KMS_KEY_ID"`
+}
+
+// ParseExternalVolumeStorageLocations returns storage locations from DESCRIBE EXTERNAL VOLUME output ordered the same way
+// they were specified (or added) for the external volume.
+func ParseExternalVolumeStorageLocations(properties []ExternalVolumeProperty) ([]ExternalVolumeStorageLocationDetails, error) {
+	type indexedStorageLocation struct {
+		index   int
+		details ExternalVolumeStorageLocationDetails
+	}
+	indexed := make([]indexedStorageLocation, 0)
+	for _, property := range properties {
+		rawIndex, found := strings.CutPrefix(property.Name, "STORAGE_LOCATION_")
+		if !found {
+			continue
+		}
+		index, err := strconv.Atoi(rawIndex)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse storage location index from property %s: %w", property.Name, err)
+		}
+		var details ExternalVolumeStorageLocationDetails
+		if err := json.Unmarshal([]byte(property.Value), &details); err != nil {
+			return nil, fmt.Errorf("unable to parse storage location %s: %w", property.Name, err)
+		}
+		indexed = append(indexed, indexedStorageLocation{index: index, details: details})
+	}
+	slices.SortFunc(indexed, func(a, b indexedStorageLocation) int { return a.index - b.index })
+	storageLocations := make([]ExternalVolumeStorageLocationDetails, len(indexed))
+	for i, v := range indexed {
+		storageLocations[i] = v.details
+	}
+	return storageLocations, nil
+}
+
+// custom:end additional
@@ -0,0 +1,98 @@
+package sdk
+
+var (
+	_ validatable = new(CreateExternalVolumeOptions)
+	_ validatable = new(AlterExternalVolumeOptions)
+	_ validatable = new(DropExternalVolumeOptions)
+	_ validatable = new(ShowExternalVolumeOptions)
+	_ validatable = new(DescribeExternalVolumeOptions)
+)
+
+func (opts *CreateExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateExternalVolumeOptions", "OrReplace", "IfNotExists"))
+	}
+	if !valueSet(opts.StorageLocations) {
+		errs = append(errs, errNotSet("CreateExternalVolumeOptions", "StorageLocations"))
+	}
+	for _, v := range opts.StorageLocations {
+		if !exactlyOneValueSet(v.S3StorageLocationParams, v.GCSStorageLocationParams, v.AzureStorageLocationParams) {
+			errs = append(errs, errExactlyOneOf("CreateExternalVolumeOptions.StorageLocations", "S3StorageLocationParams", "GCSStorageLocationParams", "AzureStorageLocationParams"))
+		}
+	}
+	// custom:begin CreateExternalVolumeOptions: additional validations
+	// custom:end CreateExternalVolumeOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RemoveStorageLocation, opts.Set, opts.AddStorageLocation) {
+		errs = append(errs, errExactlyOneOf("AlterExternalVolumeOptions", "RemoveStorageLocation", "Set", "AddStorageLocation"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.AllowWrites, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterExternalVolumeOptions.Set", "AllowWrites", "Comment"))
+		}
+	}
+	if valueSet(opts.AddStorageLocation) {
+		if !exactlyOneValueSet(opts.AddStorageLocation.S3StorageLocationParams, opts.AddStorageLocation.GCSStorageLocationParams, opts.AddStorageLocation.AzureStorageLocationParams) {
+			errs = append(errs, errExactlyOneOf("AlterExternalVolumeOptions.AddStorageLocation", "S3StorageLocationParams", "GCSStorageLocationParams", "AzureStorageLocationParams"))
+		}
+	}
+	// custom:begin AlterExternalVolumeOptions: additional validations
+	// custom:end AlterExternalVolumeOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropExternalVolumeOptions: additional validations
+	// custom:end DropExternalVolumeOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowExternalVolumeOptions: additional validations
+	// custom:end ShowExternalVolumeOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeExternalVolumeOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeExternalVolumeOptions: additional validations
+	// custom:end DescribeExternalVolumeOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
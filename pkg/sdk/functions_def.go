@@ -236,16 +236,18 @@ var FunctionsDef = g.NewInterface(
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET LOG_LEVEL", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET TRACE_LEVEL", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("SET METRIC_LEVEL", g.ParameterOptions().SingleQuotes()).
 		OptionalSQL("SET SECURE").
 		OptionalSQL("UNSET SECURE").
 		OptionalSQL("UNSET LOG_LEVEL").
 		OptionalSQL("UNSET TRACE_LEVEL").
+		OptionalSQL("UNSET METRIC_LEVEL").
 		OptionalSQL("UNSET COMMENT").
 		OptionalSetTags().
 		OptionalUnsetTags().
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetMetricLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetMetricLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-function",
 	g.NewQueryStruct("DropFunction").
@@ -500,6 +500,11 @@ func (s *AlterFunctionRequest) WithSetTraceLevel(SetTraceLevel *string) *AlterFu
 	return s
 }
 
+func (s *AlterFunctionRequest) WithSetMetricLevel(SetMetricLevel *string) *AlterFunctionRequest {
+	s.SetMetricLevel = SetMetricLevel
+	return s
+}
+
 func (s *AlterFunctionRequest) WithSetSecure(SetSecure *bool) *AlterFunctionRequest {
 	s.SetSecure = SetSecure
 	return s
@@ -520,6 +525,11 @@ func (s *AlterFunctionRequest) WithUnsetTraceLevel(UnsetTraceLevel *bool) *Alter
 	return s
 }
 
+func (s *AlterFunctionRequest) WithUnsetMetricLevel(UnsetMetricLevel *bool) *AlterFunctionRequest {
+	s.UnsetMetricLevel = UnsetMetricLevel
+	return s
+}
+
 func (s *AlterFunctionRequest) WithUnsetComment(UnsetComment *bool) *AlterFunctionRequest {
 	s.UnsetComment = UnsetComment
 	return s
@@ -150,10 +150,12 @@ type AlterFunctionRequest struct {
 	SetComment        *string
 	SetLogLevel       *string
 	SetTraceLevel     *string
+	SetMetricLevel    *string
 	SetSecure         *bool
 	UnsetSecure       *bool
 	UnsetLogLevel     *bool
 	UnsetTraceLevel   *bool
+	UnsetMetricLevel  *bool
 	UnsetComment      *bool
 	SetTags           []TagAssociation
 	UnsetTags         []ObjectIdentifier
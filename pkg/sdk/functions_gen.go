@@ -176,10 +176,12 @@ type AlterFunctionOptions struct {
 	SetComment        *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
 	SetLogLevel       *string                 `ddl:"parameter,single_quotes" sql:"SET LOG_LEVEL"`
 	SetTraceLevel     *string                 `ddl:"parameter,single_quotes" sql:"SET TRACE_LEVEL"`
+	SetMetricLevel    *string                 `ddl:"parameter,single_quotes" sql:"SET METRIC_LEVEL"`
 	SetSecure         *bool                   `ddl:"keyword" sql:"SET SECURE"`
 	UnsetSecure       *bool                   `ddl:"keyword" sql:"UNSET SECURE"`
 	UnsetLogLevel     *bool                   `ddl:"keyword" sql:"UNSET LOG_LEVEL"`
 	UnsetTraceLevel   *bool                   `ddl:"keyword" sql:"UNSET TRACE_LEVEL"`
+	UnsetMetricLevel  *bool                   `ddl:"keyword" sql:"UNSET METRIC_LEVEL"`
 	UnsetComment      *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
 	SetTags           []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
 	UnsetTags         []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
@@ -491,14 +491,14 @@ func TestFunctions_Alter(t *testing.T) {
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetMetricLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetMetricLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetLogLevel = String("DEBUG")
 		opts.UnsetComment = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterFunctionOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetMetricLevel", "SetSecure", "UnsetLogLevel", "UnsetTraceLevel", "UnsetMetricLevel", "UnsetSecure", "UnsetComment", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("alter: rename to", func(t *testing.T) {
@@ -527,6 +527,12 @@ func TestFunctions_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) SET TRACE_LEVEL = 'DEBUG'`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set metric level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetMetricLevel = String("ALL")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) SET METRIC_LEVEL = 'ALL'`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetComment = String("comment")
@@ -551,6 +557,12 @@ func TestFunctions_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) UNSET TRACE_LEVEL`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter: unset metric level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetMetricLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER FUNCTION IF EXISTS %s (VARCHAR, NUMBER) UNSET METRIC_LEVEL`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter: unset secure", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.UnsetSecure = Bool(true)
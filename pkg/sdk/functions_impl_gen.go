@@ -333,10 +333,12 @@ func (r *AlterFunctionRequest) toOpts() *AlterFunctionOptions {
 		SetComment:        r.SetComment,
 		SetLogLevel:       r.SetLogLevel,
 		SetTraceLevel:     r.SetTraceLevel,
+		SetMetricLevel:    r.SetMetricLevel,
 		SetSecure:         r.SetSecure,
 		UnsetSecure:       r.UnsetSecure,
 		UnsetLogLevel:     r.UnsetLogLevel,
 		UnsetTraceLevel:   r.UnsetTraceLevel,
+		UnsetMetricLevel:  r.UnsetMetricLevel,
 		UnsetComment:      r.UnsetComment,
 		SetTags:           r.SetTags,
 		UnsetTags:         r.UnsetTags,
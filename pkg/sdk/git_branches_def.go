@@ -0,0 +1,32 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// GitBranchesDef covers the branches of a git repository that were fetched to Snowflake, they can be only listed.
+var GitBranchesDef = g.NewInterface(
+	"GitBranches",
+	"GitBranch",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-git-branches",
+		g.DbStruct("gitBranchesRow").
+			Text("name").
+			Text("path").
+			OptionalText("checkouts").
+			Text("commit_hash"),
+		g.PlainStruct("GitBranch").
+			DeriveMapping().
+			Text("Name").
+			Text("Path").
+			OptionalText("Checkouts").
+			Text("CommitHash"),
+		g.NewQueryStruct("ShowGitBranches").
+			Show().
+			SQL("GIT BRANCHES").
+			OptionalLike().
+			Identifier("InGitRepository", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN GIT REPOSITORY").Required()).
+			WithValidation(g.ValidIdentifier, "InGitRepository"),
+	)
@@ -0,0 +1,12 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[ShowGitBranchOptions] = new(ShowGitBranchRequest)
+)
+
+type ShowGitBranchRequest struct {
+	Like            *Like
+	InGitRepository SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
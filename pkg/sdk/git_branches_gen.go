@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type GitBranches interface {
+	Show(ctx context.Context, request *ShowGitBranchRequest) ([]GitBranch, error)
+}
+
+// ShowGitBranchOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-git-branches.
+type ShowGitBranchOptions struct {
+	show            bool                   `ddl:"static" sql:"SHOW"`
+	gitBranches     bool                   `ddl:"static" sql:"GIT BRANCHES"`
+	Like            *Like                  `ddl:"keyword" sql:"LIKE"`
+	InGitRepository SchemaObjectIdentifier `ddl:"identifier" sql:"IN GIT REPOSITORY"`
+}
+
+type gitBranchesRow struct {
+	Name       string         `db:"name"`
+	Path       string         `db:"path"`
+	Checkouts  sql.NullString `db:"checkouts"`
+	CommitHash string         `db:"commit_hash"`
+}
+
+type GitBranch struct {
+	Name       string
+	Path       string
+	Checkouts  *string
+	CommitHash string
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,50 @@
+package sdk
+
+import "testing"
+
+func TestGitBranches_Show(t *testing.T) {
+	// custom:begin ShowGitBranchOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid ShowGitBranchOptions
+	defaultOpts := func() *ShowGitBranchOptions {
+		return &ShowGitBranchOptions{
+			InGitRepository: id,
+		}
+	}
+	// custom:end ShowGitBranchOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitBranchOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InGitRepository]", func(t *testing.T) {
+		// custom:begin ShowGitBranchOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InGitRepository = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowGitBranchOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowGitBranchOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT BRANCHES IN GIT REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end ShowGitBranchOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowGitBranchOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT BRANCHES LIKE 'some pattern' IN GIT REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end ShowGitBranchOptions: all options
+	})
+
+	// custom:begin ShowGitBranchOptions: additional test cases
+	// custom:end ShowGitBranchOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,45 @@
+package sdk
+
+import "context"
+
+var _ GitBranches = (*gitBranches)(nil)
+
+type gitBranches struct {
+	client *Client
+}
+
+func (v *gitBranches) Show(ctx context.Context, request *ShowGitBranchRequest) ([]GitBranch, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitBranchesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitBranchesRow, GitBranch](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowGitBranchRequest) toOpts() *ShowGitBranchOptions {
+	opts := &ShowGitBranchOptions{
+		Like:            r.Like,
+		InGitRepository: r.InGitRepository,
+	}
+	return opts
+}
+
+func (r gitBranchesRow) convert() *GitBranch {
+	gitBranch := GitBranch{
+		Name:       r.Name,
+		Path:       r.Path,
+		CommitHash: r.CommitHash,
+	}
+	if r.Checkouts.Valid {
+		gitBranch.Checkouts = String(r.Checkouts.String)
+	}
+	return &gitBranch
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,21 @@
+package sdk
+
+var (
+	_ validatable = new(ShowGitBranchOptions)
+)
+
+func (opts *ShowGitBranchOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.InGitRepository) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowGitBranchOptions: additional validations
+	// custom:end ShowGitBranchOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,52 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateGitRepositoryOptions] = new(CreateGitRepositoryRequest)
+	_ optionsProvider[AlterGitRepositoryOptions]  = new(AlterGitRepositoryRequest)
+	_ optionsProvider[DropGitRepositoryOptions]   = new(DropGitRepositoryRequest)
+	_ optionsProvider[ShowGitRepositoryOptions]   = new(ShowGitRepositoryRequest)
+)
+
+type CreateGitRepositoryRequest struct {
+	OrReplace      *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists    *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name           SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	Origin         string                  // required
+	ApiIntegration AccountObjectIdentifier `validate:"validIdentifier"` // required
+	GitCredentials *SchemaObjectIdentifier `validate:"validIdentifierIfSet"`
+	Comment        *string
+	Tag            []TagAssociation
+}
+
+type AlterGitRepositoryRequest struct {
+	IfExists  *bool
+	name      SchemaObjectIdentifier     `validate:"validIdentifier"` // required
+	Set       *GitRepositorySetRequest   `validate:"exactlyOneValueSet=Set|Unset|Fetch|SetTags|UnsetTags"`
+	Unset     *GitRepositoryUnsetRequest `validate:"exactlyOneValueSet=Set|Unset|Fetch|SetTags|UnsetTags"`
+	Fetch     *bool                      `validate:"exactlyOneValueSet=Set|Unset|Fetch|SetTags|UnsetTags"`
+	SetTags   []TagAssociation           `validate:"exactlyOneValueSet=Set|Unset|Fetch|SetTags|UnsetTags"`
+	UnsetTags []ObjectIdentifier         `validate:"exactlyOneValueSet=Set|Unset|Fetch|SetTags|UnsetTags"`
+}
+
+type GitRepositorySetRequest struct {
+	ApiIntegration *AccountObjectIdentifier `validate:"validIdentifierIfSet,atLeastOneValueSet=ApiIntegration|GitCredentials|Comment"`
+	GitCredentials *SchemaObjectIdentifier  `validate:"validIdentifierIfSet,atLeastOneValueSet=ApiIntegration|GitCredentials|Comment"`
+	Comment        *string                  `validate:"atLeastOneValueSet=ApiIntegration|GitCredentials|Comment"`
+}
+
+type GitRepositoryUnsetRequest struct {
+	GitCredentials *bool `validate:"atLeastOneValueSet=GitCredentials|Comment"`
+	Comment        *bool `validate:"atLeastOneValueSet=GitCredentials|Comment"`
+}
+
+type DropGitRepositoryRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowGitRepositoryRequest struct {
+	Like *Like
+	In   *In
+}
@@ -0,0 +1,284 @@
+package sdk
+
+import "testing"
+
+func TestGitRepositories_Create(t *testing.T) {
+	// custom:begin CreateGitRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+	apiIntegrationId := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateGitRepositoryOptions
+	defaultOpts := func() *CreateGitRepositoryOptions {
+		return &CreateGitRepositoryOptions{
+			name:           id,
+			Origin:         "https://github.com/user/repo.git",
+			ApiIntegration: apiIntegrationId,
+		}
+	}
+	// custom:end CreateGitRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateGitRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ApiIntegration]", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.ApiIntegration = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateGitRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.GitCredentials] if set", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.GitCredentials = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateGitRepositoryOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateGitRepositoryOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateGitRepositoryOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE GIT REPOSITORY %s ORIGIN = 'https://github.com/user/repo.git' API_INTEGRATION = %s", id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName())
+		// custom:end CreateGitRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateGitRepositoryOptions: all options
+		secretId := randomSchemaObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.GitCredentials = Pointer(secretId)
+		opts.Comment = String("some comment")
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE GIT REPOSITORY %s ORIGIN = 'https://github.com/user/repo.git' API_INTEGRATION = %s GIT_CREDENTIALS = %s COMMENT = 'some comment' TAG (%s = 'v1')", id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName(), secretId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateGitRepositoryOptions: all options
+	})
+
+	// custom:begin CreateGitRepositoryOptions: additional test cases
+	t.Run("if not exists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE GIT REPOSITORY IF NOT EXISTS %s ORIGIN = 'https://github.com/user/repo.git' API_INTEGRATION = %s", id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName())
+	})
+	// custom:end CreateGitRepositoryOptions: additional test cases
+}
+
+func TestGitRepositories_Alter(t *testing.T) {
+	// custom:begin AlterGitRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterGitRepositoryOptions
+	defaultOpts := func() *AlterGitRepositoryOptions {
+		return &AlterGitRepositoryOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterGitRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Fetch = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterGitRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.Fetch opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterGitRepositoryOptions", "Set", "Unset", "Fetch", "SetTags", "UnsetTags"))
+
+		opts.Fetch = Bool(true)
+		opts.Unset = &GitRepositoryUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterGitRepositoryOptions", "Set", "Unset", "Fetch", "SetTags", "UnsetTags"))
+		// custom:end AlterGitRepositoryOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.ApiIntegration] if set", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions.Set: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{ApiIntegration: Pointer(NewAccountObjectIdentifier(""))}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterGitRepositoryOptions.Set: validation (valid identifier if set)
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.GitCredentials] if set", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions.Set: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{GitCredentials: Pointer(NewSchemaObjectIdentifier("", "", ""))}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterGitRepositoryOptions.Set: validation (valid identifier if set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.ApiIntegration opts.Set.GitCredentials opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &GitRepositorySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterGitRepositoryOptions.Set", "ApiIntegration", "GitCredentials", "Comment"))
+		// custom:end AlterGitRepositoryOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.GitCredentials opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &GitRepositoryUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterGitRepositoryOptions.Unset", "GitCredentials", "Comment"))
+		// custom:end AlterGitRepositoryOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions: basic
+		opts := defaultOpts()
+		opts.Fetch = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER GIT REPOSITORY %s FETCH", id.FullyQualifiedName())
+		// custom:end AlterGitRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterGitRepositoryOptions: all options
+		apiIntegrationId := randomAccountObjectIdentifier()
+		secretId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &GitRepositorySet{
+			ApiIntegration: Pointer(apiIntegrationId),
+			GitCredentials: Pointer(secretId),
+			Comment:        String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER GIT REPOSITORY IF EXISTS %s SET API_INTEGRATION = %s GIT_CREDENTIALS = %s COMMENT = 'some comment'", id.FullyQualifiedName(), apiIntegrationId.FullyQualifiedName(), secretId.FullyQualifiedName())
+		// custom:end AlterGitRepositoryOptions: all options
+	})
+
+	// custom:begin AlterGitRepositoryOptions: additional test cases
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &GitRepositoryUnset{
+			GitCredentials: Bool(true),
+			Comment:        Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER GIT REPOSITORY %s UNSET GIT_CREDENTIALS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER GIT REPOSITORY %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER GIT REPOSITORY %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+	// custom:end AlterGitRepositoryOptions: additional test cases
+}
+
+func TestGitRepositories_Drop(t *testing.T) {
+	// custom:begin DropGitRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropGitRepositoryOptions
+	defaultOpts := func() *DropGitRepositoryOptions {
+		return &DropGitRepositoryOptions{
+			name: id,
+		}
+	}
+	// custom:end DropGitRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropGitRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropGitRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropGitRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP GIT REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end DropGitRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropGitRepositoryOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP GIT REPOSITORY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropGitRepositoryOptions: all options
+	})
+
+	// custom:begin DropGitRepositoryOptions: additional test cases
+	// custom:end DropGitRepositoryOptions: additional test cases
+}
+
+func TestGitRepositories_Show(t *testing.T) {
+	// custom:begin ShowGitRepositoryOptions: default options
+	// Minimal valid ShowGitRepositoryOptions
+	defaultOpts := func() *ShowGitRepositoryOptions {
+		return &ShowGitRepositoryOptions{}
+	}
+	// custom:end ShowGitRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowGitRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT REPOSITORIES")
+		// custom:end ShowGitRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowGitRepositoryOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT REPOSITORIES LIKE 'some pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+		// custom:end ShowGitRepositoryOptions: all options
+	})
+
+	// custom:begin ShowGitRepositoryOptions: additional test cases
+	// custom:end ShowGitRepositoryOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
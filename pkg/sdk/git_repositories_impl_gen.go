@@ -0,0 +1,144 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ GitRepositories = (*gitRepositories)(nil)
+
+type gitRepositories struct {
+	client *Client
+}
+
+func (v *gitRepositories) Create(ctx context.Context, request *CreateGitRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Alter(ctx context.Context, request *AlterGitRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Drop(ctx context.Context, request *DropGitRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *gitRepositories) Show(ctx context.Context, request *ShowGitRepositoryRequest) ([]GitRepository, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitRepositoriesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitRepositoriesRow, GitRepository](dbRows)
+	return resultList, nil
+}
+
+func (v *gitRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error) {
+	// custom:begin ShowByID
+	gitRepositories, err := v.Show(ctx, NewShowGitRepositoryRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(gitRepositories, func(r GitRepository) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (r *CreateGitRepositoryRequest) toOpts() *CreateGitRepositoryOptions {
+	opts := &CreateGitRepositoryOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		name:           r.name,
+		Origin:         r.Origin,
+		ApiIntegration: r.ApiIntegration,
+		GitCredentials: r.GitCredentials,
+		Comment:        r.Comment,
+		Tag:            r.Tag,
+	}
+	return opts
+}
+
+func (r *AlterGitRepositoryRequest) toOpts() *AlterGitRepositoryOptions {
+	opts := &AlterGitRepositoryOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Fetch:     r.Fetch,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &GitRepositorySet{
+			ApiIntegration: r.Set.ApiIntegration,
+			GitCredentials: r.Set.GitCredentials,
+			Comment:        r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &GitRepositoryUnset{
+			GitCredentials: r.Unset.GitCredentials,
+			Comment:        r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropGitRepositoryRequest) toOpts() *DropGitRepositoryOptions {
+	opts := &DropGitRepositoryOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowGitRepositoryRequest) toOpts() *ShowGitRepositoryOptions {
+	opts := &ShowGitRepositoryOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r gitRepositoriesRow) convert() *GitRepository {
+	gitRepository := GitRepository{
+		CreatedOn:      r.CreatedOn,
+		Name:           r.Name,
+		DatabaseName:   r.DatabaseName,
+		SchemaName:     r.SchemaName,
+		Origin:         r.Origin,
+		ApiIntegration: r.ApiIntegration,
+		Owner:          r.Owner,
+	}
+	if r.GitCredentials.Valid {
+		gitRepository.GitCredentials = String(r.GitCredentials.String)
+	}
+	if r.OwnerRoleType.Valid {
+		gitRepository.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	if r.Comment.Valid {
+		gitRepository.Comment = String(r.Comment.String)
+	}
+	if r.LastFetchedAt.Valid {
+		gitRepository.LastFetchedAt = Pointer(r.LastFetchedAt.Time)
+	}
+	return &gitRepository
+}
+
+// custom:begin additional
+// custom:end additional
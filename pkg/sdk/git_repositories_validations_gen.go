@@ -0,0 +1,88 @@
+package sdk
+
+var (
+	_ validatable = new(CreateGitRepositoryOptions)
+	_ validatable = new(AlterGitRepositoryOptions)
+	_ validatable = new(DropGitRepositoryOptions)
+	_ validatable = new(ShowGitRepositoryOptions)
+)
+
+func (opts *CreateGitRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.ApiIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.GitCredentials != nil && !ValidObjectIdentifier(opts.GitCredentials) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateGitRepositoryOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateGitRepositoryOptions: additional validations
+	// custom:end CreateGitRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterGitRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.Fetch, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterGitRepositoryOptions", "Set", "Unset", "Fetch", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if opts.Set.ApiIntegration != nil && !ValidObjectIdentifier(opts.Set.ApiIntegration) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if opts.Set.GitCredentials != nil && !ValidObjectIdentifier(opts.Set.GitCredentials) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !anyValueSet(opts.Set.ApiIntegration, opts.Set.GitCredentials, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterGitRepositoryOptions.Set", "ApiIntegration", "GitCredentials", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.GitCredentials, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterGitRepositoryOptions.Unset", "GitCredentials", "Comment"))
+		}
+	}
+	// custom:begin AlterGitRepositoryOptions: additional validations
+	// custom:end AlterGitRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropGitRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropGitRepositoryOptions: additional validations
+	// custom:end DropGitRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowGitRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowGitRepositoryOptions: additional validations
+	// custom:end ShowGitRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,34 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// GitTagsDef covers the tags of a git repository that were fetched to Snowflake, they can be only listed.
+var GitTagsDef = g.NewInterface(
+	"GitTags",
+	"GitTag",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-git-tags",
+		g.DbStruct("gitTagsRow").
+			Text("name").
+			Text("path").
+			Text("commit_hash").
+			OptionalText("author").
+			OptionalText("message"),
+		g.PlainStruct("GitTag").
+			DeriveMapping().
+			Text("Name").
+			Text("Path").
+			Text("CommitHash").
+			OptionalText("Author").
+			OptionalText("Message"),
+		g.NewQueryStruct("ShowGitTags").
+			Show().
+			SQL("GIT TAGS").
+			OptionalLike().
+			Identifier("InGitRepository", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN GIT REPOSITORY").Required()).
+			WithValidation(g.ValidIdentifier, "InGitRepository"),
+	)
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type GitTags interface {
+	Show(ctx context.Context, request *ShowGitTagRequest) ([]GitTag, error)
+}
+
+// ShowGitTagOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-git-tags.
+type ShowGitTagOptions struct {
+	show            bool                   `ddl:"static" sql:"SHOW"`
+	gitTags         bool                   `ddl:"static" sql:"GIT TAGS"`
+	Like            *Like                  `ddl:"keyword" sql:"LIKE"`
+	InGitRepository SchemaObjectIdentifier `ddl:"identifier" sql:"IN GIT REPOSITORY"`
+}
+
+type gitTagsRow struct {
+	Name       string         `db:"name"`
+	Path       string         `db:"path"`
+	CommitHash string         `db:"commit_hash"`
+	Author     sql.NullString `db:"author"`
+	Message    sql.NullString `db:"message"`
+}
+
+type GitTag struct {
+	Name       string
+	Path       string
+	CommitHash string
+	Author     *string
+	Message    *string
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,50 @@
+package sdk
+
+import "testing"
+
+func TestGitTags_Show(t *testing.T) {
+	// custom:begin ShowGitTagOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid ShowGitTagOptions
+	defaultOpts := func() *ShowGitTagOptions {
+		return &ShowGitTagOptions{
+			InGitRepository: id,
+		}
+	}
+	// custom:end ShowGitTagOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowGitTagOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InGitRepository]", func(t *testing.T) {
+		// custom:begin ShowGitTagOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InGitRepository = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowGitTagOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowGitTagOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT TAGS IN GIT REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end ShowGitTagOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowGitTagOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW GIT TAGS LIKE 'some pattern' IN GIT REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end ShowGitTagOptions: all options
+	})
+
+	// custom:begin ShowGitTagOptions: additional test cases
+	// custom:end ShowGitTagOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
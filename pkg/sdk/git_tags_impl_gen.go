@@ -0,0 +1,48 @@
+package sdk
+
+import "context"
+
+var _ GitTags = (*gitTags)(nil)
+
+type gitTags struct {
+	client *Client
+}
+
+func (v *gitTags) Show(ctx context.Context, request *ShowGitTagRequest) ([]GitTag, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[gitTagsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[gitTagsRow, GitTag](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowGitTagRequest) toOpts() *ShowGitTagOptions {
+	opts := &ShowGitTagOptions{
+		Like:            r.Like,
+		InGitRepository: r.InGitRepository,
+	}
+	return opts
+}
+
+func (r gitTagsRow) convert() *GitTag {
+	gitTag := GitTag{
+		Name:       r.Name,
+		Path:       r.Path,
+		CommitHash: r.CommitHash,
+	}
+	if r.Author.Valid {
+		gitTag.Author = String(r.Author.String)
+	}
+	if r.Message.Valid {
+		gitTag.Message = String(r.Message.String)
+	}
+	return &gitTag
+}
+
+// custom:begin additional
+// custom:end additional
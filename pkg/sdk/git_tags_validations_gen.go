@@ -0,0 +1,21 @@
+package sdk
+
+var (
+	_ validatable = new(ShowGitTagOptions)
+)
+
+func (opts *ShowGitTagOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.InGitRepository) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowGitTagOptions: additional validations
+	// custom:end ShowGitTagOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -10,6 +10,20 @@ type validatable interface {
 	validate() error
 }
 
+// validatableRequest is implemented by request DTOs with validations generated by dto-builder-generator (based on "validate" struct tags).
+type validatableRequest interface {
+	Validate() error
+}
+
+// validateRequest validates the request DTO if it has generated validations, so that invalid request is rejected
+// with errors pointing to the request fields (before it is converted to the options struct and validated again).
+func validateRequest(request any) error {
+	if r, ok := request.(validatableRequest); ok {
+		return r.Validate()
+	}
+	return nil
+}
+
 // validateAndExec is just a proposal how we can remove some of the boilerplate.
 func validateAndExec(client *Client, ctx context.Context, opts validatable) error {
 	if err := opts.validate(); err != nil {
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateRequest(t *testing.T) {
+	t.Run("request with generated validations", func(t *testing.T) {
+		err := validateRequest(NewAlterConnectionRequest(NewAccountObjectIdentifier("")))
+
+		require.ErrorContains(t, err, "invalid object identifier of AlterConnectionRequest field: name")
+		require.ErrorContains(t, err, "exactly one of AlterConnectionRequest fields [EnableConnectionFailover DisableConnectionFailover Primary Refresh Set Unset] must be set")
+	})
+
+	t.Run("valid request with generated validations", func(t *testing.T) {
+		err := validateRequest(NewAlterConnectionRequest(randomAccountObjectIdentifier()).WithRefresh(true))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("request without validations", func(t *testing.T) {
+		err := validateRequest(NewShowConnectionRequest())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid request is rejected before execution", func(t *testing.T) {
+		// client is not set, so the request would fail with panic if it was executed
+		err := (&connections{}).Alter(context.Background(), NewAlterConnectionRequest(randomAccountObjectIdentifier()))
+
+		require.ErrorContains(t, err, "exactly one of AlterConnectionRequest fields [EnableConnectionFailover DisableConnectionFailover Primary Refresh Set Unset] must be set")
+	})
+}
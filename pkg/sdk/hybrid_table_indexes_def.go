@@ -0,0 +1,70 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// HybridTableIndexesDef covers secondary indexes of hybrid tables. Index is identified by the table identifier and the index name
+// (https://docs.snowflake.com/en/user-guide/tables-hybrid-create#create-a-secondary-index).
+var HybridTableIndexesDef = g.NewInterface(
+	"HybridTableIndexes",
+	"HybridTableIndex",
+	g.KindOfT[TableColumnIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-index",
+		g.NewQueryStruct("CreateHybridTableIndex").
+			Create().
+			OrReplace().
+			SQL("INDEX").
+			IfNotExists().
+			Text("IndexName", g.KeywordOptions().DoubleQuotes().Required()).
+			SQL("ON").
+			Identifier("TableName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+			PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+			PredefinedQueryStructField("IncludeColumns", "[]string", g.KeywordOptions().Parentheses().SQL("INCLUDE")).
+			WithValidation(g.ValidIdentifier, "TableName").
+			WithValidation(g.ValidateValueSet, "IndexName").
+			WithValidation(g.ValidateValueSet, "Columns").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-index",
+		g.NewQueryStruct("DropHybridTableIndex").
+			Drop().
+			SQL("INDEX").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-indexes",
+		g.DbStruct("showHybridTableIndexesDbRow").
+			Time("created_on").
+			Text("name").
+			Text("is_unique").
+			Text("columns").
+			OptionalText("included_columns").
+			Text("table").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			OptionalText("owner_role_type"),
+		g.PlainStruct("HybridTableIndex").
+			Time("CreatedOn").
+			Text("Name").
+			Bool("IsUnique").
+			Field("Columns", "[]string").
+			Field("IncludedColumns", "[]string").
+			Text("TableName").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			OptionalText("OwnerRoleType"),
+		g.NewQueryStruct("ShowHybridTableIndexes").
+			Show().
+			SQL("INDEXES").
+			OptionalIdentifier("InTable", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN TABLE")).
+			WithValidation(g.ValidIdentifierIfSet, "InTable"),
+	).
+	ShowByIdOperation()
@@ -0,0 +1,27 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateHybridTableIndexOptions] = new(CreateHybridTableIndexRequest)
+	_ optionsProvider[DropHybridTableIndexOptions]   = new(DropHybridTableIndexRequest)
+	_ optionsProvider[ShowHybridTableIndexOptions]   = new(ShowHybridTableIndexRequest)
+)
+
+type CreateHybridTableIndexRequest struct {
+	OrReplace      *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists    *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IndexName      string                 // required
+	TableName      SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Columns        []string               // required
+	IncludeColumns []string
+}
+
+type DropHybridTableIndexRequest struct {
+	IfExists *bool
+	name     TableColumnIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowHybridTableIndexRequest struct {
+	InTable *SchemaObjectIdentifier `validate:"validIdentifierIfSet"`
+}
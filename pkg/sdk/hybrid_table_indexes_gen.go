@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type HybridTableIndexes interface {
+	Create(ctx context.Context, request *CreateHybridTableIndexRequest) error
+	Drop(ctx context.Context, request *DropHybridTableIndexRequest) error
+	Show(ctx context.Context, request *ShowHybridTableIndexRequest) ([]HybridTableIndex, error)
+	ShowByID(ctx context.Context, id TableColumnIdentifier) (*HybridTableIndex, error)
+}
+
+// CreateHybridTableIndexOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-index.
+type CreateHybridTableIndexOptions struct {
+	create         bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace      *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	index          bool                   `ddl:"static" sql:"INDEX"`
+	IfNotExists    *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	IndexName      string                 `ddl:"keyword,double_quotes"`
+	on             bool                   `ddl:"static" sql:"ON"`
+	TableName      SchemaObjectIdentifier `ddl:"identifier"`
+	Columns        []string               `ddl:"keyword,parentheses"`
+	IncludeColumns []string               `ddl:"keyword,parentheses" sql:"INCLUDE"`
+}
+
+// DropHybridTableIndexOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-index.
+type DropHybridTableIndexOptions struct {
+	drop     bool                  `ddl:"static" sql:"DROP"`
+	index    bool                  `ddl:"static" sql:"INDEX"`
+	IfExists *bool                 `ddl:"keyword" sql:"IF EXISTS"`
+	name     TableColumnIdentifier `ddl:"identifier"`
+}
+
+// ShowHybridTableIndexOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-indexes.
+type ShowHybridTableIndexOptions struct {
+	show    bool                    `ddl:"static" sql:"SHOW"`
+	indexes bool                    `ddl:"static" sql:"INDEXES"`
+	InTable *SchemaObjectIdentifier `ddl:"identifier" sql:"IN TABLE"`
+}
+
+type showHybridTableIndexesDbRow struct {
+	CreatedOn       time.Time      `db:"created_on"`
+	Name            string         `db:"name"`
+	IsUnique        string         `db:"is_unique"`
+	Columns         string         `db:"columns"`
+	IncludedColumns sql.NullString `db:"included_columns"`
+	Table           string         `db:"table"`
+	DatabaseName    string         `db:"database_name"`
+	SchemaName      string         `db:"schema_name"`
+	Owner           string         `db:"owner"`
+	OwnerRoleType   sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTableIndex struct {
+	CreatedOn       time.Time
+	Name            string
+	IsUnique        bool
+	Columns         []string
+	IncludedColumns []string
+	TableName       string
+	DatabaseName    string
+	SchemaName      string
+	Owner           string
+	OwnerRoleType   *string
+}
+
+// custom:begin additional
+func (v *HybridTableIndex) ID() TableColumnIdentifier {
+	return NewTableColumnIdentifier(v.DatabaseName, v.SchemaName, v.TableName, v.Name)
+}
+
+// custom:end additional
@@ -0,0 +1,165 @@
+package sdk
+
+import "testing"
+
+func TestHybridTableIndexes_Create(t *testing.T) {
+	// custom:begin CreateHybridTableIndexOptions: default options
+	tableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateHybridTableIndexOptions
+	defaultOpts := func() *CreateHybridTableIndexOptions {
+		return &CreateHybridTableIndexOptions{
+			IndexName: "my_index",
+			TableName: tableId,
+			Columns:   []string{"COL1"},
+		}
+	}
+	// custom:end CreateHybridTableIndexOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateHybridTableIndexOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.TableName]", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.TableName = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateHybridTableIndexOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: [opts.IndexName] should be set", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: validation (value set)
+		opts := defaultOpts()
+		opts.IndexName = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableIndexOptions", "IndexName"))
+		// custom:end CreateHybridTableIndexOptions: validation (value set)
+	})
+
+	t.Run("validation: [opts.Columns] should be set", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: validation (value set)
+		opts := defaultOpts()
+		opts.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableIndexOptions", "Columns"))
+		// custom:end CreateHybridTableIndexOptions: validation (value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateHybridTableIndexOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateHybridTableIndexOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX "my_index" ON %s (COL1)`, tableId.FullyQualifiedName())
+		// custom:end CreateHybridTableIndexOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateHybridTableIndexOptions: all options
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Columns = []string{"COL1", "COL2"}
+		opts.IncludeColumns = []string{"COL3"}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX IF NOT EXISTS "my_index" ON %s (COL1, COL2) INCLUDE (COL3)`, tableId.FullyQualifiedName())
+		// custom:end CreateHybridTableIndexOptions: all options
+	})
+
+	// custom:begin CreateHybridTableIndexOptions: additional test cases
+	// custom:end CreateHybridTableIndexOptions: additional test cases
+}
+
+func TestHybridTableIndexes_Drop(t *testing.T) {
+	// custom:begin DropHybridTableIndexOptions: default options
+	id := randomTableColumnIdentifier()
+
+	// Minimal valid DropHybridTableIndexOptions
+	defaultOpts := func() *DropHybridTableIndexOptions {
+		return &DropHybridTableIndexOptions{
+			name: id,
+		}
+	}
+	// custom:end DropHybridTableIndexOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropHybridTableIndexOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropHybridTableIndexOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewTableColumnIdentifier("", "", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropHybridTableIndexOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropHybridTableIndexOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP INDEX %s", id.FullyQualifiedName())
+		// custom:end DropHybridTableIndexOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropHybridTableIndexOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP INDEX IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropHybridTableIndexOptions: all options
+	})
+
+	// custom:begin DropHybridTableIndexOptions: additional test cases
+	// custom:end DropHybridTableIndexOptions: additional test cases
+}
+
+func TestHybridTableIndexes_Show(t *testing.T) {
+	// custom:begin ShowHybridTableIndexOptions: default options
+	tableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid ShowHybridTableIndexOptions
+	defaultOpts := func() *ShowHybridTableIndexOptions {
+		return &ShowHybridTableIndexOptions{}
+	}
+	// custom:end ShowHybridTableIndexOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowHybridTableIndexOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InTable] if set", func(t *testing.T) {
+		// custom:begin ShowHybridTableIndexOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.InTable = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowHybridTableIndexOptions: validation (valid identifier if set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowHybridTableIndexOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW INDEXES")
+		// custom:end ShowHybridTableIndexOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowHybridTableIndexOptions: all options
+		opts := defaultOpts()
+		opts.InTable = &tableId
+		assertOptsValidAndSQLEquals(t, opts, "SHOW INDEXES IN TABLE %s", tableId.FullyQualifiedName())
+		// custom:end ShowHybridTableIndexOptions: all options
+	})
+
+	// custom:begin ShowHybridTableIndexOptions: additional test cases
+	// custom:end ShowHybridTableIndexOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
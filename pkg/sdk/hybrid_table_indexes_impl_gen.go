@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ HybridTableIndexes = (*hybridTableIndexes)(nil)
+
+type hybridTableIndexes struct {
+	client *Client
+}
+
+func (v *hybridTableIndexes) Create(ctx context.Context, request *CreateHybridTableIndexRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTableIndexes) Drop(ctx context.Context, request *DropHybridTableIndexRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTableIndexes) Show(ctx context.Context, request *ShowHybridTableIndexRequest) ([]HybridTableIndex, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showHybridTableIndexesDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showHybridTableIndexesDbRow, HybridTableIndex](dbRows)
+	return resultList, nil
+}
+
+func (v *hybridTableIndexes) ShowByID(ctx context.Context, id TableColumnIdentifier) (*HybridTableIndex, error) {
+	// custom:begin ShowByID
+	hybridTableIndexes, err := v.Show(ctx, NewShowHybridTableIndexRequest().
+		WithInTable(NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), id.TableName())))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(hybridTableIndexes, func(r HybridTableIndex) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (r *CreateHybridTableIndexRequest) toOpts() *CreateHybridTableIndexOptions {
+	opts := &CreateHybridTableIndexOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		IndexName:      r.IndexName,
+		TableName:      r.TableName,
+		Columns:        r.Columns,
+		IncludeColumns: r.IncludeColumns,
+	}
+	return opts
+}
+
+func (r *DropHybridTableIndexRequest) toOpts() *DropHybridTableIndexOptions {
+	opts := &DropHybridTableIndexOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowHybridTableIndexRequest) toOpts() *ShowHybridTableIndexOptions {
+	opts := &ShowHybridTableIndexOptions{
+		InTable: r.InTable,
+	}
+	return opts
+}
+
+func (r showHybridTableIndexesDbRow) convert() *HybridTableIndex {
+	hybridTableIndex := HybridTableIndex{
+		CreatedOn:       r.CreatedOn,
+		Name:            r.Name,
+		IsUnique:        r.IsUnique == "Y",
+		Columns:         ParseCommaSeparatedStringArray(r.Columns),
+		IncludedColumns: make([]string, 0),
+		TableName:       r.Table,
+		DatabaseName:    r.DatabaseName,
+		SchemaName:      r.SchemaName,
+		Owner:           r.Owner,
+	}
+	if r.IncludedColumns.Valid {
+		hybridTableIndex.IncludedColumns = ParseCommaSeparatedStringArray(r.IncludedColumns.String)
+	}
+	if r.OwnerRoleType.Valid {
+		hybridTableIndex.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	return &hybridTableIndex
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,58 @@
+package sdk
+
+var (
+	_ validatable = new(CreateHybridTableIndexOptions)
+	_ validatable = new(DropHybridTableIndexOptions)
+	_ validatable = new(ShowHybridTableIndexOptions)
+)
+
+func (opts *CreateHybridTableIndexOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.TableName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.IndexName) {
+		errs = append(errs, errNotSet("CreateHybridTableIndexOptions", "IndexName"))
+	}
+	if !valueSet(opts.Columns) {
+		errs = append(errs, errNotSet("CreateHybridTableIndexOptions", "Columns"))
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateHybridTableIndexOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateHybridTableIndexOptions: additional validations
+	// custom:end CreateHybridTableIndexOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropHybridTableIndexOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropHybridTableIndexOptions: additional validations
+	// custom:end DropHybridTableIndexOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowHybridTableIndexOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.InTable != nil && !ValidObjectIdentifier(opts.InTable) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowHybridTableIndexOptions: additional validations
+	// custom:end ShowHybridTableIndexOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
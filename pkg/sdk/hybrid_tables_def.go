@@ -0,0 +1,142 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var hybridTableColumnDef = g.NewQueryStruct("HybridTableColumn").
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("Type", "DataType", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("InlineConstraint", "*ColumnInlineConstraint", g.KeywordOptions()).
+	OptionalSQL("NOT NULL").
+	PredefinedQueryStructField("DefaultValue", "*ColumnDefaultValue", g.KeywordOptions()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var hybridTableOutOfLineIndexDef = g.NewQueryStruct("HybridTableOutOfLineIndex").
+	SQL("INDEX").
+	Text("Name", g.KeywordOptions().DoubleQuotes().Required()).
+	PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+	PredefinedQueryStructField("IncludeColumns", "[]string", g.KeywordOptions().Parentheses().SQL("INCLUDE"))
+
+var hybridTableColumnsConstraintsAndIndexesDef = g.NewQueryStruct("HybridTableColumnsConstraintsAndIndexes").
+	ListQueryStructField("Columns", hybridTableColumnDef, g.KeywordOptions()).
+	PredefinedQueryStructField("OutOfLineConstraint", "[]OutOfLineConstraint", g.ListOptions().NoParentheses()).
+	ListQueryStructField("OutOfLineIndex", hybridTableOutOfLineIndexDef, g.ListOptions().NoParentheses()).
+	WithValidation(g.ValidateValueSet, "Columns")
+
+var hybridTableAddColumnDef = g.NewQueryStruct("HybridTableAddColumn").
+	SQL("ADD COLUMN").
+	IfNotExists().
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("Type", "DataType", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("DefaultValue", "*ColumnDefaultValue", g.KeywordOptions()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var hybridTableAlterColumnDef = g.NewQueryStruct("HybridTableAlterColumn").
+	SQL("ALTER COLUMN").
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals()).
+	OptionalSQL("UNSET COMMENT").
+	WithValidation(g.ExactlyOneValueSet, "Comment", "UnsetComment")
+
+var hybridTableDropColumnsDef = g.NewQueryStruct("HybridTableDropColumns").
+	SQL("DROP COLUMN").
+	IfExists().
+	PredefinedQueryStructField("Names", "[]string", g.KeywordOptions().Required())
+
+var hybridTableSetDef = g.NewQueryStruct("HybridTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment")
+
+var hybridTableUnsetDef = g.NewQueryStruct("HybridTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("MAX_DATA_EXTENSION_TIME_IN_DAYS").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment")
+
+// HybridTablesDef covers hybrid table specific commands; ALTER and DROP are done with the regular table commands
+// and the columns can be described with Tables.DescribeColumns (https://docs.snowflake.com/en/user-guide/tables-hybrid-commands).
+var HybridTablesDef = g.NewInterface(
+	"HybridTables",
+	"HybridTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table",
+		g.NewQueryStruct("CreateHybridTable").
+			Create().
+			OrReplace().
+			SQL("HYBRID TABLE").
+			IfNotExists().
+			Name().
+			QueryStructField("ColumnsConstraintsAndIndexes", hybridTableColumnsConstraintsAndIndexesDef, g.ListOptions().Parentheses().Required()).
+			OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+			OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-table",
+		g.NewQueryStruct("AlterHybridTable").
+			Alter().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			OptionalQueryStructField("AddColumn", hybridTableAddColumnDef, g.KeywordOptions()).
+			OptionalQueryStructField("AlterColumn", hybridTableAlterColumnDef, g.KeywordOptions()).
+			OptionalQueryStructField("DropColumns", hybridTableDropColumnsDef, g.KeywordOptions()).
+			OptionalQueryStructField("Set", hybridTableSetDef, g.KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", hybridTableUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "AddColumn", "AlterColumn", "DropColumns", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-table",
+		g.NewQueryStruct("DropHybridTable").
+			Drop().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables",
+		g.DbStruct("showHybridTablesDbRow").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			OptionalNumber("rows").
+			OptionalNumber("bytes").
+			OptionalText("comment").
+			OptionalText("owner_role_type"),
+		g.PlainStruct("HybridTable").
+			DeriveMapping().
+			Time("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			OptionalNumber("Rows").
+			OptionalNumber("Bytes").
+			OptionalText("Comment").
+			OptionalText("OwnerRoleType"),
+		g.NewQueryStruct("ShowHybridTables").
+			Show().
+			Terse().
+			SQL("HYBRID TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperation()
@@ -0,0 +1,99 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateHybridTableOptions] = new(CreateHybridTableRequest)
+	_ optionsProvider[AlterHybridTableOptions]  = new(AlterHybridTableRequest)
+	_ optionsProvider[DropHybridTableOptions]   = new(DropHybridTableRequest)
+	_ optionsProvider[ShowHybridTableOptions]   = new(ShowHybridTableRequest)
+)
+
+type CreateHybridTableRequest struct {
+	OrReplace                    *bool                                          `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                  *bool                                          `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                         SchemaObjectIdentifier                         `validate:"validIdentifier"` // required
+	ColumnsConstraintsAndIndexes HybridTableColumnsConstraintsAndIndexesRequest // required
+	DataRetentionTimeInDays      *int
+	MaxDataExtensionTimeInDays   *int
+	Comment                      *string
+	Tag                          []TagAssociation
+}
+
+type HybridTableColumnsConstraintsAndIndexesRequest struct {
+	Columns             []HybridTableColumnRequest
+	OutOfLineConstraint []OutOfLineConstraint
+	OutOfLineIndex      []HybridTableOutOfLineIndexRequest
+}
+
+type HybridTableColumnRequest struct {
+	Name             string   // required
+	Type             DataType // required
+	InlineConstraint *ColumnInlineConstraint
+	NotNull          *bool
+	DefaultValue     *ColumnDefaultValue
+	Comment          *string
+}
+
+type HybridTableOutOfLineIndexRequest struct {
+	Name           string   // required
+	Columns        []string // required
+	IncludeColumns []string
+}
+
+type AlterHybridTableRequest struct {
+	IfExists    *bool
+	name        SchemaObjectIdentifier         `validate:"validIdentifier"` // required
+	AddColumn   *HybridTableAddColumnRequest   `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	AlterColumn *HybridTableAlterColumnRequest `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	DropColumns *HybridTableDropColumnsRequest `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	Set         *HybridTableSetRequest         `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	Unset       *HybridTableUnsetRequest       `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	SetTags     []TagAssociation               `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	UnsetTags   []ObjectIdentifier             `validate:"exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	RenameTo    *SchemaObjectIdentifier        `validate:"validIdentifierIfSet,exactlyOneValueSet=AddColumn|AlterColumn|DropColumns|Set|Unset|SetTags|UnsetTags|RenameTo"`
+}
+
+type HybridTableAddColumnRequest struct {
+	IfNotExists  *bool
+	Name         string   // required
+	Type         DataType // required
+	DefaultValue *ColumnDefaultValue
+	Comment      *string
+}
+
+type HybridTableAlterColumnRequest struct {
+	Name         string  // required
+	Comment      *string `validate:"exactlyOneValueSet=Comment|UnsetComment"`
+	UnsetComment *bool   `validate:"exactlyOneValueSet=Comment|UnsetComment"`
+}
+
+type HybridTableDropColumnsRequest struct {
+	IfExists *bool
+	Names    []string // required
+}
+
+type HybridTableSetRequest struct {
+	DataRetentionTimeInDays    *int    `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+	MaxDataExtensionTimeInDays *int    `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+	Comment                    *string `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+}
+
+type HybridTableUnsetRequest struct {
+	DataRetentionTimeInDays    *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+	MaxDataExtensionTimeInDays *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+	Comment                    *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|Comment"`
+}
+
+type DropHybridTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowHybridTableRequest struct {
+	Terse      *bool
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
@@ -0,0 +1,151 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type HybridTables interface {
+	Create(ctx context.Context, request *CreateHybridTableRequest) error
+	Alter(ctx context.Context, request *AlterHybridTableRequest) error
+	Drop(ctx context.Context, request *DropHybridTableRequest) error
+	Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error)
+}
+
+// CreateHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table.
+type CreateHybridTableOptions struct {
+	create                       bool                                    `ddl:"static" sql:"CREATE"`
+	OrReplace                    *bool                                   `ddl:"keyword" sql:"OR REPLACE"`
+	hybridTable                  bool                                    `ddl:"static" sql:"HYBRID TABLE"`
+	IfNotExists                  *bool                                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                         SchemaObjectIdentifier                  `ddl:"identifier"`
+	ColumnsConstraintsAndIndexes HybridTableColumnsConstraintsAndIndexes `ddl:"list,parentheses"`
+	DataRetentionTimeInDays      *int                                    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays   *int                                    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                      *string                                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                          []TagAssociation                        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+type HybridTableColumnsConstraintsAndIndexes struct {
+	Columns             []HybridTableColumn         `ddl:"keyword"`
+	OutOfLineConstraint []OutOfLineConstraint       `ddl:"list,no_parentheses"`
+	OutOfLineIndex      []HybridTableOutOfLineIndex `ddl:"list,no_parentheses"`
+}
+
+type HybridTableColumn struct {
+	Name             string                  `ddl:"keyword,no_quotes"`
+	Type             DataType                `ddl:"keyword,no_quotes"`
+	InlineConstraint *ColumnInlineConstraint `ddl:"keyword"`
+	NotNull          *bool                   `ddl:"keyword" sql:"NOT NULL"`
+	DefaultValue     *ColumnDefaultValue     `ddl:"keyword"`
+	Comment          *string                 `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+type HybridTableOutOfLineIndex struct {
+	index          bool     `ddl:"static" sql:"INDEX"`
+	Name           string   `ddl:"keyword,double_quotes"`
+	Columns        []string `ddl:"keyword,parentheses"`
+	IncludeColumns []string `ddl:"keyword,parentheses" sql:"INCLUDE"`
+}
+
+// AlterHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-table.
+type AlterHybridTableOptions struct {
+	alter       bool                    `ddl:"static" sql:"ALTER"`
+	table       bool                    `ddl:"static" sql:"TABLE"`
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        SchemaObjectIdentifier  `ddl:"identifier"`
+	AddColumn   *HybridTableAddColumn   `ddl:"keyword"`
+	AlterColumn *HybridTableAlterColumn `ddl:"keyword"`
+	DropColumns *HybridTableDropColumns `ddl:"keyword"`
+	Set         *HybridTableSet         `ddl:"keyword" sql:"SET"`
+	Unset       *HybridTableUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags     []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags   []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	RenameTo    *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+}
+
+type HybridTableAddColumn struct {
+	addColumn    bool                `ddl:"static" sql:"ADD COLUMN"`
+	IfNotExists  *bool               `ddl:"keyword" sql:"IF NOT EXISTS"`
+	Name         string              `ddl:"keyword,no_quotes"`
+	Type         DataType            `ddl:"keyword,no_quotes"`
+	DefaultValue *ColumnDefaultValue `ddl:"keyword"`
+	Comment      *string             `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+type HybridTableAlterColumn struct {
+	alterColumn  bool    `ddl:"static" sql:"ALTER COLUMN"`
+	Name         string  `ddl:"keyword,no_quotes"`
+	Comment      *string `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+	UnsetComment *bool   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+type HybridTableDropColumns struct {
+	dropColumn bool     `ddl:"static" sql:"DROP COLUMN"`
+	IfExists   *bool    `ddl:"keyword" sql:"IF EXISTS"`
+	Names      []string `ddl:"keyword"`
+}
+
+type HybridTableSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type HybridTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-table.
+type DropHybridTableOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	table    bool                   `ddl:"static" sql:"TABLE"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables.
+type ShowHybridTableOptions struct {
+	show         bool       `ddl:"static" sql:"SHOW"`
+	Terse        *bool      `ddl:"keyword" sql:"TERSE"`
+	hybridTables bool       `ddl:"static" sql:"HYBRID TABLES"`
+	Like         *Like      `ddl:"keyword" sql:"LIKE"`
+	In           *In        `ddl:"keyword" sql:"IN"`
+	StartsWith   *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit        *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type showHybridTablesDbRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         string         `db:"owner"`
+	Rows          sql.NullInt64  `db:"rows"`
+	Bytes         sql.NullInt64  `db:"bytes"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTable struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Rows          *int
+	Bytes         *int
+	Comment       *string
+	OwnerRoleType *string
+}
+
+// custom:begin additional
+func (v *HybridTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// custom:end additional
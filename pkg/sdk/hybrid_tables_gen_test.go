@@ -0,0 +1,407 @@
+package sdk
+
+import "testing"
+
+func TestHybridTables_Create(t *testing.T) {
+	// custom:begin CreateHybridTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateHybridTableOptions
+	defaultOpts := func() *CreateHybridTableOptions {
+		return &CreateHybridTableOptions{
+			name: id,
+			ColumnsConstraintsAndIndexes: HybridTableColumnsConstraintsAndIndexes{
+				Columns: []HybridTableColumn{
+					{
+						Name:             "ID",
+						Type:             DataTypeNumber,
+						InlineConstraint: &ColumnInlineConstraint{Type: ColumnConstraintTypePrimaryKey},
+					},
+				},
+			},
+		}
+	}
+	// custom:end CreateHybridTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateHybridTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateHybridTableOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateHybridTableOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateHybridTableOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: [opts.ColumnsConstraintsAndIndexes.Columns] should be set", func(t *testing.T) {
+		// custom:begin CreateHybridTableOptions.ColumnsConstraintsAndIndexes: validation (value set)
+		opts := defaultOpts()
+		opts.ColumnsConstraintsAndIndexes.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableOptions.ColumnsConstraintsAndIndexes", "Columns"))
+		// custom:end CreateHybridTableOptions.ColumnsConstraintsAndIndexes: validation (value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateHybridTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE HYBRID TABLE %s (ID NUMBER PRIMARY KEY)", id.FullyQualifiedName())
+		// custom:end CreateHybridTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateHybridTableOptions: all options
+		referencedTableId := randomSchemaObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ColumnsConstraintsAndIndexes = HybridTableColumnsConstraintsAndIndexes{
+			Columns: []HybridTableColumn{
+				{
+					Name:    "ID",
+					Type:    DataTypeNumber,
+					NotNull: Bool(true),
+				},
+				{
+					Name:         "EMAIL",
+					Type:         DataTypeVARCHAR,
+					DefaultValue: &ColumnDefaultValue{Expression: String("'unknown'")},
+					Comment:      String("email column"),
+				},
+				{
+					Name: "PARENT_ID",
+					Type: DataTypeNumber,
+				},
+			},
+			OutOfLineConstraint: []OutOfLineConstraint{
+				{
+					Type:    ColumnConstraintTypePrimaryKey,
+					Columns: []string{"ID"},
+				},
+				{
+					Name:    String("UNIQUE_EMAIL"),
+					Type:    ColumnConstraintTypeUnique,
+					Columns: []string{"EMAIL"},
+				},
+				{
+					Type:    ColumnConstraintTypeForeignKey,
+					Columns: []string{"PARENT_ID"},
+					ForeignKey: &OutOfLineForeignKey{
+						TableName:   referencedTableId,
+						ColumnNames: []string{"ID"},
+					},
+				},
+			},
+			OutOfLineIndex: []HybridTableOutOfLineIndex{
+				{
+					Name:           "IDX_PARENT",
+					Columns:        []string{"PARENT_ID"},
+					IncludeColumns: []string{"EMAIL"},
+				},
+			},
+		}
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(14)
+		opts.Comment = String("comment")
+		opts.Tag = []TagAssociation{
+			{
+				Name:  tagId,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE HYBRID TABLE %s (ID NUMBER NOT NULL, EMAIL VARCHAR DEFAULT 'unknown' COMMENT 'email column', PARENT_ID NUMBER, PRIMARY KEY (ID), CONSTRAINT UNIQUE_EMAIL UNIQUE (EMAIL), FOREIGN KEY (PARENT_ID) REFERENCES %s (ID), INDEX "IDX_PARENT" (PARENT_ID) INCLUDE (EMAIL)) DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 COMMENT = 'comment' TAG (%s = 'v1')`,
+			id.FullyQualifiedName(), referencedTableId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateHybridTableOptions: all options
+	})
+
+	// custom:begin CreateHybridTableOptions: additional test cases
+	t.Run("validation: invalid out of line constraint", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsConstraintsAndIndexes.OutOfLineConstraint = []OutOfLineConstraint{
+			{
+				Type:    ColumnConstraintTypeForeignKey,
+				Columns: []string{"ID"},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("OutOfLineConstraint", "ForeignKey"))
+	})
+
+	t.Run("validation: invalid inline constraint", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsConstraintsAndIndexes.Columns[0].InlineConstraint = &ColumnInlineConstraint{Type: ColumnConstraintTypeForeignKey}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("ColumnInlineConstraint", "ForeignKey"))
+	})
+	// custom:end CreateHybridTableOptions: additional test cases
+}
+
+func TestHybridTables_Alter(t *testing.T) {
+	// custom:begin AlterHybridTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterHybridTableOptions
+	defaultOpts := func() *AlterHybridTableOptions {
+		return &AlterHybridTableOptions{
+			name: id,
+			Set: &HybridTableSet{
+				Comment: String("comment"),
+			},
+		}
+	}
+	// custom:end AlterHybridTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterHybridTableOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.RenameTo = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterHybridTableOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.AddColumn opts.AlterColumn opts.DropColumns opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.RenameTo] should be present", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.Unset = &HybridTableUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions", "AddColumn", "AlterColumn", "DropColumns", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"))
+		// custom:end AlterHybridTableOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.AlterColumn.Comment opts.AlterColumn.UnsetComment] should be present", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions.AlterColumn: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.AlterColumn = &HybridTableAlterColumn{
+			Name:         "ID",
+			Comment:      String("comment"),
+			UnsetComment: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions.AlterColumn", "Comment", "UnsetComment"))
+		// custom:end AlterHybridTableOptions.AlterColumn: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.DataRetentionTimeInDays opts.Set.MaxDataExtensionTimeInDays opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &HybridTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterHybridTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+		// custom:end AlterHybridTableOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.DataRetentionTimeInDays opts.Unset.MaxDataExtensionTimeInDays opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &HybridTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterHybridTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+		// custom:end AlterHybridTableOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s SET COMMENT = 'comment'", id.FullyQualifiedName())
+		// custom:end AlterHybridTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterHybridTableOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &HybridTableSet{
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(14),
+			Comment:                    String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE IF EXISTS %s SET DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 COMMENT = 'comment'", id.FullyQualifiedName())
+		// custom:end AlterHybridTableOptions: all options
+	})
+
+	// custom:begin AlterHybridTableOptions: additional test cases
+	t.Run("add column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.AddColumn = &HybridTableAddColumn{
+			IfNotExists:  Bool(true),
+			Name:         "EMAIL",
+			Type:         DataTypeVARCHAR,
+			DefaultValue: &ColumnDefaultValue{Expression: String("'unknown'")},
+			Comment:      String("email column"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD COLUMN IF NOT EXISTS EMAIL VARCHAR DEFAULT 'unknown' COMMENT 'email column'", id.FullyQualifiedName())
+	})
+
+	t.Run("alter column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.AlterColumn = &HybridTableAlterColumn{
+			Name:    "EMAIL",
+			Comment: String("new comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN EMAIL COMMENT 'new comment'", id.FullyQualifiedName())
+
+		opts.AlterColumn = &HybridTableAlterColumn{
+			Name:         "EMAIL",
+			UnsetComment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN EMAIL UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("drop columns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.DropColumns = &HybridTableDropColumns{
+			IfExists: Bool(true),
+			Names:    []string{"EMAIL", "PARENT_ID"},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP COLUMN IF EXISTS EMAIL, PARENT_ID", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &HybridTableUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  tagId,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+	// custom:end AlterHybridTableOptions: additional test cases
+}
+
+func TestHybridTables_Drop(t *testing.T) {
+	// custom:begin DropHybridTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropHybridTableOptions
+	defaultOpts := func() *DropHybridTableOptions {
+		return &DropHybridTableOptions{
+			name: id,
+		}
+	}
+	// custom:end DropHybridTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropHybridTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropHybridTableOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropHybridTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP TABLE %s", id.FullyQualifiedName())
+		// custom:end DropHybridTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropHybridTableOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP TABLE IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropHybridTableOptions: all options
+	})
+
+	// custom:begin DropHybridTableOptions: additional test cases
+	// custom:end DropHybridTableOptions: additional test cases
+}
+
+func TestHybridTables_Show(t *testing.T) {
+	// custom:begin ShowHybridTableOptions: default options
+	// Minimal valid ShowHybridTableOptions
+	defaultOpts := func() *ShowHybridTableOptions {
+		return &ShowHybridTableOptions{}
+	}
+	// custom:end ShowHybridTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowHybridTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW HYBRID TABLES")
+		// custom:end ShowHybridTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowHybridTableOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Terse = Bool(true)
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: schemaId}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{Rows: Int(10), From: String("xyz")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW TERSE HYBRID TABLES LIKE 'pattern' IN SCHEMA %s STARTS WITH 'abc' LIMIT 10 FROM 'xyz'", schemaId.FullyQualifiedName())
+		// custom:end ShowHybridTableOptions: all options
+	})
+
+	// custom:begin ShowHybridTableOptions: additional test cases
+	// custom:end ShowHybridTableOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
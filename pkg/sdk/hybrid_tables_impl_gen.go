@@ -0,0 +1,195 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ HybridTables = (*hybridTables)(nil)
+
+type hybridTables struct {
+	client *Client
+}
+
+func (v *hybridTables) Create(ctx context.Context, request *CreateHybridTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Alter(ctx context.Context, request *AlterHybridTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Drop(ctx context.Context, request *DropHybridTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showHybridTablesDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showHybridTablesDbRow, HybridTable](dbRows)
+	return resultList, nil
+}
+
+func (v *hybridTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error) {
+	// custom:begin ShowByID
+	hybridTables, err := v.Show(ctx, NewShowHybridTableRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(hybridTables, func(r HybridTable) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (r *CreateHybridTableRequest) toOpts() *CreateHybridTableOptions {
+	opts := &CreateHybridTableOptions{
+		OrReplace:                  r.OrReplace,
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		DataRetentionTimeInDays:    r.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: r.MaxDataExtensionTimeInDays,
+		Comment:                    r.Comment,
+		Tag:                        r.Tag,
+	}
+	opts.ColumnsConstraintsAndIndexes = HybridTableColumnsConstraintsAndIndexes{
+		OutOfLineConstraint: r.ColumnsConstraintsAndIndexes.OutOfLineConstraint,
+	}
+	if r.ColumnsConstraintsAndIndexes.Columns != nil {
+		s := make([]HybridTableColumn, len(r.ColumnsConstraintsAndIndexes.Columns))
+		for i, v := range r.ColumnsConstraintsAndIndexes.Columns {
+			s[i] = HybridTableColumn{
+				Name:             v.Name,
+				Type:             v.Type,
+				InlineConstraint: v.InlineConstraint,
+				NotNull:          v.NotNull,
+				DefaultValue:     v.DefaultValue,
+				Comment:          v.Comment,
+			}
+		}
+		opts.ColumnsConstraintsAndIndexes.Columns = s
+	}
+	if r.ColumnsConstraintsAndIndexes.OutOfLineIndex != nil {
+		s := make([]HybridTableOutOfLineIndex, len(r.ColumnsConstraintsAndIndexes.OutOfLineIndex))
+		for i, v := range r.ColumnsConstraintsAndIndexes.OutOfLineIndex {
+			s[i] = HybridTableOutOfLineIndex{
+				Name:           v.Name,
+				Columns:        v.Columns,
+				IncludeColumns: v.IncludeColumns,
+			}
+		}
+		opts.ColumnsConstraintsAndIndexes.OutOfLineIndex = s
+	}
+	return opts
+}
+
+func (r *AlterHybridTableRequest) toOpts() *AlterHybridTableOptions {
+	opts := &AlterHybridTableOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+		RenameTo:  r.RenameTo,
+	}
+	if r.AddColumn != nil {
+		opts.AddColumn = &HybridTableAddColumn{
+			IfNotExists:  r.AddColumn.IfNotExists,
+			Name:         r.AddColumn.Name,
+			Type:         r.AddColumn.Type,
+			DefaultValue: r.AddColumn.DefaultValue,
+			Comment:      r.AddColumn.Comment,
+		}
+	}
+	if r.AlterColumn != nil {
+		opts.AlterColumn = &HybridTableAlterColumn{
+			Name:         r.AlterColumn.Name,
+			Comment:      r.AlterColumn.Comment,
+			UnsetComment: r.AlterColumn.UnsetComment,
+		}
+	}
+	if r.DropColumns != nil {
+		opts.DropColumns = &HybridTableDropColumns{
+			IfExists: r.DropColumns.IfExists,
+			Names:    r.DropColumns.Names,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &HybridTableSet{
+			DataRetentionTimeInDays:    r.Set.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Set.MaxDataExtensionTimeInDays,
+			Comment:                    r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &HybridTableUnset{
+			DataRetentionTimeInDays:    r.Unset.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Unset.MaxDataExtensionTimeInDays,
+			Comment:                    r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropHybridTableRequest) toOpts() *DropHybridTableOptions {
+	opts := &DropHybridTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowHybridTableRequest) toOpts() *ShowHybridTableOptions {
+	opts := &ShowHybridTableOptions{
+		Terse:      r.Terse,
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r showHybridTablesDbRow) convert() *HybridTable {
+	hybridTable := HybridTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+	}
+	if r.Rows.Valid {
+		hybridTable.Rows = Int(int(r.Rows.Int64))
+	}
+	if r.Bytes.Valid {
+		hybridTable.Bytes = Int(int(r.Bytes.Int64))
+	}
+	if r.Comment.Valid {
+		hybridTable.Comment = String(r.Comment.String)
+	}
+	if r.OwnerRoleType.Valid {
+		hybridTable.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	return &hybridTable
+}
+
+// custom:begin additional
+// custom:end additional
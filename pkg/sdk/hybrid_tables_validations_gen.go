@@ -0,0 +1,101 @@
+package sdk
+
+var (
+	_ validatable = new(CreateHybridTableOptions)
+	_ validatable = new(AlterHybridTableOptions)
+	_ validatable = new(DropHybridTableOptions)
+	_ validatable = new(ShowHybridTableOptions)
+)
+
+func (opts *CreateHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if valueSet(opts.ColumnsConstraintsAndIndexes) {
+		if !valueSet(opts.ColumnsConstraintsAndIndexes.Columns) {
+			errs = append(errs, errNotSet("CreateHybridTableOptions.ColumnsConstraintsAndIndexes", "Columns"))
+		}
+	}
+	// custom:begin CreateHybridTableOptions: additional validations
+	for _, column := range opts.ColumnsConstraintsAndIndexes.Columns {
+		if column.InlineConstraint != nil {
+			if err := column.InlineConstraint.validate(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	for _, outOfLineConstraint := range opts.ColumnsConstraintsAndIndexes.OutOfLineConstraint {
+		if err := outOfLineConstraint.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// custom:end CreateHybridTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.AddColumn, opts.AlterColumn, opts.DropColumns, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("AlterHybridTableOptions", "AddColumn", "AlterColumn", "DropColumns", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"))
+	}
+	if valueSet(opts.AlterColumn) {
+		if !exactlyOneValueSet(opts.AlterColumn.Comment, opts.AlterColumn.UnsetComment) {
+			errs = append(errs, errExactlyOneOf("AlterHybridTableOptions.AlterColumn", "Comment", "UnsetComment"))
+		}
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterHybridTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterHybridTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+		}
+	}
+	// custom:begin AlterHybridTableOptions: additional validations
+	// custom:end AlterHybridTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropHybridTableOptions: additional validations
+	// custom:end DropHybridTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowHybridTableOptions: additional validations
+	// custom:end ShowHybridTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,233 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type IcebergTableStorageSerializationPolicy string
+
+var (
+	IcebergTableStorageSerializationPolicyCompatible IcebergTableStorageSerializationPolicy = "COMPATIBLE"
+	IcebergTableStorageSerializationPolicyOptimized  IcebergTableStorageSerializationPolicy = "OPTIMIZED"
+)
+
+var icebergTableColumnDef = g.NewQueryStruct("IcebergTableColumn").
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("Type", "DataType", g.KeywordOptions().NoQuotes().Required()).
+	OptionalSQL("NOT NULL").
+	PredefinedQueryStructField("MaskingPolicy", "*ColumnMaskingPolicy", g.KeywordOptions()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var icebergTableAddColumnDef = g.NewQueryStruct("IcebergTableAddColumn").
+	SQL("ADD COLUMN").
+	IfNotExists().
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("Type", "DataType", g.KeywordOptions().NoQuotes().Required()).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals())
+
+var icebergTableRenameColumnDef = g.NewQueryStruct("IcebergTableRenameColumn").
+	SQL("RENAME COLUMN").
+	Text("OldName", g.KeywordOptions().NoQuotes().Required()).
+	SQL("TO").
+	Text("NewName", g.KeywordOptions().NoQuotes().Required())
+
+var icebergTableAlterColumnDef = g.NewQueryStruct("IcebergTableAlterColumn").
+	SQL("ALTER COLUMN").
+	Text("Name", g.KeywordOptions().NoQuotes().Required()).
+	PredefinedQueryStructField("SetDataType", "*DataType", g.ParameterOptions().NoEquals().SQL("SET DATA TYPE")).
+	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes().NoEquals()).
+	OptionalSQL("UNSET COMMENT").
+	OptionalSQL("SET NOT NULL").
+	OptionalSQL("DROP NOT NULL").
+	WithValidation(g.ExactlyOneValueSet, "SetDataType", "Comment", "UnsetComment", "SetNotNull", "DropNotNull")
+
+var icebergTableDropColumnsDef = g.NewQueryStruct("IcebergTableDropColumns").
+	SQL("DROP COLUMN").
+	IfExists().
+	PredefinedQueryStructField("Names", "[]string", g.KeywordOptions().Required())
+
+var icebergTableSetDef = g.NewQueryStruct("IcebergTableSet").
+	OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+	OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+	OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+	OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+	OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "AutoRefresh", "ReplaceInvalidCharacters", "Comment")
+
+var icebergTableUnsetDef = g.NewQueryStruct("IcebergTableUnset").
+	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
+	OptionalSQL("MAX_DATA_EXTENSION_TIME_IN_DAYS").
+	OptionalSQL("CHANGE_TRACKING").
+	OptionalSQL("DEFAULT_DDL_COLLATION").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "Comment")
+
+var IcebergTablesDef = g.NewInterface(
+	"IcebergTables",
+	"IcebergTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake",
+		g.NewQueryStruct("CreateIcebergTable").
+			Create().
+			OrReplace().
+			SQL("ICEBERG TABLE").
+			IfNotExists().
+			Name().
+			ListQueryStructField("Columns", icebergTableColumnDef, g.ListOptions().Parentheses().Required()).
+			PredefinedQueryStructField("ClusterBy", "[]string", g.KeywordOptions().Parentheses().SQL("CLUSTER BY")).
+			OptionalTextAssignment("EXTERNAL_VOLUME", g.ParameterOptions().SingleQuotes()).
+			PredefinedQueryStructField("catalog", "bool", g.StaticOptions().SQL("CATALOG = 'SNOWFLAKE'")).
+			TextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes().Required()).
+			OptionalAssignment("STORAGE_SERIALIZATION_POLICY", g.KindOfTPointer[IcebergTableStorageSerializationPolicy](), g.ParameterOptions()).
+			OptionalNumberAssignment("DATA_RETENTION_TIME_IN_DAYS", g.ParameterOptions()).
+			OptionalNumberAssignment("MAX_DATA_EXTENSION_TIME_IN_DAYS", g.ParameterOptions()).
+			OptionalBooleanAssignment("CHANGE_TRACKING", g.ParameterOptions()).
+			OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
+			OptionalCopyGrants().
+			OptionalComment().
+			PredefinedQueryStructField("RowAccessPolicy", "*TableRowAccessPolicy", g.KeywordOptions()).
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "Columns").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	CustomOperation(
+		"CreateWithExternalCatalog",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table",
+		g.NewQueryStruct("CreateWithExternalCatalog").
+			Create().
+			OrReplace().
+			SQL("ICEBERG TABLE").
+			IfNotExists().
+			Name().
+			OptionalTextAssignment("EXTERNAL_VOLUME", g.ParameterOptions().SingleQuotes()).
+			TextAssignment("CATALOG", g.ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("CATALOG_TABLE_NAME", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("CATALOG_NAMESPACE", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("METADATA_FILE_PATH", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+			OptionalBooleanAssignment("REPLACE_INVALID_CHARACTERS", g.ParameterOptions()).
+			OptionalBooleanAssignment("AUTO_REFRESH", g.ParameterOptions()).
+			OptionalComment().
+			OptionalTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ExactlyOneValueSet, "CatalogTableName", "MetadataFilePath", "BaseLocation"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table",
+		g.NewQueryStruct("AlterIcebergTable").
+			Alter().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Refresh",
+				g.NewQueryStruct("IcebergTableRefresh").
+					OptionalText("MetadataFileRelativePath", g.KeywordOptions().SingleQuotes()),
+				g.KeywordOptions().SQL("REFRESH"),
+			).
+			OptionalQueryStructField(
+				"ConvertToManaged",
+				g.NewQueryStruct("IcebergTableConvertToManaged").
+					OptionalTextAssignment("BASE_LOCATION", g.ParameterOptions().SingleQuotes()).
+					OptionalAssignment("STORAGE_SERIALIZATION_POLICY", g.KindOfTPointer[IcebergTableStorageSerializationPolicy](), g.ParameterOptions()),
+				g.KeywordOptions().SQL("CONVERT TO MANAGED"),
+			).
+			OptionalQueryStructField("AddColumn", icebergTableAddColumnDef, g.KeywordOptions()).
+			OptionalQueryStructField("RenameColumn", icebergTableRenameColumnDef, g.KeywordOptions()).
+			OptionalQueryStructField("AlterColumn", icebergTableAlterColumnDef, g.KeywordOptions()).
+			OptionalQueryStructField("DropColumns", icebergTableDropColumnsDef, g.KeywordOptions()).
+			PredefinedQueryStructField("ClusterBy", "[]string", g.KeywordOptions().Parentheses().SQL("CLUSTER BY")).
+			OptionalSQL("DROP CLUSTERING KEY").
+			OptionalQueryStructField("Set", icebergTableSetDef, g.KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", icebergTableUnsetDef, g.KeywordOptions().SQL("UNSET")).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "Refresh", "ConvertToManaged", "AddColumn", "RenameColumn", "AlterColumn", "DropColumns", "ClusterBy", "DropClusteringKey", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table",
+		g.NewQueryStruct("DropIcebergTable").
+			Drop().
+			SQL("ICEBERG TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables",
+		g.DbStruct("icebergTableRow").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			OptionalText("external_volume_name").
+			OptionalText("catalog_name").
+			OptionalText("iceberg_table_type").
+			OptionalText("catalog_table_name").
+			OptionalText("catalog_namespace").
+			OptionalText("base_location").
+			OptionalText("storage_serialization_policy").
+			OptionalText("auto_refresh_status").
+			OptionalText("comment").
+			OptionalText("owner_role_type"),
+		g.PlainStruct("IcebergTable").
+			Time("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			Text("ExternalVolumeName").
+			Text("CatalogName").
+			Text("IcebergTableType").
+			Text("CatalogTableName").
+			Text("CatalogNamespace").
+			Text("BaseLocation").
+			Text("StorageSerializationPolicy").
+			Text("AutoRefreshStatus").
+			OptionalText("Comment").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowIcebergTables").
+			Show().
+			Terse().
+			SQL("ICEBERG TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSlice,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-iceberg-table",
+		g.DbStruct("icebergTableColumnDetailsRow").
+			Text("name").
+			Field("type", "DataType").
+			Text("kind").
+			Text("null?").
+			OptionalText("default").
+			OptionalText("comment").
+			OptionalText("policy name"),
+		g.PlainStruct("IcebergTableColumnDetails").
+			Text("Name").
+			Field("Type", "DataType").
+			Text("Kind").
+			Bool("IsNullable").
+			OptionalText("Default").
+			OptionalText("Comment").
+			OptionalText("PolicyName"),
+		g.NewQueryStruct("DescribeIcebergTable").
+			Describe().
+			SQL("ICEBERG TABLE").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,143 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateIcebergTableOptions]                    = new(CreateIcebergTableRequest)
+	_ optionsProvider[CreateWithExternalCatalogIcebergTableOptions] = new(CreateWithExternalCatalogIcebergTableRequest)
+	_ optionsProvider[AlterIcebergTableOptions]                     = new(AlterIcebergTableRequest)
+	_ optionsProvider[DropIcebergTableOptions]                      = new(DropIcebergTableRequest)
+	_ optionsProvider[ShowIcebergTableOptions]                      = new(ShowIcebergTableRequest)
+	_ optionsProvider[DescribeIcebergTableOptions]                  = new(DescribeIcebergTableRequest)
+)
+
+type CreateIcebergTableRequest struct {
+	OrReplace                  *bool                       `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                *bool                       `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                       SchemaObjectIdentifier      `validate:"validIdentifier"` // required
+	Columns                    []IcebergTableColumnRequest // required
+	ClusterBy                  []string
+	ExternalVolume             *string
+	BaseLocation               string // required
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy
+	DataRetentionTimeInDays    *int
+	MaxDataExtensionTimeInDays *int
+	ChangeTracking             *bool
+	DefaultDdlCollation        *string
+	CopyGrants                 *bool
+	Comment                    *string
+	RowAccessPolicy            *TableRowAccessPolicy
+	Tag                        []TagAssociation
+}
+
+type IcebergTableColumnRequest struct {
+	Name          string   // required
+	Type          DataType // required
+	NotNull       *bool
+	MaskingPolicy *ColumnMaskingPolicy
+	Comment       *string
+}
+
+type CreateWithExternalCatalogIcebergTableRequest struct {
+	OrReplace                *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists              *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	ExternalVolume           *string
+	Catalog                  string  // required
+	CatalogTableName         *string `validate:"exactlyOneValueSet=CatalogTableName|MetadataFilePath|BaseLocation"`
+	CatalogNamespace         *string
+	MetadataFilePath         *string `validate:"exactlyOneValueSet=CatalogTableName|MetadataFilePath|BaseLocation"`
+	BaseLocation             *string `validate:"exactlyOneValueSet=CatalogTableName|MetadataFilePath|BaseLocation"`
+	ReplaceInvalidCharacters *bool
+	AutoRefresh              *bool
+	Comment                  *string
+	Tag                      []TagAssociation
+}
+
+type AlterIcebergTableRequest struct {
+	IfExists          *bool
+	name              SchemaObjectIdentifier               `validate:"validIdentifier"` // required
+	Refresh           *IcebergTableRefreshRequest          `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	ConvertToManaged  *IcebergTableConvertToManagedRequest `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	AddColumn         *IcebergTableAddColumnRequest        `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	RenameColumn      *IcebergTableRenameColumnRequest     `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	AlterColumn       *IcebergTableAlterColumnRequest      `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	DropColumns       *IcebergTableDropColumnsRequest      `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	ClusterBy         []string                             `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	DropClusteringKey *bool                                `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	Set               *IcebergTableSetRequest              `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	Unset             *IcebergTableUnsetRequest            `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	SetTags           []TagAssociation                     `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	UnsetTags         []ObjectIdentifier                   `validate:"exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+	RenameTo          *SchemaObjectIdentifier              `validate:"validIdentifierIfSet,exactlyOneValueSet=Refresh|ConvertToManaged|AddColumn|RenameColumn|AlterColumn|DropColumns|ClusterBy|DropClusteringKey|Set|Unset|SetTags|UnsetTags|RenameTo"`
+}
+
+type IcebergTableRefreshRequest struct {
+	MetadataFileRelativePath *string
+}
+
+type IcebergTableConvertToManagedRequest struct {
+	BaseLocation               *string
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy
+}
+
+type IcebergTableAddColumnRequest struct {
+	IfNotExists *bool
+	Name        string   // required
+	Type        DataType // required
+	Comment     *string
+}
+
+type IcebergTableRenameColumnRequest struct {
+	OldName string // required
+	NewName string // required
+}
+
+type IcebergTableAlterColumnRequest struct {
+	Name         string    // required
+	SetDataType  *DataType `validate:"exactlyOneValueSet=SetDataType|Comment|UnsetComment|SetNotNull|DropNotNull"`
+	Comment      *string   `validate:"exactlyOneValueSet=SetDataType|Comment|UnsetComment|SetNotNull|DropNotNull"`
+	UnsetComment *bool     `validate:"exactlyOneValueSet=SetDataType|Comment|UnsetComment|SetNotNull|DropNotNull"`
+	SetNotNull   *bool     `validate:"exactlyOneValueSet=SetDataType|Comment|UnsetComment|SetNotNull|DropNotNull"`
+	DropNotNull  *bool     `validate:"exactlyOneValueSet=SetDataType|Comment|UnsetComment|SetNotNull|DropNotNull"`
+}
+
+type IcebergTableDropColumnsRequest struct {
+	IfExists *bool
+	Names    []string // required
+}
+
+type IcebergTableSetRequest struct {
+	DataRetentionTimeInDays    *int    `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	MaxDataExtensionTimeInDays *int    `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	ChangeTracking             *bool   `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	DefaultDdlCollation        *string `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	AutoRefresh                *bool   `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	ReplaceInvalidCharacters   *bool   `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+	Comment                    *string `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|AutoRefresh|ReplaceInvalidCharacters|Comment"`
+}
+
+type IcebergTableUnsetRequest struct {
+	DataRetentionTimeInDays    *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|Comment"`
+	MaxDataExtensionTimeInDays *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|Comment"`
+	ChangeTracking             *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|Comment"`
+	DefaultDdlCollation        *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|Comment"`
+	Comment                    *bool `validate:"atLeastOneValueSet=DataRetentionTimeInDays|MaxDataExtensionTimeInDays|ChangeTracking|DefaultDdlCollation|Comment"`
+}
+
+type DropIcebergTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowIcebergTableRequest struct {
+	Terse      *bool
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type DescribeIcebergTableRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,235 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type IcebergTables interface {
+	Create(ctx context.Context, request *CreateIcebergTableRequest) error
+	CreateWithExternalCatalog(ctx context.Context, request *CreateWithExternalCatalogIcebergTableRequest) error
+	Alter(ctx context.Context, request *AlterIcebergTableRequest) error
+	Drop(ctx context.Context, request *DropIcebergTableRequest) error
+	Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableColumnDetails, error)
+}
+
+// CreateIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table-snowflake.
+type CreateIcebergTableOptions struct {
+	create                     bool                                    `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                                   `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable               bool                                    `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists                *bool                                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier                  `ddl:"identifier"`
+	Columns                    []IcebergTableColumn                    `ddl:"list,parentheses"`
+	ClusterBy                  []string                                `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	ExternalVolume             *string                                 `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	catalog                    bool                                    `ddl:"static" sql:"CATALOG = 'SNOWFLAKE'"`
+	BaseLocation               string                                  `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy `ddl:"parameter" sql:"STORAGE_SERIALIZATION_POLICY"`
+	DataRetentionTimeInDays    *int                                    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                                    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool                                   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *string                                 `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	CopyGrants                 *bool                                   `ddl:"keyword" sql:"COPY GRANTS"`
+	Comment                    *string                                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	RowAccessPolicy            *TableRowAccessPolicy                   `ddl:"keyword"`
+	Tag                        []TagAssociation                        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+type IcebergTableColumn struct {
+	Name          string               `ddl:"keyword,no_quotes"`
+	Type          DataType             `ddl:"keyword,no_quotes"`
+	NotNull       *bool                `ddl:"keyword" sql:"NOT NULL"`
+	MaskingPolicy *ColumnMaskingPolicy `ddl:"keyword"`
+	Comment       *string              `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+// CreateWithExternalCatalogIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table.
+type CreateWithExternalCatalogIcebergTableOptions struct {
+	create                   bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable             bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists              *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier `ddl:"identifier"`
+	ExternalVolume           *string                `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	Catalog                  string                 `ddl:"parameter,single_quotes" sql:"CATALOG"`
+	CatalogTableName         *string                `ddl:"parameter,single_quotes" sql:"CATALOG_TABLE_NAME"`
+	CatalogNamespace         *string                `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	MetadataFilePath         *string                `ddl:"parameter,single_quotes" sql:"METADATA_FILE_PATH"`
+	BaseLocation             *string                `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	ReplaceInvalidCharacters *bool                  `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	AutoRefresh              *bool                  `ddl:"parameter" sql:"AUTO_REFRESH"`
+	Comment                  *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                      []TagAssociation       `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+// AlterIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table.
+type AlterIcebergTableOptions struct {
+	alter             bool                          `ddl:"static" sql:"ALTER"`
+	icebergTable      bool                          `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists          *bool                         `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier        `ddl:"identifier"`
+	Refresh           *IcebergTableRefresh          `ddl:"keyword" sql:"REFRESH"`
+	ConvertToManaged  *IcebergTableConvertToManaged `ddl:"keyword" sql:"CONVERT TO MANAGED"`
+	AddColumn         *IcebergTableAddColumn        `ddl:"keyword"`
+	RenameColumn      *IcebergTableRenameColumn     `ddl:"keyword"`
+	AlterColumn       *IcebergTableAlterColumn      `ddl:"keyword"`
+	DropColumns       *IcebergTableDropColumns      `ddl:"keyword"`
+	ClusterBy         []string                      `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool                         `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
+	Set               *IcebergTableSet              `ddl:"keyword" sql:"SET"`
+	Unset             *IcebergTableUnset            `ddl:"keyword" sql:"UNSET"`
+	SetTags           []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags         []ObjectIdentifier            `ddl:"keyword" sql:"UNSET TAG"`
+	RenameTo          *SchemaObjectIdentifier       `ddl:"identifier" sql:"RENAME TO"`
+}
+
+type IcebergTableRefresh struct {
+	MetadataFileRelativePath *string `ddl:"keyword,single_quotes"`
+}
+
+type IcebergTableConvertToManaged struct {
+	BaseLocation               *string                                 `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+	StorageSerializationPolicy *IcebergTableStorageSerializationPolicy `ddl:"parameter" sql:"STORAGE_SERIALIZATION_POLICY"`
+}
+
+type IcebergTableAddColumn struct {
+	addColumn   bool     `ddl:"static" sql:"ADD COLUMN"`
+	IfNotExists *bool    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	Name        string   `ddl:"keyword,no_quotes"`
+	Type        DataType `ddl:"keyword,no_quotes"`
+	Comment     *string  `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+}
+
+type IcebergTableRenameColumn struct {
+	renameColumn bool   `ddl:"static" sql:"RENAME COLUMN"`
+	OldName      string `ddl:"keyword,no_quotes"`
+	to           bool   `ddl:"static" sql:"TO"`
+	NewName      string `ddl:"keyword,no_quotes"`
+}
+
+type IcebergTableAlterColumn struct {
+	alterColumn  bool      `ddl:"static" sql:"ALTER COLUMN"`
+	Name         string    `ddl:"keyword,no_quotes"`
+	SetDataType  *DataType `ddl:"parameter,no_equals" sql:"SET DATA TYPE"`
+	Comment      *string   `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+	UnsetComment *bool     `ddl:"keyword" sql:"UNSET COMMENT"`
+	SetNotNull   *bool     `ddl:"keyword" sql:"SET NOT NULL"`
+	DropNotNull  *bool     `ddl:"keyword" sql:"DROP NOT NULL"`
+}
+
+type IcebergTableDropColumns struct {
+	dropColumn bool     `ddl:"static" sql:"DROP COLUMN"`
+	IfExists   *bool    `ddl:"keyword" sql:"IF EXISTS"`
+	Names      []string `ddl:"keyword"`
+}
+
+type IcebergTableSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *string `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	AutoRefresh                *bool   `ddl:"parameter" sql:"AUTO_REFRESH"`
+	ReplaceInvalidCharacters   *bool   `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type IcebergTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool `ddl:"keyword" sql:"CHANGE_TRACKING"`
+	DefaultDdlCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table.
+type DropIcebergTableOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables.
+type ShowIcebergTableOptions struct {
+	show          bool       `ddl:"static" sql:"SHOW"`
+	Terse         *bool      `ddl:"keyword" sql:"TERSE"`
+	icebergTables bool       `ddl:"static" sql:"ICEBERG TABLES"`
+	Like          *Like      `ddl:"keyword" sql:"LIKE"`
+	In            *In        `ddl:"keyword" sql:"IN"`
+	StartsWith    *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit         *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type icebergTableRow struct {
+	CreatedOn                  time.Time      `db:"created_on"`
+	Name                       string         `db:"name"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      string         `db:"owner"`
+	ExternalVolumeName         sql.NullString `db:"external_volume_name"`
+	CatalogName                sql.NullString `db:"catalog_name"`
+	IcebergTableType           sql.NullString `db:"iceberg_table_type"`
+	CatalogTableName           sql.NullString `db:"catalog_table_name"`
+	CatalogNamespace           sql.NullString `db:"catalog_namespace"`
+	BaseLocation               sql.NullString `db:"base_location"`
+	StorageSerializationPolicy sql.NullString `db:"storage_serialization_policy"`
+	AutoRefreshStatus          sql.NullString `db:"auto_refresh_status"`
+	Comment                    sql.NullString `db:"comment"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+}
+
+type IcebergTable struct {
+	CreatedOn                  time.Time
+	Name                       string
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ExternalVolumeName         string
+	CatalogName                string
+	IcebergTableType           string
+	CatalogTableName           string
+	CatalogNamespace           string
+	BaseLocation               string
+	StorageSerializationPolicy string
+	AutoRefreshStatus          string
+	Comment                    *string
+	OwnerRoleType              string
+}
+
+// DescribeIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-iceberg-table.
+type DescribeIcebergTableOptions struct {
+	describe     bool                   `ddl:"static" sql:"DESCRIBE"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type icebergTableColumnDetailsRow struct {
+	Name       string         `db:"name"`
+	Type       DataType       `db:"type"`
+	Kind       string         `db:"kind"`
+	Null       string         `db:"null?"`
+	Default    sql.NullString `db:"default"`
+	Comment    sql.NullString `db:"comment"`
+	PolicyName sql.NullString `db:"policy name"`
+}
+
+type IcebergTableColumnDetails struct {
+	Name       string
+	Type       DataType
+	Kind       string
+	IsNullable bool
+	Default    *string
+	Comment    *string
+	PolicyName *string
+}
+
+// custom:begin additional
+func (v *IcebergTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// custom:end additional
@@ -0,0 +1,570 @@
+package sdk
+
+import "testing"
+
+func TestIcebergTables_Create(t *testing.T) {
+	// custom:begin CreateIcebergTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateIcebergTableOptions
+	defaultOpts := func() *CreateIcebergTableOptions {
+		return &CreateIcebergTableOptions{
+			name: id,
+			Columns: []IcebergTableColumn{
+				{Name: "id", Type: DataTypeNumber},
+			},
+			BaseLocation: "my/location",
+		}
+	}
+	// custom:end CreateIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateIcebergTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateIcebergTableOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: [opts.Columns] should be set", func(t *testing.T) {
+		// custom:begin CreateIcebergTableOptions: validation (value set)
+		opts := defaultOpts()
+		opts.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateIcebergTableOptions", "Columns"))
+		// custom:end CreateIcebergTableOptions: validation (value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateIcebergTableOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateIcebergTableOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s (id NUMBER) CATALOG = 'SNOWFLAKE' BASE_LOCATION = 'my/location'", id.FullyQualifiedName())
+		// custom:end CreateIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateIcebergTableOptions: all options
+		maskingPolicyId := randomSchemaObjectIdentifier()
+		rowAccessPolicyId := randomSchemaObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Columns = []IcebergTableColumn{
+			{
+				Name:    "id",
+				Type:    DataTypeNumber,
+				NotNull: Bool(true),
+				Comment: String("id column"),
+			},
+			{
+				Name: "name",
+				Type: DataTypeVARCHAR,
+				MaskingPolicy: &ColumnMaskingPolicy{
+					Name: maskingPolicyId,
+				},
+			},
+		}
+		opts.ClusterBy = []string{"id"}
+		opts.ExternalVolume = String("my_volume")
+		opts.StorageSerializationPolicy = Pointer(IcebergTableStorageSerializationPolicyOptimized)
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(14)
+		opts.ChangeTracking = Bool(true)
+		opts.DefaultDdlCollation = String("en")
+		opts.CopyGrants = Bool(true)
+		opts.Comment = String("comment")
+		opts.RowAccessPolicy = &TableRowAccessPolicy{
+			Name: rowAccessPolicyId,
+			On:   []string{"id"},
+		}
+		opts.Tag = []TagAssociation{
+			{
+				Name:  tagId,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE ICEBERG TABLE %s (id NUMBER NOT NULL COMMENT 'id column', name VARCHAR MASKING POLICY %s) CLUSTER BY (id) EXTERNAL_VOLUME = 'my_volume' CATALOG = 'SNOWFLAKE' BASE_LOCATION = 'my/location' STORAGE_SERIALIZATION_POLICY = OPTIMIZED DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' COPY GRANTS COMMENT = 'comment' ROW ACCESS POLICY %s ON (id) TAG (%s = 'v1')",
+			id.FullyQualifiedName(), maskingPolicyId.FullyQualifiedName(), rowAccessPolicyId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateIcebergTableOptions: all options
+	})
+
+	// custom:begin CreateIcebergTableOptions: additional test cases
+	// custom:end CreateIcebergTableOptions: additional test cases
+}
+
+func TestIcebergTables_CreateWithExternalCatalog(t *testing.T) {
+	// custom:begin CreateWithExternalCatalogIcebergTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateWithExternalCatalogIcebergTableOptions
+	defaultOpts := func() *CreateWithExternalCatalogIcebergTableOptions {
+		return &CreateWithExternalCatalogIcebergTableOptions{
+			name:             id,
+			Catalog:          "glue_catalog_integration",
+			CatalogTableName: String("my_table"),
+		}
+	}
+	// custom:end CreateWithExternalCatalogIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithExternalCatalogIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateWithExternalCatalogIcebergTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithExternalCatalogIcebergTableOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateWithExternalCatalogIcebergTableOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithExternalCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateWithExternalCatalogIcebergTableOptions: validation (conflicting fields)
+	})
+
+	t.Run("validation: exactly one field from [opts.CatalogTableName opts.MetadataFilePath opts.BaseLocation] should be present", func(t *testing.T) {
+		// custom:begin CreateWithExternalCatalogIcebergTableOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.MetadataFilePath = String("path/to/metadata.json")
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateWithExternalCatalogIcebergTableOptions", "CatalogTableName", "MetadataFilePath", "BaseLocation"))
+		// custom:end CreateWithExternalCatalogIcebergTableOptions: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateWithExternalCatalogIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s CATALOG = 'glue_catalog_integration' CATALOG_TABLE_NAME = 'my_table'", id.FullyQualifiedName())
+		// custom:end CreateWithExternalCatalogIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateWithExternalCatalogIcebergTableOptions: all options
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.ExternalVolume = String("my_volume")
+		opts.CatalogNamespace = String("my_namespace")
+		opts.ReplaceInvalidCharacters = Bool(true)
+		opts.AutoRefresh = Bool(true)
+		opts.Comment = String("comment")
+		opts.Tag = []TagAssociation{
+			{
+				Name:  tagId,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE IF NOT EXISTS %s EXTERNAL_VOLUME = 'my_volume' CATALOG = 'glue_catalog_integration' CATALOG_TABLE_NAME = 'my_table' CATALOG_NAMESPACE = 'my_namespace' REPLACE_INVALID_CHARACTERS = true AUTO_REFRESH = true COMMENT = 'comment' TAG (%s = 'v1')", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateWithExternalCatalogIcebergTableOptions: all options
+	})
+
+	// custom:begin CreateWithExternalCatalogIcebergTableOptions: additional test cases
+	t.Run("from metadata file", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Catalog = "object_store_catalog_integration"
+		opts.CatalogTableName = nil
+		opts.MetadataFilePath = String("path/to/metadata/v1.metadata.json")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s CATALOG = 'object_store_catalog_integration' METADATA_FILE_PATH = 'path/to/metadata/v1.metadata.json'", id.FullyQualifiedName())
+	})
+
+	t.Run("from delta files", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Catalog = "delta_catalog_integration"
+		opts.CatalogTableName = nil
+		opts.BaseLocation = String("relative/path/to/delta/table")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ICEBERG TABLE %s CATALOG = 'delta_catalog_integration' BASE_LOCATION = 'relative/path/to/delta/table'", id.FullyQualifiedName())
+	})
+	// custom:end CreateWithExternalCatalogIcebergTableOptions: additional test cases
+}
+
+func TestIcebergTables_Alter(t *testing.T) {
+	// custom:begin AlterIcebergTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterIcebergTableOptions
+	defaultOpts := func() *AlterIcebergTableOptions {
+		return &AlterIcebergTableOptions{
+			name:    id,
+			Refresh: &IcebergTableRefresh{},
+		}
+	}
+	// custom:end AlterIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterIcebergTableOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.RenameTo = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterIcebergTableOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.Refresh opts.ConvertToManaged opts.AddColumn opts.RenameColumn opts.AlterColumn opts.DropColumns opts.ClusterBy opts.DropClusteringKey opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.RenameTo] should be present", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.DropClusteringKey = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions", "Refresh", "ConvertToManaged", "AddColumn", "RenameColumn", "AlterColumn", "DropColumns", "ClusterBy", "DropClusteringKey", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"))
+		// custom:end AlterIcebergTableOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.AlterColumn.SetDataType opts.AlterColumn.Comment opts.AlterColumn.UnsetComment opts.AlterColumn.SetNotNull opts.AlterColumn.DropNotNull] should be present", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions.AlterColumn: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:        "id",
+			SetNotNull:  Bool(true),
+			DropNotNull: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterIcebergTableOptions.AlterColumn", "SetDataType", "Comment", "UnsetComment", "SetNotNull", "DropNotNull"))
+		// custom:end AlterIcebergTableOptions.AlterColumn: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.DataRetentionTimeInDays opts.Set.MaxDataExtensionTimeInDays opts.Set.ChangeTracking opts.Set.DefaultDdlCollation opts.Set.AutoRefresh opts.Set.ReplaceInvalidCharacters opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.Set = &IcebergTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "AutoRefresh", "ReplaceInvalidCharacters", "Comment"))
+		// custom:end AlterIcebergTableOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.DataRetentionTimeInDays opts.Unset.MaxDataExtensionTimeInDays opts.Unset.ChangeTracking opts.Unset.DefaultDdlCollation opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.Unset = &IcebergTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "Comment"))
+		// custom:end AlterIcebergTableOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s REFRESH", id.FullyQualifiedName())
+		// custom:end AlterIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterIcebergTableOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Refresh = &IcebergTableRefresh{
+			MetadataFileRelativePath: String("metadata/v2.metadata.json"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE IF EXISTS %s REFRESH 'metadata/v2.metadata.json'", id.FullyQualifiedName())
+		// custom:end AlterIcebergTableOptions: all options
+	})
+
+	// custom:begin AlterIcebergTableOptions: additional test cases
+	t.Run("convert to managed", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{
+			BaseLocation:               String("my/location"),
+			StorageSerializationPolicy: Pointer(IcebergTableStorageSerializationPolicyCompatible),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s CONVERT TO MANAGED BASE_LOCATION = 'my/location' STORAGE_SERIALIZATION_POLICY = COMPATIBLE", id.FullyQualifiedName())
+	})
+
+	t.Run("add column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.AddColumn = &IcebergTableAddColumn{
+			IfNotExists: Bool(true),
+			Name:        "name",
+			Type:        DataTypeVARCHAR,
+			Comment:     String("name column"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s ADD COLUMN IF NOT EXISTS name VARCHAR COMMENT 'name column'", id.FullyQualifiedName())
+	})
+
+	t.Run("rename column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.RenameColumn = &IcebergTableRenameColumn{
+			OldName: "name",
+			NewName: "new_name",
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s RENAME COLUMN name TO new_name", id.FullyQualifiedName())
+	})
+
+	t.Run("alter column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:        "id",
+			SetDataType: Pointer(DataType("NUMBER(38, 0)")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s ALTER COLUMN id SET DATA TYPE NUMBER(38, 0)", id.FullyQualifiedName())
+
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:    "id",
+			Comment: String("new comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s ALTER COLUMN id COMMENT 'new comment'", id.FullyQualifiedName())
+
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:         "id",
+			UnsetComment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s ALTER COLUMN id UNSET COMMENT", id.FullyQualifiedName())
+
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:        "id",
+			DropNotNull: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s ALTER COLUMN id DROP NOT NULL", id.FullyQualifiedName())
+	})
+
+	t.Run("drop columns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.DropColumns = &IcebergTableDropColumns{
+			IfExists: Bool(true),
+			Names:    []string{"id", "name"},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s DROP COLUMN IF EXISTS id, name", id.FullyQualifiedName())
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.ClusterBy = []string{"id", "name"}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s CLUSTER BY (id, name)", id.FullyQualifiedName())
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.DropClusteringKey = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s DROP CLUSTERING KEY", id.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.Set = &IcebergTableSet{
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(14),
+			ChangeTracking:             Bool(true),
+			DefaultDdlCollation:        String("en"),
+			AutoRefresh:                Bool(true),
+			ReplaceInvalidCharacters:   Bool(false),
+			Comment:                    String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en' AUTO_REFRESH = true REPLACE_INVALID_CHARACTERS = false COMMENT = 'comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.Unset = &IcebergTableUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			ChangeTracking:             Bool(true),
+			DefaultDdlCollation:        Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS MAX_DATA_EXTENSION_TIME_IN_DAYS CHANGE_TRACKING DEFAULT_DDL_COLLATION COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("tag2"),
+				Value: "value2",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s SET TAG "tag1" = 'value1', "tag2" = 'value2'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+			NewAccountObjectIdentifier("tag2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s UNSET TAG "tag1", "tag2"`, id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Refresh = nil
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+	// custom:end AlterIcebergTableOptions: additional test cases
+}
+
+func TestIcebergTables_Drop(t *testing.T) {
+	// custom:begin DropIcebergTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropIcebergTableOptions
+	defaultOpts := func() *DropIcebergTableOptions {
+		return &DropIcebergTableOptions{
+			name: id,
+		}
+	}
+	// custom:end DropIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropIcebergTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropIcebergTableOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP ICEBERG TABLE %s", id.FullyQualifiedName())
+		// custom:end DropIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropIcebergTableOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP ICEBERG TABLE IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropIcebergTableOptions: all options
+	})
+
+	// custom:begin DropIcebergTableOptions: additional test cases
+	// custom:end DropIcebergTableOptions: additional test cases
+}
+
+func TestIcebergTables_Show(t *testing.T) {
+	// custom:begin ShowIcebergTableOptions: default options
+	// Minimal valid ShowIcebergTableOptions
+	defaultOpts := func() *ShowIcebergTableOptions {
+		return &ShowIcebergTableOptions{}
+	}
+	// custom:end ShowIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ICEBERG TABLES")
+		// custom:end ShowIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowIcebergTableOptions: all options
+		opts := defaultOpts()
+		opts.Terse = Bool(true)
+		opts.Like = &Like{
+			Pattern: String("pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{
+			Rows: Int(10),
+			From: String("xyz"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW TERSE ICEBERG TABLES LIKE 'pattern' IN SCHEMA "db"."schema" STARTS WITH 'abc' LIMIT 10 FROM 'xyz'`)
+		// custom:end ShowIcebergTableOptions: all options
+	})
+
+	// custom:begin ShowIcebergTableOptions: additional test cases
+	// custom:end ShowIcebergTableOptions: additional test cases
+}
+
+func TestIcebergTables_Describe(t *testing.T) {
+	// custom:begin DescribeIcebergTableOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeIcebergTableOptions
+	defaultOpts := func() *DescribeIcebergTableOptions {
+		return &DescribeIcebergTableOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeIcebergTableOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeIcebergTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeIcebergTableOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeIcebergTableOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeIcebergTableOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE ICEBERG TABLE %s", id.FullyQualifiedName())
+		// custom:end DescribeIcebergTableOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeIcebergTableOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE ICEBERG TABLE %s", id.FullyQualifiedName())
+		// custom:end DescribeIcebergTableOptions: all options
+	})
+
+	// custom:begin DescribeIcebergTableOptions: additional test cases
+	// custom:end DescribeIcebergTableOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,299 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ IcebergTables = (*icebergTables)(nil)
+
+type icebergTables struct {
+	client *Client
+}
+
+func (v *icebergTables) Create(ctx context.Context, request *CreateIcebergTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) CreateWithExternalCatalog(ctx context.Context, request *CreateWithExternalCatalogIcebergTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Alter(ctx context.Context, request *AlterIcebergTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Drop(ctx context.Context, request *DropIcebergTableRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *icebergTables) Show(ctx context.Context, request *ShowIcebergTableRequest) ([]IcebergTable, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[icebergTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[icebergTableRow, IcebergTable](dbRows)
+	return resultList, nil
+}
+
+func (v *icebergTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error) {
+	// custom:begin ShowByID
+	icebergTables, err := v.Show(ctx, NewShowIcebergTableRequest().WithIn(In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}).WithLike(Like{
+		Pattern: String(id.Name()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(icebergTables, func(r IcebergTable) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *icebergTables) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableColumnDetails, error) {
+	opts := &DescribeIcebergTableOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[icebergTableColumnDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[icebergTableColumnDetailsRow, IcebergTableColumnDetails](rows), nil
+}
+
+func (r *CreateIcebergTableRequest) toOpts() *CreateIcebergTableOptions {
+	opts := &CreateIcebergTableOptions{
+		OrReplace:                  r.OrReplace,
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		ClusterBy:                  r.ClusterBy,
+		ExternalVolume:             r.ExternalVolume,
+		BaseLocation:               r.BaseLocation,
+		StorageSerializationPolicy: r.StorageSerializationPolicy,
+		DataRetentionTimeInDays:    r.DataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: r.MaxDataExtensionTimeInDays,
+		ChangeTracking:             r.ChangeTracking,
+		DefaultDdlCollation:        r.DefaultDdlCollation,
+		CopyGrants:                 r.CopyGrants,
+		Comment:                    r.Comment,
+		RowAccessPolicy:            r.RowAccessPolicy,
+		Tag:                        r.Tag,
+	}
+	if r.Columns != nil {
+		s := make([]IcebergTableColumn, len(r.Columns))
+		for i, v := range r.Columns {
+			s[i] = IcebergTableColumn{
+				Name:          v.Name,
+				Type:          v.Type,
+				NotNull:       v.NotNull,
+				MaskingPolicy: v.MaskingPolicy,
+				Comment:       v.Comment,
+			}
+		}
+		opts.Columns = s
+	}
+	return opts
+}
+
+func (r *CreateWithExternalCatalogIcebergTableRequest) toOpts() *CreateWithExternalCatalogIcebergTableOptions {
+	opts := &CreateWithExternalCatalogIcebergTableOptions{
+		OrReplace:                r.OrReplace,
+		IfNotExists:              r.IfNotExists,
+		name:                     r.name,
+		ExternalVolume:           r.ExternalVolume,
+		Catalog:                  r.Catalog,
+		CatalogTableName:         r.CatalogTableName,
+		CatalogNamespace:         r.CatalogNamespace,
+		MetadataFilePath:         r.MetadataFilePath,
+		BaseLocation:             r.BaseLocation,
+		ReplaceInvalidCharacters: r.ReplaceInvalidCharacters,
+		AutoRefresh:              r.AutoRefresh,
+		Comment:                  r.Comment,
+		Tag:                      r.Tag,
+	}
+	return opts
+}
+
+func (r *AlterIcebergTableRequest) toOpts() *AlterIcebergTableOptions {
+	opts := &AlterIcebergTableOptions{
+		IfExists:          r.IfExists,
+		name:              r.name,
+		ClusterBy:         r.ClusterBy,
+		DropClusteringKey: r.DropClusteringKey,
+		SetTags:           r.SetTags,
+		UnsetTags:         r.UnsetTags,
+		RenameTo:          r.RenameTo,
+	}
+	if r.Refresh != nil {
+		opts.Refresh = &IcebergTableRefresh{
+			MetadataFileRelativePath: r.Refresh.MetadataFileRelativePath,
+		}
+	}
+	if r.ConvertToManaged != nil {
+		opts.ConvertToManaged = &IcebergTableConvertToManaged{
+			BaseLocation:               r.ConvertToManaged.BaseLocation,
+			StorageSerializationPolicy: r.ConvertToManaged.StorageSerializationPolicy,
+		}
+	}
+	if r.AddColumn != nil {
+		opts.AddColumn = &IcebergTableAddColumn{
+			IfNotExists: r.AddColumn.IfNotExists,
+			Name:        r.AddColumn.Name,
+			Type:        r.AddColumn.Type,
+			Comment:     r.AddColumn.Comment,
+		}
+	}
+	if r.RenameColumn != nil {
+		opts.RenameColumn = &IcebergTableRenameColumn{
+			OldName: r.RenameColumn.OldName,
+			NewName: r.RenameColumn.NewName,
+		}
+	}
+	if r.AlterColumn != nil {
+		opts.AlterColumn = &IcebergTableAlterColumn{
+			Name:         r.AlterColumn.Name,
+			SetDataType:  r.AlterColumn.SetDataType,
+			Comment:      r.AlterColumn.Comment,
+			UnsetComment: r.AlterColumn.UnsetComment,
+			SetNotNull:   r.AlterColumn.SetNotNull,
+			DropNotNull:  r.AlterColumn.DropNotNull,
+		}
+	}
+	if r.DropColumns != nil {
+		opts.DropColumns = &IcebergTableDropColumns{
+			IfExists: r.DropColumns.IfExists,
+			Names:    r.DropColumns.Names,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &IcebergTableSet{
+			DataRetentionTimeInDays:    r.Set.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Set.MaxDataExtensionTimeInDays,
+			ChangeTracking:             r.Set.ChangeTracking,
+			DefaultDdlCollation:        r.Set.DefaultDdlCollation,
+			AutoRefresh:                r.Set.AutoRefresh,
+			ReplaceInvalidCharacters:   r.Set.ReplaceInvalidCharacters,
+			Comment:                    r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &IcebergTableUnset{
+			DataRetentionTimeInDays:    r.Unset.DataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: r.Unset.MaxDataExtensionTimeInDays,
+			ChangeTracking:             r.Unset.ChangeTracking,
+			DefaultDdlCollation:        r.Unset.DefaultDdlCollation,
+			Comment:                    r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropIcebergTableRequest) toOpts() *DropIcebergTableOptions {
+	opts := &DropIcebergTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowIcebergTableRequest) toOpts() *ShowIcebergTableOptions {
+	opts := &ShowIcebergTableOptions{
+		Terse:      r.Terse,
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r icebergTableRow) convert() *IcebergTable {
+	icebergTable := &IcebergTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+	}
+	if r.ExternalVolumeName.Valid {
+		icebergTable.ExternalVolumeName = r.ExternalVolumeName.String
+	}
+	if r.CatalogName.Valid {
+		icebergTable.CatalogName = r.CatalogName.String
+	}
+	if r.IcebergTableType.Valid {
+		icebergTable.IcebergTableType = r.IcebergTableType.String
+	}
+	if r.CatalogTableName.Valid {
+		icebergTable.CatalogTableName = r.CatalogTableName.String
+	}
+	if r.CatalogNamespace.Valid {
+		icebergTable.CatalogNamespace = r.CatalogNamespace.String
+	}
+	if r.BaseLocation.Valid {
+		icebergTable.BaseLocation = r.BaseLocation.String
+	}
+	if r.StorageSerializationPolicy.Valid {
+		icebergTable.StorageSerializationPolicy = r.StorageSerializationPolicy.String
+	}
+	if r.AutoRefreshStatus.Valid {
+		icebergTable.AutoRefreshStatus = r.AutoRefreshStatus.String
+	}
+	if r.Comment.Valid {
+		icebergTable.Comment = String(r.Comment.String)
+	}
+	if r.OwnerRoleType.Valid {
+		icebergTable.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return icebergTable
+}
+
+func (r *DescribeIcebergTableRequest) toOpts() *DescribeIcebergTableOptions {
+	opts := &DescribeIcebergTableOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r icebergTableColumnDetailsRow) convert() *IcebergTableColumnDetails {
+	details := &IcebergTableColumnDetails{
+		Name:       r.Name,
+		Type:       r.Type,
+		Kind:       r.Kind,
+		IsNullable: r.Null == "Y",
+	}
+	if r.Default.Valid {
+		details.Default = String(r.Default.String)
+	}
+	if r.Comment.Valid {
+		details.Comment = String(r.Comment.String)
+	}
+	if r.PolicyName.Valid {
+		details.PolicyName = String(r.PolicyName.String)
+	}
+	return details
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,121 @@
+package sdk
+
+var (
+	_ validatable = new(CreateIcebergTableOptions)
+	_ validatable = new(CreateWithExternalCatalogIcebergTableOptions)
+	_ validatable = new(AlterIcebergTableOptions)
+	_ validatable = new(DropIcebergTableOptions)
+	_ validatable = new(ShowIcebergTableOptions)
+	_ validatable = new(DescribeIcebergTableOptions)
+)
+
+func (opts *CreateIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.Columns) {
+		errs = append(errs, errNotSet("CreateIcebergTableOptions", "Columns"))
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateIcebergTableOptions: additional validations
+	// custom:end CreateIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithExternalCatalogIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithExternalCatalogIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if !exactlyOneValueSet(opts.CatalogTableName, opts.MetadataFilePath, opts.BaseLocation) {
+		errs = append(errs, errExactlyOneOf("CreateWithExternalCatalogIcebergTableOptions", "CatalogTableName", "MetadataFilePath", "BaseLocation"))
+	}
+	// custom:begin CreateWithExternalCatalogIcebergTableOptions: additional validations
+	// custom:end CreateWithExternalCatalogIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.ConvertToManaged, opts.AddColumn, opts.RenameColumn, opts.AlterColumn, opts.DropColumns, opts.ClusterBy, opts.DropClusteringKey, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("AlterIcebergTableOptions", "Refresh", "ConvertToManaged", "AddColumn", "RenameColumn", "AlterColumn", "DropColumns", "ClusterBy", "DropClusteringKey", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo"))
+	}
+	if valueSet(opts.AlterColumn) {
+		if !exactlyOneValueSet(opts.AlterColumn.SetDataType, opts.AlterColumn.Comment, opts.AlterColumn.UnsetComment, opts.AlterColumn.SetNotNull, opts.AlterColumn.DropNotNull) {
+			errs = append(errs, errExactlyOneOf("AlterIcebergTableOptions.AlterColumn", "SetDataType", "Comment", "UnsetComment", "SetNotNull", "DropNotNull"))
+		}
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.ChangeTracking, opts.Set.DefaultDdlCollation, opts.Set.AutoRefresh, opts.Set.ReplaceInvalidCharacters, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "AutoRefresh", "ReplaceInvalidCharacters", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.ChangeTracking, opts.Unset.DefaultDdlCollation, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterIcebergTableOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDdlCollation", "Comment"))
+		}
+	}
+	// custom:begin AlterIcebergTableOptions: additional validations
+	// custom:end AlterIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropIcebergTableOptions: additional validations
+	// custom:end DropIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowIcebergTableOptions: additional validations
+	// custom:end ShowIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeIcebergTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeIcebergTableOptions: additional validations
+	// custom:end DescribeIcebergTableOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -104,6 +104,10 @@ func (i ExternalObjectIdentifier) Name() string {
 	return i.objectIdentifier.Name()
 }
 
+func (i ExternalObjectIdentifier) AccountIdentifier() AccountIdentifier {
+	return i.accountIdentifier
+}
+
 func (i ExternalObjectIdentifier) FullyQualifiedName() string {
 	return fmt.Sprintf(`%v.%v`, i.accountIdentifier.FullyQualifiedName(), i.objectIdentifier.FullyQualifiedName())
 }
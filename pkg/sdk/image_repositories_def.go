@@ -0,0 +1,87 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var ImageRepositoriesDef = g.NewInterface(
+	"ImageRepositories",
+	"ImageRepository",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-image-repository",
+		g.NewQueryStruct("CreateImageRepository").
+			Create().
+			OrReplace().
+			SQL("IMAGE REPOSITORY").
+			IfNotExists().
+			Name().
+			OptionalTags().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-image-repository",
+		g.NewQueryStruct("AlterImageRepository").
+			Alter().
+			SQL("IMAGE REPOSITORY").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ImageRepositorySet").
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ImageRepositoryUnset").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-image-repository",
+		g.NewQueryStruct("DropImageRepository").
+			Drop().
+			SQL("IMAGE REPOSITORY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-image-repositories",
+		g.DbStruct("imageRepositoriesRow").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("repository_url").
+			Text("owner").
+			OptionalText("owner_role_type").
+			OptionalText("comment"),
+		g.PlainStruct("ImageRepository").
+			DeriveMapping().
+			Time("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("RepositoryUrl").
+			Text("Owner").
+			OptionalText("OwnerRoleType").
+			OptionalText("Comment"),
+		g.NewQueryStruct("ShowImageRepositories").
+			Show().
+			SQL("IMAGE REPOSITORIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation()
@@ -0,0 +1,45 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateImageRepositoryOptions] = new(CreateImageRepositoryRequest)
+	_ optionsProvider[AlterImageRepositoryOptions]  = new(AlterImageRepositoryRequest)
+	_ optionsProvider[DropImageRepositoryOptions]   = new(DropImageRepositoryRequest)
+	_ optionsProvider[ShowImageRepositoryOptions]   = new(ShowImageRepositoryRequest)
+)
+
+type CreateImageRepositoryRequest struct {
+	OrReplace   *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Tag         []TagAssociation
+	Comment     *string
+}
+
+type AlterImageRepositoryRequest struct {
+	IfExists  *bool
+	name      SchemaObjectIdentifier       `validate:"validIdentifier"` // required
+	Set       *ImageRepositorySetRequest   `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	Unset     *ImageRepositoryUnsetRequest `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	SetTags   []TagAssociation             `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+	UnsetTags []ObjectIdentifier           `validate:"exactlyOneValueSet=Set|Unset|SetTags|UnsetTags"`
+}
+
+type ImageRepositorySetRequest struct {
+	Comment *string `validate:"atLeastOneValueSet=Comment"`
+}
+
+type ImageRepositoryUnsetRequest struct {
+	Comment *bool `validate:"atLeastOneValueSet=Comment"`
+}
+
+type DropImageRepositoryRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowImageRepositoryRequest struct {
+	Like *Like
+	In   *In
+}
@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type ImageRepositories interface {
+	Create(ctx context.Context, request *CreateImageRepositoryRequest) error
+	Alter(ctx context.Context, request *AlterImageRepositoryRequest) error
+	Drop(ctx context.Context, request *DropImageRepositoryRequest) error
+	Show(ctx context.Context, request *ShowImageRepositoryRequest) ([]ImageRepository, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error)
+}
+
+// CreateImageRepositoryOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-image-repository.
+type CreateImageRepositoryOptions struct {
+	create          bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace       *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	imageRepository bool                   `ddl:"static" sql:"IMAGE REPOSITORY"`
+	IfNotExists     *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+	Tag             []TagAssociation       `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment         *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterImageRepositoryOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-image-repository.
+type AlterImageRepositoryOptions struct {
+	alter           bool                   `ddl:"static" sql:"ALTER"`
+	imageRepository bool                   `ddl:"static" sql:"IMAGE REPOSITORY"`
+	IfExists        *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+	Set             *ImageRepositorySet    `ddl:"keyword" sql:"SET"`
+	Unset           *ImageRepositoryUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags         []TagAssociation       `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags       []ObjectIdentifier     `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type ImageRepositorySet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ImageRepositoryUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropImageRepositoryOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-image-repository.
+type DropImageRepositoryOptions struct {
+	drop            bool                   `ddl:"static" sql:"DROP"`
+	imageRepository bool                   `ddl:"static" sql:"IMAGE REPOSITORY"`
+	IfExists        *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowImageRepositoryOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-image-repositories.
+type ShowImageRepositoryOptions struct {
+	show              bool  `ddl:"static" sql:"SHOW"`
+	imageRepositories bool  `ddl:"static" sql:"IMAGE REPOSITORIES"`
+	Like              *Like `ddl:"keyword" sql:"LIKE"`
+	In                *In   `ddl:"keyword" sql:"IN"`
+}
+
+type imageRepositoriesRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	RepositoryUrl string         `db:"repository_url"`
+	Owner         string         `db:"owner"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+	Comment       sql.NullString `db:"comment"`
+}
+
+type ImageRepository struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	RepositoryUrl string
+	Owner         string
+	OwnerRoleType *string
+	Comment       *string
+}
+
+// custom:begin additional
+func (v *ImageRepository) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// custom:end additional
@@ -0,0 +1,232 @@
+package sdk
+
+import "testing"
+
+func TestImageRepositories_Create(t *testing.T) {
+	// custom:begin CreateImageRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateImageRepositoryOptions
+	defaultOpts := func() *CreateImageRepositoryOptions {
+		return &CreateImageRepositoryOptions{
+			name: id,
+		}
+	}
+	// custom:end CreateImageRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateImageRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateImageRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateImageRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateImageRepositoryOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateImageRepositoryOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateImageRepositoryOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateImageRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE IMAGE REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end CreateImageRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateImageRepositoryOptions: all options
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE IMAGE REPOSITORY %s TAG (%s = 'v1') COMMENT = 'some comment'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateImageRepositoryOptions: all options
+	})
+
+	// custom:begin CreateImageRepositoryOptions: additional test cases
+	t.Run("if not exists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE IMAGE REPOSITORY IF NOT EXISTS %s", id.FullyQualifiedName())
+	})
+	// custom:end CreateImageRepositoryOptions: additional test cases
+}
+
+func TestImageRepositories_Alter(t *testing.T) {
+	// custom:begin AlterImageRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterImageRepositoryOptions
+	defaultOpts := func() *AlterImageRepositoryOptions {
+		return &AlterImageRepositoryOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterImageRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterImageRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &ImageRepositoryUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterImageRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterImageRepositoryOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+
+		opts.Set = &ImageRepositorySet{Comment: String("some comment")}
+		opts.Unset = &ImageRepositoryUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterImageRepositoryOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+		// custom:end AlterImageRepositoryOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &ImageRepositorySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterImageRepositoryOptions.Set", "Comment"))
+		// custom:end AlterImageRepositoryOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &ImageRepositoryUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterImageRepositoryOptions.Unset", "Comment"))
+		// custom:end AlterImageRepositoryOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions: basic
+		opts := defaultOpts()
+		opts.Set = &ImageRepositorySet{Comment: String("some comment")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER IMAGE REPOSITORY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterImageRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterImageRepositoryOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Unset = &ImageRepositoryUnset{Comment: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER IMAGE REPOSITORY IF EXISTS %s UNSET COMMENT", id.FullyQualifiedName())
+		// custom:end AlterImageRepositoryOptions: all options
+	})
+
+	// custom:begin AlterImageRepositoryOptions: additional test cases
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER IMAGE REPOSITORY %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER IMAGE REPOSITORY %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+	// custom:end AlterImageRepositoryOptions: additional test cases
+}
+
+func TestImageRepositories_Drop(t *testing.T) {
+	// custom:begin DropImageRepositoryOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropImageRepositoryOptions
+	defaultOpts := func() *DropImageRepositoryOptions {
+		return &DropImageRepositoryOptions{
+			name: id,
+		}
+	}
+	// custom:end DropImageRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropImageRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropImageRepositoryOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropImageRepositoryOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropImageRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP IMAGE REPOSITORY %s", id.FullyQualifiedName())
+		// custom:end DropImageRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropImageRepositoryOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP IMAGE REPOSITORY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropImageRepositoryOptions: all options
+	})
+
+	// custom:begin DropImageRepositoryOptions: additional test cases
+	// custom:end DropImageRepositoryOptions: additional test cases
+}
+
+func TestImageRepositories_Show(t *testing.T) {
+	// custom:begin ShowImageRepositoryOptions: default options
+	// Minimal valid ShowImageRepositoryOptions
+	defaultOpts := func() *ShowImageRepositoryOptions {
+		return &ShowImageRepositoryOptions{}
+	}
+	// custom:end ShowImageRepositoryOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowImageRepositoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowImageRepositoryOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW IMAGE REPOSITORIES")
+		// custom:end ShowImageRepositoryOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowImageRepositoryOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW IMAGE REPOSITORIES LIKE 'some pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+		// custom:end ShowImageRepositoryOptions: all options
+	})
+
+	// custom:begin ShowImageRepositoryOptions: additional test cases
+	// custom:end ShowImageRepositoryOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ ImageRepositories = (*imageRepositories)(nil)
+
+type imageRepositories struct {
+	client *Client
+}
+
+func (v *imageRepositories) Create(ctx context.Context, request *CreateImageRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *imageRepositories) Alter(ctx context.Context, request *AlterImageRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *imageRepositories) Drop(ctx context.Context, request *DropImageRepositoryRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *imageRepositories) Show(ctx context.Context, request *ShowImageRepositoryRequest) ([]ImageRepository, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[imageRepositoriesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[imageRepositoriesRow, ImageRepository](dbRows)
+	return resultList, nil
+}
+
+func (v *imageRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error) {
+	// custom:begin ShowByID
+	imageRepositories, err := v.Show(ctx, NewShowImageRepositoryRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(imageRepositories, func(r ImageRepository) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (r *CreateImageRepositoryRequest) toOpts() *CreateImageRepositoryOptions {
+	opts := &CreateImageRepositoryOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Tag:         r.Tag,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterImageRepositoryRequest) toOpts() *AlterImageRepositoryOptions {
+	opts := &AlterImageRepositoryOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ImageRepositorySet{
+			Comment: r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ImageRepositoryUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropImageRepositoryRequest) toOpts() *DropImageRepositoryOptions {
+	opts := &DropImageRepositoryOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowImageRepositoryRequest) toOpts() *ShowImageRepositoryOptions {
+	opts := &ShowImageRepositoryOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r imageRepositoriesRow) convert() *ImageRepository {
+	imageRepository := ImageRepository{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		RepositoryUrl: r.RepositoryUrl,
+		Owner:         r.Owner,
+	}
+	if r.OwnerRoleType.Valid {
+		imageRepository.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	if r.Comment.Valid {
+		imageRepository.Comment = String(r.Comment.String)
+	}
+	return &imageRepository
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,76 @@
+package sdk
+
+var (
+	_ validatable = new(CreateImageRepositoryOptions)
+	_ validatable = new(AlterImageRepositoryOptions)
+	_ validatable = new(DropImageRepositoryOptions)
+	_ validatable = new(ShowImageRepositoryOptions)
+)
+
+func (opts *CreateImageRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateImageRepositoryOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateImageRepositoryOptions: additional validations
+	// custom:end CreateImageRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterImageRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterImageRepositoryOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterImageRepositoryOptions.Set", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterImageRepositoryOptions.Unset", "Comment"))
+		}
+	}
+	// custom:begin AlterImageRepositoryOptions: additional validations
+	// custom:end AlterImageRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropImageRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropImageRepositoryOptions: additional validations
+	// custom:end DropImageRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowImageRepositoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowImageRepositoryOptions: additional validations
+	// custom:end ShowImageRepositoryOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,134 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type PackagesPolicyLanguage string
+
+const (
+	PackagesPolicyLanguagePython PackagesPolicyLanguage = "PYTHON"
+)
+
+var AllPackagesPolicyLanguages = []PackagesPolicyLanguage{
+	PackagesPolicyLanguagePython,
+}
+
+var packagesPolicyPackageDef = g.NewQueryStruct("PackagesPolicyPackage").
+	Text("Spec", g.KeywordOptions().SingleQuotes().Required())
+
+var PackagesPoliciesDef = g.NewInterface(
+	"PackagesPolicies",
+	"PackagesPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-packages-policy",
+		g.NewQueryStruct("CreatePackagesPolicy").
+			Create().
+			OrReplace().
+			SQL("PACKAGES POLICY").
+			IfNotExists().
+			Name().
+			PredefinedQueryStructField("Language", "PackagesPolicyLanguage", g.ParameterOptions().NoEquals().SQL("LANGUAGE").Required()).
+			ListAssignment("ALLOWLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+			ListAssignment("BLOCKLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+			ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		packagesPolicyPackageDef,
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-packages-policy",
+		g.NewQueryStruct("AlterPackagesPolicy").
+			Alter().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("PackagesPolicySet").
+					ListAssignment("ALLOWLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+					ListAssignment("BLOCKLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+					ListAssignment("ADDITIONAL_CREATION_BLOCKLIST", "PackagesPolicyPackage", g.ParameterOptions().Parentheses()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("PackagesPolicyUnset").
+					OptionalSQL("ALLOWLIST").
+					OptionalSQL("BLOCKLIST").
+					OptionalSQL("ADDITIONAL_CREATION_BLOCKLIST").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-packages-policy",
+		g.NewQueryStruct("DropPackagesPolicy").
+			Drop().
+			SQL("PACKAGES POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies",
+		g.DbStruct("showPackagesPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			Text("comment").
+			Text("options").
+			Text("owner_role_type"),
+		g.PlainStruct("PackagesPolicy").
+			DeriveMapping().
+			Text("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Kind").
+			Text("Owner").
+			Text("Comment").
+			Text("Options").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowPackagesPolicies").
+			Show().
+			SQL("PACKAGES POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-packages-policy",
+		g.DbStruct("describePackagesPolicyDBRow").
+			Text("name").
+			Text("language").
+			Text("allowlist").
+			Text("blocklist").
+			Text("additional_creation_blocklist").
+			Text("comment"),
+		g.PlainStruct("PackagesPolicyDescription").
+			DeriveMapping().
+			Text("Name").
+			Text("Language").
+			Text("Allowlist").
+			Text("Blocklist").
+			Text("AdditionalCreationBlocklist").
+			Text("Comment"),
+		g.NewQueryStruct("DescribePackagesPolicy").
+			Describe().
+			SQL("PACKAGES POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,57 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreatePackagesPolicyOptions]   = new(CreatePackagesPolicyRequest)
+	_ optionsProvider[AlterPackagesPolicyOptions]    = new(AlterPackagesPolicyRequest)
+	_ optionsProvider[DropPackagesPolicyOptions]     = new(DropPackagesPolicyRequest)
+	_ optionsProvider[ShowPackagesPolicyOptions]     = new(ShowPackagesPolicyRequest)
+	_ optionsProvider[DescribePackagesPolicyOptions] = new(DescribePackagesPolicyRequest)
+)
+
+type CreatePackagesPolicyRequest struct {
+	OrReplace                   *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                 *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                        SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Language                    PackagesPolicyLanguage // required
+	Allowlist                   []PackagesPolicyPackage
+	Blocklist                   []PackagesPolicyPackage
+	AdditionalCreationBlocklist []PackagesPolicyPackage
+	Comment                     *string
+}
+
+type AlterPackagesPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier      `validate:"validIdentifier"` // required
+	Set      *PackagesPolicySetRequest   `validate:"exactlyOneValueSet=Set|Unset"`
+	Unset    *PackagesPolicyUnsetRequest `validate:"exactlyOneValueSet=Set|Unset"`
+}
+
+type PackagesPolicySetRequest struct {
+	Allowlist                   []PackagesPolicyPackage `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	Blocklist                   []PackagesPolicyPackage `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	AdditionalCreationBlocklist []PackagesPolicyPackage `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	Comment                     *string                 `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+}
+
+type PackagesPolicyUnsetRequest struct {
+	Allowlist                   *bool `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	Blocklist                   *bool `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	AdditionalCreationBlocklist *bool `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+	Comment                     *bool `validate:"atLeastOneValueSet=Allowlist|Blocklist|AdditionalCreationBlocklist|Comment"`
+}
+
+type DropPackagesPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowPackagesPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribePackagesPolicyRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,269 @@
+package sdk
+
+import "testing"
+
+func TestPackagesPolicies_Create(t *testing.T) {
+	// custom:begin CreatePackagesPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreatePackagesPolicyOptions
+	defaultOpts := func() *CreatePackagesPolicyOptions {
+		return &CreatePackagesPolicyOptions{
+			name:     id,
+			Language: PackagesPolicyLanguagePython,
+		}
+	}
+	// custom:end CreatePackagesPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreatePackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreatePackagesPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreatePackagesPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreatePackagesPolicyOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreatePackagesPolicyOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreatePackagesPolicyOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreatePackagesPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE PACKAGES POLICY %s LANGUAGE PYTHON", id.FullyQualifiedName())
+		// custom:end CreatePackagesPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreatePackagesPolicyOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Allowlist = []PackagesPolicyPackage{{Spec: "numpy"}, {Spec: "pandas==1.2.3"}}
+		opts.Blocklist = []PackagesPolicyPackage{{Spec: "requests"}}
+		opts.AdditionalCreationBlocklist = []PackagesPolicyPackage{{Spec: "scipy>=1.1"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE PACKAGES POLICY %s LANGUAGE PYTHON ALLOWLIST = ('numpy', 'pandas==1.2.3') BLOCKLIST = ('requests') ADDITIONAL_CREATION_BLOCKLIST = ('scipy>=1.1') COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreatePackagesPolicyOptions: all options
+	})
+
+	// custom:begin CreatePackagesPolicyOptions: additional test cases
+	// custom:end CreatePackagesPolicyOptions: additional test cases
+}
+
+func TestPackagesPolicies_Alter(t *testing.T) {
+	// custom:begin AlterPackagesPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterPackagesPolicyOptions
+	defaultOpts := func() *AlterPackagesPolicyOptions {
+		return &AlterPackagesPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterPackagesPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &PackagesPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterPackagesPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset] should be present", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterPackagesPolicyOptions", "Set", "Unset"))
+		// custom:end AlterPackagesPolicyOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Allowlist opts.Set.Blocklist opts.Set.AdditionalCreationBlocklist opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &PackagesPolicySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterPackagesPolicyOptions.Set", "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		// custom:end AlterPackagesPolicyOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Allowlist opts.Unset.Blocklist opts.Unset.AdditionalCreationBlocklist opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &PackagesPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterPackagesPolicyOptions.Unset", "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		// custom:end AlterPackagesPolicyOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions: basic
+		opts := defaultOpts()
+		opts.Set = &PackagesPolicySet{Comment: String("some comment")}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PACKAGES POLICY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterPackagesPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterPackagesPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &PackagesPolicySet{
+			Allowlist:                   []PackagesPolicyPackage{{Spec: "numpy"}},
+			Blocklist:                   []PackagesPolicyPackage{{Spec: "requests"}, {Spec: "scipy"}},
+			AdditionalCreationBlocklist: []PackagesPolicyPackage{{Spec: "pandas"}},
+			Comment:                     String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PACKAGES POLICY IF EXISTS %s SET ALLOWLIST = ('numpy') BLOCKLIST = ('requests', 'scipy') ADDITIONAL_CREATION_BLOCKLIST = ('pandas') COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterPackagesPolicyOptions: all options
+	})
+
+	// custom:begin AlterPackagesPolicyOptions: additional test cases
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &PackagesPolicyUnset{
+			Allowlist:                   Bool(true),
+			Blocklist:                   Bool(true),
+			AdditionalCreationBlocklist: Bool(true),
+			Comment:                     Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PACKAGES POLICY %s UNSET ALLOWLIST, BLOCKLIST, ADDITIONAL_CREATION_BLOCKLIST, COMMENT", id.FullyQualifiedName())
+	})
+	// custom:end AlterPackagesPolicyOptions: additional test cases
+}
+
+func TestPackagesPolicies_Drop(t *testing.T) {
+	// custom:begin DropPackagesPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropPackagesPolicyOptions
+	defaultOpts := func() *DropPackagesPolicyOptions {
+		return &DropPackagesPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DropPackagesPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropPackagesPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropPackagesPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropPackagesPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP PACKAGES POLICY %s", id.FullyQualifiedName())
+		// custom:end DropPackagesPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropPackagesPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP PACKAGES POLICY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropPackagesPolicyOptions: all options
+	})
+
+	// custom:begin DropPackagesPolicyOptions: additional test cases
+	// custom:end DropPackagesPolicyOptions: additional test cases
+}
+
+func TestPackagesPolicies_Show(t *testing.T) {
+	// custom:begin ShowPackagesPolicyOptions: default options
+	// Minimal valid ShowPackagesPolicyOptions
+	defaultOpts := func() *ShowPackagesPolicyOptions {
+		return &ShowPackagesPolicyOptions{}
+	}
+	// custom:end ShowPackagesPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowPackagesPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PACKAGES POLICIES")
+		// custom:end ShowPackagesPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowPackagesPolicyOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: NewDatabaseObjectIdentifier("db", "schema")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW PACKAGES POLICIES LIKE 'pattern' IN SCHEMA "db"."schema"`)
+		// custom:end ShowPackagesPolicyOptions: all options
+	})
+
+	// custom:begin ShowPackagesPolicyOptions: additional test cases
+	// custom:end ShowPackagesPolicyOptions: additional test cases
+}
+
+func TestPackagesPolicies_Describe(t *testing.T) {
+	// custom:begin DescribePackagesPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribePackagesPolicyOptions
+	defaultOpts := func() *DescribePackagesPolicyOptions {
+		return &DescribePackagesPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribePackagesPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribePackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribePackagesPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribePackagesPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribePackagesPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PACKAGES POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribePackagesPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribePackagesPolicyOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PACKAGES POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribePackagesPolicyOptions: all options
+	})
+
+	// custom:begin DescribePackagesPolicyOptions: additional test cases
+	// custom:end DescribePackagesPolicyOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ PackagesPolicies = (*packagesPolicies)(nil)
+
+type packagesPolicies struct {
+	client *Client
+}
+
+func (v *packagesPolicies) Create(ctx context.Context, request *CreatePackagesPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Alter(ctx context.Context, request *AlterPackagesPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Drop(ctx context.Context, request *DropPackagesPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Show(ctx context.Context, request *ShowPackagesPolicyRequest) ([]PackagesPolicy, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showPackagesPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showPackagesPolicyDBRow, PackagesPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *packagesPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicy, error) {
+	// custom:begin ShowByID
+	packagesPolicies, err := v.Show(ctx, NewShowPackagesPolicyRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(packagesPolicies, func(r PackagesPolicy) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *packagesPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicyDescription, error) {
+	opts := &DescribePackagesPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describePackagesPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreatePackagesPolicyRequest) toOpts() *CreatePackagesPolicyOptions {
+	opts := &CreatePackagesPolicyOptions{
+		OrReplace:                   r.OrReplace,
+		IfNotExists:                 r.IfNotExists,
+		name:                        r.name,
+		Language:                    r.Language,
+		Allowlist:                   r.Allowlist,
+		Blocklist:                   r.Blocklist,
+		AdditionalCreationBlocklist: r.AdditionalCreationBlocklist,
+		Comment:                     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterPackagesPolicyRequest) toOpts() *AlterPackagesPolicyOptions {
+	opts := &AlterPackagesPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &PackagesPolicySet{
+			Allowlist:                   r.Set.Allowlist,
+			Blocklist:                   r.Set.Blocklist,
+			AdditionalCreationBlocklist: r.Set.AdditionalCreationBlocklist,
+			Comment:                     r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &PackagesPolicyUnset{
+			Allowlist:                   r.Unset.Allowlist,
+			Blocklist:                   r.Unset.Blocklist,
+			AdditionalCreationBlocklist: r.Unset.AdditionalCreationBlocklist,
+			Comment:                     r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropPackagesPolicyRequest) toOpts() *DropPackagesPolicyOptions {
+	opts := &DropPackagesPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowPackagesPolicyRequest) toOpts() *ShowPackagesPolicyOptions {
+	opts := &ShowPackagesPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showPackagesPolicyDBRow) convert() *PackagesPolicy {
+	packagesPolicy := PackagesPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Comment:       r.Comment,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	return &packagesPolicy
+}
+
+func (r *DescribePackagesPolicyRequest) toOpts() *DescribePackagesPolicyOptions {
+	opts := &DescribePackagesPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describePackagesPolicyDBRow) convert() *PackagesPolicyDescription {
+	packagesPolicyDescription := PackagesPolicyDescription{
+		Name:                        r.Name,
+		Language:                    r.Language,
+		Allowlist:                   r.Allowlist,
+		Blocklist:                   r.Blocklist,
+		AdditionalCreationBlocklist: r.AdditionalCreationBlocklist,
+		Comment:                     r.Comment,
+	}
+	return &packagesPolicyDescription
+}
+
+// custom:begin additional
+// custom:end additional
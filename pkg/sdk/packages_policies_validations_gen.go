@@ -0,0 +1,90 @@
+package sdk
+
+var (
+	_ validatable = new(CreatePackagesPolicyOptions)
+	_ validatable = new(AlterPackagesPolicyOptions)
+	_ validatable = new(DropPackagesPolicyOptions)
+	_ validatable = new(ShowPackagesPolicyOptions)
+	_ validatable = new(DescribePackagesPolicyOptions)
+)
+
+func (opts *CreatePackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreatePackagesPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreatePackagesPolicyOptions: additional validations
+	// custom:end CreatePackagesPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterPackagesPolicyOptions", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Allowlist, opts.Set.Blocklist, opts.Set.AdditionalCreationBlocklist, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterPackagesPolicyOptions.Set", "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Allowlist, opts.Unset.Blocklist, opts.Unset.AdditionalCreationBlocklist, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterPackagesPolicyOptions.Unset", "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		}
+	}
+	// custom:begin AlterPackagesPolicyOptions: additional validations
+	// custom:end AlterPackagesPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropPackagesPolicyOptions: additional validations
+	// custom:end DropPackagesPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowPackagesPolicyOptions: additional validations
+	// custom:end ShowPackagesPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribePackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribePackagesPolicyOptions: additional validations
+	// custom:end DescribePackagesPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -225,6 +225,8 @@ func (parameters *parameters) SetObjectParameterOnAccount(ctx context.Context, p
 			return fmt.Errorf("MAX_DATA_EXTENSION_TIME_IN_DAYS session parameter is an integer, got %v", value)
 		}
 		opts.Set.Parameters.ObjectParameters.MaxDataExtensionTimeInDays = Pointer(v)
+	case ObjectParameterMetricLevel:
+		opts.Set.Parameters.ObjectParameters.MetricLevel = Pointer(MetricLevel(value))
 	case ObjectParameterPipeExecutionPaused:
 		b, err := parseBooleanParameter(string(parameter), value)
 		if err != nil {
@@ -398,6 +400,7 @@ const (
 	AccountParameterLogLevel                            AccountParameter = "LOG_LEVEL"
 	AccountParameterMaxConcurrencyLevel                 AccountParameter = "MAX_CONCURRENCY_LEVEL"
 	AccountParameterMaxDataExtensionTimeInDays          AccountParameter = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+	AccountParameterMetricLevel                         AccountParameter = "METRIC_LEVEL"
 	AccountParameterPipeExecutionPaused                 AccountParameter = "PIPE_EXECUTION_PAUSED"
 	AccountParameterStatementQueuedTimeoutInSeconds     AccountParameter = "STATEMENT_QUEUED_TIMEOUT_IN_SECONDS"
 	AccountParameterShareRestrictions                   AccountParameter = "SHARE_RESTRICTIONS"
@@ -460,9 +463,11 @@ const (
 	// Object Parameters
 	ObjectParameterDataRetentionTimeInDays             ObjectParameter = "DATA_RETENTION_TIME_IN_DAYS"
 	ObjectParameterDefaultDDLCollation                 ObjectParameter = "DEFAULT_DDL_COLLATION"
+	ObjectParameterEventTable                          ObjectParameter = "EVENT_TABLE" // also an account param
 	ObjectParameterLogLevel                            ObjectParameter = "LOG_LEVEL"
 	ObjectParameterMaxConcurrencyLevel                 ObjectParameter = "MAX_CONCURRENCY_LEVEL"
 	ObjectParameterMaxDataExtensionTimeInDays          ObjectParameter = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+	ObjectParameterMetricLevel                         ObjectParameter = "METRIC_LEVEL"
 	ObjectParameterPipeExecutionPaused                 ObjectParameter = "PIPE_EXECUTION_PAUSED"
 	ObjectParameterPreventUnloadToInternalStages       ObjectParameter = "PREVENT_UNLOAD_TO_INTERNAL_STAGES" // also an account param
 	ObjectParameterStatementQueuedTimeoutInSeconds     ObjectParameter = "STATEMENT_QUEUED_TIMEOUT_IN_SECONDS"
@@ -770,6 +775,7 @@ type ObjectParameters struct {
 	LogLevel                            *LogLevel      `ddl:"parameter" sql:"LOG_LEVEL"`
 	MaxConcurrencyLevel                 *int           `ddl:"parameter" sql:"MAX_CONCURRENCY_LEVEL"`
 	MaxDataExtensionTimeInDays          *int           `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	MetricLevel                         *MetricLevel   `ddl:"parameter" sql:"METRIC_LEVEL"`
 	PipeExecutionPaused                 *bool          `ddl:"parameter" sql:"PIPE_EXECUTION_PAUSED"`
 	PreventUnloadToInternalStages       *bool          `ddl:"parameter" sql:"PREVENT_UNLOAD_TO_INTERNAL_STAGES"`
 	StatementQueuedTimeoutInSeconds     *int           `ddl:"parameter" sql:"STATEMENT_QUEUED_TIMEOUT_IN_SECONDS"`
@@ -822,6 +828,7 @@ type ObjectParametersUnset struct {
 	LogLevel                            *bool `ddl:"keyword" sql:"LOG_LEVEL"`
 	MaxConcurrencyLevel                 *bool `ddl:"keyword" sql:"MAX_CONCURRENCY_LEVEL"`
 	MaxDataExtensionTimeInDays          *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	MetricLevel                         *bool `ddl:"keyword" sql:"METRIC_LEVEL"`
 	PipeExecutionPaused                 *bool `ddl:"keyword" sql:"PIPE_EXECUTION_PAUSED"`
 	PreventUnloadToInternalStages       *bool `ddl:"keyword" sql:"PREVENT_UNLOAD_TO_INTERNAL_STAGES"`
 	StatementQueuedTimeoutInSeconds     *bool `ddl:"keyword" sql:"STATEMENT_QUEUED_TIMEOUT_IN_SECONDS"`
@@ -863,19 +870,27 @@ func (opts *ShowParametersOptions) validate() error {
 }
 
 type ParametersIn struct {
-	Session   *bool                    `ddl:"keyword" sql:"SESSION"`
-	Account   *bool                    `ddl:"keyword" sql:"ACCOUNT"`
-	User      AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
-	Warehouse AccountObjectIdentifier  `ddl:"identifier" sql:"WAREHOUSE"`
-	Database  AccountObjectIdentifier  `ddl:"identifier" sql:"DATABASE"`
-	Schema    DatabaseObjectIdentifier `ddl:"identifier" sql:"SCHEMA"`
-	Task      SchemaObjectIdentifier   `ddl:"identifier" sql:"TASK"`
-	Table     SchemaObjectIdentifier   `ddl:"identifier" sql:"TABLE"`
+	Session   *bool                            `ddl:"keyword" sql:"SESSION"`
+	Account   *bool                            `ddl:"keyword" sql:"ACCOUNT"`
+	User      AccountObjectIdentifier          `ddl:"identifier" sql:"USER"`
+	Warehouse AccountObjectIdentifier          `ddl:"identifier" sql:"WAREHOUSE"`
+	Database  AccountObjectIdentifier          `ddl:"identifier" sql:"DATABASE"`
+	Schema    DatabaseObjectIdentifier         `ddl:"identifier" sql:"SCHEMA"`
+	Task      SchemaObjectIdentifier           `ddl:"identifier" sql:"TASK"`
+	Table     SchemaObjectIdentifier           `ddl:"identifier" sql:"TABLE"`
+	Function  *ParametersInObjectWithArguments `ddl:"keyword" sql:"FUNCTION"`
+	Procedure *ParametersInObjectWithArguments `ddl:"keyword" sql:"PROCEDURE"`
+}
+
+// ParametersInObjectWithArguments identifies a function or a procedure; the argument data types are always rendered in parentheses.
+type ParametersInObjectWithArguments struct {
+	Name              SchemaObjectIdentifier `ddl:"identifier"`
+	ArgumentDataTypes []DataType             `ddl:"keyword,must_parentheses"`
 }
 
 func (v *ParametersIn) validate() error {
-	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table) {
-		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table"))
+	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table, v.Function, v.Procedure) {
+		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table", "Function", "Procedure"))
 	}
 	return nil
 }
@@ -1015,6 +1030,12 @@ func (v *parameters) ShowObjectParameter(ctx context.Context, parameter ObjectPa
 		opts.In.Table = object.Name.(SchemaObjectIdentifier)
 	case ObjectTypeUser:
 		opts.In.User = object.Name.(AccountObjectIdentifier)
+	case ObjectTypeFunction:
+		id := object.Name.(SchemaObjectIdentifier)
+		opts.In.Function = &ParametersInObjectWithArguments{Name: id.WithoutArguments(), ArgumentDataTypes: id.Arguments()}
+	case ObjectTypeProcedure:
+		id := object.Name.(SchemaObjectIdentifier)
+		opts.In.Procedure = &ParametersInObjectWithArguments{Name: id.WithoutArguments(), ArgumentDataTypes: id.Arguments()}
 	default:
 		return nil, fmt.Errorf("unsupported object type %s", object.Name)
 	}
@@ -51,3 +51,27 @@ func TestUnSetObjectParameterNetworkPolicyOnUser(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER USER "TEST_USER" UNSET NETWORK_POLICY`)
 	})
 }
+
+func TestShowParameters(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("validation: no in", func(t *testing.T) {
+		opts := &ShowParametersOptions{In: &ParametersIn{}}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table", "Function", "Procedure"))
+	})
+
+	t.Run("in function", func(t *testing.T) {
+		opts := &ShowParametersOptions{
+			Like: &Like{Pattern: String("LOG_LEVEL")},
+			In:   &ParametersIn{Function: &ParametersInObjectWithArguments{Name: id, ArgumentDataTypes: []DataType{DataTypeNumber, DataTypeVARCHAR}}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PARAMETERS LIKE 'LOG_LEVEL' IN FUNCTION %s (NUMBER, VARCHAR)", id.FullyQualifiedName())
+	})
+
+	t.Run("in procedure without arguments", func(t *testing.T) {
+		opts := &ShowParametersOptions{
+			In: &ParametersIn{Procedure: &ParametersInObjectWithArguments{Name: id}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PARAMETERS IN PROCEDURE %s ()", id.FullyQualifiedName())
+	})
+}
@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -15,3 +17,46 @@ func ParseTimestampWithOffset(s string, dateTimeFormat string) (string, error) {
 	adjustedTimeFormat := adjustedTime.Format(dateTimeFormat)
 	return adjustedTimeFormat, nil
 }
+
+// timestampLayouts are the layouts in which Snowflake returns timestamps as text (e.g. in DESCRIBE outputs)
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05.000 -0700",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+}
+
+// ParseTimestamp parses timestamp returned as text by Snowflake.
+func ParseTimestamp(s string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("could not parse timestamp %s", s)
+}
+
+// ParseCommaSeparatedStringArray parses list returned by Snowflake as text, e.g. "a, b" or "[a, b]" or `["a","b"]`.
+func ParseCommaSeparatedStringArray(value string) []string {
+	trimmed := strings.TrimSpace(strings.Trim(strings.TrimSpace(value), "[]"))
+	if trimmed == "" {
+		return make([]string, 0)
+	}
+	items := strings.Split(trimmed, ",")
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = strings.Trim(strings.TrimSpace(item), `"'`)
+	}
+	return result
+}
+
+// ParseCommaSeparatedAccountIdentifierArray parses list of accounts returned by Snowflake as text, e.g. "ORG.ACCOUNT1, ORG.ACCOUNT2".
+func ParseCommaSeparatedAccountIdentifierArray(value string) []AccountIdentifier {
+	items := ParseCommaSeparatedStringArray(value)
+	result := make([]AccountIdentifier, len(items))
+	for i, item := range items {
+		result[i] = NewAccountIdentifierFromFullyQualifiedName(item)
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	t.Run("RFC3339", func(t *testing.T) {
+		ts, err := ParseTimestamp("2024-04-30T05:49:13-07:00")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 4, 30, 12, 49, 13, 0, time.UTC), ts.UTC())
+	})
+
+	t.Run("with milliseconds and offset", func(t *testing.T) {
+		ts, err := ParseTimestamp("2024-04-30 05:49:13.431 -0700")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 4, 30, 12, 49, 13, 431000000, time.UTC), ts.UTC())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseTimestamp("yesterday")
+		require.ErrorContains(t, err, "could not parse timestamp yesterday")
+	})
+}
+
+func TestParseCommaSeparatedStringArray(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Value  string
+		Result []string
+	}{
+		{Name: "empty", Value: "", Result: []string{}},
+		{Name: "empty brackets", Value: "[]", Result: []string{}},
+		{Name: "one element", Value: "a", Result: []string{"a"}},
+		{Name: "multiple elements", Value: "a, b,c", Result: []string{"a", "b", "c"}},
+		{Name: "multiple elements in brackets", Value: "[a, b]", Result: []string{"a", "b"}},
+		{Name: "quoted elements", Value: `["a","b"]`, Result: []string{"a", "b"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Result, ParseCommaSeparatedStringArray(tc.Value))
+		})
+	}
+}
+
+func TestParseCommaSeparatedAccountIdentifierArray(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Value  string
+		Result []AccountIdentifier
+	}{
+		{Name: "empty", Value: "", Result: []AccountIdentifier{}},
+		{Name: "one element", Value: "ORG.ACCOUNT", Result: []AccountIdentifier{NewAccountIdentifier("ORG", "ACCOUNT")}},
+		{Name: "multiple elements", Value: "ORG.ACCOUNT1, ORG.ACCOUNT2", Result: []AccountIdentifier{NewAccountIdentifier("ORG", "ACCOUNT1"), NewAccountIdentifier("ORG", "ACCOUNT2")}},
+		{Name: "account locator", Value: "[AB12345]", Result: []AccountIdentifier{NewAccountIdentifierFromAccountLocator("AB12345")}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Result, ParseCommaSeparatedAccountIdentifierArray(tc.Value))
+		})
+	}
+}
@@ -2,6 +2,8 @@
 
 package example
 
+import ()
+
 func NewCreateDatabaseRoleRequest(
 	name DatabaseObjectIdentifier,
 ) *CreateDatabaseRoleRequest {
@@ -10,21 +12,78 @@ func NewCreateDatabaseRoleRequest(
 	return &s
 }
 
-func (s *CreateDatabaseRoleRequest) WithOrReplace(OrReplace *bool) *CreateDatabaseRoleRequest {
-	s.OrReplace = OrReplace
+func (s *CreateDatabaseRoleRequest) WithOrReplace(OrReplace bool) *CreateDatabaseRoleRequest {
+	s.OrReplace = &OrReplace
 	return s
 }
 
-func (s *CreateDatabaseRoleRequest) WithIfNotExists(IfNotExists *bool) *CreateDatabaseRoleRequest {
-	s.IfNotExists = IfNotExists
+func (s *CreateDatabaseRoleRequest) WithoutOrReplace() *CreateDatabaseRoleRequest {
+	s.OrReplace = nil
 	return s
 }
 
-func (s *CreateDatabaseRoleRequest) WithComment(Comment *string) *CreateDatabaseRoleRequest {
-	s.Comment = Comment
+func (s *CreateDatabaseRoleRequest) WithIfNotExists(IfNotExists bool) *CreateDatabaseRoleRequest {
+	s.IfNotExists = &IfNotExists
+	return s
+}
+
+func (s *CreateDatabaseRoleRequest) WithoutIfNotExists() *CreateDatabaseRoleRequest {
+	s.IfNotExists = nil
+	return s
+}
+
+func (s *CreateDatabaseRoleRequest) WithComment(Comment string) *CreateDatabaseRoleRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func (s *CreateDatabaseRoleRequest) WithoutComment() *CreateDatabaseRoleRequest {
+	s.Comment = nil
 	return s
 }
 
+type CreateDatabaseRoleRequestOption func(*CreateDatabaseRoleRequest)
+
+func NewCreateDatabaseRoleRequestWithOptions(
+	name DatabaseObjectIdentifier,
+	options ...CreateDatabaseRoleRequestOption,
+) *CreateDatabaseRoleRequest {
+	s := NewCreateDatabaseRoleRequest(name)
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+func CreateDatabaseRoleRequestWithOrReplace(OrReplace bool) CreateDatabaseRoleRequestOption {
+	return func(s *CreateDatabaseRoleRequest) {
+		s.WithOrReplace(OrReplace)
+	}
+}
+
+func CreateDatabaseRoleRequestWithIfNotExists(IfNotExists bool) CreateDatabaseRoleRequestOption {
+	return func(s *CreateDatabaseRoleRequest) {
+		s.WithIfNotExists(IfNotExists)
+	}
+}
+
+func CreateDatabaseRoleRequestWithComment(Comment string) CreateDatabaseRoleRequestOption {
+	return func(s *CreateDatabaseRoleRequest) {
+		s.WithComment(Comment)
+	}
+}
+
+func (s *CreateDatabaseRoleRequest) Validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(s.name) {
+		errs = append(errs, errInvalidIdentifier("CreateDatabaseRoleRequest", "name"))
+	}
+	if moreThanOneValueSet(s.OrReplace, s.IfNotExists) {
+		errs = append(errs, errOneOf("CreateDatabaseRoleRequest", "OrReplace", "IfNotExists"))
+	}
+	return JoinErrors(errs...)
+}
+
 func NewAlterDatabaseRoleRequest(
 	name DatabaseObjectIdentifier,
 ) *AlterDatabaseRoleRequest {
@@ -33,26 +92,109 @@ func NewAlterDatabaseRoleRequest(
 	return &s
 }
 
-func (s *AlterDatabaseRoleRequest) WithIfExists(IfExists *bool) *AlterDatabaseRoleRequest {
-	s.IfExists = IfExists
+func (s *AlterDatabaseRoleRequest) WithIfExists(IfExists bool) *AlterDatabaseRoleRequest {
+	s.IfExists = &IfExists
+	return s
+}
+
+func (s *AlterDatabaseRoleRequest) WithoutIfExists() *AlterDatabaseRoleRequest {
+	s.IfExists = nil
+	return s
+}
+
+func (s *AlterDatabaseRoleRequest) WithRename(Rename DatabaseRoleRenameRequest) *AlterDatabaseRoleRequest {
+	s.Rename = &Rename
 	return s
 }
 
-func (s *AlterDatabaseRoleRequest) WithRename(Rename *DatabaseRoleRenameRequest) *AlterDatabaseRoleRequest {
-	s.Rename = Rename
+func (s *AlterDatabaseRoleRequest) WithoutRename() *AlterDatabaseRoleRequest {
+	s.Rename = nil
 	return s
 }
 
-func (s *AlterDatabaseRoleRequest) WithSet(Set *DatabaseRoleSetRequest) *AlterDatabaseRoleRequest {
-	s.Set = Set
+func (s *AlterDatabaseRoleRequest) WithSet(Set DatabaseRoleSetRequest) *AlterDatabaseRoleRequest {
+	s.Set = &Set
 	return s
 }
 
-func (s *AlterDatabaseRoleRequest) WithUnset(Unset *DatabaseRoleUnsetRequest) *AlterDatabaseRoleRequest {
-	s.Unset = Unset
+func (s *AlterDatabaseRoleRequest) WithoutSet() *AlterDatabaseRoleRequest {
+	s.Set = nil
+	return s
+}
+
+func (s *AlterDatabaseRoleRequest) WithUnset(Unset DatabaseRoleUnsetRequest) *AlterDatabaseRoleRequest {
+	s.Unset = &Unset
+	return s
+}
+
+func (s *AlterDatabaseRoleRequest) WithoutUnset() *AlterDatabaseRoleRequest {
+	s.Unset = nil
+	return s
+}
+
+type AlterDatabaseRoleRequestOption func(*AlterDatabaseRoleRequest)
+
+func NewAlterDatabaseRoleRequestWithOptions(
+	name DatabaseObjectIdentifier,
+	options ...AlterDatabaseRoleRequestOption,
+) *AlterDatabaseRoleRequest {
+	s := NewAlterDatabaseRoleRequest(name)
+	for _, option := range options {
+		option(s)
+	}
 	return s
 }
 
+func AlterDatabaseRoleRequestWithIfExists(IfExists bool) AlterDatabaseRoleRequestOption {
+	return func(s *AlterDatabaseRoleRequest) {
+		s.WithIfExists(IfExists)
+	}
+}
+
+func AlterDatabaseRoleRequestWithRename(Rename DatabaseRoleRenameRequest) AlterDatabaseRoleRequestOption {
+	return func(s *AlterDatabaseRoleRequest) {
+		s.WithRename(Rename)
+	}
+}
+
+func AlterDatabaseRoleRequestWithSet(Set DatabaseRoleSetRequest) AlterDatabaseRoleRequestOption {
+	return func(s *AlterDatabaseRoleRequest) {
+		s.WithSet(Set)
+	}
+}
+
+func AlterDatabaseRoleRequestWithUnset(Unset DatabaseRoleUnsetRequest) AlterDatabaseRoleRequestOption {
+	return func(s *AlterDatabaseRoleRequest) {
+		s.WithUnset(Unset)
+	}
+}
+
+func (s *AlterDatabaseRoleRequest) Validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(s.name) {
+		errs = append(errs, errInvalidIdentifier("AlterDatabaseRoleRequest", "name"))
+	}
+	if !exactlyOneValueSet(s.Rename, s.Set, s.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterDatabaseRoleRequest", "Rename", "Set", "Unset"))
+	}
+	if s.Rename != nil {
+		if err := s.Rename.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if s.Set != nil {
+		if err := s.Set.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if s.Unset != nil {
+		if err := s.Unset.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return JoinErrors(errs...)
+}
+
 func NewDatabaseRoleRenameRequest(
 	Name DatabaseObjectIdentifier,
 ) *DatabaseRoleRenameRequest {
@@ -61,6 +203,14 @@ func NewDatabaseRoleRenameRequest(
 	return &s
 }
 
+func (s *DatabaseRoleRenameRequest) Validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(s.Name) {
+		errs = append(errs, errInvalidIdentifier("DatabaseRoleRenameRequest", "Name"))
+	}
+	return JoinErrors(errs...)
+}
+
 func NewDatabaseRoleSetRequest(
 	Comment string,
 ) *DatabaseRoleSetRequest {
@@ -69,11 +219,45 @@ func NewDatabaseRoleSetRequest(
 	return &s
 }
 
-func (s *DatabaseRoleSetRequest) WithNestedThirdLevel(NestedThirdLevel *NestedThirdLevelRequest) *DatabaseRoleSetRequest {
-	s.NestedThirdLevel = NestedThirdLevel
+func (s *DatabaseRoleSetRequest) WithNestedThirdLevel(NestedThirdLevel NestedThirdLevelRequest) *DatabaseRoleSetRequest {
+	s.NestedThirdLevel = &NestedThirdLevel
+	return s
+}
+
+func (s *DatabaseRoleSetRequest) WithoutNestedThirdLevel() *DatabaseRoleSetRequest {
+	s.NestedThirdLevel = nil
 	return s
 }
 
+type DatabaseRoleSetRequestOption func(*DatabaseRoleSetRequest)
+
+func NewDatabaseRoleSetRequestWithOptions(
+	Comment string,
+	options ...DatabaseRoleSetRequestOption,
+) *DatabaseRoleSetRequest {
+	s := NewDatabaseRoleSetRequest(Comment)
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+func DatabaseRoleSetRequestWithNestedThirdLevel(NestedThirdLevel NestedThirdLevelRequest) DatabaseRoleSetRequestOption {
+	return func(s *DatabaseRoleSetRequest) {
+		s.WithNestedThirdLevel(NestedThirdLevel)
+	}
+}
+
+func (s *DatabaseRoleSetRequest) Validate() error {
+	var errs []error
+	if s.NestedThirdLevel != nil {
+		if err := s.NestedThirdLevel.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return JoinErrors(errs...)
+}
+
 func NewNestedThirdLevelRequest(
 	Field DatabaseObjectIdentifier,
 ) *NestedThirdLevelRequest {
@@ -82,11 +266,50 @@ func NewNestedThirdLevelRequest(
 	return &s
 }
 
+func (s *NestedThirdLevelRequest) Validate() error {
+	var errs []error
+	if !anyValueSet(s.Field) {
+		errs = append(errs, errAtLeastOneOf("NestedThirdLevelRequest", "Field"))
+	}
+	return JoinErrors(errs...)
+}
+
 func NewDatabaseRoleUnsetRequest() *DatabaseRoleUnsetRequest {
 	return &DatabaseRoleUnsetRequest{}
 }
 
-func (s *DatabaseRoleUnsetRequest) WithComment(Comment *bool) *DatabaseRoleUnsetRequest {
-	s.Comment = Comment
+func (s *DatabaseRoleUnsetRequest) WithComment(Comment bool) *DatabaseRoleUnsetRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func (s *DatabaseRoleUnsetRequest) WithoutComment() *DatabaseRoleUnsetRequest {
+	s.Comment = nil
+	return s
+}
+
+type DatabaseRoleUnsetRequestOption func(*DatabaseRoleUnsetRequest)
+
+func NewDatabaseRoleUnsetRequestWithOptions(
+	options ...DatabaseRoleUnsetRequestOption,
+) *DatabaseRoleUnsetRequest {
+	s := NewDatabaseRoleUnsetRequest()
+	for _, option := range options {
+		option(s)
+	}
 	return s
 }
+
+func DatabaseRoleUnsetRequestWithComment(Comment bool) DatabaseRoleUnsetRequestOption {
+	return func(s *DatabaseRoleUnsetRequest) {
+		s.WithComment(Comment)
+	}
+}
+
+func (s *DatabaseRoleUnsetRequest) Validate() error {
+	var errs []error
+	if !anyValueSet(s.Comment) {
+		errs = append(errs, errAtLeastOneOf("DatabaseRoleUnsetRequest", "Comment"))
+	}
+	return JoinErrors(errs...)
+}
@@ -1,6 +1,6 @@
 package example
 
-//go:generate go run ./../../dto-builder-generator/main.go
+//go:generate go run ./dto-builder-generator/main.go
 
 var (
 	_ optionsProvider[CreateDatabaseRoleOptions] = new(CreateDatabaseRoleRequest)
@@ -8,22 +8,22 @@ var (
 )
 
 type CreateDatabaseRoleRequest struct {
-	OrReplace   *bool
-	IfNotExists *bool
-	name        DatabaseObjectIdentifier // required
+	OrReplace   *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                    `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        DatabaseObjectIdentifier `validate:"validIdentifier"` // required
 	Comment     *string
 }
 
 type AlterDatabaseRoleRequest struct {
 	IfExists *bool
-	name     DatabaseObjectIdentifier // required
-	Rename   *DatabaseRoleRenameRequest
-	Set      *DatabaseRoleSetRequest
-	Unset    *DatabaseRoleUnsetRequest
+	name     DatabaseObjectIdentifier   `validate:"validIdentifier"` // required
+	Rename   *DatabaseRoleRenameRequest `validate:"exactlyOneValueSet=Rename|Set|Unset"`
+	Set      *DatabaseRoleSetRequest    `validate:"exactlyOneValueSet=Rename|Set|Unset"`
+	Unset    *DatabaseRoleUnsetRequest  `validate:"exactlyOneValueSet=Rename|Set|Unset"`
 }
 
 type DatabaseRoleRenameRequest struct {
-	Name DatabaseObjectIdentifier // required
+	Name DatabaseObjectIdentifier `validate:"validIdentifier"` // required
 }
 
 type DatabaseRoleSetRequest struct {
@@ -32,9 +32,9 @@ type DatabaseRoleSetRequest struct {
 }
 
 type NestedThirdLevelRequest struct {
-	Field DatabaseObjectIdentifier // required
+	Field DatabaseObjectIdentifier `validate:"atLeastOneValueSet=Field"` // required
 }
 
 type DatabaseRoleUnsetRequest struct {
-	Comment *bool
+	Comment *bool `validate:"atLeastOneValueSet=Comment"`
 }
@@ -44,3 +44,6 @@ type NestedThirdLevel struct {
 type DatabaseRoleUnset struct {
 	Comment *bool `ddl:"keyword" sql:"COMMENT"`
 }
+
+// custom:begin additional
+// custom:end additional
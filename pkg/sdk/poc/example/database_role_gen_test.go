@@ -3,7 +3,8 @@ package example
 import "testing"
 
 func TestDatabaseRoles_Create(t *testing.T) {
-	id := RandomDatabaseObjectIdentifier(t)
+	// custom:begin CreateDatabaseRoleOptions: default options
+	id := randomDatabaseObjectIdentifier()
 
 	// Minimal valid CreateDatabaseRoleOptions
 	defaultOpts := func() *CreateDatabaseRoleOptions {
@@ -11,6 +12,7 @@ func TestDatabaseRoles_Create(t *testing.T) {
 			name: id,
 		}
 	}
+	// custom:end CreateDatabaseRoleOptions: default options
 
 	t.Run("validation: nil options", func(t *testing.T) {
 		var opts *CreateDatabaseRoleOptions = nil
@@ -18,32 +20,44 @@ func TestDatabaseRoles_Create(t *testing.T) {
 	})
 
 	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateDatabaseRoleOptions: validation (valid identifier)
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateDatabaseRoleOptions: validation (valid identifier)
 	})
 
 	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateDatabaseRoleOptions: validation (conflicting fields)
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateDatabaseRoleOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateDatabaseRoleOptions: validation (conflicting fields)
 	})
 
 	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateDatabaseRoleOptions: basic
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+		// custom:end CreateDatabaseRoleOptions: basic
 	})
 
 	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateDatabaseRoleOptions: all options
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+		// custom:end CreateDatabaseRoleOptions: all options
 	})
+
+	// custom:begin CreateDatabaseRoleOptions: additional test cases
+	// custom:end CreateDatabaseRoleOptions: additional test cases
 }
 
 func TestDatabaseRoles_Alter(t *testing.T) {
-	id := RandomDatabaseObjectIdentifier(t)
+	// custom:begin AlterDatabaseRoleOptions: default options
+	id := randomDatabaseObjectIdentifier()
 
 	// Minimal valid AlterDatabaseRoleOptions
 	defaultOpts := func() *AlterDatabaseRoleOptions {
@@ -51,6 +65,7 @@ func TestDatabaseRoles_Alter(t *testing.T) {
 			name: id,
 		}
 	}
+	// custom:end AlterDatabaseRoleOptions: default options
 
 	t.Run("validation: nil options", func(t *testing.T) {
 		var opts *AlterDatabaseRoleOptions = nil
@@ -58,44 +73,64 @@ func TestDatabaseRoles_Alter(t *testing.T) {
 	})
 
 	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions: validation (valid identifier)
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterDatabaseRoleOptions: validation (valid identifier)
 	})
 
 	t.Run("validation: exactly one field from [opts.Rename opts.Set opts.Unset] should be present", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions: validation (exactly one value set)
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Rename", "Set", "Unset"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterDatabaseRoleOptions", "Rename", "Set", "Unset"))
+		// custom:end AlterDatabaseRoleOptions: validation (exactly one value set)
 	})
 
 	t.Run("validation: valid identifier for [opts.Rename.Name]", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions.Rename: validation (valid identifier)
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterDatabaseRoleOptions.Rename: validation (valid identifier)
 	})
 
 	t.Run("validation: at least one of the fields [opts.Set.NestedThirdLevel.Field] should be set", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions.Set.NestedThirdLevel: validation (at least one value set)
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Field"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDatabaseRoleOptions.Set.NestedThirdLevel", "Field"))
+		// custom:end AlterDatabaseRoleOptions.Set.NestedThirdLevel: validation (at least one value set)
 	})
 
 	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions.Unset: validation (at least one value set)
 		opts := defaultOpts()
 		// TODO: fill me
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterDatabaseRoleOptions.Unset", "Comment"))
+		// custom:end AlterDatabaseRoleOptions.Unset: validation (at least one value set)
 	})
 
 	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions: basic
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+		// custom:end AlterDatabaseRoleOptions: basic
 	})
 
 	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterDatabaseRoleOptions: all options
 		opts := defaultOpts()
 		// TODO: fill me
 		assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+		// custom:end AlterDatabaseRoleOptions: all options
 	})
+
+	// custom:begin AlterDatabaseRoleOptions: additional test cases
+	// custom:end AlterDatabaseRoleOptions: additional test cases
 }
+
+// custom:begin additional
+// custom:end additional
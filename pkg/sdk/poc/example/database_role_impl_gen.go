@@ -9,11 +9,17 @@ type databaseRoles struct {
 }
 
 func (v *databaseRoles) Create(ctx context.Context, request *CreateDatabaseRoleRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
 }
 
 func (v *databaseRoles) Alter(ctx context.Context, request *AlterDatabaseRoleRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
 }
@@ -55,3 +61,6 @@ func (r *AlterDatabaseRoleRequest) toOpts() *AlterDatabaseRoleOptions {
 	}
 	return opts
 }
+
+// custom:begin additional
+// custom:end additional
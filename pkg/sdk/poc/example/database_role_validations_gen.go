@@ -1,7 +1,5 @@
 package example
 
-import "errors"
-
 var (
 	_ validatable = new(CreateDatabaseRoleOptions)
 	_ validatable = new(AlterDatabaseRoleOptions)
@@ -9,28 +7,30 @@ var (
 
 func (opts *CreateDatabaseRoleOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateDatabaseRoleOptions", "OrReplace", "IfNotExists"))
 	}
-	return errors.Join(errs...)
+	// custom:begin CreateDatabaseRoleOptions: additional validations
+	// custom:end CreateDatabaseRoleOptions: additional validations
+	return JoinErrors(errs...)
 }
 
 func (opts *AlterDatabaseRoleOptions) validate() error {
 	if opts == nil {
-		return errors.Join(ErrNilOptions)
+		return ErrNilOptions
 	}
 	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Rename, opts.Set, opts.Unset); !ok {
-		errs = append(errs, errExactlyOneOf("Rename", "Set", "Unset"))
+	if !exactlyOneValueSet(opts.Rename, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterDatabaseRoleOptions", "Rename", "Set", "Unset"))
 	}
 	if valueSet(opts.Rename) {
 		if !ValidObjectIdentifier(opts.Rename.Name) {
@@ -39,15 +39,20 @@ func (opts *AlterDatabaseRoleOptions) validate() error {
 	}
 	if valueSet(opts.Set) {
 		if valueSet(opts.Set.NestedThirdLevel) {
-			if ok := anyValueSet(opts.Set.NestedThirdLevel.Field); !ok {
-				errs = append(errs, errAtLeastOneOf("Field"))
+			if !anyValueSet(opts.Set.NestedThirdLevel.Field) {
+				errs = append(errs, errAtLeastOneOf("AlterDatabaseRoleOptions.Set.NestedThirdLevel", "Field"))
 			}
 		}
 	}
 	if valueSet(opts.Unset) {
-		if ok := anyValueSet(opts.Unset.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("Comment"))
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDatabaseRoleOptions.Unset", "Comment"))
 		}
 	}
-	return errors.Join(errs...)
+	// custom:begin AlterDatabaseRoleOptions: additional validations
+	// custom:end AlterDatabaseRoleOptions: additional validations
+	return JoinErrors(errs...)
 }
+
+// custom:begin additional
+// custom:end additional
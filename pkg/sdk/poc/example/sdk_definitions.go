@@ -29,21 +29,15 @@ type (
 	TableColumnIdentifier    struct{}
 )
 
-func RandomAccountObjectIdentifier(t *testing.T) AccountObjectIdentifier {
-	t.Helper()
-	_ = t
+func randomAccountObjectIdentifier() AccountObjectIdentifier {
 	return AccountObjectIdentifier{}
 }
 
-func RandomDatabaseObjectIdentifier(t *testing.T) DatabaseObjectIdentifier {
-	t.Helper()
-	_ = t
+func randomDatabaseObjectIdentifier() DatabaseObjectIdentifier {
 	return DatabaseObjectIdentifier{}
 }
 
-func RandomSchemaObjectIdentifier(t *testing.T) SchemaObjectIdentifier {
-	t.Helper()
-	_ = t
+func randomSchemaObjectIdentifier() SchemaObjectIdentifier {
 	return SchemaObjectIdentifier{}
 }
 
@@ -67,11 +61,20 @@ func everyValueSet(values ...interface{}) bool {
 	return true
 }
 
+func moreThanOneValueSet(values ...interface{}) bool {
+	_ = values
+	return false
+}
+
 func exactlyOneValueSet(values ...interface{}) bool {
 	_ = values
 	return true
 }
 
+func errInvalidIdentifier(structName string, identifierField string) error {
+	return fmt.Errorf("invalid object identifier of %s field: %s", structName, identifierField)
+}
+
 func errOneOf(fieldNames ...string) error {
 	return fmt.Errorf("fields %v are incompatible and cannot be set at once", fieldNames)
 }
@@ -89,6 +92,15 @@ var (
 	ErrInvalidObjectIdentifier = errors.New("invalid object identifier")
 )
 
+func JoinErrors(errs ...error) error {
+	return errors.Join(errs...)
+}
+
+func validateRequest(request any) error {
+	_ = request
+	return nil
+}
+
 func validateAndExec(client *Client, ctx context.Context, opts validatable) error {
 	_, _, _ = client, ctx, opts
 	return nil
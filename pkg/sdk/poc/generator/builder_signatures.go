@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// BuilderSignatures describe constructors and builder methods of DTOs existing on disk (<object>_dto_builders_gen.go).
+// Scaffolded code has to follow them, because DTO builders of the older objects take pointers (e.g. WithComment(Comment *string)),
+// while the current dto-builder-generator generates methods taking values (e.g. WithComment(Comment string)).
+type BuilderSignatures struct {
+	// params are types of the parameters by function name (e.g. NewCreateStreamlitRequest or CreateStreamlitRequest.WithComment)
+	params map[string][]string
+}
+
+// ParseBuilderSignatures reads signatures from the source of DTO builders file
+func ParseBuilderSignatures(src []byte) (*BuilderSignatures, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse DTO builders: %w", err)
+	}
+	signatures := &BuilderSignatures{params: make(map[string][]string)}
+	for _, decl := range file.Decls {
+		f, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		params := make([]string, 0)
+		for _, param := range f.Type.Params.List {
+			// unnamed parameter is also a single parameter
+			for i := 0; i < len(param.Names) || i == 0; i++ {
+				params = append(params, types.ExprString(param.Type))
+			}
+		}
+		signatures.params[funcKey(f)] = params
+	}
+	return signatures, nil
+}
+
+// takesPointer checks if the parameter with the given index is a pointer, defaultValue is returned when the function is unknown
+func (s *BuilderSignatures) takesPointer(function string, paramIdx int, defaultValue bool) bool {
+	if s == nil {
+		return defaultValue
+	}
+	params, ok := s.params[function]
+	if !ok || paramIdx >= len(params) {
+		return defaultValue
+	}
+	return strings.HasPrefix(params[paramIdx], "*")
+}
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderSignatures(t *testing.T) {
+	signatures, err := ParseBuilderSignatures([]byte(`package sdk
+
+func NewCreateSomeRequest(
+	name SchemaObjectIdentifier,
+	Comment *string,
+) *CreateSomeRequest {
+	return &CreateSomeRequest{name: name, Comment: Comment}
+}
+
+func (s *CreateSomeRequest) WithIfNotExists(IfNotExists *bool) *CreateSomeRequest {
+	s.IfNotExists = IfNotExists
+	return s
+}
+
+func (s *SomeSetRequest) WithComment(Comment string) *SomeSetRequest {
+	s.Comment = &Comment
+	return s
+}
+`))
+	require.NoError(t, err)
+
+	assert.False(t, signatures.takesPointer("NewCreateSomeRequest", 0, true))
+	assert.True(t, signatures.takesPointer("NewCreateSomeRequest", 1, false))
+	assert.True(t, signatures.takesPointer("CreateSomeRequest.WithIfNotExists", 0, false))
+	assert.False(t, signatures.takesPointer("SomeSetRequest.WithComment", 0, true))
+
+	t.Run("unknown function", func(t *testing.T) {
+		assert.True(t, signatures.takesPointer("SomeUnsetRequest.WithComment", 0, true))
+		assert.False(t, signatures.takesPointer("SomeUnsetRequest.WithComment", 0, false))
+	})
+
+	t.Run("unknown signatures", func(t *testing.T) {
+		var unknown *BuilderSignatures
+		assert.True(t, unknown.takesPointer("CreateSomeRequest.WithIfNotExists", 0, true))
+	})
+}
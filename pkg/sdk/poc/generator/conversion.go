@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
+type fieldMappingKind string
+
+const (
+	fieldMappingKindDirect     fieldMappingKind = "direct"
+	fieldMappingKindIdentifier fieldMappingKind = "identifier"
+	fieldMappingKindList       fieldMappingKind = "list"
+	fieldMappingKindTimestamp  fieldMappingKind = "timestamp"
+	fieldMappingKindConverted  fieldMappingKind = "converted"
+)
+
+// fieldMapping defines how plain struct field is derived from db struct fields
+type fieldMapping struct {
+	kind    fieldMappingKind
+	dbNames []string
+	// conversionFunc is used only by fieldMappingKindConverted
+	conversionFunc string
+}
+
+// nullableValueAccessors maps nullable db kinds to the way their value is accessed and the kind of the value
+var nullableValueAccessors = map[string]struct {
+	format string
+	kind   string
+}{
+	"sql.NullString":  {format: "%s.String", kind: "string"},
+	"sql.NullBool":    {format: "%s.Bool", kind: "bool"},
+	"sql.NullInt64":   {format: "int(%s.Int64)", kind: "int"},
+	"sql.NullFloat64": {format: "%s.Float64", kind: "float64"},
+	"sql.NullTime":    {format: "%s.Time", kind: "time.Time"},
+}
+
+// pointerConstructors maps kinds to SDK functions returning pointer to the given value (Pointer is used for other kinds)
+var pointerConstructors = map[string]string{
+	"string": "String",
+	"bool":   "Bool",
+	"int":    "Int",
+}
+
+var identifierPartsCount = map[string]int{
+	"AccountObjectIdentifier":  1,
+	"DatabaseObjectIdentifier": 2,
+	"SchemaObjectIdentifier":   3,
+}
+
+// conversionBody generates body of the function converting db struct into plain struct, it panics for the mappings
+// that cannot be derived, because it should be invoked only during generation
+func conversionBody(db *dbStruct, plain *plainStruct) string {
+	varName := startingWithLowerCase(plain.name)
+	literalEntries := make([]string, 0)
+	statements := make([]string, 0)
+	for _, field := range plain.fields {
+		mapping := field.mapping
+		if mapping == nil {
+			mapping = &fieldMapping{kind: fieldMappingKindDirect, dbNames: []string{defaultDbName(db, field.name)}}
+		}
+		dbFields := make([]dbField, len(mapping.dbNames))
+		for i, dbName := range mapping.dbNames {
+			dbFields[i] = db.fieldByName(dbName, plain.name, field.name)
+		}
+		target := fmt.Sprintf("%s.%s", varName, field.name)
+		switch mapping.kind {
+		case fieldMappingKindDirect:
+			value, valueKind := dbValue(dbFields[0])
+			value = convertToKind(value, valueKind, field.kind, plain.name, field.name)
+			if dbFields[0].isNullable() {
+				statements = append(statements, ifValid(dbFields[0], fmt.Sprintf("%s = %s", target, value)))
+			} else {
+				literalEntries = append(literalEntries, fmt.Sprintf("%s: %s,", field.name, value))
+			}
+		case fieldMappingKindIdentifier:
+			identifierKind := strings.TrimPrefix(field.kind, "*")
+			if count, ok := identifierPartsCount[identifierKind]; !ok || count != len(dbFields) {
+				log.Panicf("Identifier %s.%s of kind %s cannot be constructed from db fields %v", plain.name, field.name, field.kind, mapping.dbNames)
+			}
+			args := make([]string, len(dbFields))
+			for i, f := range dbFields {
+				args[i], _ = dbValue(f)
+			}
+			value := fmt.Sprintf("New%s(%s)", identifierKind, strings.Join(args, ", "))
+			literalEntries = append(literalEntries, fmt.Sprintf("%s: %s,", field.name, convertToKind(value, identifierKind, field.kind, plain.name, field.name)))
+		case fieldMappingKindList, fieldMappingKindConverted:
+			value, _ := dbValue(dbFields[0])
+			valueKind := strings.TrimPrefix(field.kind, "*")
+			if mapping.kind == fieldMappingKindList {
+				value = fmt.Sprintf("ParseCommaSeparatedStringArray(%s)", value)
+			} else {
+				value = fmt.Sprintf("%s(%s)", mapping.conversionFunc, value)
+			}
+			value = convertToKind(value, valueKind, field.kind, plain.name, field.name)
+			if dbFields[0].isNullable() {
+				statements = append(statements, ifValid(dbFields[0], fmt.Sprintf("%s = %s", target, value)))
+			} else {
+				literalEntries = append(literalEntries, fmt.Sprintf("%s: %s,", field.name, value))
+			}
+		case fieldMappingKindTimestamp:
+			value, _ := dbValue(dbFields[0])
+			statement := fmt.Sprintf("if t, err := ParseTimestamp(%s); err == nil {\n%s = %s\n}", value, target, convertToKind("t", "time.Time", field.kind, plain.name, field.name))
+			if dbFields[0].isNullable() {
+				statement = ifValid(dbFields[0], statement)
+			}
+			statements = append(statements, statement)
+		}
+	}
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("%s := %s{\n", varName, plain.name))
+	for _, e := range literalEntries {
+		body.WriteString(e + "\n")
+	}
+	body.WriteString("}\n")
+	for _, s := range statements {
+		body.WriteString(s + "\n")
+	}
+	body.WriteString(fmt.Sprintf("return &%s", varName))
+	return body.String()
+}
+
+// defaultDbName finds db field matching plain field name (e.g. "database_name" for "DatabaseName")
+func defaultDbName(db *dbStruct, plainFieldName string) string {
+	idx := slices.IndexFunc(db.fields, func(f dbField) bool { return sqlToFieldName(f.name, true) == plainFieldName })
+	if idx == -1 {
+		log.Panicf("Cannot find db field matching %s in %s, use one of the explicit mappings (e.g. FieldFrom)", plainFieldName, db.name)
+	}
+	return db.fields[idx].name
+}
+
+func (v *dbStruct) fieldByName(dbName string, plainStructName string, plainFieldName string) dbField {
+	idx := slices.IndexFunc(v.fields, func(f dbField) bool { return f.name == dbName })
+	if idx == -1 {
+		log.Panicf("Db field %s used in mapping of %s.%s not found in %s", dbName, plainStructName, plainFieldName, v.name)
+	}
+	return v.fields[idx]
+}
+
+func (f dbField) isNullable() bool {
+	_, ok := nullableValueAccessors[f.kind]
+	return ok
+}
+
+func (f dbField) goName() string {
+	return fmt.Sprintf("r.%s", sqlToFieldName(f.name, true))
+}
+
+// dbValue returns expression accessing the value of db field together with value's kind
+func dbValue(f dbField) (string, string) {
+	if accessor, ok := nullableValueAccessors[f.kind]; ok {
+		return fmt.Sprintf(accessor.format, f.goName()), accessor.kind
+	}
+	return f.goName(), f.kind
+}
+
+func ifValid(f dbField, statement string) string {
+	return fmt.Sprintf("if %s.Valid {\n%s\n}", f.goName(), statement)
+}
+
+func convertToKind(value string, valueKind string, targetKind string, plainStructName string, plainFieldName string) string {
+	switch {
+	case valueKind == targetKind:
+		return value
+	case KindOfPointer(valueKind) == targetKind:
+		if constructor, ok := pointerConstructors[valueKind]; ok {
+			return fmt.Sprintf("%s(%s)", constructor, value)
+		}
+		return fmt.Sprintf("Pointer(%s)", value)
+	default:
+		log.Panicf("Cannot map value of kind %s to %s.%s of kind %s, use one of the explicit mappings (e.g. Converted)", valueKind, plainStructName, plainFieldName, targetKind)
+		return ""
+	}
+}
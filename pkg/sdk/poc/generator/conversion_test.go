@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionBody(t *testing.T) {
+	db := DbStruct("secretDBRow").
+		Time("created_on").
+		Text("name").
+		Text("database_name").
+		Text("schema_name").
+		OptionalText("comment").
+		OptionalNumber("refresh_interval").
+		Text("secret_type").
+		OptionalText("oauth_scopes").
+		OptionalText("expires_at")
+
+	plain := PlainStruct("Secret").
+		DeriveMapping().
+		Time("CreatedOn").
+		Identifier("ID", "SchemaObjectIdentifier", "database_name", "schema_name", "name").
+		Text("Name").
+		OptionalText("Comment").
+		Number("RefreshInterval").
+		Converted("SecretType", "SecretType", "secret_type", "SecretType").
+		List("OauthScopes", "oauth_scopes").
+		OptionalParsedTime("ExpiresAt", "expires_at")
+
+	assert.Equal(t, `secret := Secret{
+CreatedOn: r.CreatedOn,
+ID: NewSchemaObjectIdentifier(r.DatabaseName, r.SchemaName, r.Name),
+Name: r.Name,
+SecretType: SecretType(r.SecretType),
+}
+if r.Comment.Valid {
+secret.Comment = String(r.Comment.String)
+}
+if r.RefreshInterval.Valid {
+secret.RefreshInterval = int(r.RefreshInterval.Int64)
+}
+if r.OauthScopes.Valid {
+secret.OauthScopes = ParseCommaSeparatedStringArray(r.OauthScopes.String)
+}
+if r.ExpiresAt.Valid {
+if t, err := ParseTimestamp(r.ExpiresAt.String); err == nil {
+secret.ExpiresAt = Pointer(t)
+}
+}
+return &secret`, conversionBody(db, plain))
+}
+
+func TestConversionBody_Invalid(t *testing.T) {
+	t.Run("missing db field", func(t *testing.T) {
+		assert.PanicsWithValue(t, "Cannot find db field matching Owner in someDBRow, use one of the explicit mappings (e.g. FieldFrom)", func() {
+			conversionBody(DbStruct("someDBRow").Text("name"), PlainStruct("Some").DeriveMapping().Text("Owner"))
+		})
+	})
+
+	t.Run("incompatible kinds", func(t *testing.T) {
+		assert.PanicsWithValue(t, "Cannot map value of kind string to Some.Enabled of kind bool, use one of the explicit mappings (e.g. Converted)", func() {
+			conversionBody(DbStruct("someDBRow").Text("enabled"), PlainStruct("Some").DeriveMapping().Bool("Enabled"))
+		})
+	})
+}
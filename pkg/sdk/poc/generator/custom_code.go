@@ -0,0 +1,267 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// Generated files can contain parts that have to be filled by hand (e.g. test cases or mappings). To be able to
+// re-generate such files without losing the work, two kinds of custom code are carried over from the existing file:
+//   - custom regions, which are emitted by templates in places where hand-written code is expected (e.g. test cases
+//     bodies or the end of the file) and are marked in the following way:
+//     // custom:begin <name>
+//     ...
+//     // custom:end <name>
+//   - functions that are generated with a placeholder body (e.g. db row to plain struct convert() without mapping
+//     derived from the definition), but were already filled in the existing file.
+const (
+	customRegionBeginPrefix = "// custom:begin "
+	customRegionEndPrefix   = "// custom:end "
+	placeholderMarker       = "// TODO: Mapping"
+)
+
+type customRegion struct {
+	key     string
+	content []string
+}
+
+// PreserveCustomCode returns generated code with custom regions and filled-in placeholder functions taken from
+// existing code. It returns an error when regions are malformed, when a non-empty region from existing code
+// has no counterpart in generated code, or when existing code has no custom regions at all while generated code
+// has them (to not lose any hand-written code silently).
+func PreserveCustomCode(generated []byte, existing []byte) ([]byte, error) {
+	withFunctions, err := preserveFilledFunctions(generated, existing)
+	if err != nil {
+		return nil, err
+	}
+	return preserveCustomRegions(withFunctions, existing)
+}
+
+func preserveCustomRegions(generated []byte, existing []byte) ([]byte, error) {
+	existingRegions, err := parseCustomRegions(splitLines(existing))
+	if err != nil {
+		return nil, fmt.Errorf("existing code: %w", err)
+	}
+
+	generatedLines := splitLines(generated)
+	generatedRegions, err := parseCustomRegions(generatedLines)
+	if err != nil {
+		return nil, fmt.Errorf("generated code: %w", err)
+	}
+	if len(generatedRegions) == 0 {
+		return generated, nil
+	}
+	// files generated before the custom regions were introduced could have been edited anywhere, so they are not overwritten
+	if len(existingRegions) == 0 {
+		return nil, fmt.Errorf("existing code has no custom regions (it was generated before they were introduced), move hand-written code to custom regions (e.g. %q) before re-generating", customRegionBeginPrefix+generatedRegions[len(generatedRegions)-1].key)
+	}
+
+	regionsByKey := make(map[string]customRegion)
+	for _, r := range existingRegions {
+		regionsByKey[r.key] = r
+	}
+
+	usedKeys := make([]string, 0)
+	occurrences := make(map[string]int)
+	result := make([]string, 0, len(generatedLines))
+	for i := 0; i < len(generatedLines); i++ {
+		line := generatedLines[i]
+		result = append(result, line)
+		name, ok := regionName(line, customRegionBeginPrefix)
+		if !ok {
+			continue
+		}
+		key := regionKey(normalizeRegionName(name), occurrences)
+		existingRegion, found := regionsByKey[key]
+		if !found {
+			continue
+		}
+		usedKeys = append(usedKeys, key)
+		// skip generated region content (parsing above guarantees that the region is closed)
+		for i+1 < len(generatedLines) {
+			if _, isEnd := regionName(generatedLines[i+1], customRegionEndPrefix); isEnd {
+				break
+			}
+			i++
+		}
+		// blank lines around the content are trimmed, because formatting can add them (which would make generation unstable)
+		result = append(result, trimBlankLines(existingRegion.content)...)
+	}
+
+	var orphaned []string
+	for _, r := range existingRegions {
+		if !slices.Contains(usedKeys, r.key) && strings.TrimSpace(strings.Join(r.content, "")) != "" {
+			orphaned = append(orphaned, r.key)
+		}
+	}
+	if len(orphaned) > 0 {
+		return nil, fmt.Errorf("custom regions %v from existing code are not present in generated code, move their content to other regions before re-generating", orphaned)
+	}
+
+	return []byte(strings.Join(result, "\n")), nil
+}
+
+func parseCustomRegions(lines []string) ([]customRegion, error) {
+	regions := make([]customRegion, 0)
+	occurrences := make(map[string]int)
+	var current *customRegion
+	var currentName string
+	for _, line := range lines {
+		if name, ok := regionName(line, customRegionBeginPrefix); ok {
+			if current != nil {
+				return nil, fmt.Errorf("custom region %s begins before the end of custom region %s", name, currentName)
+			}
+			current = &customRegion{key: regionKey(normalizeRegionName(name), occurrences), content: make([]string, 0)}
+			currentName = name
+			continue
+		}
+		if name, ok := regionName(line, customRegionEndPrefix); ok {
+			if current == nil || name != currentName {
+				return nil, fmt.Errorf("unexpected end of custom region %s", name)
+			}
+			regions = append(regions, *current)
+			current = nil
+			continue
+		}
+		if current != nil {
+			current.content = append(current.content, line)
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("custom region %s is not closed", currentName)
+	}
+	return regions, nil
+}
+
+// regionKey distinguishes regions with the same name by the order of their occurrence
+func regionKey(name string, occurrences map[string]int) string {
+	occurrences[name]++
+	if occurrences[name] == 1 {
+		return name
+	}
+	return fmt.Sprintf("%s#%d", name, occurrences[name])
+}
+
+// legacyValidationRegionPrefixes map the beginnings of validation regions names used before (they contained the whole
+// description of the validation, e.g. "AlterSecretOptions: validation: at least one of the fields [opts.Set opts.Unset] should be set")
+// to validation types; more specific prefixes go first
+var legacyValidationRegionPrefixes = []struct {
+	prefix         string
+	suffix         string
+	validationType ValidationType
+}{
+	{prefix: "valid identifier for ", suffix: " if set", validationType: ValidIdentifierIfSet},
+	{prefix: "valid identifier for ", validationType: ValidIdentifier},
+	{prefix: "conflicting fields for ", validationType: ConflictingFields},
+	{prefix: "exactly one field from ", validationType: ExactlyOneValueSet},
+	{prefix: "at least one of the fields ", validationType: AtLeastOneValueSet},
+	{suffix: " should be set", validationType: ValidateValueSet},
+	{suffix: " should be valid", validationType: ValidateValue},
+}
+
+// normalizeRegionName converts legacy names of validation regions to the current ones (see Validation.RegionName),
+// so that the content of such regions is carried over when the validated fields change
+func normalizeRegionName(name string) string {
+	path, description, found := strings.Cut(name, ": validation: ")
+	if !found {
+		return name
+	}
+	for _, legacy := range legacyValidationRegionPrefixes {
+		if strings.HasPrefix(description, legacy.prefix) && strings.HasSuffix(description, legacy.suffix) {
+			return validationRegionName(path, legacy.validationType)
+		}
+	}
+	return name
+}
+
+func regionName(line string, prefix string) (string, bool) {
+	name, found := strings.CutPrefix(strings.TrimSpace(line), prefix)
+	return strings.TrimSpace(name), found
+}
+
+func trimBlankLines(lines []string) []string {
+	isBlank := func(line string) bool { return strings.TrimSpace(line) == "" }
+	for len(lines) > 0 && isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func splitLines(src []byte) []string {
+	return strings.Split(string(src), "\n")
+}
+
+func preserveFilledFunctions(generated []byte, existing []byte) ([]byte, error) {
+	existingFset := token.NewFileSet()
+	existingFile, err := parser.ParseFile(existingFset, "", existing, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("existing code: %w", err)
+	}
+	generatedFset := token.NewFileSet()
+	generatedFile, err := parser.ParseFile(generatedFset, "", generated, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("generated code: %w", err)
+	}
+
+	// whole declarations are taken, because e.g. receiver name could also be changed by hand
+	filledFunctions := make(map[string]string)
+	for _, decl := range existingFile.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Body != nil {
+			if !strings.Contains(sourceOf(existing, existingFset, f.Body), placeholderMarker) {
+				filledFunctions[funcKey(f)] = sourceOf(existing, existingFset, f)
+			}
+		}
+	}
+
+	type replacement struct {
+		start, end int
+		function   string
+	}
+	replacements := make([]replacement, 0)
+	for _, decl := range generatedFile.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Body != nil {
+			function, filled := filledFunctions[funcKey(f)]
+			if filled && strings.Contains(sourceOf(generated, generatedFset, f.Body), placeholderMarker) {
+				replacements = append(replacements, replacement{
+					start:    generatedFset.Position(f.Pos()).Offset,
+					end:      generatedFset.Position(f.End()).Offset,
+					function: function,
+				})
+			}
+		}
+	}
+
+	result := bytes.Clone(generated)
+	for i := len(replacements) - 1; i >= 0; i-- {
+		r := replacements[i]
+		result = append(result[:r.start:r.start], append([]byte(r.function), result[r.end:]...)...)
+	}
+	return result, nil
+}
+
+func sourceOf(src []byte, fset *token.FileSet, node ast.Node) string {
+	return string(src[fset.Position(node.Pos()).Offset:fset.Position(node.End()).Offset])
+}
+
+// funcKey identifies function by its name and receiver type (e.g. taskDBRow.convert)
+func funcKey(f *ast.FuncDecl) string {
+	if f.Recv == nil || len(f.Recv.List) == 0 {
+		return f.Name.Name
+	}
+	recv := f.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return fmt.Sprintf("%s.%s", ident.Name, f.Name.Name)
+	}
+	return f.Name.Name
+}
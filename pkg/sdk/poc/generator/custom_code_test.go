@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreserveCustomCode(t *testing.T) {
+	generated := `package sdk
+
+func (r someDBRow) convert() *Some {
+	// TODO: Mapping
+	return &Some{}
+}
+
+func TestSome(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin basic
+		// TODO: fill me
+		// custom:end basic
+	})
+}
+
+// custom:begin additional
+// custom:end additional
+`
+
+	t.Run("empty custom regions", func(t *testing.T) {
+		result, err := PreserveCustomCode([]byte(generated), []byte(generated))
+		require.NoError(t, err)
+		assert.Equal(t, generated, string(result))
+	})
+
+	t.Run("existing code without custom regions", func(t *testing.T) {
+		_, err := PreserveCustomCode([]byte(generated), []byte("package sdk\n\nfunc helper() {}\n"))
+		require.ErrorContains(t, err, "existing code has no custom regions")
+	})
+
+	t.Run("generated code without custom regions", func(t *testing.T) {
+		result, err := PreserveCustomCode([]byte("package sdk\n"), []byte("package sdk\n\nfunc helper() {}\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "package sdk\n", string(result))
+	})
+
+	t.Run("filled regions and functions are preserved", func(t *testing.T) {
+		existing := `package sdk
+
+func (row someDBRow) convert() *Some {
+	return &Some{Name: row.Name}
+}
+
+func TestSome(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin basic
+		assertSomething(t)
+		// custom:end basic
+	})
+}
+
+// custom:begin additional
+func helper() {}
+
+// custom:end additional
+`
+		result, err := PreserveCustomCode([]byte(generated), []byte(existing))
+		require.NoError(t, err)
+		assert.Equal(t, `package sdk
+
+func (row someDBRow) convert() *Some {
+	return &Some{Name: row.Name}
+}
+
+func TestSome(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin basic
+		assertSomething(t)
+		// custom:end basic
+	})
+}
+
+// custom:begin additional
+func helper() {}
+// custom:end additional
+`, string(result))
+	})
+
+	t.Run("orphaned region", func(t *testing.T) {
+		existing := "package sdk\n\n// custom:begin removed\nfunc helper() {}\n// custom:end removed\n"
+		_, err := PreserveCustomCode([]byte(generated), []byte(existing))
+		require.ErrorContains(t, err, "custom regions [removed] from existing code are not present in generated code")
+	})
+
+	t.Run("not closed region", func(t *testing.T) {
+		existing := "package sdk\n\n// custom:begin additional\nfunc helper() {}\n"
+		_, err := PreserveCustomCode([]byte(generated), []byte(existing))
+		require.ErrorContains(t, err, "custom region additional is not closed")
+	})
+
+	t.Run("legacy validation regions", func(t *testing.T) {
+		generated := `package sdk
+
+func TestSome(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set opts.Unset opts.RenameTo] should be set", func(t *testing.T) {
+		// custom:begin AlterSomeOptions: validation (at least one value set)
+		// TODO: fill me
+		// custom:end AlterSomeOptions: validation (at least one value set)
+	})
+}
+`
+		existing := `package sdk
+
+func TestSome(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set opts.Unset] should be set", func(t *testing.T) {
+		// custom:begin AlterSomeOptions: validation: at least one of the fields [opts.Set opts.Unset] should be set
+		assertSomething(t)
+		// custom:end AlterSomeOptions: validation: at least one of the fields [opts.Set opts.Unset] should be set
+	})
+}
+`
+		result, err := PreserveCustomCode([]byte(generated), []byte(existing))
+		require.NoError(t, err)
+		assert.Contains(t, string(result), `		// custom:begin AlterSomeOptions: validation (at least one value set)
+		assertSomething(t)
+		// custom:end AlterSomeOptions: validation (at least one value set)`)
+	})
+}
+
+func Test_normalizeRegionName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "CreateSomeOptions: validation: valid identifier for [opts.name]", expected: "CreateSomeOptions: validation (valid identifier)"},
+		{name: "AlterSomeOptions: validation: valid identifier for [opts.RenameTo] if set", expected: "AlterSomeOptions: validation (valid identifier if set)"},
+		{name: "CreateSomeOptions: validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", expected: "CreateSomeOptions: validation (conflicting fields)"},
+		{name: "AlterSomeOptions: validation: exactly one field from [opts.Set opts.Unset] should be present", expected: "AlterSomeOptions: validation (exactly one value set)"},
+		{name: "AlterSomeOptions.Set: validation: at least one of the fields [opts.Set.Comment] should be set", expected: "AlterSomeOptions.Set: validation (at least one value set)"},
+		{name: "CreateSomeOptions: validation: [opts.Body] should be set", expected: "CreateSomeOptions: validation (value set)"},
+		{name: "CreateSomeOptions: validation: opts.Body should be valid", expected: "CreateSomeOptions: validation (valid value)"},
+		{name: "CreateSomeOptions: validation (valid identifier)", expected: "CreateSomeOptions: validation (valid identifier)"},
+		{name: "CreateSomeOptions: basic", expected: "CreateSomeOptions: basic"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeRegionName(tc.name))
+		})
+	}
+}
@@ -52,6 +52,21 @@ func (f *Field) withFields(fields ...*Field) *Field {
 	return f
 }
 
+// clone copies the field together with its subtree, so that each usage of the same query struct in the definition
+// has its own fields (with their own parents)
+func (f *Field) clone() *Field {
+	c := *f
+	c.Tags = make(map[string][]string, len(f.Tags))
+	for k, v := range f.Tags {
+		c.Tags[k] = slices.Clone(v)
+	}
+	c.Fields = make([]*Field, len(f.Fields))
+	for i, child := range f.Fields {
+		c.Fields[i] = child.clone()
+	}
+	return &c
+}
+
 func (f *Field) withValidations(validations ...*Validation) *Field {
 	f.Validations = validations
 	return f
@@ -118,6 +133,11 @@ func (f *Field) ShouldBeInDto() bool {
 	return !slices.Contains(f.Tags["ddl"], "static")
 }
 
+// HasName checks if field has a direct child holding the object identifier (e.g. name in options structs)
+func (f *Field) HasName() bool {
+	return slices.ContainsFunc(f.Fields, func(child *Field) bool { return child.Name == "name" })
+}
+
 // IsRoot checks if field is at the top of field hierarchy, basically it is true for Option structs
 func (f *Field) IsRoot() bool {
 	return f.Parent == nil
@@ -141,6 +161,34 @@ func (f *Field) PathWithRoot() string {
 	}
 }
 
+// pathFrom returns the way to the field starting with the given root variable; fields of slice elements are
+// accessed through the element variable instead, because slices are mapped and validated in loops (e.g. v.SomeChild)
+func (f *Field) pathFrom(rootVariable string, elementVariable string) string {
+	switch {
+	case f.IsRoot():
+		return rootVariable
+	case f.Parent.IsSlice():
+		return fmt.Sprintf("%s.%s", elementVariable, f.Name)
+	default:
+		return fmt.Sprintf("%s.%s", f.Parent.pathFrom(rootVariable, elementVariable), f.Name)
+	}
+}
+
+// MappingSource returns the way to the field in DTO used in toOpts mapping (e.g. r.SomeField.SomeChild)
+func (f *Field) MappingSource() string {
+	return f.pathFrom("r", "v")
+}
+
+// MappingTarget returns the way to the field in options struct used in toOpts mapping (e.g. opts.SomeField.SomeChild)
+func (f *Field) MappingTarget() string {
+	return f.pathFrom("opts", "s[i]")
+}
+
+// ValidationSource returns the way to the field in options struct used in validate() (e.g. opts.SomeField.SomeChild)
+func (f *Field) ValidationSource() string {
+	return f.pathFrom("opts", "v")
+}
+
 // DtoKind returns what should be fields kind in generated DTO, because it may differ from Kind
 func (f *Field) DtoKind() string {
 	switch {
@@ -166,3 +214,24 @@ func (f *Field) DtoDecl() string {
 		return f.KindNoPtr()
 	}
 }
+
+// DtoTags returns struct tags of the field in generated DTO; validate tag mirrors validations of the options struct
+// and is used by dto-builder-generator to generate Validate methods
+func (f *Field) DtoTags() string {
+	if f.Parent == nil {
+		return ""
+	}
+	validations := make([]string, 0)
+	for _, v := range f.Parent.Validations {
+		if !slices.Contains(v.FieldNames, f.Name) {
+			continue
+		}
+		if tag := v.DtoTag(); tag != "" {
+			validations = append(validations, tag)
+		}
+	}
+	if len(validations) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("`validate:\"%s\"`", strings.Join(validations, ","))
+}
@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestField_DtoTags(t *testing.T) {
+	parent := &Field{
+		Name: "CreateOptions",
+		Validations: []*Validation{
+			NewValidation(ValidIdentifier, "name"),
+			NewValidation(ExactlyOneValueSet, "Warehouse", "ComputePool"),
+			NewValidation(ConflictingFields, "OrReplace", "IfNotExists"),
+			NewValidation(ValidateValue, "Set"),
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Field  *Field
+		Result string
+	}{
+		{Name: "no parent", Field: &Field{Name: "name"}, Result: ""},
+		{Name: "valid identifier", Field: &Field{Name: "name", Parent: parent}, Result: "`validate:\"validIdentifier\"`"},
+		{Name: "value set group", Field: &Field{Name: "ComputePool", Parent: parent}, Result: "`validate:\"exactlyOneValueSet=Warehouse|ComputePool\"`"},
+		{Name: "conflicting fields", Field: &Field{Name: "OrReplace", Parent: parent}, Result: "`validate:\"conflictingFields=OrReplace|IfNotExists\"`"},
+		{Name: "unsupported validation", Field: &Field{Name: "Set", Parent: parent}, Result: ""},
+		{Name: "without validations", Field: &Field{Name: "Comment", Parent: parent}, Result: ""},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Result, tc.Field.DtoTags())
+		})
+	}
+}
+
+func TestField_Paths(t *testing.T) {
+	location := NewQueryStruct("Location").
+		OptionalQueryStructField("S3", NewQueryStruct("S3Params").Text("Url", nil), nil)
+	opts := NewQueryStruct("CreateVolumeOptions").
+		ListQueryStructField("Locations", location, nil).
+		OptionalQueryStructField("Added", location, nil).
+		IntoField()
+	setParent(opts)
+
+	inSlice := opts.Fields[0].Fields[0].Fields[0]
+	inStruct := opts.Fields[1].Fields[0].Fields[0]
+
+	assert.Equal(t, ".Locations.S3.Url", inSlice.Path())
+	assert.Equal(t, "v.S3.Url", inSlice.MappingSource())
+	assert.Equal(t, "s[i].S3.Url", inSlice.MappingTarget())
+	assert.Equal(t, "v.S3.Url", inSlice.ValidationSource())
+
+	assert.Equal(t, ".Added.S3.Url", inStruct.Path())
+	assert.Equal(t, "r.Added.S3.Url", inStruct.MappingSource())
+	assert.Equal(t, "opts.Added.S3.Url", inStruct.MappingTarget())
+	assert.Equal(t, "opts.Added.S3.Url", inStruct.ValidationSource())
+}
@@ -2,23 +2,97 @@ package generator
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"go/format"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
 )
 
 func WriteCodeToFile(buffer *bytes.Buffer, fileName string) {
+	outputPath := outputPathFor(fileName)
+	src, err := prepareCode(buffer, outputPath)
+	if err != nil {
+		log.Panicln(err)
+	}
+	if err := os.WriteFile(outputPath, src, 0o600); err != nil {
+		log.Panicln(err)
+	}
+}
+
+// IsCodeUpToDate checks if the file contains exactly the code that would be written by WriteCodeToFile
+// (the file is reported as stale also when it could not be re-generated, e.g. because of orphaned custom regions)
+func IsCodeUpToDate(buffer *bytes.Buffer, fileName string) bool {
+	outputPath := outputPathFor(fileName)
+	src, err := prepareCode(buffer, outputPath)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	existing, err := os.ReadFile(outputPath)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(src, existing)
+}
+
+func outputPathFor(fileName string) string {
 	wd, errWd := os.Getwd()
 	if errWd != nil {
 		log.Panicln(errWd)
 	}
-	outputPath := filepath.Join(wd, fileName)
-	src, errSrcFormat := format.Source(buffer.Bytes())
-	if errSrcFormat != nil {
-		log.Panicln(errSrcFormat)
+	return filepath.Join(wd, fileName)
+}
+
+// prepareCode formats the generated code, carries over custom code from the already existing file (see PreserveCustomCode)
+// and fixes imports of the result (see FixImports)
+func prepareCode(buffer *bytes.Buffer, outputPath string) ([]byte, error) {
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	existing, err := os.ReadFile(outputPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		src, err = PreserveCustomCode(src, existing)
+		if err != nil {
+			return nil, fmt.Errorf("cannot preserve custom code of %s: %w", outputPath, err)
+		}
+	}
+	withImports, err := FixImports(src)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fix imports of %s: %w", outputPath, err)
+	}
+	return format.Source(withImports)
+}
+
+// ScaffoldFile writes the content to the file only when it does not exist yet, Go code is formatted before writing.
+// Scaffolded files are meant to be edited by hand, so they are never overwritten. Returns true if the file was written.
+func ScaffoldFile(buffer *bytes.Buffer, fileName string) bool {
+	outputPath := outputPathFor(fileName)
+	if _, err := os.Stat(outputPath); err == nil {
+		return false
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		log.Panicln(err)
+	}
+	src := buffer.Bytes()
+	if filepath.Ext(outputPath) == ".go" {
+		formatted, err := format.Source(src)
+		if err != nil {
+			log.Panicln(err)
+		}
+		src = formatted
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		log.Panicln(err)
 	}
 	if err := os.WriteFile(outputPath, src, 0o600); err != nil {
 		log.Panicln(err)
 	}
+	return true
 }
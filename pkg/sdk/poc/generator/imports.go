@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// knownImports are packages that can be referenced from the generated code or from custom regions,
+// they are added to the file by FixImports when used and not imported yet
+var knownImports = map[string]string{
+	"assert":      "github.com/stretchr/testify/assert",
+	"collections": "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections",
+	"context":     "context",
+	"errors":      "errors",
+	"fmt":         "fmt",
+	"json":        "encoding/json",
+	"require":     "github.com/stretchr/testify/require",
+	"slices":      "slices",
+	"sql":         "database/sql",
+	"strconv":     "strconv",
+	"strings":     "strings",
+	"time":        "time",
+}
+
+type importSpec struct {
+	name string
+	path string
+}
+
+// FixImports removes unused imports and adds missing known imports (see knownImports); imports are grouped
+// into standard library and other packages. It is needed, because templates cannot tell which packages will be
+// used by the generated code (e.g. sql.NullString in db rows) and by the hand-written code in custom regions.
+func FixImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	imports := make([]importSpec, 0)
+	imported := make(map[string]bool)
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := path.Base(importPath)
+		alias := ""
+		if spec.Name != nil {
+			alias = spec.Name.Name
+			name = alias
+		}
+		imported[name] = true
+		// name of the package can differ from the last path element, so only unused known imports are removed
+		if used[name] || !slices.Contains(knownImportPaths(), importPath) {
+			imports = append(imports, importSpec{name: alias, path: importPath})
+		}
+	}
+	for name := range used {
+		if importPath, ok := knownImports[name]; ok && !imported[name] {
+			imports = append(imports, importSpec{path: importPath})
+		}
+	}
+
+	// all import declarations are replaced by a single one put right after the package clause
+	result := make([]byte, 0, len(src))
+	offset := 0
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			result = append(result, src[offset:fset.Position(genDecl.Pos()).Offset]...)
+			offset = fset.Position(genDecl.End()).Offset
+		}
+	}
+	result = append(result, src[offset:]...)
+	packageEnd := fset.Position(file.Name.End()).Offset
+	withImports := append([]byte(nil), result[:packageEnd]...)
+	withImports = append(withImports, "\n\n"+importDecl(imports)...)
+	return append(withImports, result[packageEnd:]...), nil
+}
+
+func knownImportPaths() []string {
+	paths := make([]string, 0, len(knownImports))
+	for _, importPath := range knownImports {
+		paths = append(paths, importPath)
+	}
+	return paths
+}
+
+func importDecl(imports []importSpec) string {
+	switch len(imports) {
+	case 0:
+		return ""
+	case 1:
+		if imports[0].name == "" {
+			return fmt.Sprintf("import %q\n", imports[0].path)
+		}
+	}
+	var std, other []string
+	for _, i := range imports {
+		line := strconv.Quote(i.path)
+		if i.name != "" {
+			line = fmt.Sprintf("%s %s", i.name, line)
+		}
+		// the same heuristic as in goimports: standard library paths do not contain a dot in the first element
+		if strings.Contains(strings.Split(i.path, "/")[0], ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	sortByPath := func(a, b string) int {
+		return strings.Compare(a[strings.Index(a, `"`):], b[strings.Index(b, `"`):])
+	}
+	slices.SortFunc(std, sortByPath)
+	slices.SortFunc(other, sortByPath)
+	groups := make([]string, 0, 2)
+	for _, group := range [][]string{std, other} {
+		if len(group) > 0 {
+			groups = append(groups, strings.Join(group, "\n"))
+		}
+	}
+	return fmt.Sprintf("import (\n%s\n)\n", strings.Join(groups, "\n\n"))
+}
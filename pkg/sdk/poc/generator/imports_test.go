@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixImports(t *testing.T) {
+	fixImports := func(t *testing.T, src string) string {
+		t.Helper()
+		result, err := FixImports([]byte(src))
+		require.NoError(t, err)
+		formatted, err := format.Source(result)
+		require.NoError(t, err)
+		return string(formatted)
+	}
+
+	t.Run("unused known imports are removed", func(t *testing.T) {
+		result := fixImports(t, `package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+func (v *some) Drop(ctx context.Context) error { return nil }
+`)
+
+		assert.Equal(t, `package sdk
+
+import "context"
+
+func (v *some) Drop(ctx context.Context) error { return nil }
+`, result)
+	})
+
+	t.Run("missing known imports are added and grouped", func(t *testing.T) {
+		result := fixImports(t, `package sdk
+
+import "context"
+
+type someRow struct {
+	CreatedOn time.Time
+	Comment   sql.NullString
+}
+
+func (v *some) Show(ctx context.Context) ([]Some, error) {
+	return collections.Map(rows, func(r someRow) Some { return r.convert() }), nil
+}
+`)
+
+		assert.Equal(t, `package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+type someRow struct {
+	CreatedOn time.Time
+	Comment   sql.NullString
+}
+
+func (v *some) Show(ctx context.Context) ([]Some, error) {
+	return collections.Map(rows, func(r someRow) Some { return r.convert() }), nil
+}
+`, result)
+	})
+
+	t.Run("unknown imports are kept", func(t *testing.T) {
+		src := `package sdk
+
+import (
+	"testing"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+func TestSome(t *testing.T) {}
+`
+		assert.Equal(t, src, fixImports(t, src))
+	})
+
+	t.Run("no imports", func(t *testing.T) {
+		src := "package sdk\n\ntype Some struct{}\n"
+		assert.Equal(t, src, fixImports(t, src))
+	})
+}
@@ -1,5 +1,7 @@
 package generator
 
+import "fmt"
+
 // Interface groups operations for particular object or objects family (e.g. DATABASE ROLE)
 type Interface struct {
 	// Name is the interface's name, e.g. "DatabaseRoles"
@@ -25,3 +27,33 @@ func NewInterface(name string, nameSingular string, identifierKind string, opera
 func (i *Interface) NameLowerCased() string {
 	return startingWithLowerCase(i.Name)
 }
+
+// ShowObjectName returns the name of the struct returned by the Show operation, which is usually the same as NameSingular
+func (i *Interface) ShowObjectName() string {
+	for _, o := range i.Operations {
+		if o.Name == string(OperationKindShow) && o.ShowMapping != nil {
+			return o.ShowMapping.To.Name
+		}
+	}
+	return i.NameSingular
+}
+
+// Preprocess links operations and fields of the definition (names of options structs, parents of the fields), it has
+// to be called before generating the code. It is needed because current simple builder is not ideal, should be removed later.
+func (i *Interface) Preprocess() {
+	for _, o := range i.Operations {
+		o.ObjectInterface = i
+		if o.OptsField != nil {
+			o.OptsField.Name = fmt.Sprintf("%s%sOptions", o.Name, i.NameSingular)
+			o.OptsField.Kind = fmt.Sprintf("%s%sOptions", o.Name, i.NameSingular)
+			setParent(o.OptsField)
+		}
+	}
+}
+
+func setParent(field *Field) {
+	for _, f := range field.Fields {
+		f.Parent = field
+		setParent(f)
+	}
+}
@@ -44,6 +44,19 @@ type Mapping struct {
 	MappingFuncName string
 	From            *Field
 	To              *Field
+	// db and plain keep the definitions used to derive the conversion
+	db    *dbStruct
+	plain *plainStruct
+}
+
+// DerivesConversion checks if the conversion body should be generated instead of the placeholder
+func (m *Mapping) DerivesConversion() bool {
+	return m.db != nil && m.plain != nil && m.plain.deriveMapping
+}
+
+// ConversionBody returns body of the conversion function derived from db and plain struct definitions
+func (m *Mapping) ConversionBody() string {
+	return conversionBody(m.db, m.plain)
 }
 
 func newOperation(kind string, doc string) *Operation {
@@ -77,12 +90,18 @@ func (s *Operation) withHelperStructs(helperStructs ...*Field) *Operation {
 	return s
 }
 
-func addShowMapping(op *Operation, from, to *Field) {
-	op.ShowMapping = newMapping("convert", from, to)
+func (m *Mapping) withDefinitions(db *dbStruct, plain *plainStruct) *Mapping {
+	m.db = db
+	m.plain = plain
+	return m
+}
+
+func addShowMapping(op *Operation, mapping *Mapping) {
+	op.ShowMapping = mapping
 }
 
-func addDescriptionMapping(op *Operation, from, to *Field) {
-	op.DescribeMapping = newMapping("convert", from, to)
+func addDescriptionMapping(op *Operation, mapping *Mapping) {
+	op.DescribeMapping = mapping
 }
 
 func (i *Interface) newNoSqlOperation(kind string) *Interface {
@@ -115,7 +134,7 @@ func (i *Interface) newOperationWithDBMapping(
 	dbRepresentation *dbStruct,
 	resourceRepresentation *plainStruct,
 	queryStruct *QueryStruct,
-	addMappingFunc func(op *Operation, from, to *Field),
+	addMappingFunc func(op *Operation, mapping *Mapping),
 ) *Operation {
 	db := dbRepresentation.IntoField()
 	res := resourceRepresentation.IntoField()
@@ -126,7 +145,7 @@ func (i *Interface) newOperationWithDBMapping(
 		withHelperStruct(db).
 		withHelperStruct(res).
 		withOptionsStruct(queryStruct.IntoField())
-	addMappingFunc(op, db, res)
+	addMappingFunc(op, newMapping("convert", db, res).withDefinitions(dbRepresentation, resourceRepresentation))
 	i.Operations = append(i.Operations, op)
 	return op
 }
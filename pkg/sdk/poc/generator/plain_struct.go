@@ -3,11 +3,15 @@ package generator
 type plainStruct struct {
 	name   string
 	fields []plainField
+	// deriveMapping decides if the conversion from db struct should be generated (instead of "// TODO: Mapping" placeholder)
+	deriveMapping bool
 }
 
 type plainField struct {
 	name string
 	kind string
+	// mapping describes how the field is derived from db struct, by default it's taken from the db field with the matching name
+	mapping *fieldMapping
 }
 
 func PlainStruct(name string) *plainStruct {
@@ -17,6 +21,12 @@ func PlainStruct(name string) *plainStruct {
 	}
 }
 
+// DeriveMapping turns on generation of the conversion from db struct (see fieldMapping for supported mappings)
+func (v *plainStruct) DeriveMapping() *plainStruct {
+	v.deriveMapping = true
+	return v
+}
+
 func (v *plainStruct) Field(name string, kind string) *plainStruct {
 	v.fields = append(v.fields, plainField{
 		name: name,
@@ -25,6 +35,15 @@ func (v *plainStruct) Field(name string, kind string) *plainStruct {
 	return v
 }
 
+func (v *plainStruct) mappedField(name string, kind string, mapping *fieldMapping) *plainStruct {
+	v.fields = append(v.fields, plainField{
+		name:    name,
+		kind:    kind,
+		mapping: mapping,
+	})
+	return v
+}
+
 func (v *plainStruct) Text(name string) *plainStruct {
 	return v.Field(name, "string")
 }
@@ -33,6 +52,10 @@ func (v *plainStruct) Time(name string) *plainStruct {
 	return v.Field(name, "time.Time")
 }
 
+func (v *plainStruct) OptionalTime(name string) *plainStruct {
+	return v.Field(name, "*time.Time")
+}
+
 func (v *plainStruct) OptionalText(name string) *plainStruct {
 	return v.Field(name, "*string")
 }
@@ -53,6 +76,37 @@ func (v *plainStruct) OptionalNumber(dbName string) *plainStruct {
 	return v.Field(dbName, "*int")
 }
 
+// FieldFrom adds field which is taken from the db field with a different name
+func (v *plainStruct) FieldFrom(name string, kind string, dbName string) *plainStruct {
+	return v.mappedField(name, kind, &fieldMapping{kind: fieldMappingKindDirect, dbNames: []string{dbName}})
+}
+
+// Identifier adds identifier field constructed from the given db fields, e.g. Identifier("ID", g.KindOfT[SchemaObjectIdentifier](), "database_name", "schema_name", "name")
+func (v *plainStruct) Identifier(name string, identifierKind string, dbNames ...string) *plainStruct {
+	return v.mappedField(name, identifierKind, &fieldMapping{kind: fieldMappingKindIdentifier, dbNames: dbNames})
+}
+
+// List adds []string field parsed from comma-separated list (e.g. "a, b" or "[a, b]") returned in the given db field
+func (v *plainStruct) List(name string, dbName string) *plainStruct {
+	return v.mappedField(name, "[]string", &fieldMapping{kind: fieldMappingKindList, dbNames: []string{dbName}})
+}
+
+// ParsedTime adds time.Time field parsed from the timestamp returned as text in the given db field
+func (v *plainStruct) ParsedTime(name string, dbName string) *plainStruct {
+	return v.mappedField(name, "time.Time", &fieldMapping{kind: fieldMappingKindTimestamp, dbNames: []string{dbName}})
+}
+
+// OptionalParsedTime adds *time.Time field parsed from the timestamp returned as text in the given db field
+func (v *plainStruct) OptionalParsedTime(name string, dbName string) *plainStruct {
+	return v.mappedField(name, "*time.Time", &fieldMapping{kind: fieldMappingKindTimestamp, dbNames: []string{dbName}})
+}
+
+// Converted adds field of the given kind which is a result of calling conversionFunc on the value of the given db field
+// (e.g. Converted("Type", "NetworkRuleType", "type", "NetworkRuleType") for enums)
+func (v *plainStruct) Converted(name string, kind string, dbName string, conversionFunc string) *plainStruct {
+	return v.mappedField(name, kind, &fieldMapping{kind: fieldMappingKindConverted, dbNames: []string{dbName}, conversionFunc: conversionFunc})
+}
+
 func (v *plainStruct) IntoField() *Field {
 	f := NewField(v.name, v.name, nil, nil)
 	for _, field := range v.fields {
@@ -22,8 +22,12 @@ func NewQueryStruct(name string) *QueryStruct {
 }
 
 func (v *QueryStruct) IntoField() *Field {
+	fields := make([]*Field, len(v.fields))
+	for i, f := range v.fields {
+		fields[i] = f.clone()
+	}
 	return NewField(v.name, v.name, nil, nil).
-		withFields(v.fields...).
+		withFields(fields...).
 		withValidations(v.validations...)
 }
 
@@ -0,0 +1,614 @@
+package generator
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
+type ResourceAttributeKind string
+
+const (
+	ResourceAttributeKindString    ResourceAttributeKind = "string"
+	ResourceAttributeKindBool      ResourceAttributeKind = "bool"
+	ResourceAttributeKindInt       ResourceAttributeKind = "int"
+	ResourceAttributeKindStringSet ResourceAttributeKind = "string_set"
+)
+
+// ResourceDefinition maps SDK object definition to the Terraform resource, data source and their acceptance tests
+type ResourceDefinition struct {
+	// Interface is the SDK object definition (e.g. NetworkRuleDef)
+	Interface *Interface
+	// Name is the resource name without the provider prefix (e.g. "network_rule" for snowflake_network_rule)
+	Name string
+	// Attributes are resource attributes other than the ones derived from identifier (name, database, schema)
+	Attributes []*ResourceAttribute
+	// Builders are signatures of the DTO builders existing on disk, nil when unknown (then the builders are assumed
+	// to be generated by the current dto-builder-generator)
+	Builders *BuilderSignatures
+}
+
+// ResourceAttribute defines a single attribute of the resource and how it maps to the SDK requests and show output
+type ResourceAttribute struct {
+	Name        string
+	Kind        ResourceAttributeKind
+	Description string
+	Required    bool
+	ForceNew    bool
+	Sensitive   bool
+	// CreateField is the name of the field in Create request (attribute is computed when it's not used in Create nor Alter)
+	CreateField string
+	// SetField and UnsetField are dot-separated paths to the fields in Alter request (e.g. "Set.Comment"), ForceNew is implied when both are empty
+	SetField   string
+	UnsetField string
+	// ShowField is the name of the field in Show output used to read the attribute, attribute is not read when it's empty
+	ShowField string
+}
+
+func NewResourceDefinition(def *Interface, name string, attributes ...*ResourceAttribute) *ResourceDefinition {
+	return &ResourceDefinition{
+		Interface:  def,
+		Name:       name,
+		Attributes: attributes,
+	}
+}
+
+// WithBuilders makes the scaffolded code follow signatures of the given DTO builders
+func (r *ResourceDefinition) WithBuilders(builders *BuilderSignatures) *ResourceDefinition {
+	r.Builders = builders
+	return r
+}
+
+func ResourceAttr(name string, kind ResourceAttributeKind, description string) *ResourceAttribute {
+	return &ResourceAttribute{
+		Name:        name,
+		Kind:        kind,
+		Description: description,
+	}
+}
+
+func (a *ResourceAttribute) WithRequired() *ResourceAttribute {
+	a.Required = true
+	return a
+}
+
+func (a *ResourceAttribute) WithSensitive() *ResourceAttribute {
+	a.Sensitive = true
+	return a
+}
+
+func (a *ResourceAttribute) OnCreate(createField string) *ResourceAttribute {
+	a.CreateField = createField
+	return a
+}
+
+func (a *ResourceAttribute) OnSet(setField string) *ResourceAttribute {
+	a.SetField = setField
+	return a
+}
+
+func (a *ResourceAttribute) OnUnset(unsetField string) *ResourceAttribute {
+	a.UnsetField = unsetField
+	return a
+}
+
+func (a *ResourceAttribute) ReadFrom(showField string) *ResourceAttribute {
+	a.ShowField = showField
+	return a
+}
+
+// IsComputed checks if attribute can only be read
+func (a *ResourceAttribute) IsComputed() bool {
+	return a.CreateField == "" && a.SetField == "" && a.UnsetField == ""
+}
+
+// IsForceNew checks if change of the attribute requires recreation of the object
+func (a *ResourceAttribute) IsForceNew() bool {
+	return a.ForceNew || (!a.IsComputed() && a.SetField == "" && a.UnsetField == "")
+}
+
+func (a *ResourceAttribute) SchemaType() string {
+	switch a.Kind {
+	case ResourceAttributeKindBool:
+		return "schema.TypeBool"
+	case ResourceAttributeKindInt:
+		return "schema.TypeInt"
+	case ResourceAttributeKindStringSet:
+		return "schema.TypeSet"
+	default:
+		return "schema.TypeString"
+	}
+}
+
+// GoKind is the kind of the value in Terraform state
+func (a *ResourceAttribute) GoKind() string {
+	switch a.Kind {
+	case ResourceAttributeKindBool:
+		return "bool"
+	case ResourceAttributeKindInt:
+		return "int"
+	case ResourceAttributeKindStringSet:
+		return "[]string"
+	default:
+		return "string"
+	}
+}
+
+// ExampleValue is used in examples and acceptance tests skeletons
+func (a *ResourceAttribute) ExampleValue() string {
+	switch a.Kind {
+	case ResourceAttributeKindBool:
+		return "true"
+	case ResourceAttributeKindInt:
+		return "1"
+	case ResourceAttributeKindStringSet:
+		return `["TODO"]`
+	default:
+		return `"TODO"`
+	}
+}
+
+// TerraformName is the full resource name (e.g. snowflake_network_rule)
+func (r *ResourceDefinition) TerraformName() string {
+	return fmt.Sprintf("snowflake_%s", r.Name)
+}
+
+// TerraformPluralName is the data source name (e.g. snowflake_network_rules)
+func (r *ResourceDefinition) TerraformPluralName() string {
+	return fmt.Sprintf("snowflake_%s", r.PluralName())
+}
+
+func (r *ResourceDefinition) PluralName() string {
+	return toSnakeCase(r.Interface.Name)
+}
+
+// HumanName is used in descriptions and messages (e.g. "network rule")
+func (r *ResourceDefinition) HumanName() string {
+	return strings.ReplaceAll(r.Name, "_", " ")
+}
+
+func (r *ResourceDefinition) SchemaVarName() string {
+	return fmt.Sprintf("%sSchema", startingWithLowerCase(r.Interface.NameSingular))
+}
+
+func (r *ResourceDefinition) DataSourceSchemaVarName() string {
+	return fmt.Sprintf("%sSchema", startingWithLowerCase(r.Interface.Name))
+}
+
+// IdentifierAttributes are attributes from which the identifier of the object is built
+func (r *ResourceDefinition) IdentifierAttributes() []string {
+	switch r.Interface.IdentifierKind {
+	case "DatabaseObjectIdentifier":
+		return []string{"database", "name"}
+	case "SchemaObjectIdentifier":
+		return []string{"database", "schema", "name"}
+	default:
+		return []string{"name"}
+	}
+}
+
+// ContainerAttributes are attributes specifying the container of the object (used as data source filters)
+func (r *ResourceDefinition) ContainerAttributes() []string {
+	attributes := r.IdentifierAttributes()
+	return attributes[:len(attributes)-1]
+}
+
+// DataSourceFilterAttributes are container attributes used to narrow down Show in the data source (empty when Show does not support IN)
+func (r *ResourceDefinition) DataSourceFilterAttributes() []string {
+	show := r.operation(string(OperationKindShow))
+	if show == nil || findField(show.OptsField, "In") == nil {
+		return nil
+	}
+	return r.ContainerAttributes()
+}
+
+// CreateDoc is the link to the Snowflake documentation of the object
+func (r *ResourceDefinition) CreateDoc() string {
+	return r.mustOperation(string(OperationKindCreate)).Doc
+}
+
+// ShowDoc is the link to the Snowflake documentation of SHOW command
+func (r *ResourceDefinition) ShowDoc() string {
+	return r.mustOperation(string(OperationKindShow)).Doc
+}
+
+// IdentifierFromAttributes returns code creating the identifier from the attribute values (e.g. sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name))
+func (r *ResourceDefinition) IdentifierFromAttributes() string {
+	values := make([]string, 0)
+	for _, a := range r.ContainerAttributes() {
+		values = append(values, a+"Name")
+	}
+	return r.identifierConstructor(append(values, "name"))
+}
+
+// RenamedIdentifier returns code creating the identifier with the new name in the same container as the current id
+func (r *ResourceDefinition) RenamedIdentifier() string {
+	values := make([]string, 0)
+	for _, a := range r.ContainerAttributes() {
+		values = append(values, fmt.Sprintf("id.%sName()", startingWithUpperCase(a)))
+	}
+	return r.identifierConstructor(append(values, `d.Get("name").(string)`))
+}
+
+func (r *ResourceDefinition) identifierConstructor(values []string) string {
+	return fmt.Sprintf("sdk.New%s(%s)", r.Interface.IdentifierKind, strings.Join(values, ", "))
+}
+
+// DataSourceId returns code of the data source id
+func (r *ResourceDefinition) DataSourceId() string {
+	containers := r.DataSourceFilterAttributes()
+	if len(containers) == 0 {
+		return fmt.Sprintf("%q", r.PluralName()+"_read")
+	}
+	values := make([]string, len(containers))
+	for i, a := range containers {
+		values[i] = a + "Name"
+	}
+	return fmt.Sprintf("helpers.EncodeSnowflakeID(%s)", strings.Join(values, ", "))
+}
+
+// ImportIdExample returns example id used to import the resource (e.g. 'databaseName|schemaName|networkRuleName')
+func (r *ResourceDefinition) ImportIdExample() string {
+	parts := make([]string, 0)
+	for _, a := range r.ContainerAttributes() {
+		parts = append(parts, a+"Name")
+	}
+	return strings.Join(append(parts, r.VarName()+"Name"), "|")
+}
+
+// VarName is the name of variable holding single object (e.g. networkRule)
+func (r *ResourceDefinition) VarName() string {
+	return startingWithLowerCase(r.Interface.NameSingular)
+}
+
+// PluralVarName is the name of variable holding multiple objects (e.g. networkRules)
+func (r *ResourceDefinition) PluralVarName() string {
+	return startingWithLowerCase(r.Interface.Name)
+}
+
+// ShowObjectsKeyword is used in descriptions (e.g. NETWORK RULES)
+func (r *ResourceDefinition) ShowObjectsKeyword() string {
+	return strings.ToUpper(strings.ReplaceAll(r.PluralName(), "_", " "))
+}
+
+// IsRenameSupported checks if the Alter operation allows to change the name
+func (r *ResourceDefinition) IsRenameSupported() bool {
+	alter := r.operation(string(OperationKindAlter))
+	return alter != nil && findField(alter.OptsField, "RenameTo") != nil
+}
+
+// CreateRequest returns code creating Create request with all the required fields (without optional ones)
+func (r *ResourceDefinition) CreateRequest() string {
+	create := r.mustOperation(string(OperationKindCreate))
+	args := r.requiredArgs(create.OptsField, func(a *ResourceAttribute) string { return a.CreateField })
+	return fmt.Sprintf("sdk.New%s(%s)", create.OptsField.DtoDecl(), strings.Join(args, ", "))
+}
+
+// CreateOptionals returns code setting optional Create request fields from the attributes
+func (r *ResourceDefinition) CreateOptionals() string {
+	create := r.mustOperation(string(OperationKindCreate))
+	var b strings.Builder
+	for _, a := range r.Attributes {
+		if a.CreateField == "" {
+			continue
+		}
+		field := mustFindField(create.OptsField, a.CreateField)
+		if field.Required {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("if v, ok := d.GetOk(%q); ok {\n", a.Name))
+		b.WriteString(r.withCall("request", field, a, "v"))
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// AlterChanges returns code collecting Set/Unset changes of the updatable attributes
+func (r *ResourceDefinition) AlterChanges() string {
+	var b strings.Builder
+	for _, a := range r.Attributes {
+		if a.SetField == "" && a.UnsetField == "" {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("if d.HasChange(%q) {\n", a.Name))
+		switch {
+		case a.SetField != "" && a.UnsetField != "":
+			b.WriteString(fmt.Sprintf("if v, ok := d.GetOk(%q); ok {\n", a.Name))
+			b.WriteString(r.alterWithCall("set", a.SetField, a, "v"))
+			b.WriteString("} else {\n")
+			b.WriteString(r.alterWithCall("unset", a.UnsetField, a, ""))
+			b.WriteString("}\n")
+		case a.SetField != "":
+			b.WriteString(r.alterWithCall("set", a.SetField, a, fmt.Sprintf("d.Get(%q)", a.Name)))
+		default:
+			b.WriteString(fmt.Sprintf("if _, ok := d.GetOk(%q); !ok {\n", a.Name))
+			b.WriteString(r.alterWithCall("unset", a.UnsetField, a, ""))
+			b.WriteString("}\n")
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// AlterStructs returns the names of Set and Unset structs used in Alter (empty when not used)
+func (r *ResourceDefinition) AlterStructs() map[string]*Field {
+	structs := make(map[string]*Field)
+	alter := r.operation(string(OperationKindAlter))
+	for _, a := range r.Attributes {
+		for _, path := range []string{a.SetField, a.UnsetField} {
+			if path == "" {
+				continue
+			}
+			if alter == nil {
+				log.Panicf("Attribute %s is altered with %s, but there is no Alter operation in %s", a.Name, path, r.Interface.Name)
+			}
+			parts := strings.Split(path, ".")
+			if len(parts) != 2 {
+				log.Panicf("Alter path %s of attribute %s should have form <Struct>.<Field>", path, a.Name)
+			}
+			structs[parts[0]] = mustFindField(alter.OptsField, parts[0])
+		}
+	}
+	return structs
+}
+
+// AlterStructsInit returns code creating Set and Unset requests
+func (r *ResourceDefinition) AlterStructsInit() string {
+	var b strings.Builder
+	for _, name := range sortedKeys(r.AlterStructs()) {
+		field := r.AlterStructs()[name]
+		args := r.requiredArgs(field, func(a *ResourceAttribute) string {
+			if strings.HasPrefix(a.SetField, name+".") {
+				return strings.TrimPrefix(a.SetField, name+".")
+			}
+			return ""
+		})
+		b.WriteString(fmt.Sprintf("%s, %sChanged := sdk.New%s(%s), false\n", startingWithLowerCase(name), startingWithLowerCase(name), field.DtoDecl(), strings.Join(args, ", ")))
+	}
+	return b.String()
+}
+
+// AlterStructsExec returns code running Alter for each of the changed Set and Unset requests
+func (r *ResourceDefinition) AlterStructsExec() string {
+	var b strings.Builder
+	for _, name := range sortedKeys(r.AlterStructs()) {
+		v := startingWithLowerCase(name)
+		b.WriteString(fmt.Sprintf("if %sChanged {\n", v))
+		alter := fmt.Sprintf("Alter%sRequest", r.Interface.NameSingular)
+		arg := v
+		if !r.Builders.takesPointer(fmt.Sprintf("%s.With%s", alter, name), 0, false) {
+			arg = "*" + v
+		}
+		b.WriteString(fmt.Sprintf("if err := client.%s.Alter(ctx, sdk.New%s(id).With%s(%s)); err != nil {\n", r.Interface.Name, alter, name, arg))
+		b.WriteString("return diag.FromErr(err)\n}\n}\n")
+	}
+	return b.String()
+}
+
+// DropRequest returns code creating Drop request (with IF EXISTS when it's supported)
+func (r *ResourceDefinition) DropRequest() string {
+	drop := r.mustOperation(string(OperationKindDrop))
+	request := fmt.Sprintf("sdk.New%s(%s)", drop.OptsField.DtoDecl(), strings.Join(r.requiredArgs(drop.OptsField, func(*ResourceAttribute) string { return "" }), ", "))
+	if findField(drop.OptsField, "IfExists") != nil {
+		request += fmt.Sprintf(".WithIfExists(%s)", r.builderArg(drop.OptsField.DtoDecl()+".WithIfExists", "true", "sdk.Bool(true)"))
+	}
+	return request
+}
+
+// RenameRequest returns code creating Alter request renaming the object to newId
+func (r *ResourceDefinition) RenameRequest() string {
+	alter := fmt.Sprintf("Alter%sRequest", r.Interface.NameSingular)
+	return fmt.Sprintf("sdk.New%s(id).WithRenameTo(%s)", alter, r.builderArg(alter+".WithRenameTo", "newId", "&newId"))
+}
+
+// ShowLikeCall returns code setting LIKE pattern (kept in v) in the data source Show request
+func (r *ResourceDefinition) ShowLikeCall() string {
+	like := "sdk.Like{Pattern: sdk.String(v.(string))}"
+	show := r.mustOperation(string(OperationKindShow))
+	return fmt.Sprintf("request.WithLike(%s)", r.builderArg(show.OptsField.DtoDecl()+".WithLike", like, "&"+like))
+}
+
+// builderArg returns the value or the pointer variant of the argument, depending on the builder method signature
+func (r *ResourceDefinition) builderArg(method string, value string, pointer string) string {
+	if r.Builders.takesPointer(method, 0, false) {
+		return pointer
+	}
+	return value
+}
+
+// ShowRequest returns code creating Show request for the data source
+func (r *ResourceDefinition) ShowRequest() string {
+	show := r.mustOperation(string(OperationKindShow))
+	request := fmt.Sprintf("sdk.New%s()", show.OptsField.DtoDecl())
+	var in string
+	switch r.Interface.IdentifierKind {
+	case "DatabaseObjectIdentifier":
+		in = "sdk.In{Database: sdk.NewAccountObjectIdentifier(databaseName)}"
+	case "SchemaObjectIdentifier":
+		in = "sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)}"
+	}
+	if in != "" && findField(show.OptsField, "In") != nil {
+		request += fmt.Sprintf(".WithIn(%s)", r.builderArg(show.OptsField.DtoDecl()+".WithIn", in, "&"+in))
+	}
+	return request
+}
+
+// HasShowLike checks if data source can filter objects with LIKE
+func (r *ResourceDefinition) HasShowLike() bool {
+	show := r.operation(string(OperationKindShow))
+	return show != nil && findField(show.OptsField, "Like") != nil
+}
+
+// ReadAttributes are attributes read from the Show output
+func (r *ResourceDefinition) ReadAttributes() []*ResourceAttribute {
+	attributes := make([]*ResourceAttribute, 0)
+	for _, a := range r.Attributes {
+		if a.ShowField != "" {
+			attributes = append(attributes, a)
+		}
+	}
+	return attributes
+}
+
+// ShowFieldValue returns code reading the attribute value from the Show output kept in the given variable
+func (r *ResourceDefinition) ShowFieldValue(variable string, a *ResourceAttribute) string {
+	show := r.mustOperation(string(OperationKindShow))
+	var outputField *Field
+	for _, f := range show.ShowMapping.To.Fields {
+		if f.Name == a.ShowField {
+			outputField = f
+		}
+	}
+	if outputField == nil {
+		log.Panicf("Field %s read by attribute %s not found in %s", a.ShowField, a.Name, show.ShowMapping.To.Name)
+	}
+	value := fmt.Sprintf("%s.%s", variable, a.ShowField)
+	switch {
+	case outputField.Kind == a.GoKind():
+		return value
+	case outputField.Kind == KindOfPointer(a.GoKind()):
+		return fmt.Sprintf("%s /* TODO: handle nil */", value)
+	case a.Kind == ResourceAttributeKindString && !strings.HasPrefix(outputField.Kind, "[]") && !strings.HasPrefix(outputField.Kind, "*"):
+		return fmt.Sprintf("string(%s)", value)
+	default:
+		return fmt.Sprintf("%s /* TODO: convert %s to %s */", value, outputField.Kind, a.GoKind())
+	}
+}
+
+func (r *ResourceDefinition) operation(name string) *Operation {
+	idx := slices.IndexFunc(r.Interface.Operations, func(o *Operation) bool { return o.Name == name })
+	if idx == -1 {
+		return nil
+	}
+	return r.Interface.Operations[idx]
+}
+
+func (r *ResourceDefinition) mustOperation(name string) *Operation {
+	op := r.operation(name)
+	if op == nil {
+		log.Panicf("Operation %s is required to generate the resource, but it's missing in %s", name, r.Interface.Name)
+	}
+	return op
+}
+
+// requiredArgs returns arguments for the DTO constructor, fieldOf returns name of the field in the given struct set by attribute
+func (r *ResourceDefinition) requiredArgs(structField *Field, fieldOf func(*ResourceAttribute) string) []string {
+	constructor := "New" + dtoStructName(structField)
+	args := make([]string, 0)
+	for _, f := range structField.Fields {
+		if !f.Required || !f.ShouldBeInDto() {
+			continue
+		}
+		if f.Name == "name" {
+			args = append(args, "id")
+			continue
+		}
+		idx := slices.IndexFunc(r.Attributes, func(a *ResourceAttribute) bool { return fieldOf(a) == f.Name })
+		if idx == -1 {
+			log.Panicf("Required field %s of %s is not set by any of the attributes", f.Name, structField.DtoDecl())
+		}
+		pointer := r.Builders.takesPointer(constructor, len(args), f.IsPointer())
+		args = append(args, valueConversion(f, r.Attributes[idx], fmt.Sprintf("d.Get(%q)", r.Attributes[idx].Name), pointer))
+	}
+	return args
+}
+
+func (r *ResourceDefinition) alterWithCall(variable string, path string, a *ResourceAttribute, value string) string {
+	alter := r.mustOperation(string(OperationKindAlter))
+	parts := strings.Split(path, ".")
+	field := mustFindField(mustFindField(alter.OptsField, parts[0]), parts[1])
+	call := r.withCall(variable, field, a, value)
+	return fmt.Sprintf("%s%sChanged = true\n", call, variable)
+}
+
+func (r *ResourceDefinition) withCall(variable string, field *Field, a *ResourceAttribute, value string) string {
+	if field.Required {
+		// required fields are passed in the constructor
+		return ""
+	}
+	method := fmt.Sprintf("%s.With%s", dtoStructName(field.Parent), startingWithUpperCase(field.Name))
+	if value == "" {
+		if field.KindNoPtr() != "bool" {
+			return fmt.Sprintf("// TODO: unset %s.%s\n", variable, field.Name)
+		}
+		return fmt.Sprintf("%s.With%s(%s)\n", variable, startingWithUpperCase(field.Name), r.builderArg(method, "true", "sdk.Bool(true)"))
+	}
+	conversion := valueConversion(field, a, value, r.Builders.takesPointer(method, 0, false))
+	if strings.HasPrefix(conversion, "//") {
+		return conversion + "\n"
+	}
+	return fmt.Sprintf("%s.With%s(%s)\n", variable, startingWithUpperCase(field.Name), conversion)
+}
+
+// valueConversion returns code converting Terraform value to the kind of the DTO field; the value is wrapped in pointer
+// when the target parameter (of constructor or builder method, see BuilderSignatures) is a pointer
+func valueConversion(field *Field, a *ResourceAttribute, value string, pointer bool) string {
+	kind := strings.TrimPrefix(field.DtoKind(), "*")
+	var converted string
+	switch {
+	case a.Kind == ResourceAttributeKindStringSet && kind == "[]string":
+		converted = fmt.Sprintf("expandStringList(%s.(*schema.Set).List())", value)
+	case a.Kind == ResourceAttributeKindStringSet && kind == "[]AccountObjectIdentifier":
+		converted = fmt.Sprintf("expandAccountObjectIdentifiers(%s.(*schema.Set).List())", value)
+	case a.Kind != ResourceAttributeKindStringSet && kind == a.GoKind():
+		converted = fmt.Sprintf("%s.(%s)", value, a.GoKind())
+	case a.Kind == ResourceAttributeKindString && kind == "AccountObjectIdentifier":
+		converted = fmt.Sprintf("sdk.NewAccountObjectIdentifier(%s.(string))", value)
+	case a.Kind == ResourceAttributeKindString && !field.IsStruct() && !strings.HasPrefix(kind, "[]") && !strings.HasSuffix(kind, "Identifier"):
+		converted = fmt.Sprintf("sdk.%s(%s.(string))", kind, value)
+	default:
+		return fmt.Sprintf("// TODO: map %s to %s of kind %s", a.Name, field.Name, field.DtoKind())
+	}
+	if pointer {
+		return fmt.Sprintf("sdk.Pointer(%s)", converted)
+	}
+	return converted
+}
+
+// dtoStructName returns name of the DTO generated for the struct field (e.g. CreateStreamlitRequest or StreamlitSetRequest)
+func dtoStructName(structField *Field) string {
+	if structField.IsRoot() {
+		return structField.DtoDecl()
+	}
+	return fmt.Sprintf("%sRequest", structField.KindNoPtr())
+}
+
+func findField(parent *Field, name string) *Field {
+	if parent == nil {
+		return nil
+	}
+	idx := slices.IndexFunc(parent.Fields, func(f *Field) bool { return f.Name == name })
+	if idx == -1 {
+		return nil
+	}
+	return parent.Fields[idx]
+}
+
+func mustFindField(parent *Field, name string) *Field {
+	f := findField(parent, name)
+	if f == nil {
+		log.Panicf("Field %s not found in %s", name, parent.DtoDecl())
+	}
+	return f
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteRune('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(b.String())
+}
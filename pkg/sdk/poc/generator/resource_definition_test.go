@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueConversion(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Field     *Field
+		Attribute *ResourceAttribute
+		Result    string
+	}{
+		{
+			Name:      "string",
+			Field:     &Field{Name: "MainFile", Kind: "string"},
+			Attribute: ResourceAttr("main_file", ResourceAttributeKindString, ""),
+			Result:    `v.(string)`,
+		},
+		{
+			Name:      "optional string",
+			Field:     &Field{Name: "Comment", Kind: "*string"},
+			Attribute: ResourceAttr("comment", ResourceAttributeKindString, ""),
+			Result:    `v.(string)`,
+		},
+		{
+			Name:      "optional bool",
+			Field:     &Field{Name: "Enabled", Kind: "*bool"},
+			Attribute: ResourceAttr("enabled", ResourceAttributeKindBool, ""),
+			Result:    `v.(bool)`,
+		},
+		{
+			Name:      "string set",
+			Field:     &Field{Name: "AllowedValues", Kind: "[]string"},
+			Attribute: ResourceAttr("allowed_values", ResourceAttributeKindStringSet, ""),
+			Result:    `expandStringList(v.(*schema.Set).List())`,
+		},
+		{
+			Name:      "account object identifier",
+			Field:     &Field{Name: "Warehouse", Kind: "*AccountObjectIdentifier"},
+			Attribute: ResourceAttr("warehouse", ResourceAttributeKindString, ""),
+			Result:    `sdk.NewAccountObjectIdentifier(v.(string))`,
+		},
+		{
+			Name:      "enum",
+			Field:     &Field{Name: "Mode", Kind: "NetworkRuleMode"},
+			Attribute: ResourceAttr("mode", ResourceAttributeKindString, ""),
+			Result:    `sdk.NetworkRuleMode(v.(string))`,
+		},
+		{
+			Name:      "not supported",
+			Field:     &Field{Name: "Values", Kind: "[]NetworkRuleValue"},
+			Attribute: ResourceAttr("values", ResourceAttributeKindStringSet, ""),
+			Result:    `// TODO: map values to Values of kind []NetworkRuleValue`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Field.Parent = &Field{Name: "CreateSomeOptions", Kind: "CreateSomeOptions"}
+			assert.Equal(t, tc.Result, valueConversion(tc.Field, tc.Attribute, "v", false))
+		})
+	}
+}
+
+func TestValueConversion_ConstructorArgument(t *testing.T) {
+	field := &Field{Name: "RootLocation", Kind: "*string", Parent: &Field{Name: "StreamlitSet", Kind: "*StreamlitSet"}}
+	assert.Equal(t, `sdk.Pointer(d.Get("root_location").(string))`, valueConversion(field, ResourceAttr("root_location", ResourceAttributeKindString, ""), `d.Get("root_location")`, true))
+}
+
+func TestResourceAttribute_IsForceNew(t *testing.T) {
+	assert.True(t, ResourceAttr("mode", ResourceAttributeKindString, "").OnCreate("Mode").IsForceNew())
+	assert.False(t, ResourceAttr("comment", ResourceAttributeKindString, "").OnCreate("Comment").OnSet("Set.Comment").IsForceNew())
+	assert.False(t, ResourceAttr("owner", ResourceAttributeKindString, "").ReadFrom("Owner").IsForceNew())
+}
@@ -0,0 +1,58 @@
+package generator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScaffolding_StreamlitResourceDef scaffolds resource, data source and their acceptance tests from StreamlitResourceDef
+// (the same way as make scaffold-resource-streamlits does) and type-checks them in place of the existing files
+// (with go vet and build overlay, so that the repository files are not changed).
+func TestScaffolding_StreamlitResourceDef(t *testing.T) {
+	moduleRoot, err := filepath.Abs(filepath.Join("..", "..", "..", ".."))
+	require.NoError(t, err)
+
+	def := sdk.StreamlitResourceDef
+	def.Interface.Preprocess()
+	builders, err := os.ReadFile(filepath.Join(moduleRoot, "pkg", "sdk", "streamlits_dto_builders_gen.go"))
+	require.NoError(t, err)
+	signatures, err := generator.ParseBuilderSignatures(builders)
+	require.NoError(t, err)
+	def.WithBuilders(signatures)
+
+	tmpDir := t.TempDir()
+	replace := make(map[string]string)
+	scaffold := func(genFunc func(io.Writer, *generator.ResourceDefinition), target string) {
+		buffer := bytes.Buffer{}
+		genFunc(&buffer, def)
+		src, err := format.Source(buffer.Bytes())
+		require.NoError(t, err)
+		scaffolded := filepath.Join(tmpDir, filepath.Base(target))
+		require.NoError(t, os.WriteFile(scaffolded, src, 0o600))
+		replace[filepath.Join(moduleRoot, target)] = scaffolded
+	}
+	scaffold(generator.GenerateResource, filepath.Join("pkg", "resources", "streamlit.go"))
+	scaffold(generator.GenerateResourceAcceptanceTests, filepath.Join("pkg", "resources", "streamlit_acceptance_test.go"))
+	scaffold(generator.GenerateDataSource, filepath.Join("pkg", "datasources", "streamlits.go"))
+	scaffold(generator.GenerateDataSourceAcceptanceTests, filepath.Join("pkg", "datasources", "streamlits_acceptance_test.go"))
+
+	overlay, err := json.Marshal(map[string]any{"Replace": replace})
+	require.NoError(t, err)
+	overlayFile := filepath.Join(tmpDir, "overlay.json")
+	require.NoError(t, os.WriteFile(overlayFile, overlay, 0o600))
+
+	cmd := exec.Command("go", "vet", "-overlay", overlayFile, "./pkg/resources/", "./pkg/datasources/")
+	cmd.Dir = moduleRoot
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"io"
+)
+
+func GenerateResource(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, PackageTemplate, "resources")
+	printTo(writer, ResourceTemplate, def)
+}
+
+func GenerateDataSource(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, PackageTemplate, "datasources")
+	printTo(writer, DataSourceTemplate, def)
+}
+
+func GenerateResourceAcceptanceTests(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, PackageTemplate, "resources_test")
+	printTo(writer, ResourceAcceptanceTestTemplate, def)
+}
+
+func GenerateDataSourceAcceptanceTests(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, PackageTemplate, "datasources_test")
+	printTo(writer, DataSourceAcceptanceTestTemplate, def)
+}
+
+func GenerateResourceExample(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, ResourceExampleTemplate, def)
+}
+
+func GenerateImportExample(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, ImportExampleTemplate, def)
+}
+
+func GenerateDataSourceExample(writer io.Writer, def *ResourceDefinition) {
+	printTo(writer, DataSourceExampleTemplate, def)
+}
@@ -0,0 +1,419 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+)
+
+var resourceTemplateFuncs = template.FuncMap{
+	"camel": startingWithUpperCase,
+	"human": func(s string) string { return strings.ReplaceAll(s, "_", " ") },
+}
+
+var ResourceTemplate, _ = template.New("resourceTemplate").Funcs(resourceTemplateFuncs).Parse(`
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var {{ .SchemaVarName }} = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		{{- if not .IsRenameSupported }}
+		ForceNew:    true,
+		{{- end }}
+		Description: "Specifies the identifier for the {{ .HumanName }}.",
+	},
+	{{- range .ContainerAttributes }}
+	"{{ . }}": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The {{ . }} in which to create the {{ $.HumanName }}.",
+	},
+	{{- end }}
+	{{- range .Attributes }}
+	"{{ .Name }}": {
+		Type:        {{ .SchemaType }},
+		{{- if eq .Kind "string_set" }}
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		{{- end }}
+		{{- if .IsComputed }}
+		Computed:    true,
+		{{- else if .Required }}
+		Required:    true,
+		{{- else }}
+		Optional:    true,
+		{{- end }}
+		{{- if and (not .IsComputed) .IsForceNew }}
+		ForceNew:    true,
+		{{- end }}
+		{{- if .Sensitive }}
+		Sensitive:   true,
+		{{- end }}
+		Description: "{{ .Description }}",
+	},
+	{{- end }}
+}
+
+// {{ .Interface.NameSingular }} returns a pointer to the resource representing a {{ .HumanName }}.
+func {{ .Interface.NameSingular }}() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource used to manage {{ .HumanName }} objects. For more information, check [{{ .HumanName }} documentation]({{ .CreateDoc }}).",
+
+		CreateContext: CreateContext{{ .Interface.NameSingular }},
+		ReadContext:   ReadContext{{ .Interface.NameSingular }},
+		UpdateContext: UpdateContext{{ .Interface.NameSingular }},
+		DeleteContext: DeleteContext{{ .Interface.NameSingular }},
+
+		Schema: {{ .SchemaVarName }},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func CreateContext{{ .Interface.NameSingular }}(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	{{- range .ContainerAttributes }}
+	{{ . }}Name := d.Get("{{ . }}").(string)
+	{{- end }}
+	name := d.Get("name").(string)
+	id := {{ .IdentifierFromAttributes }}
+
+	request := {{ .CreateRequest }}
+	{{ .CreateOptionals }}
+
+	if err := client.{{ .Interface.Name }}.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadContext{{ .Interface.NameSingular }}(ctx, d, meta)
+}
+
+func ReadContext{{ .Interface.NameSingular }}(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .Interface.IdentifierKind }})
+
+	{{ .VarName }}, err := client.{{ .Interface.Name }}.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve {{ .HumanName }}. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", id.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	{{- range .ContainerAttributes }}
+	if err := d.Set("{{ . }}", id.{{ camel . }}Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	{{- end }}
+	{{- range .ReadAttributes }}
+	if err := d.Set("{{ .Name }}", {{ $.ShowFieldValue $.VarName . }}); err != nil {
+		return diag.FromErr(err)
+	}
+	{{- end }}
+
+	return nil
+}
+
+func UpdateContext{{ .Interface.NameSingular }}(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .Interface.IdentifierKind }})
+	{{- if .IsRenameSupported }}
+
+	if d.HasChange("name") {
+		newId := {{ .RenamedIdentifier }}
+		if err := client.{{ .Interface.Name }}.Alter(ctx, {{ .RenameRequest }}); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newId))
+		id = newId
+	}
+	{{- end }}
+
+	{{ .AlterStructsInit }}
+	{{ .AlterChanges }}
+	{{ .AlterStructsExec }}
+
+	return ReadContext{{ .Interface.NameSingular }}(ctx, d, meta)
+}
+
+func DeleteContext{{ .Interface.NameSingular }}(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.{{ .Interface.IdentifierKind }})
+
+	if err := client.{{ .Interface.Name }}.Drop(ctx, {{ .DropRequest }}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+`)
+
+var DataSourceTemplate, _ = template.New("dataSourceTemplate").Funcs(resourceTemplateFuncs).Parse(`
+import (
+	"context"
+
+	{{- if .DataSourceFilterAttributes }}
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	{{- end }}
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var {{ .DataSourceSchemaVarName }} = map[string]*schema.Schema{
+	{{- range .DataSourceFilterAttributes }}
+	"{{ . }}": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The {{ . }} from which to return the {{ $.PluralName }}.",
+	},
+	{{- end }}
+	{{- if .HasShowLike }}
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output with **case-insensitive** pattern, with support for SQL wildcard characters (` + "`%`" + ` and ` + "`_`" + `).",
+	},
+	{{- end }}
+	"{{ .PluralName }}": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW {{ .ShowObjectsKeyword }}.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				{{- range .ContainerAttributes }}
+				"{{ . }}": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				{{- end }}
+				{{- range .ReadAttributes }}
+				"{{ .Name }}": {
+					Type:     {{ .SchemaType }},
+					{{- if eq .Kind "string_set" }}
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					{{- end }}
+					Computed: true,
+					{{- if .Sensitive }}
+					Sensitive: true,
+					{{- end }}
+				},
+				{{- end }}
+			},
+		},
+	},
+}
+
+func {{ .Interface.Name }}() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source used to get details of filtered {{ .PluralName | human }}. Filtering is aligned with the current possibilities for [SHOW {{ .ShowObjectsKeyword }}]({{ .ShowDoc }}) query.",
+		ReadContext: ReadContext{{ .Interface.Name }},
+		Schema:      {{ .DataSourceSchemaVarName }},
+	}
+}
+
+func ReadContext{{ .Interface.Name }}(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	{{- range .DataSourceFilterAttributes }}
+	{{ . }}Name := d.Get("{{ . }}").(string)
+	{{- end }}
+
+	request := {{ .ShowRequest }}
+	{{- if .HasShowLike }}
+	if v, ok := d.GetOk("like"); ok {
+		{{ .ShowLikeCall }}
+	}
+	{{- end }}
+
+	{{ .PluralVarName }}, err := client.{{ .Interface.Name }}.Show(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId({{ .DataSourceId }})
+
+	result := make([]map[string]any, len({{ .PluralVarName }}))
+	for i, {{ .VarName }} := range {{ .PluralVarName }} {
+		result[i] = map[string]any{
+			"name": {{ .VarName }}.Name,
+			{{- range .ContainerAttributes }}
+			"{{ . }}": {{ $.VarName }}.{{ camel . }}Name,
+			{{- end }}
+			{{- range .ReadAttributes }}
+			"{{ .Name }}": {{ $.ShowFieldValue $.VarName . }},
+			{{- end }}
+		}
+	}
+	if err := d.Set("{{ .PluralName }}", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+`)
+
+var ResourceAcceptanceTestTemplate, _ = template.New("resourceAcceptanceTestTemplate").Parse(`
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_{{ .Interface.NameSingular }}_basic(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.{{ .Interface.NameSingular }}),
+		Steps: []resource.TestStep{
+			{
+				Config: {{ .VarName }}Config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("{{ .TerraformName }}.test", "name", name),
+					{{- range .ContainerAttributes }}
+					resource.TestCheckResourceAttr("{{ $.TerraformName }}.test", "{{ . }}", acc.Test{{ if eq . "database" }}Database{{ else }}Schema{{ end }}Name),
+					{{- end }}
+					// TODO: add checks of the remaining attributes
+				),
+			},
+			{
+				ResourceName:      "{{ .TerraformName }}.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func {{ .VarName }}Config(name string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{ .TerraformName }}" "test" {
+	name = "%[1]s"
+	{{- range .ContainerAttributes }}
+	{{ . }} = "%[{{ if eq . "database" }}2{{ else }}3{{ end }}]s"
+	{{- end }}
+	{{- range .Attributes }}
+	{{- if .Required }}
+	{{ .Name }} = {{ .ExampleValue }}
+	{{- end }}
+	{{- end }}
+}
+` + "`" + `, name{{ range .ContainerAttributes }}, acc.Test{{ if eq . "database" }}Database{{ else }}Schema{{ end }}Name{{ end }})
+}
+`)
+
+var DataSourceAcceptanceTestTemplate, _ = template.New("dataSourceAcceptanceTestTemplate").Parse(`
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_{{ .Interface.Name }}(t *testing.T) {
+	name := acc.TestClient().Ids.Alpha()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: {{ .PluralVarName }}Config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.{{ .TerraformPluralName }}.test", "{{ .PluralName }}.#", "1"),
+					resource.TestCheckResourceAttr("data.{{ .TerraformPluralName }}.test", "{{ .PluralName }}.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func {{ .PluralVarName }}Config(name string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{ .TerraformName }}" "test" {
+	name = "%[1]s"
+	{{- range .ContainerAttributes }}
+	{{ . }} = "%[{{ if eq . "database" }}2{{ else }}3{{ end }}]s"
+	{{- end }}
+	{{- range .Attributes }}
+	{{- if .Required }}
+	{{ .Name }} = {{ .ExampleValue }}
+	{{- end }}
+	{{- end }}
+}
+
+data "{{ .TerraformPluralName }}" "test" {
+	{{- range .DataSourceFilterAttributes }}
+	{{ . }} = {{ $.TerraformName }}.test.{{ . }}
+	{{- end }}
+	{{- if .HasShowLike }}
+	like = {{ .TerraformName }}.test.name
+	{{- end }}
+	depends_on = [{{ .TerraformName }}.test]
+}
+` + "`" + `, name{{ range .ContainerAttributes }}, acc.Test{{ if eq . "database" }}Database{{ else }}Schema{{ end }}Name{{ end }})
+}
+`)
+
+var ResourceExampleTemplate, _ = template.New("resourceExampleTemplate").Parse(`resource "{{ .TerraformName }}" "example" {
+  name = "{{ .Name }}"
+{{- range .ContainerAttributes }}
+  {{ . }} = "{{ . }}_name"
+{{- end }}
+{{- range .Attributes }}
+{{- if not .IsComputed }}
+  {{ .Name }} = {{ .ExampleValue }}
+{{- end }}
+{{- end }}
+}
+`)
+
+var ImportExampleTemplate, _ = template.New("importExampleTemplate").Parse(`terraform import {{ .TerraformName }}.example '{{ .ImportIdExample }}'
+`)
+
+var DataSourceExampleTemplate, _ = template.New("dataSourceExampleTemplate").Parse(`data "{{ .TerraformPluralName }}" "example" {
+{{- range .DataSourceFilterAttributes }}
+  {{ . }} = "{{ . }}_name"
+{{- end }}
+}
+`)
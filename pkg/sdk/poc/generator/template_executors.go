@@ -22,6 +22,7 @@ func GenerateInterface(writer io.Writer, def *Interface) {
 			generateOptionsStruct(writer, o)
 		}
 	}
+	printTo(writer, AdditionalCodeTemplate, nil)
 }
 
 func generateOptionsStruct(writer io.Writer, operation *Operation) {
@@ -93,10 +94,20 @@ func GenerateValidations(writer io.Writer, def *Interface) {
 }
 
 func GenerateIntegrationTests(writer io.Writer, def *Interface) {
-	generatePackageDirective(writer)
+	_, pkg := IntegrationTestsLocation()
+	printTo(writer, PackageTemplate, pkg)
 	printTo(writer, IntegrationTestsTemplate, def)
 }
 
+// IntegrationTestsLocation returns directory (relative to the definition) and package of the integration tests;
+// SDK integration tests are kept in the separate testint package, other packages (e.g. example) keep them next to the definition
+func IntegrationTestsLocation() (string, string) {
+	if pkg := os.Getenv("GOPACKAGE"); pkg != "sdk" {
+		return ".", pkg
+	}
+	return "testint", "testint"
+}
+
 func generatePackageDirective(writer io.Writer) {
 	printTo(writer, PackageTemplate, os.Getenv("GOPACKAGE"))
 }
@@ -18,7 +18,7 @@ type {{ .Name }} interface {
 		{{- if and (eq .Name "Show") .ShowMapping }}
 			{{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ([]{{ .ShowMapping.To.Name }}, error)
 		{{- else if eq .Name "ShowByID" }}
-			{{ .Name }}(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.NameSingular }}, error)
+			{{ .Name }}(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.ShowObjectName }}, error)
 		{{- else if and (eq .Name "Describe") .DescribeMapping }}
 			{{- if .DescribeKind }}
 				{{- if eq (deref .DescribeKind) "single_value" }}
@@ -68,7 +68,7 @@ var DtoDeclTemplate, _ = template.New("dtoTemplate").Parse(`
 type {{ .DtoDecl }} struct {
 	{{- range .Fields }}
 		{{- if .ShouldBeInDto }}
-		{{ .Name }} {{ .DtoKind }} {{ if .Required }}// required{{ end }}
+		{{ .Name }} {{ .DtoKind }} {{ .DtoTags }} {{ if .Required }}// required{{ end }}
 		{{- end }}
 	{{- end }}
 }
@@ -79,40 +79,54 @@ var ImplementationTemplate, _ = template.New("implementationTemplate").
 		"deref": func(p *DescriptionMappingKind) string { return string(*p) },
 	}).
 	Parse(`
-{{ define "MAPPING" -}}
-	&{{ .KindNoPtr }}{
-		{{- range .Fields }}
-			{{- if .ShouldBeInDto }}
-			{{ if .IsStruct }}{{ else }}{{ .Name }}: r{{ .Path }},{{ end -}}
-			{{- end -}}
-		{{- end }}
-	}
+{{ define "MAPPING_FIELDS" -}}
+	{{- range .Fields }}
+		{{- if and .ShouldBeInDto (not .IsStruct) }}
+		{{ .Name }}: {{ .MappingSource }},
+		{{- end -}}
+	{{- end }}
+{{- end }}
+{{ define "MAPPING_STRUCTS" -}}
 	{{- range .Fields }}
 		{{- if .ShouldBeInDto }}
-			{{- if .IsStruct }}
-				if r{{ .Path }} != nil {
+			{{- if and .IsStruct (not .IsSlice) (not .IsPointer) }}
+				{{ .MappingTarget }} = {{ template "MAPPING_VALUE" . -}}
+			{{- else if .IsStruct }}
+				if {{ .MappingSource }} != nil {
 					{{- if not .IsSlice }}
-						opts{{ .Path }} = {{ template "MAPPING" . -}}
+						{{ .MappingTarget }} = {{ template "MAPPING" . -}}
 					{{- else }}
-						s := make({{ .Kind }}, len(r{{ .Path }}))
-						for i, v := range r{{ .Path }} {
+						s := make({{ .Kind }}, len({{ .MappingSource }}))
+						for i, v := range {{ .MappingSource }} {
 							s[i] = {{ .KindNoSlice }}{
-							  {{- range .Fields }}
-								   {{ .Name }}: v.{{ .Name }},
-							  {{- end }}
+								{{- template "MAPPING_FIELDS" . }}
 							}
+							{{- template "MAPPING_STRUCTS" . }}
 						}
-						opts{{ .Path }} = s
+						{{ .MappingTarget }} = s
 					{{ end -}}
 				}
 			{{- end -}}
 		{{ end -}}
 	{{ end }}
+{{- end }}
+{{ define "MAPPING_VALUE" -}}
+	{{ .KindNoPtr }}{
+		{{- template "MAPPING_FIELDS" . }}
+	}
+	{{- template "MAPPING_STRUCTS" . }}
+{{- end }}
+{{ define "MAPPING" -}}
+	&{{ template "MAPPING_VALUE" . }}
 {{ end }}
 {{ define "MAPPING_FUNC" }}
 	func (r {{ .From.Name }}) {{ .MappingFuncName }}() *{{ .To.KindNoPtr }} {
+		{{- if .DerivesConversion }}
+		{{ .ConversionBody }}
+		{{- else }}
 		// TODO: Mapping
 		return &{{ .To.KindNoPtr }}{}
+		{{- end }}
 	}
 {{ end }}
 import (
@@ -130,6 +144,9 @@ type {{ $impl }} struct {
 {{ range .Operations }}
 	{{ if and (eq .Name "Show") .ShowMapping }}
 		func (v *{{ $impl }}) Show(ctx context.Context, request *{{ .OptsField.DtoDecl }}) ([]{{ .ShowMapping.To.Name }}, error) {
+			if err := validateRequest(request); err != nil {
+				return nil, err
+			}
 			opts := request.toOpts()
 			dbRows, err := validateAndQuery[{{ .ShowMapping.From.Name }}](v.client, ctx, opts)
 			if err != nil {
@@ -139,13 +156,15 @@ type {{ $impl }} struct {
 			return resultList, nil
 		}
 	{{ else if eq .Name "ShowByID" }}
-		func (v *{{ $impl }}) ShowByID(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.NameSingular }}, error) {
+		func (v *{{ $impl }}) ShowByID(ctx context.Context, id {{ .ObjectInterface.IdentifierKind }}) (*{{ .ObjectInterface.ShowObjectName }}, error) {
+			// custom:begin ShowByID
 			// TODO: adjust request if e.g. LIKE is supported for the resource
 			{{ $impl }}, err := v.Show(ctx, NewShow{{ .ObjectInterface.NameSingular }}Request())
 			if err != nil {
 				return nil, err
 			}
-			return collections.FindOne({{ $impl }}, func(r {{ .ObjectInterface.NameSingular }}) bool { return r.Name == id.Name() })
+			return collections.FindOne({{ $impl }}, func(r {{ .ObjectInterface.ShowObjectName }}) bool { return r.Name == id.Name() })
+			// custom:end ShowByID
 		}
 	{{ else if and (eq .Name "Describe") .DescribeMapping }}
 		{{ if .DescribeKind }}
@@ -175,6 +194,9 @@ type {{ $impl }} struct {
 		{{ end }}
 	{{ else }}
 		func (v *{{ $impl }}) {{ .Name }}(ctx context.Context, request *{{ .OptsField.DtoDecl }}) error {
+			if err := validateRequest(request); err != nil {
+				return err
+			}
 			opts := request.toOpts()
 			return validateAndExec(v.client, ctx, opts)
 		}
@@ -195,6 +217,9 @@ type {{ $impl }} struct {
 	{{ end }}
 	{{- end}}
 {{ end }}
+
+// custom:begin additional
+// custom:end additional
 `)
 
 var TestFuncTemplate, _ = template.New("testFuncTemplate").Parse(`
@@ -202,9 +227,11 @@ var TestFuncTemplate, _ = template.New("testFuncTemplate").Parse(`
 	{{ $field := . }}
 	{{- range .Validations }}
 		t.Run("{{ .TodoComment $field }}", func(t *testing.T) {
+			// custom:begin {{ .RegionName $field }}
 			opts := defaultOpts()
 			// TODO: fill me
 			assertOptsInvalidJoinedErrors(t, opts, {{ .ReturnedError $field }})
+			// custom:end {{ .RegionName $field }}
 		})
 	{{ end -}}
 {{ end }}
@@ -223,14 +250,20 @@ import "testing"
 {{ range .Operations }}
 	{{- if .OptsField }}
 	func Test{{ .ObjectInterface.Name }}_{{ .Name }}(t *testing.T) {
+		// custom:begin {{ .OptsField.KindNoPtr }}: default options
+		{{- if .OptsField.HasName }}
 		id := random{{ .ObjectInterface.IdentifierKind }}()
+		{{- end }}
 
 		// Minimal valid {{ .OptsField.KindNoPtr }}
 		defaultOpts := func() *{{ .OptsField.KindNoPtr }} {
 			return &{{ .OptsField.KindNoPtr }}{
+				{{- if .OptsField.HasName }}
 				name: id,
+				{{- end }}
 			}
 		}
+		// custom:end {{ .OptsField.KindNoPtr }}: default options
 
 		t.Run("validation: nil options", func(t *testing.T) {
 			var opts *{{ .OptsField.KindNoPtr }} = nil
@@ -240,19 +273,29 @@ import "testing"
 		{{- template "VALIDATIONS" .OptsField }}
 
 		t.Run("basic", func(t *testing.T) {
+			// custom:begin {{ .OptsField.KindNoPtr }}: basic
 			opts := defaultOpts()
 			// TODO: fill me
 			assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+			// custom:end {{ .OptsField.KindNoPtr }}: basic
 		})
 
 		t.Run("all options", func(t *testing.T) {
+			// custom:begin {{ .OptsField.KindNoPtr }}: all options
 			opts := defaultOpts()
 			// TODO: fill me
 			assertOptsValidAndSQLEquals(t, opts, "TODO: fill me")
+			// custom:end {{ .OptsField.KindNoPtr }}: all options
 		})
+
+		// custom:begin {{ .OptsField.KindNoPtr }}: additional test cases
+		// custom:end {{ .OptsField.KindNoPtr }}: additional test cases
 	}
 	{{- end }}
 {{ end }}
+
+// custom:begin additional
+// custom:end additional
 `)
 
 var ValidationsImplTemplate, _ = template.New("validationsImplTemplate").Parse(`
@@ -265,9 +308,15 @@ var ValidationsImplTemplate, _ = template.New("validationsImplTemplate").Parse(`
 	{{- end -}}
 	{{- range .Fields }}
 		{{- if .HasAnyValidationInSubtree }}
-			if valueSet(opts{{ .Path }}) {
+			{{- if .IsSlice }}
+			for _, v := range {{ .ValidationSource }} {
 				{{- template "VALIDATIONS" . }}
 			}
+			{{- else }}
+			if valueSet({{ .ValidationSource }}) {
+				{{- template "VALIDATIONS" . }}
+			}
+			{{- end }}
 		{{- end -}}
 	{{- end -}}
 {{ end }}
@@ -287,22 +336,40 @@ var (
 		}
 		var errs []error
 		{{- template "VALIDATIONS" .OptsField }}
+		// custom:begin {{ .OptsField.KindNoPtr }}: additional validations
+		// custom:end {{ .OptsField.KindNoPtr }}: additional validations
 		return JoinErrors(errs...)
 	}
 	{{- end }}
 {{ end }}
+
+// custom:begin additional
+// custom:end additional
+`)
+
+// AdditionalCodeTemplate is put at the end of generated files that do not end with their own custom region
+var AdditionalCodeTemplate, _ = template.New("additionalCodeTemplate").Parse(`
+// custom:begin additional
+// custom:end additional
 `)
 
 var IntegrationTestsTemplate, _ = template.New("integrationTestsTemplate").Parse(`
 import "testing"
 
 func TestInt_{{ .Name }}(t *testing.T) {
+	// custom:begin common resources
 	// TODO: prepare common resources
+	// custom:end common resources
 
 	{{ range .Operations }}
 	t.Run("{{ .Name }}", func(t *testing.T) {
+		// custom:begin {{ .Name }}
 		// TODO: fill me
+		// custom:end {{ .Name }}
 	})
 	{{ end -}}
 }
+
+// custom:begin additional
+// custom:end additional
 `)
@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	// Split by empty space or underscore
-	splitSQLPattern   = regexp.MustCompile(`\s+|_`)
+	// Split by empty space or underscore, question mark is dropped (e.g. "null?" column in DESCRIBE output)
+	splitSQLPattern   = regexp.MustCompile(`\s+|_|\?`)
 	englishLowerCaser = cases.Lower(language.English)
 	englishTitleCaser = cases.Title(language.English)
 )
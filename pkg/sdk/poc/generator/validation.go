@@ -53,22 +53,36 @@ func (v *Validation) fieldsWithPath(field *Field) []string {
 	return params
 }
 
+// validatedFields returns the validated fields as they are referenced in validate(), fields of slice elements
+// are referenced through the loop variable
+func (v *Validation) validatedFields(field *Field) []string {
+	source := field.ValidationSource()
+	if field.IsSlice() {
+		source = "v"
+	}
+	params := make([]string, len(v.FieldNames))
+	for i, s := range v.FieldNames {
+		params[i] = fmt.Sprintf("%s.%s", source, s)
+	}
+	return params
+}
+
 func (v *Validation) Condition(field *Field) string {
 	switch v.Type {
 	case ValidIdentifier:
-		return fmt.Sprintf("!ValidObjectIdentifier(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("!ValidObjectIdentifier(%s)", strings.Join(v.validatedFields(field), ","))
 	case ValidIdentifierIfSet:
-		return fmt.Sprintf("%s != nil && !ValidObjectIdentifier(%s)", strings.Join(v.fieldsWithPath(field), ","), strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("%s != nil && !ValidObjectIdentifier(%s)", strings.Join(v.validatedFields(field), ","), strings.Join(v.validatedFields(field), ","))
 	case ConflictingFields:
-		return fmt.Sprintf("everyValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("moreThanOneValueSet(%s)", strings.Join(v.validatedFields(field), ","))
 	case ExactlyOneValueSet:
-		return fmt.Sprintf("!exactlyOneValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("!exactlyOneValueSet(%s)", strings.Join(v.validatedFields(field), ","))
 	case AtLeastOneValueSet:
-		return fmt.Sprintf("!anyValueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("!anyValueSet(%s)", strings.Join(v.validatedFields(field), ","))
 	case ValidateValueSet:
-		return fmt.Sprintf("!valueSet(%s)", strings.Join(v.fieldsWithPath(field), ","))
+		return fmt.Sprintf("!valueSet(%s)", strings.Join(v.validatedFields(field), ","))
 	case ValidateValue:
-		return fmt.Sprintf("err := %s.validate(); err != nil", strings.Join(v.fieldsWithPath(field.Parent), ","))
+		return fmt.Sprintf("err := %s.validate(); err != nil", strings.Join(v.validatedFields(field.Parent), ","))
 	}
 	panic("condition for validation unknown")
 }
@@ -112,3 +126,42 @@ func (v *Validation) TodoComment(field *Field) string {
 	}
 	panic("condition for validation unknown")
 }
+
+// validationRegionLabels are used in names of custom regions of validation test cases; unlike TodoComment
+// they do not depend on validated fields, so changing the fields in the definition does not rename the region
+var validationRegionLabels = map[ValidationType]string{
+	ValidIdentifier:      "valid identifier",
+	ValidIdentifierIfSet: "valid identifier if set",
+	ConflictingFields:    "conflicting fields",
+	ExactlyOneValueSet:   "exactly one value set",
+	AtLeastOneValueSet:   "at least one value set",
+	ValidateValueSet:     "value set",
+	ValidateValue:        "valid value",
+}
+
+// RegionName returns name of the custom region of validation test case, e.g. "AlterSecretOptions.Set: validation (at least one value set)"
+func (v *Validation) RegionName(field *Field) string {
+	return validationRegionName(field.PathWithRoot(), v.Type)
+}
+
+func validationRegionName(path string, validationType ValidationType) string {
+	return fmt.Sprintf("%s: validation (%s)", path, validationRegionLabels[validationType])
+}
+
+// DtoTag returns validation in the form understood by dto-builder-generator (empty when it's not supported there)
+func (v *Validation) DtoTag() string {
+	group := strings.Join(v.FieldNames, "|")
+	switch v.Type {
+	case ValidIdentifier:
+		return "validIdentifier"
+	case ValidIdentifierIfSet:
+		return "validIdentifierIfSet"
+	case ConflictingFields:
+		return fmt.Sprintf("conflictingFields=%s", group)
+	case ExactlyOneValueSet:
+		return fmt.Sprintf("exactlyOneValueSet=%s", group)
+	case AtLeastOneValueSet:
+		return fmt.Sprintf("atLeastOneValueSet=%s", group)
+	}
+	return ""
+}
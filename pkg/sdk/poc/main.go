@@ -4,10 +4,17 @@ package main
 
 import (
 	"bytes"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -16,39 +23,117 @@ import (
 )
 
 var definitionMapping = map[string]*generator.Interface{
-	"database_role_def.go":             example.DatabaseRole,
-	"network_policies_def.go":          sdk.NetworkPoliciesDef,
-	"session_policies_def.go":          sdk.SessionPoliciesDef,
-	"tasks_def.go":                     sdk.TasksDef,
-	"streams_def.go":                   sdk.StreamsDef,
-	"application_roles_def.go":         sdk.ApplicationRolesDef,
-	"views_def.go":                     sdk.ViewsDef,
-	"stages_def.go":                    sdk.StagesDef,
-	"functions_def.go":                 sdk.FunctionsDef,
-	"procedures_def.go":                sdk.ProceduresDef,
-	"event_tables_def.go":              sdk.EventTablesDef,
-	"application_packages_def.go":      sdk.ApplicationPackagesDef,
-	"storage_integration_def.go":       sdk.StorageIntegrationDef,
-	"managed_accounts_def.go":          sdk.ManagedAccountsDef,
-	"row_access_policies_def.go":       sdk.RowAccessPoliciesDef,
-	"applications_def.go":              sdk.ApplicationsDef,
-	"sequences_def.go":                 sdk.SequencesDef,
-	"materialized_views_def.go":        sdk.MaterializedViewsDef,
-	"api_integrations_def.go":          sdk.ApiIntegrationsDef,
-	"notification_integrations_def.go": sdk.NotificationIntegrationsDef,
-	"external_functions_def.go":        sdk.ExternalFunctionsDef,
-	"streamlits_def.go":                sdk.StreamlitsDef,
-	"network_rule_def.go":              sdk.NetworkRuleDef,
-	"security_integrations_def.go":     sdk.SecurityIntegrationsDef,
+	"database_role_def.go":                example.DatabaseRole,
+	"network_policies_def.go":             sdk.NetworkPoliciesDef,
+	"session_policies_def.go":             sdk.SessionPoliciesDef,
+	"tasks_def.go":                        sdk.TasksDef,
+	"streams_def.go":                      sdk.StreamsDef,
+	"application_roles_def.go":            sdk.ApplicationRolesDef,
+	"views_def.go":                        sdk.ViewsDef,
+	"stages_def.go":                       sdk.StagesDef,
+	"functions_def.go":                    sdk.FunctionsDef,
+	"procedures_def.go":                   sdk.ProceduresDef,
+	"event_tables_def.go":                 sdk.EventTablesDef,
+	"application_packages_def.go":         sdk.ApplicationPackagesDef,
+	"storage_integration_def.go":          sdk.StorageIntegrationDef,
+	"managed_accounts_def.go":             sdk.ManagedAccountsDef,
+	"row_access_policies_def.go":          sdk.RowAccessPoliciesDef,
+	"applications_def.go":                 sdk.ApplicationsDef,
+	"sequences_def.go":                    sdk.SequencesDef,
+	"materialized_views_def.go":           sdk.MaterializedViewsDef,
+	"api_integrations_def.go":             sdk.ApiIntegrationsDef,
+	"notification_integrations_def.go":    sdk.NotificationIntegrationsDef,
+	"external_functions_def.go":           sdk.ExternalFunctionsDef,
+	"streamlits_def.go":                   sdk.StreamlitsDef,
+	"network_rule_def.go":                 sdk.NetworkRuleDef,
+	"security_integrations_def.go":        sdk.SecurityIntegrationsDef,
+	"secrets_def.go":                      sdk.SecretsDef,
+	"external_access_integrations_def.go": sdk.ExternalAccessIntegrationsDef,
+	"external_volumes_def.go":             sdk.ExternalVolumesDef,
+	"catalog_integrations_def.go":         sdk.CatalogIntegrationsDef,
+	"iceberg_tables_def.go":               sdk.IcebergTablesDef,
+	"hybrid_tables_def.go":                sdk.HybridTablesDef,
+	"hybrid_table_indexes_def.go":         sdk.HybridTableIndexesDef,
+	"compute_pools_def.go":                sdk.ComputePoolsDef,
+	"image_repositories_def.go":           sdk.ImageRepositoriesDef,
+	"services_def.go":                     sdk.ServicesDef,
+	"service_endpoints_def.go":            sdk.ServiceEndpointsDef,
+	"git_repositories_def.go":             sdk.GitRepositoriesDef,
+	"git_branches_def.go":                 sdk.GitBranchesDef,
+	"git_tags_def.go":                     sdk.GitTagsDef,
+	"connections_def.go":                  sdk.ConnectionsDef,
+	"authentication_policies_def.go":      sdk.AuthenticationPoliciesDef,
+	"aggregation_policies_def.go":         sdk.AggregationPoliciesDef,
+	"projection_policies_def.go":          sdk.ProjectionPoliciesDef,
+	"packages_policies_def.go":            sdk.PackagesPoliciesDef,
+	"application_package_versions_def.go": sdk.ApplicationPackageVersionsDef,
+	"release_directives_def.go":           sdk.ReleaseDirectivesDef,
 }
 
+// resourceDefinitionMapping is used to scaffold resources and data sources (see scaffoldParts)
+var resourceDefinitionMapping = map[string]*generator.ResourceDefinition{
+	"streamlits_def.go": sdk.StreamlitResourceDef,
+}
+
+type generationPart string
+
+const (
+	partInterface        generationPart = "interface"
+	partDto              generationPart = "dto"
+	partBuilders         generationPart = "builders"
+	partValidations      generationPart = "validations"
+	partImpl             generationPart = "impl"
+	partUnitTests        generationPart = "unit_tests"
+	partIntegrationTests generationPart = "integration_tests"
+
+	partResource                  generationPart = "resource"
+	partDataSource                generationPart = "datasource"
+	partResourceAcceptanceTests   generationPart = "resource_acceptance_tests"
+	partDataSourceAcceptanceTests generationPart = "datasource_acceptance_tests"
+	partExamples                  generationPart = "examples"
+)
+
+// allParts is ordered, because builders are generated from the already saved DTOs
+var allParts = []generationPart{
+	partInterface,
+	partDto,
+	partBuilders,
+	partValidations,
+	partImpl,
+	partUnitTests,
+}
+
+// scaffoldParts are generated only when requested explicitly; they are written once (existing files are skipped) and edited by hand afterwards
+var scaffoldParts = []generationPart{
+	partIntegrationTests,
+	partResource,
+	partDataSource,
+	partResourceAcceptanceTests,
+	partDataSourceAcceptanceTests,
+	partExamples,
+}
+
+var (
+	partsFlag = flag.String("parts", "", fmt.Sprintf("comma-separated list of parts to (re)generate, all parts are generated when empty; available parts: %v, scaffolding parts: %v", allParts, scaffoldParts))
+	checkFlag = flag.Bool("check", false, "do not write any file, instead fail when any of the selected generated files is stale")
+)
+
 func main() {
+	flag.Parse()
 	file := os.Getenv("GOFILE")
 	fmt.Printf("Running generator on %s with args %#v\n", file, os.Args[1:])
 	definition := getDefinition(file)
+	parts, scaffolds := getParts(*partsFlag)
 
 	// runAllTemplatesToStdOut(definition)
-	runAllTemplatesAndSave(definition, file)
+	staleFiles := runTemplatesAndSave(definition, file, parts, *checkFlag)
+	if len(scaffolds) > 0 && !*checkFlag {
+		runScaffolding(definition, file, scaffolds)
+	}
+	if len(staleFiles) > 0 {
+		fmt.Printf("Generated files are stale: %v\n", staleFiles)
+		os.Exit(1)
+	}
 }
 
 func getDefinition(file string) *generator.Interface {
@@ -56,27 +141,41 @@ func getDefinition(file string) *generator.Interface {
 	if !ok {
 		log.Panicf("Definition for key %s not found", file)
 	}
-	preprocessDefinition(def)
+	def.Preprocess()
 	return def
 }
 
-// preprocessDefinition is needed because current simple builder is not ideal, should be removed later
-func preprocessDefinition(definition *generator.Interface) {
-	for _, o := range definition.Operations {
-		o.ObjectInterface = definition
-		if o.OptsField != nil {
-			o.OptsField.Name = fmt.Sprintf("%s%sOptions", o.Name, o.ObjectInterface.NameSingular)
-			o.OptsField.Kind = fmt.Sprintf("%s%sOptions", o.Name, o.ObjectInterface.NameSingular)
-			setParent(o.OptsField)
-		}
+func getResourceDefinition(file string) *generator.ResourceDefinition {
+	def, ok := resourceDefinitionMapping[file]
+	if !ok {
+		log.Panicf("Resource definition for key %s not found", file)
 	}
+	return def
 }
 
-func setParent(field *generator.Field) {
-	for _, f := range field.Fields {
-		f.Parent = field
-		setParent(f)
+// getParts returns generated parts and scaffolding parts selected in the flag
+func getParts(partsValue string) ([]generationPart, []generationPart) {
+	if partsValue == "" {
+		return allParts, nil
+	}
+	parts := make([]generationPart, 0)
+	scaffolds := make([]generationPart, 0)
+	for _, p := range strings.Split(partsValue, ",") {
+		part := generationPart(strings.TrimSpace(p))
+		switch {
+		case slices.Contains(allParts, part):
+			parts = append(parts, part)
+		case slices.Contains(scaffoldParts, part):
+			scaffolds = append(scaffolds, part)
+		default:
+			log.Panicf("Unknown generation part %s, available parts: %v, scaffolding parts: %v", part, allParts, scaffoldParts)
+		}
 	}
+	// keep the generation order regardless of the order in the flag
+	slices.SortFunc(parts, func(a, b generationPart) int {
+		return slices.Index(allParts, a) - slices.Index(allParts, b)
+	})
+	return parts, scaffolds
 }
 
 func runAllTemplatesToStdOut(definition *generator.Interface) {
@@ -89,20 +188,140 @@ func runAllTemplatesToStdOut(definition *generator.Interface) {
 	generator.GenerateIntegrationTests(writer, definition)
 }
 
-func runAllTemplatesAndSave(definition *generator.Interface, file string) {
+// runTemplatesAndSave generates selected parts and returns names of stale files (only in check mode, when nothing is saved)
+func runTemplatesAndSave(definition *generator.Interface, file string, parts []generationPart, check bool) []string {
+	fileWithoutSuffix, _ := strings.CutSuffix(file, "_def.go")
+	staleFiles := make([]string, 0)
+	for _, part := range parts {
+		var fileName string
+		var upToDate bool
+		switch part {
+		case partInterface:
+			fileName = filenameFor(fileWithoutSuffix, "")
+			upToDate = runTemplateAndSave(definition, generator.GenerateInterface, fileName, check)
+		case partDto:
+			fileName = filenameFor(fileWithoutSuffix, "_dto")
+			upToDate = runTemplateAndSave(definition, generator.GenerateDtos, fileName, check)
+		case partBuilders:
+			fileName = filenameFor(fileWithoutSuffix, "_dto_builders")
+			upToDate = runDtoBuilderGenerator(filenameFor(fileWithoutSuffix, "_dto"), check)
+		case partValidations:
+			fileName = filenameFor(fileWithoutSuffix, "_validations")
+			upToDate = runTemplateAndSave(definition, generator.GenerateValidations, fileName, check)
+		case partImpl:
+			fileName = filenameFor(fileWithoutSuffix, "_impl")
+			upToDate = runTemplateAndSave(definition, generator.GenerateImplementation, fileName, check)
+		case partUnitTests:
+			fileName = filename(fileWithoutSuffix, "_gen", "_test.go")
+			upToDate = runTemplateAndSave(definition, generator.GenerateUnitTests, fileName, check)
+		}
+		if !upToDate {
+			staleFiles = append(staleFiles, fileName)
+		}
+	}
+	return staleFiles
+}
+
+// runScaffolding writes integration tests, resource, data source, their acceptance tests and examples (relative to pkg/sdk) unless they already exist
+func runScaffolding(definition *generator.Interface, file string, scaffolds []generationPart) {
 	fileWithoutSuffix, _ := strings.CutSuffix(file, "_def.go")
-	runTemplateAndSave(definition, generator.GenerateInterface, filenameFor(fileWithoutSuffix, ""))
-	runTemplateAndSave(definition, generator.GenerateDtos, filenameFor(fileWithoutSuffix, "_dto"))
-	runTemplateAndSave(definition, generator.GenerateImplementation, filenameFor(fileWithoutSuffix, "_impl"))
-	runTemplateAndSave(definition, generator.GenerateUnitTests, filename(fileWithoutSuffix, "_gen", "_test.go"))
-	runTemplateAndSave(definition, generator.GenerateValidations, filenameFor(fileWithoutSuffix, "_validations"))
-	runTemplateAndSave(definition, generator.GenerateIntegrationTests, filename(fileWithoutSuffix, "_gen_integration", "_test.go"))
+	if slices.Contains(scaffolds, partIntegrationTests) {
+		dir, _ := generator.IntegrationTestsLocation()
+		fileName := filepath.Join(dir, filename(fileWithoutSuffix, "_gen_integration", "_test.go"))
+		buffer := bytes.Buffer{}
+		generator.GenerateIntegrationTests(&buffer, definition)
+		printScaffoldResult(generator.ScaffoldFile(&buffer, fileName), fileName)
+		scaffolds = slices.DeleteFunc(slices.Clone(scaffolds), func(part generationPart) bool { return part == partIntegrationTests })
+		if len(scaffolds) == 0 {
+			return
+		}
+	}
+
+	def := getResourceDefinition(file).WithBuilders(readBuilderSignatures(filenameFor(fileWithoutSuffix, "_dto_builders")))
+	for _, part := range scaffolds {
+		switch part {
+		case partResource:
+			runScaffoldTemplate(def, generator.GenerateResource, filepath.Join("..", "resources", def.Name+".go"))
+		case partDataSource:
+			runScaffoldTemplate(def, generator.GenerateDataSource, filepath.Join("..", "datasources", def.PluralName()+".go"))
+		case partResourceAcceptanceTests:
+			runScaffoldTemplate(def, generator.GenerateResourceAcceptanceTests, filepath.Join("..", "resources", def.Name+"_acceptance_test.go"))
+		case partDataSourceAcceptanceTests:
+			runScaffoldTemplate(def, generator.GenerateDataSourceAcceptanceTests, filepath.Join("..", "datasources", def.PluralName()+"_acceptance_test.go"))
+		case partExamples:
+			runScaffoldTemplate(def, generator.GenerateResourceExample, filepath.Join("..", "..", "examples", "resources", def.TerraformName(), "resource.tf"))
+			runScaffoldTemplate(def, generator.GenerateImportExample, filepath.Join("..", "..", "examples", "resources", def.TerraformName(), "import.sh"))
+			runScaffoldTemplate(def, generator.GenerateDataSourceExample, filepath.Join("..", "..", "examples", "data-sources", def.TerraformPluralName(), "data-source.tf"))
+		}
+	}
+	fmt.Printf(`Scaffolding finished, remaining manual steps:
+- add resources.%[1]s constant in pkg/provider/resources/resources.go and the entry in showByIdFunctions in pkg/acceptance/check_destroy.go
+- register "%[2]s" resource and "%[3]s" data source in pkg/provider/provider.go
+- resolve TODOs in the scaffolded files and run "make docs"
+`, def.Interface.NameSingular, def.TerraformName(), def.TerraformPluralName())
 }
 
-func runTemplateAndSave(def *generator.Interface, genFunc func(io.Writer, *generator.Interface), fileName string) {
+// readBuilderSignatures reads signatures of the DTO builders, so that scaffolded code follows them (nil when the builders are not generated yet)
+func readBuilderSignatures(fileName string) *generator.BuilderSignatures {
+	src, err := os.ReadFile(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		log.Panicln(err)
+	}
+	signatures, err := generator.ParseBuilderSignatures(src)
+	if err != nil {
+		log.Panicln(err)
+	}
+	return signatures
+}
+
+func runScaffoldTemplate(def *generator.ResourceDefinition, genFunc func(io.Writer, *generator.ResourceDefinition), fileName string) {
 	buffer := bytes.Buffer{}
 	genFunc(&buffer, def)
+	printScaffoldResult(generator.ScaffoldFile(&buffer, fileName), fileName)
+}
+
+func printScaffoldResult(scaffolded bool, fileName string) {
+	if scaffolded {
+		fmt.Printf("Scaffolded %s\n", fileName)
+	} else {
+		fmt.Printf("Skipped %s, file already exists\n", fileName)
+	}
+}
+
+func runTemplateAndSave(def *generator.Interface, genFunc func(io.Writer, *generator.Interface), fileName string, check bool) bool {
+	buffer := bytes.Buffer{}
+	genFunc(&buffer, def)
+	if check {
+		return generator.IsCodeUpToDate(&buffer, fileName)
+	}
 	generator.WriteCodeToFile(&buffer, fileName)
+	return true
+}
+
+// runDtoBuilderGenerator runs dto-builder-generator on the given DTO file (the same as go:generate directive inside DTO file does)
+func runDtoBuilderGenerator(dtoFileName string, check bool) bool {
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		log.Panicln("Cannot determine dto-builder-generator location")
+	}
+	args := []string{"run", filepath.Join(filepath.Dir(thisFile), "..", "dto-builder-generator", "main.go")}
+	if check {
+		args = append(args, "-check")
+	}
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("GOFILE=%s", dtoFileName))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if check {
+			return false
+		}
+		log.Panicln(err)
+	}
+	return true
 }
 
 func filenameFor(prefix string, part string) string {
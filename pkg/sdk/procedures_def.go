@@ -259,13 +259,17 @@ var ProceduresDef = g.NewInterface(
 		OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET LOG_LEVEL", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("SET TRACE_LEVEL", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("SET METRIC_LEVEL", g.ParameterOptions().SingleQuotes()).
+		OptionalSQL("UNSET LOG_LEVEL").
+		OptionalSQL("UNSET TRACE_LEVEL").
+		OptionalSQL("UNSET METRIC_LEVEL").
 		OptionalSQL("UNSET COMMENT").
 		OptionalSetTags().
 		OptionalUnsetTags().
 		PredefinedQueryStructField("ExecuteAs", "*ExecuteAs", g.KeywordOptions()).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetMetricLevel", "UnsetLogLevel", "UnsetTraceLevel", "UnsetMetricLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-procedure",
 	g.NewQueryStruct("DropProcedure").
@@ -452,6 +452,26 @@ func (s *AlterProcedureRequest) WithSetTraceLevel(SetTraceLevel *string) *AlterP
 	return s
 }
 
+func (s *AlterProcedureRequest) WithSetMetricLevel(SetMetricLevel *string) *AlterProcedureRequest {
+	s.SetMetricLevel = SetMetricLevel
+	return s
+}
+
+func (s *AlterProcedureRequest) WithUnsetLogLevel(UnsetLogLevel *bool) *AlterProcedureRequest {
+	s.UnsetLogLevel = UnsetLogLevel
+	return s
+}
+
+func (s *AlterProcedureRequest) WithUnsetTraceLevel(UnsetTraceLevel *bool) *AlterProcedureRequest {
+	s.UnsetTraceLevel = UnsetTraceLevel
+	return s
+}
+
+func (s *AlterProcedureRequest) WithUnsetMetricLevel(UnsetMetricLevel *bool) *AlterProcedureRequest {
+	s.UnsetMetricLevel = UnsetMetricLevel
+	return s
+}
+
 func (s *AlterProcedureRequest) WithUnsetComment(UnsetComment *bool) *AlterProcedureRequest {
 	s.UnsetComment = UnsetComment
 	return s
@@ -152,6 +152,10 @@ type AlterProcedureRequest struct {
 	SetComment        *string
 	SetLogLevel       *string
 	SetTraceLevel     *string
+	SetMetricLevel    *string
+	UnsetLogLevel     *bool
+	UnsetTraceLevel   *bool
+	UnsetMetricLevel  *bool
 	UnsetComment      *bool
 	SetTags           []TagAssociation
 	UnsetTags         []ObjectIdentifier
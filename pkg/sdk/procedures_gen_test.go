@@ -462,7 +462,7 @@ func TestProcedures_Alter(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetLogLevel = String("DEBUG")
 		opts.UnsetComment = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProcedureOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProcedureOptions", "RenameTo", "SetComment", "SetLogLevel", "SetTraceLevel", "SetMetricLevel", "UnsetLogLevel", "UnsetTraceLevel", "UnsetMetricLevel", "UnsetComment", "SetTags", "UnsetTags", "ExecuteAs"))
 	})
 
 	t.Run("alter: rename to", func(t *testing.T) {
@@ -498,6 +498,30 @@ func TestProcedures_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) SET TRACE_LEVEL = 'DEBUG'`, id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set metric level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetMetricLevel = String("ALL")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) SET METRIC_LEVEL = 'ALL'`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset log level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetLogLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) UNSET LOG_LEVEL`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset trace level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTraceLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) UNSET TRACE_LEVEL`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset metric level", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetMetricLevel = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROCEDURE IF EXISTS %s (VARCHAR, NUMBER) UNSET METRIC_LEVEL`, id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetComment = String("comment")
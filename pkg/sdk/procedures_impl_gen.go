@@ -359,6 +359,10 @@ func (r *AlterProcedureRequest) toOpts() *AlterProcedureOptions {
 		SetComment:        r.SetComment,
 		SetLogLevel:       r.SetLogLevel,
 		SetTraceLevel:     r.SetTraceLevel,
+		SetMetricLevel:    r.SetMetricLevel,
+		UnsetLogLevel:     r.UnsetLogLevel,
+		UnsetTraceLevel:   r.UnsetTraceLevel,
+		UnsetMetricLevel:  r.UnsetMetricLevel,
 		UnsetComment:      r.UnsetComment,
 		SetTags:           r.SetTags,
 		UnsetTags:         r.UnsetTags,
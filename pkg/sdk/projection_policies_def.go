@@ -0,0 +1,102 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var ProjectionPoliciesDef = g.NewInterface(
+	"ProjectionPolicies",
+	"ProjectionPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy",
+		g.NewQueryStruct("CreateProjectionPolicy").
+			Create().
+			OrReplace().
+			SQL("PROJECTION POLICY").
+			IfNotExists().
+			Name().
+			PredefinedQueryStructField("returnsProjectionConstraint", "bool", g.StaticOptions().SQL("AS () RETURNS PROJECTION_CONSTRAINT")).
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-projection-policy",
+		g.NewQueryStruct("AlterProjectionPolicy").
+			Alter().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-projection-policy",
+		g.NewQueryStruct("DropProjectionPolicy").
+			Drop().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies",
+		g.DbStruct("showProjectionPolicyDBRow").
+			Text("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			Text("comment").
+			Text("options").
+			Text("owner_role_type"),
+		g.PlainStruct("ProjectionPolicy").
+			DeriveMapping().
+			Text("CreatedOn").
+			Text("Name").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Kind").
+			Text("Owner").
+			Text("Comment").
+			Text("Options").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowProjectionPolicies").
+			Show().
+			SQL("PROJECTION POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-projection-policy",
+		g.DbStruct("describeProjectionPolicyDBRow").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body"),
+		g.PlainStruct("ProjectionPolicyDescription").
+			DeriveMapping().
+			Text("Name").
+			Text("Signature").
+			Text("ReturnType").
+			Text("Body"),
+		g.NewQueryStruct("DescribeProjectionPolicy").
+			Describe().
+			SQL("PROJECTION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
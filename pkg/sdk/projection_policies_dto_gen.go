@@ -0,0 +1,44 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateProjectionPolicyOptions]   = new(CreateProjectionPolicyRequest)
+	_ optionsProvider[AlterProjectionPolicyOptions]    = new(AlterProjectionPolicyRequest)
+	_ optionsProvider[DropProjectionPolicyOptions]     = new(DropProjectionPolicyRequest)
+	_ optionsProvider[ShowProjectionPolicyOptions]     = new(ShowProjectionPolicyRequest)
+	_ optionsProvider[DescribeProjectionPolicyOptions] = new(DescribeProjectionPolicyRequest)
+)
+
+type CreateProjectionPolicyRequest struct {
+	OrReplace   *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterProjectionPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	RenameTo     *SchemaObjectIdentifier `validate:"validIdentifierIfSet,exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetBody      *string                 `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetTags      []TagAssociation        `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	UnsetTags    []ObjectIdentifier      `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	SetComment   *string                 `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+	UnsetComment *bool                   `validate:"exactlyOneValueSet=RenameTo|SetBody|SetTags|UnsetTags|SetComment|UnsetComment"`
+}
+
+type DropProjectionPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowProjectionPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeProjectionPolicyRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
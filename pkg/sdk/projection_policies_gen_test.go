@@ -0,0 +1,275 @@
+package sdk
+
+import "testing"
+
+func TestProjectionPolicies_Create(t *testing.T) {
+	// custom:begin CreateProjectionPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateProjectionPolicyOptions
+	defaultOpts := func() *CreateProjectionPolicyOptions {
+		return &CreateProjectionPolicyOptions{
+			name: id,
+			body: "PROJECTION_CONSTRAINT(ALLOW => false)",
+		}
+	}
+	// custom:end CreateProjectionPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateProjectionPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateProjectionPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: [opts.body] should be set", func(t *testing.T) {
+		// custom:begin CreateProjectionPolicyOptions: validation (value set)
+		opts := defaultOpts()
+		opts.body = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateProjectionPolicyOptions", "body"))
+		// custom:end CreateProjectionPolicyOptions: validation (value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateProjectionPolicyOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateProjectionPolicyOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateProjectionPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false)", id.FullyQualifiedName())
+		// custom:end CreateProjectionPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateProjectionPolicyOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false) COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateProjectionPolicyOptions: all options
+	})
+
+	// custom:begin CreateProjectionPolicyOptions: additional test cases
+	// custom:end CreateProjectionPolicyOptions: additional test cases
+}
+
+func TestProjectionPolicies_Alter(t *testing.T) {
+	// custom:begin AlterProjectionPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterProjectionPolicyOptions
+	defaultOpts := func() *AlterProjectionPolicyOptions {
+		return &AlterProjectionPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterProjectionPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterProjectionPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterProjectionPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		// custom:begin AlterProjectionPolicyOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.RenameTo = Pointer(NewSchemaObjectIdentifier("", "", ""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterProjectionPolicyOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetBody opts.SetTags opts.UnsetTags opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		// custom:begin AlterProjectionPolicyOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+		// custom:end AlterProjectionPolicyOptions: validation (exactly one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterProjectionPolicyOptions: basic
+		opts := defaultOpts()
+		opts.SetComment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterProjectionPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterProjectionPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetBody = String("PROJECTION_CONSTRAINT(ALLOW => false)")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY IF EXISTS %s SET BODY -> PROJECTION_CONSTRAINT(ALLOW => false)", id.FullyQualifiedName())
+		// custom:end AlterProjectionPolicyOptions: all options
+	})
+
+	// custom:begin AlterProjectionPolicyOptions: additional test cases
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set and unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+
+		opts = defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+	// custom:end AlterProjectionPolicyOptions: additional test cases
+}
+
+func TestProjectionPolicies_Drop(t *testing.T) {
+	// custom:begin DropProjectionPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropProjectionPolicyOptions
+	defaultOpts := func() *DropProjectionPolicyOptions {
+		return &DropProjectionPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DropProjectionPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropProjectionPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropProjectionPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropProjectionPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY %s", id.FullyQualifiedName())
+		// custom:end DropProjectionPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropProjectionPolicyOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropProjectionPolicyOptions: all options
+	})
+
+	// custom:begin DropProjectionPolicyOptions: additional test cases
+	// custom:end DropProjectionPolicyOptions: additional test cases
+}
+
+func TestProjectionPolicies_Show(t *testing.T) {
+	// custom:begin ShowProjectionPolicyOptions: default options
+	// Minimal valid ShowProjectionPolicyOptions
+	defaultOpts := func() *ShowProjectionPolicyOptions {
+		return &ShowProjectionPolicyOptions{}
+	}
+	// custom:end ShowProjectionPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowProjectionPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PROJECTION POLICIES")
+		// custom:end ShowProjectionPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowProjectionPolicyOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PROJECTION POLICIES LIKE 'some pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+		// custom:end ShowProjectionPolicyOptions: all options
+	})
+
+	// custom:begin ShowProjectionPolicyOptions: additional test cases
+	// custom:end ShowProjectionPolicyOptions: additional test cases
+}
+
+func TestProjectionPolicies_Describe(t *testing.T) {
+	// custom:begin DescribeProjectionPolicyOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeProjectionPolicyOptions
+	defaultOpts := func() *DescribeProjectionPolicyOptions {
+		return &DescribeProjectionPolicyOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeProjectionPolicyOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeProjectionPolicyOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeProjectionPolicyOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeProjectionPolicyOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PROJECTION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeProjectionPolicyOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeProjectionPolicyOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PROJECTION POLICY %s", id.FullyQualifiedName())
+		// custom:end DescribeProjectionPolicyOptions: all options
+	})
+
+	// custom:begin DescribeProjectionPolicyOptions: additional test cases
+	// custom:end DescribeProjectionPolicyOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
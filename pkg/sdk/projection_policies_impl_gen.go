@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ ProjectionPolicies = (*projectionPolicies)(nil)
+
+type projectionPolicies struct {
+	client *Client
+}
+
+func (v *projectionPolicies) Create(ctx context.Context, request *CreateProjectionPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Alter(ctx context.Context, request *AlterProjectionPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Drop(ctx context.Context, request *DropProjectionPolicyRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Show(ctx context.Context, request *ShowProjectionPolicyRequest) ([]ProjectionPolicy, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showProjectionPolicyDBRow, ProjectionPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *projectionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	// custom:begin ShowByID
+	projectionPolicies, err := v.Show(ctx, NewShowProjectionPolicyRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(projectionPolicies, func(r ProjectionPolicy) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *projectionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDescription, error) {
+	opts := &DescribeProjectionPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateProjectionPolicyRequest) toOpts() *CreateProjectionPolicyOptions {
+	opts := &CreateProjectionPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterProjectionPolicyRequest) toOpts() *AlterProjectionPolicyOptions {
+	opts := &AlterProjectionPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropProjectionPolicyRequest) toOpts() *DropProjectionPolicyOptions {
+	opts := &DropProjectionPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowProjectionPolicyRequest) toOpts() *ShowProjectionPolicyOptions {
+	opts := &ShowProjectionPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showProjectionPolicyDBRow) convert() *ProjectionPolicy {
+	projectionPolicy := ProjectionPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Comment:       r.Comment,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	return &projectionPolicy
+}
+
+func (r *DescribeProjectionPolicyRequest) toOpts() *DescribeProjectionPolicyOptions {
+	opts := &DescribeProjectionPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeProjectionPolicyDBRow) convert() *ProjectionPolicyDescription {
+	projectionPolicyDescription := ProjectionPolicyDescription{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+	return &projectionPolicyDescription
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,86 @@
+package sdk
+
+var (
+	_ validatable = new(CreateProjectionPolicyOptions)
+	_ validatable = new(AlterProjectionPolicyOptions)
+	_ validatable = new(DropProjectionPolicyOptions)
+	_ validatable = new(ShowProjectionPolicyOptions)
+	_ validatable = new(DescribeProjectionPolicyOptions)
+)
+
+func (opts *CreateProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.body) {
+		errs = append(errs, errNotSet("CreateProjectionPolicyOptions", "body"))
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateProjectionPolicyOptions: additional validations
+	// custom:end CreateProjectionPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.SetBody, opts.SetTags, opts.UnsetTags, opts.SetComment, opts.UnsetComment) {
+		errs = append(errs, errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"))
+	}
+	// custom:begin AlterProjectionPolicyOptions: additional validations
+	// custom:end AlterProjectionPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropProjectionPolicyOptions: additional validations
+	// custom:end DropProjectionPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowProjectionPolicyOptions: additional validations
+	// custom:end ShowProjectionPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeProjectionPolicyOptions: additional validations
+	// custom:end DescribeProjectionPolicyOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
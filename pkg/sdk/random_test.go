@@ -19,3 +19,7 @@ func randomDatabaseObjectIdentifier() DatabaseObjectIdentifier {
 func randomAccountObjectIdentifier() AccountObjectIdentifier {
 	return NewAccountObjectIdentifier(random.StringN(12))
 }
+
+func randomTableColumnIdentifier() TableColumnIdentifier {
+	return NewTableColumnIdentifier(random.StringN(12), random.StringN(12), random.StringN(12), random.StringN(12))
+}
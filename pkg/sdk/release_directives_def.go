@@ -0,0 +1,39 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// ReleaseDirectivesDef covers the default and custom release directives of an application package, they can be only listed.
+// Release directives are set, modified and unset with ALTER APPLICATION PACKAGE (see ApplicationPackagesDef).
+var ReleaseDirectivesDef = g.NewInterface(
+	"ReleaseDirectives",
+	"ReleaseDirective",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-release-directives",
+		g.DbStruct("releaseDirectiveRow").
+			Text("name").
+			OptionalText("target_type").
+			OptionalText("target_name").
+			Text("created_on").
+			Text("version").
+			Number("patch").
+			OptionalText("modified_on"),
+		g.PlainStruct("ReleaseDirective").
+			DeriveMapping().
+			Text("Name").
+			OptionalText("TargetType").
+			OptionalText("TargetName").
+			Text("CreatedOn").
+			Text("Version").
+			Number("Patch").
+			OptionalText("ModifiedOn"),
+		g.NewQueryStruct("ShowReleaseDirectives").
+			Show().
+			SQL("RELEASE DIRECTIVES").
+			OptionalLike().
+			Identifier("InApplicationPackage", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("IN APPLICATION PACKAGE").Required()).
+			WithValidation(g.ValidIdentifier, "InApplicationPackage"),
+	)
@@ -0,0 +1,12 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[ShowReleaseDirectiveOptions] = new(ShowReleaseDirectiveRequest)
+)
+
+type ShowReleaseDirectiveRequest struct {
+	Like                 *Like
+	InApplicationPackage AccountObjectIdentifier `validate:"validIdentifier"` // required
+}
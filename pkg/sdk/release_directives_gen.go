@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ReleaseDirectives interface {
+	Show(ctx context.Context, request *ShowReleaseDirectiveRequest) ([]ReleaseDirective, error)
+}
+
+// ShowReleaseDirectiveOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-release-directives.
+type ShowReleaseDirectiveOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW"`
+	releaseDirectives    bool                    `ddl:"static" sql:"RELEASE DIRECTIVES"`
+	Like                 *Like                   `ddl:"keyword" sql:"LIKE"`
+	InApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"IN APPLICATION PACKAGE"`
+}
+
+type releaseDirectiveRow struct {
+	Name       string         `db:"name"`
+	TargetType sql.NullString `db:"target_type"`
+	TargetName sql.NullString `db:"target_name"`
+	CreatedOn  string         `db:"created_on"`
+	Version    string         `db:"version"`
+	Patch      int            `db:"patch"`
+	ModifiedOn sql.NullString `db:"modified_on"`
+}
+
+type ReleaseDirective struct {
+	Name       string
+	TargetType *string
+	TargetName *string
+	CreatedOn  string
+	Version    string
+	Patch      int
+	ModifiedOn *string
+}
+
+// custom:begin additional
+// custom:end additional
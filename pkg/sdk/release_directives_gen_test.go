@@ -0,0 +1,50 @@
+package sdk
+
+import "testing"
+
+func TestReleaseDirectives_Show(t *testing.T) {
+	// custom:begin ShowReleaseDirectiveOptions: default options
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid ShowReleaseDirectiveOptions
+	defaultOpts := func() *ShowReleaseDirectiveOptions {
+		return &ShowReleaseDirectiveOptions{
+			InApplicationPackage: id,
+		}
+	}
+	// custom:end ShowReleaseDirectiveOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowReleaseDirectiveOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InApplicationPackage]", func(t *testing.T) {
+		// custom:begin ShowReleaseDirectiveOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InApplicationPackage = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowReleaseDirectiveOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowReleaseDirectiveOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE %s", id.FullyQualifiedName())
+		// custom:end ShowReleaseDirectiveOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowReleaseDirectiveOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("some pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW RELEASE DIRECTIVES LIKE 'some pattern' IN APPLICATION PACKAGE %s", id.FullyQualifiedName())
+		// custom:end ShowReleaseDirectiveOptions: all options
+	})
+
+	// custom:begin ShowReleaseDirectiveOptions: additional test cases
+	// custom:end ShowReleaseDirectiveOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,52 @@
+package sdk
+
+import "context"
+
+var _ ReleaseDirectives = (*releaseDirectives)(nil)
+
+type releaseDirectives struct {
+	client *Client
+}
+
+func (v *releaseDirectives) Show(ctx context.Context, request *ShowReleaseDirectiveRequest) ([]ReleaseDirective, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[releaseDirectiveRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[releaseDirectiveRow, ReleaseDirective](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowReleaseDirectiveRequest) toOpts() *ShowReleaseDirectiveOptions {
+	opts := &ShowReleaseDirectiveOptions{
+		Like:                 r.Like,
+		InApplicationPackage: r.InApplicationPackage,
+	}
+	return opts
+}
+
+func (r releaseDirectiveRow) convert() *ReleaseDirective {
+	releaseDirective := ReleaseDirective{
+		Name:      r.Name,
+		CreatedOn: r.CreatedOn,
+		Version:   r.Version,
+		Patch:     r.Patch,
+	}
+	if r.TargetType.Valid {
+		releaseDirective.TargetType = String(r.TargetType.String)
+	}
+	if r.TargetName.Valid {
+		releaseDirective.TargetName = String(r.TargetName.String)
+	}
+	if r.ModifiedOn.Valid {
+		releaseDirective.ModifiedOn = String(r.ModifiedOn.String)
+	}
+	return &releaseDirective
+}
+
+// custom:begin additional
+// custom:end additional
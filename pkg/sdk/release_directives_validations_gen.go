@@ -0,0 +1,21 @@
+package sdk
+
+var (
+	_ validatable = new(ShowReleaseDirectiveOptions)
+)
+
+func (opts *ShowReleaseDirectiveOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.InApplicationPackage) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowReleaseDirectiveOptions: additional validations
+	// custom:end ShowReleaseDirectiveOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
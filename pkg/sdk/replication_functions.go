@@ -12,14 +12,20 @@ var _ ReplicationFunctions = (*replicationFunctions)(nil)
 var (
 	_ validatable = new(ShowRegionsOptions)
 	_ validatable = new(ShowReplicationDatabasesOptions)
+	_ validatable = new(replicationGroupRefreshProgressOptions)
 )
 
-var _ convertibleRow[ReplicationDatabase] = new(replicationDatabaseRow)
+var (
+	_ convertibleRow[ReplicationDatabase]             = new(replicationDatabaseRow)
+	_ convertibleRow[ReplicationGroupRefreshProgress] = new(replicationGroupRefreshProgressRow)
+)
 
 type ReplicationFunctions interface {
 	ShowReplicationAccounts(ctx context.Context) ([]*ReplicationAccount, error)
 	ShowReplicationDatabases(ctx context.Context, opts *ShowReplicationDatabasesOptions) ([]ReplicationDatabase, error)
 	ShowRegions(ctx context.Context, opts *ShowRegionsOptions) ([]*Region, error)
+	// ReplicationGroupRefreshProgress works for both secondary failover groups and secondary replication groups.
+	ReplicationGroupRefreshProgress(ctx context.Context, id AccountObjectIdentifier) ([]ReplicationGroupRefreshProgress, error)
 }
 
 type replicationFunctions struct {
@@ -215,3 +221,77 @@ func (c *replicationFunctions) ShowRegions(ctx context.Context, opts *ShowRegion
 	}
 	return regions, nil
 }
+
+// replicationGroupRefreshProgressOptions is based on https://docs.snowflake.com/en/sql-reference/functions/replication_group_refresh_progress.
+type replicationGroupRefreshProgressOptions struct {
+	selectEverythingFrom bool                                       `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *replicationGroupRefreshProgressParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type replicationGroupRefreshProgressParameters struct {
+	functionFullyQualifiedName bool                                      `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.REPLICATION_GROUP_REFRESH_PROGRESS"`
+	arguments                  *replicationGroupRefreshProgressArguments `ddl:"list,parentheses"`
+}
+
+type replicationGroupRefreshProgressArguments struct {
+	SecondaryGroupName string `ddl:"keyword,single_quotes"`
+}
+
+func (opts *replicationGroupRefreshProgressOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if opts.parameters == nil || opts.parameters.arguments == nil || opts.parameters.arguments.SecondaryGroupName == "" {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+type ReplicationGroupRefreshProgress struct {
+	PhaseName string
+	StartTime time.Time
+	EndTime   *time.Time
+	Progress  *string
+	Details   *string
+}
+
+type replicationGroupRefreshProgressRow struct {
+	PhaseName string         `db:"PHASE_NAME"`
+	StartTime time.Time      `db:"START_TIME"`
+	EndTime   sql.NullTime   `db:"END_TIME"`
+	Progress  sql.NullString `db:"PROGRESS"`
+	Details   sql.NullString `db:"DETAILS"`
+}
+
+func (row replicationGroupRefreshProgressRow) convert() *ReplicationGroupRefreshProgress {
+	progress := &ReplicationGroupRefreshProgress{
+		PhaseName: row.PhaseName,
+		StartTime: row.StartTime,
+	}
+	if row.EndTime.Valid {
+		progress.EndTime = &row.EndTime.Time
+	}
+	if row.Progress.Valid {
+		progress.Progress = &row.Progress.String
+	}
+	if row.Details.Valid {
+		progress.Details = &row.Details.String
+	}
+	return progress
+}
+
+// ReplicationGroupRefreshProgress returns the phases of the current (or the most recent) refresh of the secondary group.
+func (c *replicationFunctions) ReplicationGroupRefreshProgress(ctx context.Context, id AccountObjectIdentifier) ([]ReplicationGroupRefreshProgress, error) {
+	opts := &replicationGroupRefreshProgressOptions{
+		parameters: &replicationGroupRefreshProgressParameters{
+			arguments: &replicationGroupRefreshProgressArguments{
+				SecondaryGroupName: id.FullyQualifiedName(),
+			},
+		},
+	}
+	rows, err := validateAndQuery[replicationGroupRefreshProgressRow](c.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[replicationGroupRefreshProgressRow, ReplicationGroupRefreshProgress](rows), nil
+}
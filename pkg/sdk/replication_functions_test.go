@@ -40,3 +40,28 @@ func TestReplicationFunctions_ShowReplicationDatabases(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "SHOW REPLICATION DATABASES WITH PRIMARY %s", externalId.FullyQualifiedName())
 	})
 }
+
+func TestReplicationFunctions_ReplicationGroupRefreshProgress(t *testing.T) {
+	id := NewAccountObjectIdentifier("group_name")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *replicationGroupRefreshProgressOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: missing group name", func(t *testing.T) {
+		opts := &replicationGroupRefreshProgressOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &replicationGroupRefreshProgressOptions{
+			parameters: &replicationGroupRefreshProgressParameters{
+				arguments: &replicationGroupRefreshProgressArguments{
+					SecondaryGroupName: id.FullyQualifiedName(),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.REPLICATION_GROUP_REFRESH_PROGRESS ('\"group_name\"'))`)
+	})
+}
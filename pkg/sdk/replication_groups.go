@@ -1,4 +1,524 @@
 package sdk
 
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
-// also: TestInt_AlterReplication
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+var _ ReplicationGroups = (*replicationGroups)(nil)
+
+var (
+	_ validatable = new(CreateReplicationGroupOptions)
+	_ validatable = new(CreateReplicationGroupAsReplicaOptions)
+	_ validatable = new(AlterSourceReplicationGroupOptions)
+	_ validatable = new(AlterTargetReplicationGroupOptions)
+	_ validatable = new(DropReplicationGroupOptions)
+	_ validatable = new(ShowReplicationGroupOptions)
+	_ validatable = new(showReplicationGroupDatabasesOptions)
+	_ validatable = new(showReplicationGroupSharesOptions)
+)
+
+// ReplicationGroups are available in all editions (in contrast to FailoverGroups that require Business Critical Edition),
+// but the secondary replication groups can't be promoted to primary.
+type ReplicationGroups interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error
+	CreateSecondary(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicationGroupAsReplicaOptions) error
+	AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error
+	AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error
+	Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+	ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+}
+
+// replicationGroups implements ReplicationGroups.
+type replicationGroups struct {
+	client *Client
+}
+
+// CreateReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicationGroupOptions struct {
+	create           bool                    `ddl:"static" sql:"CREATE"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists      *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+
+	objectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	allowedAccounts         []AccountIdentifier       `ddl:"parameter" sql:"ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck      *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (opts *CreateReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if len(opts.objectTypes) == 0 {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "objectTypes"))
+	}
+	if len(opts.allowedAccounts) == 0 {
+		errs = append(errs, errNotSet("CreateReplicationGroupOptions", "allowedAccounts"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.objectTypes = objectTypes
+	opts.allowedAccounts = allowedAccounts
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// CreateReplicationGroupAsReplicaOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicationGroupAsReplicaOptions struct {
+	create                  bool                     `ddl:"static" sql:"CREATE"`
+	replicationGroup        bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists             *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier  `ddl:"identifier"`
+	primaryReplicationGroup ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+}
+
+func (opts *CreateReplicationGroupAsReplicaOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.primaryReplicationGroup) {
+		errs = append(errs, errInvalidIdentifier("CreateReplicationGroupAsReplicaOptions", "primaryReplicationGroup"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) CreateSecondary(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicationGroupAsReplicaOptions) error {
+	if opts == nil {
+		opts = &CreateReplicationGroupAsReplicaOptions{}
+	}
+	opts.name = id
+	opts.primaryReplicationGroup = primaryReplicationGroupID
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterSourceReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterSourceReplicationGroupOptions struct {
+	alter            bool                     `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier  `ddl:"identifier"`
+	NewName          *AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set              *ReplicationGroupSet     `ddl:"keyword" sql:"SET"`
+	Unset            *ReplicationGroupUnset   `ddl:"list,no_parentheses" sql:"UNSET"`
+	Add              *ReplicationGroupAdd     `ddl:"keyword" sql:"ADD"`
+	Move             *ReplicationGroupMove    `ddl:"keyword" sql:"MOVE"`
+	Remove           *ReplicationGroupRemove  `ddl:"keyword" sql:"REMOVE"`
+}
+
+func (opts *AlterSourceReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.NewName != nil && !ValidObjectIdentifier(opts.NewName) {
+		errs = append(errs, errInvalidIdentifier("AlterSourceReplicationGroupOptions", "NewName"))
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.Add, opts.Move, opts.Remove, opts.NewName) {
+		errs = append(errs, errExactlyOneOf("AlterSourceReplicationGroupOptions", "Set", "Unset", "Add", "Move", "Remove", "NewName"))
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Add) {
+		if err := opts.Add.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Move) {
+		if err := opts.Move.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Remove) {
+		if err := opts.Remove.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type ReplicationGroupSet struct {
+	ObjectTypes             []PluralObjectType `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedIntegrationTypes []IntegrationType  `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	ReplicationSchedule     *string            `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (v *ReplicationGroupSet) validate() error {
+	if len(v.ObjectTypes) == 0 && len(v.AllowedIntegrationTypes) == 0 && v.ReplicationSchedule == nil {
+		return errAtLeastOneOf("ReplicationGroupSet", "ObjectTypes", "AllowedIntegrationTypes", "ReplicationSchedule")
+	}
+	if len(v.AllowedIntegrationTypes) > 0 {
+		// INTEGRATIONS must be set in object types
+		if !slices.Contains(v.ObjectTypes, PluralObjectTypeIntegrations) {
+			return errors.New("INTEGRATIONS must be set in OBJECT_TYPES when setting allowed integration types")
+		}
+	}
+	return nil
+}
+
+type ReplicationGroupUnset struct {
+	ReplicationSchedule *bool `ddl:"keyword" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (v *ReplicationGroupUnset) validate() error {
+	if everyValueNil(v.ReplicationSchedule) {
+		return errAtLeastOneOf("ReplicationGroupUnset", "ReplicationSchedule")
+	}
+	return nil
+}
+
+type ReplicationGroupAdd struct {
+	AllowedDatabases   []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_DATABASES"`
+	AllowedShares      []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_SHARES"`
+	AllowedAccounts    []AccountIdentifier       `ddl:"parameter,reverse" sql:"TO ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+func (v *ReplicationGroupAdd) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errExactlyOneOf("ReplicationGroupAdd", "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+	}
+	return nil
+}
+
+type ReplicationGroupMove struct {
+	Databases []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"DATABASES"`
+	Shares    []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"SHARES"`
+	To        AccountObjectIdentifier   `ddl:"identifier" sql:"TO REPLICATION GROUP"`
+}
+
+func (v *ReplicationGroupMove) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(v.Databases, v.Shares) {
+		errs = append(errs, errExactlyOneOf("ReplicationGroupMove", "Databases", "Shares"))
+	}
+	if !ValidObjectIdentifier(v.To) {
+		errs = append(errs, errInvalidIdentifier("ReplicationGroupMove", "To"))
+	}
+	return errors.Join(errs...)
+}
+
+type ReplicationGroupRemove struct {
+	AllowedDatabases []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_DATABASES"`
+	AllowedShares    []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_SHARES"`
+	AllowedAccounts  []AccountIdentifier       `ddl:"parameter,reverse" sql:"FROM ALLOWED_ACCOUNTS"`
+}
+
+func (v *ReplicationGroupRemove) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errExactlyOneOf("ReplicationGroupRemove", "AllowedDatabases", "AllowedShares", "AllowedAccounts")
+	}
+	return nil
+}
+
+func (v *replicationGroups) AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterSourceReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterTargetReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterTargetReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+	Refresh          *bool                   `ddl:"keyword" sql:"REFRESH"`
+	Suspend          *bool                   `ddl:"keyword" sql:"SUSPEND"`
+	Resume           *bool                   `ddl:"keyword" sql:"RESUME"`
+}
+
+func (opts *AlterTargetReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.Suspend, opts.Resume) {
+		errs = append(errs, errExactlyOneOf("AlterTargetReplicationGroupOptions", "Refresh", "Suspend", "Resume"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *replicationGroups) AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterTargetReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group.
+type DropReplicationGroupOptions struct {
+	drop             bool                    `ddl:"static" sql:"DROP"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &DropReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups.
+type ShowReplicationGroupOptions struct {
+	show              bool               `ddl:"static" sql:"SHOW"`
+	replicationGroups bool               `ddl:"static" sql:"REPLICATION GROUPS"`
+	InAccount         *AccountIdentifier `ddl:"identifier" sql:"IN ACCOUNT"`
+}
+
+func (opts *ShowReplicationGroupOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	return nil
+}
+
+// ReplicationGroup is a user friendly result for a SHOW REPLICATION GROUPS query.
+// The output has the same columns as SHOW FAILOVER GROUPS, so the rows are converted the same way.
+type ReplicationGroup struct {
+	RegionGroup             string
+	SnowflakeRegion         string
+	CreatedOn               time.Time
+	AccountName             string
+	Name                    string
+	Type                    string
+	Comment                 string
+	IsPrimary               bool
+	Primary                 ExternalObjectIdentifier
+	ObjectTypes             []PluralObjectType
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier
+	OrganizationName        string
+	AccountLocator          string
+	ReplicationSchedule     string
+	SecondaryState          FailoverGroupSecondaryState
+	NextScheduledRefresh    string
+	Owner                   string
+}
+
+func (v *ReplicationGroup) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ReplicationGroup) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(AccountIdentifier{
+		organizationName: v.OrganizationName,
+		accountName:      v.AccountName,
+		accountLocator:   v.AccountLocator,
+	}, v.ID())
+}
+
+func (v *ReplicationGroup) ObjectType() ObjectType {
+	return ObjectTypeReplicationGroup
+}
+
+type replicationGroupDBRow failoverGroupDBRow
+
+func (row replicationGroupDBRow) convert() *ReplicationGroup {
+	replicationGroup := ReplicationGroup(*failoverGroupDBRow(row).convert())
+	return &replicationGroup
+}
+
+func (v *replicationGroups) Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[replicationGroupDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[replicationGroupDBRow, ReplicationGroup](dbRows)
+	return resultList, nil
+}
+
+func (v *replicationGroups) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replicationGroups, err := v.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// SHOW REPLICATION GROUPS lists also the replication groups from the other accounts in the organization
+	for _, replicationGroup := range replicationGroups {
+		if replicationGroup.ID() == id && replicationGroup.AccountLocator == currentAccount {
+			return &replicationGroup, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// showReplicationGroupDatabasesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group.
+type showReplicationGroupDatabasesOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`
+	databases bool                    `ddl:"static" sql:"DATABASES"`
+	in        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupDatabasesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
+
+// showReplicationGroupSharesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group.
+type showReplicationGroupSharesOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`
+	shares bool                    `ddl:"static" sql:"SHARES"`
+	in     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupSharesOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.in) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
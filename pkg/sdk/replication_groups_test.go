@@ -0,0 +1,270 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestReplicationGroupsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: missing object types and allowed accounts", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateReplicationGroupOptions", "objectTypes"))
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateReplicationGroupOptions", "allowedAccounts"))
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeShares,
+				PluralObjectTypeDatabases,
+				PluralObjectTypeIntegrations,
+			},
+			AllowedDatabases: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("db1"),
+			},
+			AllowedShares: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("share1"),
+			},
+			AllowedIntegrationTypes: []IntegrationType{
+				IntegrationTypeSecurityIntegrations,
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+			IgnoreEditionCheck:  Bool(true),
+			ReplicationSchedule: String("10 MINUTE"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" OBJECT_TYPES = SHARES, DATABASES, INTEGRATIONS ALLOWED_DATABASES = "db1" ALLOWED_SHARES = "share1" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = "MY_ORG"."MY_ACCOUNT" IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name: id,
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeDatabases,
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP "rg1" OBJECT_TYPES = DATABASES ALLOWED_ACCOUNTS = "MY_ORG"."MY_ACCOUNT"`)
+	})
+}
+
+func TestReplicationGroupsCreateSecondary(t *testing.T) {
+	t.Run("validation: invalid primary", func(t *testing.T) {
+		opts := &CreateReplicationGroupAsReplicaOptions{
+			name:                    NewAccountObjectIdentifier("rg1"),
+			primaryReplicationGroup: NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "myaccount"), NewAccountObjectIdentifier("")),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateReplicationGroupAsReplicaOptions", "primaryReplicationGroup"))
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateReplicationGroupAsReplicaOptions{
+			IfNotExists:             Bool(true),
+			name:                    NewAccountObjectIdentifier("rg1"),
+			primaryReplicationGroup: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.rg1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" AS REPLICA OF "myorg"."myaccount"."rg1"`)
+	})
+}
+
+func TestReplicationGroupsAlterSource(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSourceReplicationGroupOptions", "Set", "Unset", "Add", "Move", "Remove", "NewName"))
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Set:  &ReplicationGroupSet{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ReplicationGroupSet", "ObjectTypes", "AllowedIntegrationTypes", "ReplicationSchedule"))
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:  id,
+			Unset: &ReplicationGroupUnset{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ReplicationGroupUnset", "ReplicationSchedule"))
+	})
+
+	t.Run("validation: add more than one object kind", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+				AllowedShares:    []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ReplicationGroupAdd", "AllowedDatabases", "AllowedShares", "AllowedAccounts"))
+	})
+
+	t.Run("validation: move without target", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Move: &ReplicationGroupMove{
+				Databases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("ReplicationGroupMove", "To"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:    id,
+			NewName: Pointer(NewAccountObjectIdentifier("rg2")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" RENAME TO "rg2"`)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Set: &ReplicationGroupSet{
+				ObjectTypes:             []PluralObjectType{PluralObjectTypeDatabases, PluralObjectTypeIntegrations},
+				AllowedIntegrationTypes: []IntegrationType{IntegrationTypeAPIIntegrations},
+				ReplicationSchedule:     String("10 MINUTE"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" SET OBJECT_TYPES = DATABASES, INTEGRATIONS ALLOWED_INTEGRATION_TYPES = API INTEGRATIONS REPLICATION_SCHEDULE = '10 MINUTE'`)
+	})
+
+	t.Run("unset replication schedule", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Unset: &ReplicationGroupUnset{
+				ReplicationSchedule: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" UNSET REPLICATION_SCHEDULE`)
+	})
+
+	t.Run("add allowed accounts", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Add: &ReplicationGroupAdd{
+				AllowedAccounts:    []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP IF EXISTS "rg1" ADD "MY_ORG"."MY_ACCOUNT" TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`)
+	})
+
+	t.Run("remove allowed databases", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Remove: &ReplicationGroupRemove{
+				AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1"), NewAccountObjectIdentifier("db2")},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REMOVE "db1", "db2" FROM ALLOWED_DATABASES`)
+	})
+
+	t.Run("move databases to another replication group", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Move: &ReplicationGroupMove{
+				Databases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+				To:        NewAccountObjectIdentifier("rg2"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" MOVE DATABASES "db1" TO REPLICATION GROUP "rg2"`)
+	})
+}
+
+func TestReplicationGroupsAlterTarget(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: exactly one action", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    id,
+			Refresh: Bool(true),
+			Suspend: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterTargetReplicationGroupOptions", "Refresh", "Suspend", "Resume"))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    id,
+			Refresh: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REFRESH`)
+	})
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    id,
+			Suspend: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" SUSPEND`)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Resume:   Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP IF EXISTS "rg1" RESUME`)
+	})
+}
+
+func TestReplicationGroupsDrop(t *testing.T) {
+	t.Run("only name", func(t *testing.T) {
+		opts := &DropReplicationGroupOptions{
+			name: NewAccountObjectIdentifier("rg1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP "rg1"`)
+	})
+
+	t.Run("with IfExists", func(t *testing.T) {
+		opts := &DropReplicationGroupOptions{
+			name:     NewAccountObjectIdentifier("rg1"),
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP IF EXISTS "rg1"`)
+	})
+}
+
+func TestReplicationGroupsShow(t *testing.T) {
+	t.Run("without show options", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS`)
+	})
+
+	t.Run("with show options", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{
+			InAccount: Pointer(NewAccountIdentifierFromAccountLocator("abcd123")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS IN ACCOUNT "abcd123"`)
+	})
+}
+
+func TestReplicationGroupsShowDatabases(t *testing.T) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW DATABASES IN REPLICATION GROUP "rg1"`)
+}
+
+func TestReplicationGroupsShowShares(t *testing.T) {
+	opts := &showReplicationGroupSharesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW SHARES IN REPLICATION GROUP "rg1"`)
+}
@@ -69,10 +69,13 @@ func TestSchemasAlter(t *testing.T) {
 				DataRetentionTimeInDays:    Int(3),
 				MaxDataExtensionTimeInDays: Int(2),
 				DefaultDDLCollation:        String("en_US-trim"),
+				LogLevel:                   Pointer(LogLevelInfo),
+				TraceLevel:                 Pointer(TraceLevelAlways),
+				MetricLevel:                Pointer(MetricLevelAll),
 				Comment:                    String("comment"),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA "database_name"."schema_name" SET DATA_RETENTION_TIME_IN_DAYS = 3, MAX_DATA_EXTENSION_TIME_IN_DAYS = 2, DEFAULT_DDL_COLLATION = 'en_US-trim', COMMENT = 'comment'`)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA "database_name"."schema_name" SET DATA_RETENTION_TIME_IN_DAYS = 3, MAX_DATA_EXTENSION_TIME_IN_DAYS = 2, DEFAULT_DDL_COLLATION = 'en_US-trim', LOG_LEVEL = 'INFO', TRACE_LEVEL = 'ALWAYS', METRIC_LEVEL = 'ALL', COMMENT = 'comment'`)
 	})
 
 	t.Run("set tags", func(t *testing.T) {
@@ -110,10 +113,13 @@ func TestSchemasAlter(t *testing.T) {
 				DataRetentionTimeInDays:    Bool(true),
 				MaxDataExtensionTimeInDays: Bool(true),
 				DefaultDDLCollation:        Bool(true),
+				LogLevel:                   Bool(true),
+				TraceLevel:                 Bool(true),
+				MetricLevel:                Bool(true),
 				Comment:                    Bool(true),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA "database_name"."schema_name" UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, DEFAULT_DDL_COLLATION, COMMENT`)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SCHEMA "database_name"."schema_name" UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, DEFAULT_DDL_COLLATION, LOG_LEVEL, TRACE_LEVEL, METRIC_LEVEL, COMMENT`)
 	})
 
 	t.Run("enable managed access", func(t *testing.T) {
@@ -0,0 +1,203 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type SecretType string
+
+const (
+	SecretTypePassword      SecretType = "PASSWORD"
+	SecretTypeOAuth2        SecretType = "OAUTH2"
+	SecretTypeGenericString SecretType = "GENERIC_STRING"
+)
+
+var secretScopeDef = g.NewQueryStruct("SecretScope").
+	Text("Scope", g.KeywordOptions().SingleQuotes().Required())
+
+func createSecretOperation(structName string, opts func(qs *g.QueryStruct) *g.QueryStruct) *g.QueryStruct {
+	qs := g.NewQueryStruct(structName).
+		Create().
+		OrReplace().
+		SQL("SECRET").
+		IfNotExists().
+		Name()
+	qs = opts(qs)
+	return qs.
+		OptionalComment().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists")
+}
+
+var secretSetDef = g.NewQueryStruct("SecretSet").
+	OptionalComment().
+	OptionalQueryStructField(
+		"SetForOAuthClientCredentialsFlow",
+		g.NewQueryStruct("SetForOAuthClientCredentialsFlow").
+			ListAssignment("OAUTH_SCOPES", "SecretScope", g.ParameterOptions().Parentheses().Required()),
+		g.KeywordOptions(),
+	).
+	OptionalQueryStructField(
+		"SetForOAuthAuthorizationFlow",
+		g.NewQueryStruct("SetForOAuthAuthorizationFlow").
+			OptionalTextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes()).
+			WithValidation(g.AtLeastOneValueSet, "OauthRefreshToken", "OauthRefreshTokenExpiryTime"),
+		g.KeywordOptions(),
+	).
+	OptionalQueryStructField(
+		"SetForBasicAuthentication",
+		g.NewQueryStruct("SetForBasicAuthentication").
+			OptionalTextAssignment("USERNAME", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes()).
+			WithValidation(g.AtLeastOneValueSet, "Username", "Password"),
+		g.KeywordOptions(),
+	).
+	OptionalQueryStructField(
+		"SetForGenericString",
+		g.NewQueryStruct("SetForGenericString").
+			TextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes().Required()),
+		g.KeywordOptions(),
+	).
+	WithValidation(g.ConflictingFields, "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString").
+	WithValidation(g.AtLeastOneValueSet, "Comment", "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString")
+
+var secretUnsetDef = g.NewQueryStruct("SecretUnset").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Comment")
+
+var SecretsDef = g.NewInterface(
+	"Secrets",
+	"Secret",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CustomOperation(
+		"CreateWithOAuthClientCredentialsFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithOAuthClientCredentialsFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("secretType", "string", g.StaticOptions().SQL("TYPE = OAUTH2")).
+				Identifier("SecurityIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required().Equals().SQL("API_AUTHENTICATION")).
+				ListAssignment("OAUTH_SCOPES", "SecretScope", g.ParameterOptions().Parentheses().Required()).
+				WithValidation(g.ValidIdentifier, "SecurityIntegration")
+		}),
+		secretScopeDef,
+	).
+	CustomOperation(
+		"CreateWithOAuthAuthorizationCodeFlow",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithOAuthAuthorizationCodeFlow", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("secretType", "string", g.StaticOptions().SQL("TYPE = OAUTH2")).
+				TextAssignment("OAUTH_REFRESH_TOKEN", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("OAUTH_REFRESH_TOKEN_EXPIRY_TIME", g.ParameterOptions().SingleQuotes().Required()).
+				Identifier("SecurityIntegration", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required().Equals().SQL("API_AUTHENTICATION")).
+				WithValidation(g.ValidIdentifier, "SecurityIntegration")
+		}),
+	).
+	CustomOperation(
+		"CreateWithBasicAuthentication",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithBasicAuthentication", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("secretType", "string", g.StaticOptions().SQL("TYPE = PASSWORD")).
+				TextAssignment("USERNAME", g.ParameterOptions().SingleQuotes().Required()).
+				TextAssignment("PASSWORD", g.ParameterOptions().SingleQuotes().Required())
+		}),
+	).
+	CustomOperation(
+		"CreateWithGenericString",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-secret",
+		createSecretOperation("CreateWithGenericString", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("secretType", "string", g.StaticOptions().SQL("TYPE = GENERIC_STRING")).
+				TextAssignment("SECRET_STRING", g.ParameterOptions().SingleQuotes().Required())
+		}),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-secret",
+		g.NewQueryStruct("AlterSecret").
+			Alter().
+			SQL("SECRET").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", secretSetDef, g.KeywordOptions().SQL("SET")).
+			OptionalQueryStructField("Unset", secretUnsetDef, g.ListOptions().NoParentheses().SQL("UNSET")).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-secret",
+		g.NewQueryStruct("DropSecret").
+			Drop().
+			SQL("SECRET").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-secrets",
+		g.DbStruct("secretDBRow").
+			Time("created_on").
+			Text("name").
+			Text("schema_name").
+			Text("database_name").
+			Text("owner").
+			OptionalText("comment").
+			Text("secret_type").
+			OptionalText("oauth_scopes").
+			Text("owner_role_type"),
+		g.PlainStruct("SecretObject").
+			DeriveMapping().
+			Time("CreatedOn").
+			Text("Name").
+			Text("SchemaName").
+			Text("DatabaseName").
+			Text("Owner").
+			OptionalText("Comment").
+			Converted("SecretType", "SecretType", "secret_type", "SecretType").
+			List("OauthScopes", "oauth_scopes").
+			Text("OwnerRoleType"),
+		g.NewQueryStruct("ShowSecrets").
+			Show().
+			SQL("SECRETS").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-secret",
+		g.DbStruct("secretDetailsDBRow").
+			Time("created_on").
+			Text("name").
+			Text("schema_name").
+			Text("database_name").
+			Text("owner").
+			OptionalText("comment").
+			Text("secret_type").
+			OptionalText("username").
+			OptionalText("oauth_access_token_expiry_time").
+			OptionalText("oauth_refresh_token_expiry_time").
+			OptionalText("oauth_scopes").
+			OptionalText("integration_name"),
+		g.PlainStruct("SecretDetails").
+			DeriveMapping().
+			Time("CreatedOn").
+			Text("Name").
+			Text("SchemaName").
+			Text("DatabaseName").
+			Text("Owner").
+			OptionalText("Comment").
+			Converted("SecretType", "SecretType", "secret_type", "SecretType").
+			OptionalText("Username").
+			OptionalParsedTime("OauthAccessTokenExpiryTime", "oauth_access_token_expiry_time").
+			OptionalParsedTime("OauthRefreshTokenExpiryTime", "oauth_refresh_token_expiry_time").
+			List("OauthScopes", "oauth_scopes").
+			OptionalText("IntegrationName"),
+		g.NewQueryStruct("DescribeSecret").
+			Describe().
+			SQL("SECRET").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
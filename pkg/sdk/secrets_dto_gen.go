@@ -0,0 +1,101 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateWithOAuthClientCredentialsFlowSecretOptions] = new(CreateWithOAuthClientCredentialsFlowSecretRequest)
+	_ optionsProvider[CreateWithOAuthAuthorizationCodeFlowSecretOptions] = new(CreateWithOAuthAuthorizationCodeFlowSecretRequest)
+	_ optionsProvider[CreateWithBasicAuthenticationSecretOptions]        = new(CreateWithBasicAuthenticationSecretRequest)
+	_ optionsProvider[CreateWithGenericStringSecretOptions]              = new(CreateWithGenericStringSecretRequest)
+	_ optionsProvider[AlterSecretOptions]                                = new(AlterSecretRequest)
+	_ optionsProvider[DropSecretOptions]                                 = new(DropSecretRequest)
+	_ optionsProvider[ShowSecretOptions]                                 = new(ShowSecretRequest)
+	_ optionsProvider[DescribeSecretOptions]                             = new(DescribeSecretRequest)
+)
+
+type CreateWithOAuthClientCredentialsFlowSecretRequest struct {
+	OrReplace           *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists         *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	SecurityIntegration AccountObjectIdentifier `validate:"validIdentifier"` // required
+	OauthScopes         []SecretScope           // required
+	Comment             *string
+}
+
+type CreateWithOAuthAuthorizationCodeFlowSecretRequest struct {
+	OrReplace                   *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists                 *bool                   `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name                        SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	OauthRefreshToken           string                  // required
+	OauthRefreshTokenExpiryTime string                  // required
+	SecurityIntegration         AccountObjectIdentifier `validate:"validIdentifier"` // required
+	Comment                     *string
+}
+
+type CreateWithBasicAuthenticationSecretRequest struct {
+	OrReplace   *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name        SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Username    string                 // required
+	Password    string                 // required
+	Comment     *string
+}
+
+type CreateWithGenericStringSecretRequest struct {
+	OrReplace    *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	IfNotExists  *bool                  `validate:"conflictingFields=OrReplace|IfNotExists"`
+	name         SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	SecretString string                 // required
+	Comment      *string
+}
+
+type AlterSecretRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Set      *SecretSetRequest      `validate:"exactlyOneValueSet=Set|Unset"`
+	Unset    *SecretUnsetRequest    `validate:"exactlyOneValueSet=Set|Unset"`
+}
+
+type SecretSetRequest struct {
+	Comment                          *string                                  `validate:"atLeastOneValueSet=Comment|SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString"`
+	SetForOAuthClientCredentialsFlow *SetForOAuthClientCredentialsFlowRequest `validate:"conflictingFields=SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString,atLeastOneValueSet=Comment|SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString"`
+	SetForOAuthAuthorizationFlow     *SetForOAuthAuthorizationFlowRequest     `validate:"conflictingFields=SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString,atLeastOneValueSet=Comment|SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString"`
+	SetForBasicAuthentication        *SetForBasicAuthenticationRequest        `validate:"conflictingFields=SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString,atLeastOneValueSet=Comment|SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString"`
+	SetForGenericString              *SetForGenericStringRequest              `validate:"conflictingFields=SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString,atLeastOneValueSet=Comment|SetForOAuthClientCredentialsFlow|SetForOAuthAuthorizationFlow|SetForBasicAuthentication|SetForGenericString"`
+}
+
+type SetForOAuthClientCredentialsFlowRequest struct {
+	OauthScopes []SecretScope // required
+}
+
+type SetForOAuthAuthorizationFlowRequest struct {
+	OauthRefreshToken           *string `validate:"atLeastOneValueSet=OauthRefreshToken|OauthRefreshTokenExpiryTime"`
+	OauthRefreshTokenExpiryTime *string `validate:"atLeastOneValueSet=OauthRefreshToken|OauthRefreshTokenExpiryTime"`
+}
+
+type SetForBasicAuthenticationRequest struct {
+	Username *string `validate:"atLeastOneValueSet=Username|Password"`
+	Password *string `validate:"atLeastOneValueSet=Username|Password"`
+}
+
+type SetForGenericStringRequest struct {
+	SecretString string // required
+}
+
+type SecretUnsetRequest struct {
+	Comment *bool `validate:"atLeastOneValueSet=Comment"`
+}
+
+type DropSecretRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
+
+type ShowSecretRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeSecretRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,519 @@
+package sdk
+
+import "testing"
+
+func TestSecrets_CreateWithOAuthClientCredentialsFlow(t *testing.T) {
+	// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+	integrationId := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateWithOAuthClientCredentialsFlowSecretOptions
+	defaultOpts := func() *CreateWithOAuthClientCredentialsFlowSecretOptions {
+		return &CreateWithOAuthClientCredentialsFlowSecretOptions{
+			name:                id,
+			SecurityIntegration: integrationId,
+			OauthScopes:         []SecretScope{{Scope: "test"}},
+		}
+	}
+	// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithOAuthClientCredentialsFlowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.SecurityIntegration]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.SecurityIntegration = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithOAuthClientCredentialsFlowSecretOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('test')", id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+		// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: all options
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OauthScopes = []SecretScope{{Scope: "sample_scope"}, {Scope: "other_scope"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET IF NOT EXISTS %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('sample_scope', 'other_scope') COMMENT = 'some comment'", id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+		// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: all options
+	})
+
+	// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: additional test cases
+	t.Run("or replace", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('test')", id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+	})
+	// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: additional test cases
+}
+
+func TestSecrets_CreateWithOAuthAuthorizationCodeFlow(t *testing.T) {
+	// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+	integrationId := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateWithOAuthAuthorizationCodeFlowSecretOptions
+	defaultOpts := func() *CreateWithOAuthAuthorizationCodeFlowSecretOptions {
+		return &CreateWithOAuthAuthorizationCodeFlowSecretOptions{
+			name:                        id,
+			OauthRefreshToken:           "foo",
+			OauthRefreshTokenExpiryTime: "2022-01-02 10:00:00",
+			SecurityIntegration:         integrationId,
+		}
+	}
+	// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithOAuthAuthorizationCodeFlowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.SecurityIntegration]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.SecurityIntegration = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithOAuthAuthorizationCodeFlowSecretOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET %s TYPE = OAUTH2 OAUTH_REFRESH_TOKEN = 'foo' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2022-01-02 10:00:00' API_AUTHENTICATION = %s", id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+		// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECRET %s TYPE = OAUTH2 OAUTH_REFRESH_TOKEN = 'foo' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2022-01-02 10:00:00' API_AUTHENTICATION = %s COMMENT = 'some comment'", id.FullyQualifiedName(), integrationId.FullyQualifiedName())
+		// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: all options
+	})
+
+	// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: additional test cases
+	// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: additional test cases
+}
+
+func TestSecrets_CreateWithBasicAuthentication(t *testing.T) {
+	// custom:begin CreateWithBasicAuthenticationSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateWithBasicAuthenticationSecretOptions
+	defaultOpts := func() *CreateWithBasicAuthenticationSecretOptions {
+		return &CreateWithBasicAuthenticationSecretOptions{
+			name:     id,
+			Username: "foo",
+			Password: "bar",
+		}
+	}
+	// custom:end CreateWithBasicAuthenticationSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithBasicAuthenticationSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateWithBasicAuthenticationSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithBasicAuthenticationSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateWithBasicAuthenticationSecretOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithBasicAuthenticationSecretOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateWithBasicAuthenticationSecretOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateWithBasicAuthenticationSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET %s TYPE = PASSWORD USERNAME = 'foo' PASSWORD = 'bar'", id.FullyQualifiedName())
+		// custom:end CreateWithBasicAuthenticationSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateWithBasicAuthenticationSecretOptions: all options
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET IF NOT EXISTS %s TYPE = PASSWORD USERNAME = 'foo' PASSWORD = 'bar' COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateWithBasicAuthenticationSecretOptions: all options
+	})
+
+	// custom:begin CreateWithBasicAuthenticationSecretOptions: additional test cases
+	// custom:end CreateWithBasicAuthenticationSecretOptions: additional test cases
+}
+
+func TestSecrets_CreateWithGenericString(t *testing.T) {
+	// custom:begin CreateWithGenericStringSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateWithGenericStringSecretOptions
+	defaultOpts := func() *CreateWithGenericStringSecretOptions {
+		return &CreateWithGenericStringSecretOptions{
+			name:         id,
+			SecretString: "foo",
+		}
+	}
+	// custom:end CreateWithGenericStringSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateWithGenericStringSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateWithGenericStringSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateWithGenericStringSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		// custom:begin CreateWithGenericStringSecretOptions: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateWithGenericStringSecretOptions", "OrReplace", "IfNotExists"))
+		// custom:end CreateWithGenericStringSecretOptions: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateWithGenericStringSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECRET %s TYPE = GENERIC_STRING SECRET_STRING = 'foo'", id.FullyQualifiedName())
+		// custom:end CreateWithGenericStringSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateWithGenericStringSecretOptions: all options
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECRET %s TYPE = GENERIC_STRING SECRET_STRING = 'foo' COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end CreateWithGenericStringSecretOptions: all options
+	})
+
+	// custom:begin CreateWithGenericStringSecretOptions: additional test cases
+	// custom:end CreateWithGenericStringSecretOptions: additional test cases
+}
+
+func TestSecrets_Alter(t *testing.T) {
+	// custom:begin AlterSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterSecretOptions
+	defaultOpts := func() *AlterSecretOptions {
+		return &AlterSecretOptions{
+			name: id,
+			Set: &SecretSet{
+				Comment: String("some comment"),
+			},
+		}
+	}
+	// custom:end AlterSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset] should be present", func(t *testing.T) {
+		// custom:begin AlterSecretOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.Unset = &SecretUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterSecretOptions", "Set", "Unset"))
+		// custom:end AlterSecretOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.Set.SetForOAuthClientCredentialsFlow opts.Set.SetForOAuthAuthorizationFlow opts.Set.SetForBasicAuthentication opts.Set.SetForGenericString]", func(t *testing.T) {
+		// custom:begin AlterSecretOptions.Set: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.Set.SetForBasicAuthentication = &SetForBasicAuthentication{Username: String("foo")}
+		opts.Set.SetForGenericString = &SetForGenericString{SecretString: "bar"}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterSecretOptions.Set", "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString"))
+		// custom:end AlterSecretOptions.Set: validation (conflicting fields)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Comment opts.Set.SetForOAuthClientCredentialsFlow opts.Set.SetForOAuthAuthorizationFlow opts.Set.SetForBasicAuthentication opts.Set.SetForGenericString] should be set", func(t *testing.T) {
+		// custom:begin AlterSecretOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &SecretSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Set", "Comment", "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString"))
+		// custom:end AlterSecretOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.SetForOAuthAuthorizationFlow.OauthRefreshToken opts.Set.SetForOAuthAuthorizationFlow.OauthRefreshTokenExpiryTime] should be set", func(t *testing.T) {
+		// custom:begin AlterSecretOptions.Set.SetForOAuthAuthorizationFlow: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set.SetForOAuthAuthorizationFlow = &SetForOAuthAuthorizationFlow{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Set.SetForOAuthAuthorizationFlow", "OauthRefreshToken", "OauthRefreshTokenExpiryTime"))
+		// custom:end AlterSecretOptions.Set.SetForOAuthAuthorizationFlow: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.SetForBasicAuthentication.Username opts.Set.SetForBasicAuthentication.Password] should be set", func(t *testing.T) {
+		// custom:begin AlterSecretOptions.Set.SetForBasicAuthentication: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set.SetForBasicAuthentication = &SetForBasicAuthentication{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Set.SetForBasicAuthentication", "Username", "Password"))
+		// custom:end AlterSecretOptions.Set.SetForBasicAuthentication: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterSecretOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &SecretUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterSecretOptions.Unset", "Comment"))
+		// custom:end AlterSecretOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+		// custom:end AlterSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterSecretOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set.SetForOAuthClientCredentialsFlow = &SetForOAuthClientCredentialsFlow{
+			OauthScopes: []SecretScope{{Scope: "sample_scope"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET IF EXISTS %s SET COMMENT = 'some comment' OAUTH_SCOPES = ('sample_scope')", id.FullyQualifiedName())
+		// custom:end AlterSecretOptions: all options
+	})
+
+	// custom:begin AlterSecretOptions: additional test cases
+	t.Run("set for oauth authorization flow", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			SetForOAuthAuthorizationFlow: &SetForOAuthAuthorizationFlow{
+				OauthRefreshToken:           String("foo"),
+				OauthRefreshTokenExpiryTime: String("2022-01-02 10:00:00"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET %s SET OAUTH_REFRESH_TOKEN = 'foo' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2022-01-02 10:00:00'", id.FullyQualifiedName())
+	})
+
+	t.Run("set for basic authentication", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			SetForBasicAuthentication: &SetForBasicAuthentication{
+				Username: String("foo"),
+				Password: String("bar"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET %s SET USERNAME = 'foo' PASSWORD = 'bar'", id.FullyQualifiedName())
+	})
+
+	t.Run("set for generic string", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &SecretSet{
+			SetForGenericString: &SetForGenericString{
+				SecretString: "foo",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET %s SET SECRET_STRING = 'foo'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &SecretUnset{
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECRET %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+	// custom:end AlterSecretOptions: additional test cases
+}
+
+func TestSecrets_Drop(t *testing.T) {
+	// custom:begin DropSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropSecretOptions
+	defaultOpts := func() *DropSecretOptions {
+		return &DropSecretOptions{
+			name: id,
+		}
+	}
+	// custom:end DropSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP SECRET %s", id.FullyQualifiedName())
+		// custom:end DropSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropSecretOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP SECRET IF EXISTS %s", id.FullyQualifiedName())
+		// custom:end DropSecretOptions: all options
+	})
+
+	// custom:begin DropSecretOptions: additional test cases
+	// custom:end DropSecretOptions: additional test cases
+}
+
+func TestSecrets_Show(t *testing.T) {
+	// custom:begin ShowSecretOptions: default options
+	// Minimal valid ShowSecretOptions
+	defaultOpts := func() *ShowSecretOptions {
+		return &ShowSecretOptions{}
+	}
+	// custom:end ShowSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SECRETS")
+		// custom:end ShowSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowSecretOptions: all options
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Database: NewAccountObjectIdentifier("db")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS LIKE 'pattern' IN DATABASE "db"`)
+		// custom:end ShowSecretOptions: all options
+	})
+
+	// custom:begin ShowSecretOptions: additional test cases
+	t.Run("in schema", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.In = &In{Schema: NewDatabaseObjectIdentifier("db", "schema")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS IN SCHEMA "db"."schema"`)
+	})
+	// custom:end ShowSecretOptions: additional test cases
+}
+
+func TestSecrets_Describe(t *testing.T) {
+	// custom:begin DescribeSecretOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeSecretOptions
+	defaultOpts := func() *DescribeSecretOptions {
+		return &DescribeSecretOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeSecretOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeSecretOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeSecretOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeSecretOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeSecretOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SECRET %s", id.FullyQualifiedName())
+		// custom:end DescribeSecretOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeSecretOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SECRET %s", id.FullyQualifiedName())
+		// custom:end DescribeSecretOptions: all options
+	})
+
+	// custom:begin DescribeSecretOptions: additional test cases
+	// custom:end DescribeSecretOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,266 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ Secrets = (*secrets)(nil)
+
+type secrets struct {
+	client *Client
+}
+
+func (v *secrets) CreateWithOAuthClientCredentialsFlow(ctx context.Context, request *CreateWithOAuthClientCredentialsFlowSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithOAuthAuthorizationCodeFlow(ctx context.Context, request *CreateWithOAuthAuthorizationCodeFlowSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithBasicAuthentication(ctx context.Context, request *CreateWithBasicAuthenticationSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) CreateWithGenericString(ctx context.Context, request *CreateWithGenericStringSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Alter(ctx context.Context, request *AlterSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Drop(ctx context.Context, request *DropSecretRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *secrets) Show(ctx context.Context, request *ShowSecretRequest) ([]SecretObject, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[secretDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[secretDBRow, SecretObject](dbRows)
+	return resultList, nil
+}
+
+func (v *secrets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SecretObject, error) {
+	// custom:begin ShowByID
+	secrets, err := v.Show(ctx, NewShowSecretRequest().WithIn(In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}).WithLike(Like{
+		Pattern: String(id.Name()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(secrets, func(r SecretObject) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *secrets) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error) {
+	opts := &DescribeSecretOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[secretDetailsDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateWithOAuthClientCredentialsFlowSecretRequest) toOpts() *CreateWithOAuthClientCredentialsFlowSecretOptions {
+	opts := &CreateWithOAuthClientCredentialsFlowSecretOptions{
+		OrReplace:           r.OrReplace,
+		IfNotExists:         r.IfNotExists,
+		name:                r.name,
+		SecurityIntegration: r.SecurityIntegration,
+		OauthScopes:         r.OauthScopes,
+		Comment:             r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithOAuthAuthorizationCodeFlowSecretRequest) toOpts() *CreateWithOAuthAuthorizationCodeFlowSecretOptions {
+	opts := &CreateWithOAuthAuthorizationCodeFlowSecretOptions{
+		OrReplace:                   r.OrReplace,
+		IfNotExists:                 r.IfNotExists,
+		name:                        r.name,
+		OauthRefreshToken:           r.OauthRefreshToken,
+		OauthRefreshTokenExpiryTime: r.OauthRefreshTokenExpiryTime,
+		SecurityIntegration:         r.SecurityIntegration,
+		Comment:                     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithBasicAuthenticationSecretRequest) toOpts() *CreateWithBasicAuthenticationSecretOptions {
+	opts := &CreateWithBasicAuthenticationSecretOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Username:    r.Username,
+		Password:    r.Password,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *CreateWithGenericStringSecretRequest) toOpts() *CreateWithGenericStringSecretOptions {
+	opts := &CreateWithGenericStringSecretOptions{
+		OrReplace:    r.OrReplace,
+		IfNotExists:  r.IfNotExists,
+		name:         r.name,
+		SecretString: r.SecretString,
+		Comment:      r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterSecretRequest) toOpts() *AlterSecretOptions {
+	opts := &AlterSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &SecretSet{
+			Comment: r.Set.Comment,
+		}
+		if r.Set.SetForOAuthClientCredentialsFlow != nil {
+			opts.Set.SetForOAuthClientCredentialsFlow = &SetForOAuthClientCredentialsFlow{
+				OauthScopes: r.Set.SetForOAuthClientCredentialsFlow.OauthScopes,
+			}
+		}
+		if r.Set.SetForOAuthAuthorizationFlow != nil {
+			opts.Set.SetForOAuthAuthorizationFlow = &SetForOAuthAuthorizationFlow{
+				OauthRefreshToken:           r.Set.SetForOAuthAuthorizationFlow.OauthRefreshToken,
+				OauthRefreshTokenExpiryTime: r.Set.SetForOAuthAuthorizationFlow.OauthRefreshTokenExpiryTime,
+			}
+		}
+		if r.Set.SetForBasicAuthentication != nil {
+			opts.Set.SetForBasicAuthentication = &SetForBasicAuthentication{
+				Username: r.Set.SetForBasicAuthentication.Username,
+				Password: r.Set.SetForBasicAuthentication.Password,
+			}
+		}
+		if r.Set.SetForGenericString != nil {
+			opts.Set.SetForGenericString = &SetForGenericString{
+				SecretString: r.Set.SetForGenericString.SecretString,
+			}
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &SecretUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropSecretRequest) toOpts() *DropSecretOptions {
+	opts := &DropSecretOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowSecretRequest) toOpts() *ShowSecretOptions {
+	opts := &ShowSecretOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r secretDBRow) convert() *SecretObject {
+	secretObject := SecretObject{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		SchemaName:    r.SchemaName,
+		DatabaseName:  r.DatabaseName,
+		Owner:         r.Owner,
+		SecretType:    SecretType(r.SecretType),
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	if r.Comment.Valid {
+		secretObject.Comment = String(r.Comment.String)
+	}
+	if r.OauthScopes.Valid {
+		secretObject.OauthScopes = ParseCommaSeparatedStringArray(r.OauthScopes.String)
+	}
+	return &secretObject
+}
+
+func (r *DescribeSecretRequest) toOpts() *DescribeSecretOptions {
+	opts := &DescribeSecretOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r secretDetailsDBRow) convert() *SecretDetails {
+	secretDetails := SecretDetails{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		SchemaName:   r.SchemaName,
+		DatabaseName: r.DatabaseName,
+		Owner:        r.Owner,
+		SecretType:   SecretType(r.SecretType),
+	}
+	if r.Comment.Valid {
+		secretDetails.Comment = String(r.Comment.String)
+	}
+	if r.Username.Valid {
+		secretDetails.Username = String(r.Username.String)
+	}
+	if r.OauthAccessTokenExpiryTime.Valid {
+		if t, err := ParseTimestamp(r.OauthAccessTokenExpiryTime.String); err == nil {
+			secretDetails.OauthAccessTokenExpiryTime = Pointer(t)
+		}
+	}
+	if r.OauthRefreshTokenExpiryTime.Valid {
+		if t, err := ParseTimestamp(r.OauthRefreshTokenExpiryTime.String); err == nil {
+			secretDetails.OauthRefreshTokenExpiryTime = Pointer(t)
+		}
+	}
+	if r.OauthScopes.Valid {
+		secretDetails.OauthScopes = ParseCommaSeparatedStringArray(r.OauthScopes.String)
+	}
+	if r.IntegrationName.Valid {
+		secretDetails.IntegrationName = String(r.IntegrationName.String)
+	}
+	return &secretDetails
+}
+
+// custom:begin additional
+// custom:end additional
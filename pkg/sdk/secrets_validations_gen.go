@@ -0,0 +1,160 @@
+package sdk
+
+var (
+	_ validatable = new(CreateWithOAuthClientCredentialsFlowSecretOptions)
+	_ validatable = new(CreateWithOAuthAuthorizationCodeFlowSecretOptions)
+	_ validatable = new(CreateWithBasicAuthenticationSecretOptions)
+	_ validatable = new(CreateWithGenericStringSecretOptions)
+	_ validatable = new(AlterSecretOptions)
+	_ validatable = new(DropSecretOptions)
+	_ validatable = new(ShowSecretOptions)
+	_ validatable = new(DescribeSecretOptions)
+)
+
+func (opts *CreateWithOAuthClientCredentialsFlowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.SecurityIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithOAuthClientCredentialsFlowSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateWithOAuthClientCredentialsFlowSecretOptions: additional validations
+	// custom:end CreateWithOAuthClientCredentialsFlowSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithOAuthAuthorizationCodeFlowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.SecurityIntegration) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithOAuthAuthorizationCodeFlowSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateWithOAuthAuthorizationCodeFlowSecretOptions: additional validations
+	// custom:end CreateWithOAuthAuthorizationCodeFlowSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithBasicAuthenticationSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithBasicAuthenticationSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateWithBasicAuthenticationSecretOptions: additional validations
+	// custom:end CreateWithBasicAuthenticationSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateWithGenericStringSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if moreThanOneValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateWithGenericStringSecretOptions", "OrReplace", "IfNotExists"))
+	}
+	// custom:begin CreateWithGenericStringSecretOptions: additional validations
+	// custom:end CreateWithGenericStringSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterSecretOptions", "Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if moreThanOneValueSet(opts.Set.SetForOAuthClientCredentialsFlow, opts.Set.SetForOAuthAuthorizationFlow, opts.Set.SetForBasicAuthentication, opts.Set.SetForGenericString) {
+			errs = append(errs, errOneOf("AlterSecretOptions.Set", "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString"))
+		}
+		if !anyValueSet(opts.Set.Comment, opts.Set.SetForOAuthClientCredentialsFlow, opts.Set.SetForOAuthAuthorizationFlow, opts.Set.SetForBasicAuthentication, opts.Set.SetForGenericString) {
+			errs = append(errs, errAtLeastOneOf("AlterSecretOptions.Set", "Comment", "SetForOAuthClientCredentialsFlow", "SetForOAuthAuthorizationFlow", "SetForBasicAuthentication", "SetForGenericString"))
+		}
+		if valueSet(opts.Set.SetForOAuthAuthorizationFlow) {
+			if !anyValueSet(opts.Set.SetForOAuthAuthorizationFlow.OauthRefreshToken, opts.Set.SetForOAuthAuthorizationFlow.OauthRefreshTokenExpiryTime) {
+				errs = append(errs, errAtLeastOneOf("AlterSecretOptions.Set.SetForOAuthAuthorizationFlow", "OauthRefreshToken", "OauthRefreshTokenExpiryTime"))
+			}
+		}
+		if valueSet(opts.Set.SetForBasicAuthentication) {
+			if !anyValueSet(opts.Set.SetForBasicAuthentication.Username, opts.Set.SetForBasicAuthentication.Password) {
+				errs = append(errs, errAtLeastOneOf("AlterSecretOptions.Set.SetForBasicAuthentication", "Username", "Password"))
+			}
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterSecretOptions.Unset", "Comment"))
+		}
+	}
+	// custom:begin AlterSecretOptions: additional validations
+	// custom:end AlterSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropSecretOptions: additional validations
+	// custom:end DropSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowSecretOptions: additional validations
+	// custom:end ShowSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeSecretOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeSecretOptions: additional validations
+	// custom:end DescribeSecretOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,36 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+// ServiceEndpointsDef covers the endpoints exposed by a service, they are defined in the service specification and
+// can be only listed.
+var ServiceEndpointsDef = g.NewInterface(
+	"ServiceEndpoints",
+	"ServiceEndpoint",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-endpoints",
+		g.DbStruct("serviceEndpointsRow").
+			Text("name").
+			OptionalNumber("port").
+			OptionalText("port_range").
+			Text("protocol").
+			Bool("is_public").
+			OptionalText("ingress_url"),
+		g.PlainStruct("ServiceEndpoint").
+			DeriveMapping().
+			Text("Name").
+			OptionalNumber("Port").
+			OptionalText("PortRange").
+			Text("Protocol").
+			Bool("IsPublic").
+			OptionalText("IngressUrl"),
+		g.NewQueryStruct("ShowServiceEndpoints").
+			Show().
+			SQL("ENDPOINTS").
+			Identifier("InService", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("IN SERVICE").Required()).
+			WithValidation(g.ValidIdentifier, "InService"),
+	)
@@ -0,0 +1,11 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[ShowServiceEndpointOptions] = new(ShowServiceEndpointRequest)
+)
+
+type ShowServiceEndpointRequest struct {
+	InService SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,38 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ServiceEndpoints interface {
+	Show(ctx context.Context, request *ShowServiceEndpointRequest) ([]ServiceEndpoint, error)
+}
+
+// ShowServiceEndpointOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-endpoints.
+type ShowServiceEndpointOptions struct {
+	show      bool                   `ddl:"static" sql:"SHOW"`
+	endpoints bool                   `ddl:"static" sql:"ENDPOINTS"`
+	InService SchemaObjectIdentifier `ddl:"identifier" sql:"IN SERVICE"`
+}
+
+type serviceEndpointsRow struct {
+	Name       string         `db:"name"`
+	Port       sql.NullInt64  `db:"port"`
+	PortRange  sql.NullString `db:"port_range"`
+	Protocol   string         `db:"protocol"`
+	IsPublic   bool           `db:"is_public"`
+	IngressUrl sql.NullString `db:"ingress_url"`
+}
+
+type ServiceEndpoint struct {
+	Name       string
+	Port       *int
+	PortRange  *string
+	Protocol   string
+	IsPublic   bool
+	IngressUrl *string
+}
+
+// custom:begin additional
+// custom:end additional
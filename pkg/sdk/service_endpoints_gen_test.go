@@ -0,0 +1,49 @@
+package sdk
+
+import "testing"
+
+func TestServiceEndpoints_Show(t *testing.T) {
+	// custom:begin ShowServiceEndpointOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid ShowServiceEndpointOptions
+	defaultOpts := func() *ShowServiceEndpointOptions {
+		return &ShowServiceEndpointOptions{
+			InService: id,
+		}
+	}
+	// custom:end ShowServiceEndpointOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowServiceEndpointOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.InService]", func(t *testing.T) {
+		// custom:begin ShowServiceEndpointOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InService = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end ShowServiceEndpointOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowServiceEndpointOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ENDPOINTS IN SERVICE %s", id.FullyQualifiedName())
+		// custom:end ShowServiceEndpointOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowServiceEndpointOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ENDPOINTS IN SERVICE %s", id.FullyQualifiedName())
+		// custom:end ShowServiceEndpointOptions: all options
+	})
+
+	// custom:begin ShowServiceEndpointOptions: additional test cases
+	// custom:end ShowServiceEndpointOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
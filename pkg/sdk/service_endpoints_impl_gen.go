@@ -0,0 +1,50 @@
+package sdk
+
+import "context"
+
+var _ ServiceEndpoints = (*serviceEndpoints)(nil)
+
+type serviceEndpoints struct {
+	client *Client
+}
+
+func (v *serviceEndpoints) Show(ctx context.Context, request *ShowServiceEndpointRequest) ([]ServiceEndpoint, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[serviceEndpointsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[serviceEndpointsRow, ServiceEndpoint](dbRows)
+	return resultList, nil
+}
+
+func (r *ShowServiceEndpointRequest) toOpts() *ShowServiceEndpointOptions {
+	opts := &ShowServiceEndpointOptions{
+		InService: r.InService,
+	}
+	return opts
+}
+
+func (r serviceEndpointsRow) convert() *ServiceEndpoint {
+	serviceEndpoint := ServiceEndpoint{
+		Name:     r.Name,
+		Protocol: r.Protocol,
+		IsPublic: r.IsPublic,
+	}
+	if r.Port.Valid {
+		serviceEndpoint.Port = Int(int(r.Port.Int64))
+	}
+	if r.PortRange.Valid {
+		serviceEndpoint.PortRange = String(r.PortRange.String)
+	}
+	if r.IngressUrl.Valid {
+		serviceEndpoint.IngressUrl = String(r.IngressUrl.String)
+	}
+	return &serviceEndpoint
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,21 @@
+package sdk
+
+var (
+	_ validatable = new(ShowServiceEndpointOptions)
+)
+
+func (opts *ShowServiceEndpointOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.InService) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin ShowServiceEndpointOptions: additional validations
+	// custom:end ShowServiceEndpointOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
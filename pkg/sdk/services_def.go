@@ -0,0 +1,236 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type ServiceStatus string
+
+const (
+	ServiceStatusPending       ServiceStatus = "PENDING"
+	ServiceStatusRunning       ServiceStatus = "RUNNING"
+	ServiceStatusFailed        ServiceStatus = "FAILED"
+	ServiceStatusDone          ServiceStatus = "DONE"
+	ServiceStatusSuspended     ServiceStatus = "SUSPENDED"
+	ServiceStatusSuspending    ServiceStatus = "SUSPENDING"
+	ServiceStatusDeleting      ServiceStatus = "DELETING"
+	ServiceStatusDeleted       ServiceStatus = "DELETED"
+	ServiceStatusInternalError ServiceStatus = "INTERNAL_ERROR"
+)
+
+// The specification is either read from a file on a stage (FROM @stage SPECIFICATION_FILE = 'file.yaml') or passed
+// inline (FROM SPECIFICATION '<yaml>'). The same clause is used to create the service and to update its specification.
+var serviceFromSpecificationDef = g.NewQueryStruct("ServiceFromSpecification").
+	PredefinedQueryStructField("Stage", "*string", g.ParameterOptions().NoQuotes().NoEquals().SQL("FROM")).
+	OptionalTextAssignment("SPECIFICATION_FILE", g.ParameterOptions().SingleQuotes()).
+	PredefinedQueryStructField("Specification", "*string", g.ParameterOptions().SingleQuotes().NoEquals().SQL("FROM SPECIFICATION")).
+	WithValidation(g.ExactlyOneValueSet, "SpecificationFile", "Specification").
+	WithValidation(g.ConflictingFields, "Stage", "Specification")
+
+var ServicesDef = g.NewInterface(
+	"Services",
+	"Service",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-service",
+		g.NewQueryStruct("CreateService").
+			Create().
+			SQL("SERVICE").
+			IfNotExists().
+			Name().
+			Identifier("InComputePool", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().SQL("IN COMPUTE POOL").Required()).
+			OptionalQueryStructField("FromSpecification", serviceFromSpecificationDef, g.KeywordOptions()).
+			OptionalNumberAssignment("AUTO_SUSPEND_SECS", g.ParameterOptions()).
+			ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+			OptionalBooleanAssignment("AUTO_RESUME", g.ParameterOptions()).
+			OptionalNumberAssignment("MIN_INSTANCES", g.ParameterOptions()).
+			OptionalNumberAssignment("MIN_READY_INSTANCES", g.ParameterOptions()).
+			OptionalNumberAssignment("MAX_INSTANCES", g.ParameterOptions()).
+			OptionalIdentifier("QueryWarehouse", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
+			OptionalTags().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifier, "InComputePool").
+			WithValidation(g.ValidateValueSet, "FromSpecification").
+			WithValidation(g.ValidIdentifierIfSet, "QueryWarehouse"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-service",
+		g.NewQueryStruct("AlterService").
+			Alter().
+			SQL("SERVICE").
+			IfExists().
+			Name().
+			OptionalSQL("RESUME").
+			OptionalSQL("SUSPEND").
+			OptionalQueryStructField("FromSpecification", serviceFromSpecificationDef, g.KeywordOptions()).
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("ServiceSet").
+					OptionalNumberAssignment("MIN_INSTANCES", g.ParameterOptions()).
+					OptionalNumberAssignment("MAX_INSTANCES", g.ParameterOptions()).
+					OptionalNumberAssignment("MIN_READY_INSTANCES", g.ParameterOptions()).
+					OptionalNumberAssignment("AUTO_SUSPEND_SECS", g.ParameterOptions()).
+					OptionalIdentifier("QueryWarehouse", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
+					OptionalBooleanAssignment("AUTO_RESUME", g.ParameterOptions()).
+					ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
+					OptionalComment().
+					WithValidation(g.ValidIdentifierIfSet, "QueryWarehouse").
+					WithValidation(g.AtLeastOneValueSet, "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("ServiceUnset").
+					OptionalSQL("MIN_INSTANCES").
+					OptionalSQL("MAX_INSTANCES").
+					OptionalSQL("MIN_READY_INSTANCES").
+					OptionalSQL("AUTO_SUSPEND_SECS").
+					OptionalSQL("QUERY_WAREHOUSE").
+					OptionalSQL("AUTO_RESUME").
+					OptionalSQL("EXTERNAL_ACCESS_INTEGRATIONS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "FromSpecification", "Set", "Unset", "SetTags", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-service",
+		g.NewQueryStruct("DropService").
+			Drop().
+			SQL("SERVICE").
+			IfExists().
+			Name().
+			OptionalSQL("FORCE").
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-services",
+		g.DbStruct("servicesRow").
+			Text("name").
+			OptionalText("status").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			Text("compute_pool").
+			Text("dns_name").
+			OptionalNumber("current_instances").
+			OptionalNumber("target_instances").
+			OptionalNumber("min_ready_instances").
+			Number("min_instances").
+			Number("max_instances").
+			Bool("auto_resume").
+			OptionalText("external_access_integrations").
+			Time("created_on").
+			OptionalTime("updated_on").
+			OptionalTime("resumed_on").
+			OptionalTime("suspended_on").
+			OptionalNumber("auto_suspend_secs").
+			OptionalText("comment").
+			OptionalText("owner_role_type").
+			OptionalText("query_warehouse").
+			OptionalBool("is_job").
+			OptionalText("spec_digest"),
+		g.PlainStruct("Service").
+			DeriveMapping().
+			Text("Name").
+			Converted("Status", "*ServiceStatus", "status", "ServiceStatus").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			Text("ComputePool").
+			Text("DnsName").
+			OptionalNumber("CurrentInstances").
+			OptionalNumber("TargetInstances").
+			OptionalNumber("MinReadyInstances").
+			Number("MinInstances").
+			Number("MaxInstances").
+			Bool("AutoResume").
+			List("ExternalAccessIntegrations", "external_access_integrations").
+			Time("CreatedOn").
+			OptionalTime("UpdatedOn").
+			OptionalTime("ResumedOn").
+			OptionalTime("SuspendedOn").
+			OptionalNumber("AutoSuspendSecs").
+			OptionalText("Comment").
+			OptionalText("OwnerRoleType").
+			OptionalText("QueryWarehouse").
+			OptionalBool("IsJob").
+			OptionalText("SpecDigest"),
+		g.NewQueryStruct("ShowServices").
+			Show().
+			SQL("SERVICES").
+			OptionalSQL("EXCLUDE JOBS").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-service",
+		g.DbStruct("serviceDetailsRow").
+			Text("name").
+			OptionalText("status").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			Text("compute_pool").
+			Text("spec").
+			Text("dns_name").
+			OptionalNumber("current_instances").
+			OptionalNumber("target_instances").
+			OptionalNumber("min_ready_instances").
+			Number("min_instances").
+			Number("max_instances").
+			Bool("auto_resume").
+			OptionalText("external_access_integrations").
+			Time("created_on").
+			OptionalTime("updated_on").
+			OptionalTime("resumed_on").
+			OptionalTime("suspended_on").
+			OptionalNumber("auto_suspend_secs").
+			OptionalText("comment").
+			OptionalText("owner_role_type").
+			OptionalText("query_warehouse").
+			OptionalBool("is_job").
+			OptionalText("spec_digest"),
+		g.PlainStruct("ServiceDetails").
+			DeriveMapping().
+			Text("Name").
+			Converted("Status", "*ServiceStatus", "status", "ServiceStatus").
+			Text("DatabaseName").
+			Text("SchemaName").
+			Text("Owner").
+			Text("ComputePool").
+			Text("Spec").
+			Text("DnsName").
+			OptionalNumber("CurrentInstances").
+			OptionalNumber("TargetInstances").
+			OptionalNumber("MinReadyInstances").
+			Number("MinInstances").
+			Number("MaxInstances").
+			Bool("AutoResume").
+			List("ExternalAccessIntegrations", "external_access_integrations").
+			Time("CreatedOn").
+			OptionalTime("UpdatedOn").
+			OptionalTime("ResumedOn").
+			OptionalTime("SuspendedOn").
+			OptionalNumber("AutoSuspendSecs").
+			OptionalText("Comment").
+			OptionalText("OwnerRoleType").
+			OptionalText("QueryWarehouse").
+			OptionalBool("IsJob").
+			OptionalText("SpecDigest"),
+		g.NewQueryStruct("DescribeService").
+			Describe().
+			SQL("SERVICE").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,85 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateServiceOptions]   = new(CreateServiceRequest)
+	_ optionsProvider[AlterServiceOptions]    = new(AlterServiceRequest)
+	_ optionsProvider[DropServiceOptions]     = new(DropServiceRequest)
+	_ optionsProvider[ShowServiceOptions]     = new(ShowServiceRequest)
+	_ optionsProvider[DescribeServiceOptions] = new(DescribeServiceRequest)
+)
+
+type CreateServiceRequest struct {
+	IfNotExists                *bool
+	name                       SchemaObjectIdentifier  `validate:"validIdentifier"` // required
+	InComputePool              AccountObjectIdentifier `validate:"validIdentifier"` // required
+	FromSpecification          *ServiceFromSpecificationRequest
+	AutoSuspendSecs            *int
+	ExternalAccessIntegrations []AccountObjectIdentifier
+	AutoResume                 *bool
+	MinInstances               *int
+	MinReadyInstances          *int
+	MaxInstances               *int
+	QueryWarehouse             *AccountObjectIdentifier `validate:"validIdentifierIfSet"`
+	Tag                        []TagAssociation
+	Comment                    *string
+}
+
+type ServiceFromSpecificationRequest struct {
+	Stage             *string `validate:"conflictingFields=Stage|Specification"`
+	SpecificationFile *string `validate:"exactlyOneValueSet=SpecificationFile|Specification"`
+	Specification     *string `validate:"exactlyOneValueSet=SpecificationFile|Specification,conflictingFields=Stage|Specification"`
+}
+
+type AlterServiceRequest struct {
+	IfExists          *bool
+	name              SchemaObjectIdentifier           `validate:"validIdentifier"` // required
+	Resume            *bool                            `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	Suspend           *bool                            `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	FromSpecification *ServiceFromSpecificationRequest `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	Set               *ServiceSetRequest               `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	Unset             *ServiceUnsetRequest             `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	SetTags           []TagAssociation                 `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+	UnsetTags         []ObjectIdentifier               `validate:"exactlyOneValueSet=Resume|Suspend|FromSpecification|Set|Unset|SetTags|UnsetTags"`
+}
+
+type ServiceSetRequest struct {
+	MinInstances               *int                      `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	MaxInstances               *int                      `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	MinReadyInstances          *int                      `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	AutoSuspendSecs            *int                      `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	QueryWarehouse             *AccountObjectIdentifier  `validate:"validIdentifierIfSet,atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	AutoResume                 *bool                     `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	Comment                    *string                   `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+}
+
+type ServiceUnsetRequest struct {
+	MinInstances               *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	MaxInstances               *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	MinReadyInstances          *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	AutoSuspendSecs            *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	QueryWarehouse             *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	AutoResume                 *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	ExternalAccessIntegrations *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+	Comment                    *bool `validate:"atLeastOneValueSet=MinInstances|MaxInstances|MinReadyInstances|AutoSuspendSecs|QueryWarehouse|AutoResume|ExternalAccessIntegrations|Comment"`
+}
+
+type DropServiceRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier `validate:"validIdentifier"` // required
+	Force    *bool
+}
+
+type ShowServiceRequest struct {
+	ExcludeJobs *bool
+	Like        *Like
+	In          *In
+	StartsWith  *string
+	Limit       *LimitFrom
+}
+
+type DescribeServiceRequest struct {
+	name SchemaObjectIdentifier `validate:"validIdentifier"` // required
+}
@@ -0,0 +1,222 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type Services interface {
+	Create(ctx context.Context, request *CreateServiceRequest) error
+	Alter(ctx context.Context, request *AlterServiceRequest) error
+	Drop(ctx context.Context, request *DropServiceRequest) error
+	Show(ctx context.Context, request *ShowServiceRequest) ([]Service, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*ServiceDetails, error)
+}
+
+// CreateServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-service.
+type CreateServiceOptions struct {
+	create                     bool                      `ddl:"static" sql:"CREATE"`
+	service                    bool                      `ddl:"static" sql:"SERVICE"`
+	IfNotExists                *bool                     `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier    `ddl:"identifier"`
+	InComputePool              AccountObjectIdentifier   `ddl:"identifier" sql:"IN COMPUTE POOL"`
+	FromSpecification          *ServiceFromSpecification `ddl:"keyword"`
+	AutoSuspendSecs            *int                      `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	AutoResume                 *bool                     `ddl:"parameter" sql:"AUTO_RESUME"`
+	MinInstances               *int                      `ddl:"parameter" sql:"MIN_INSTANCES"`
+	MinReadyInstances          *int                      `ddl:"parameter" sql:"MIN_READY_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" sql:"MAX_INSTANCES"`
+	QueryWarehouse             *AccountObjectIdentifier  `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Tag                        []TagAssociation          `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ServiceFromSpecification struct {
+	Stage             *string `ddl:"parameter,no_quotes,no_equals" sql:"FROM"`
+	SpecificationFile *string `ddl:"parameter,single_quotes" sql:"SPECIFICATION_FILE"`
+	Specification     *string `ddl:"parameter,single_quotes,no_equals" sql:"FROM SPECIFICATION"`
+}
+
+// AlterServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-service.
+type AlterServiceOptions struct {
+	alter             bool                      `ddl:"static" sql:"ALTER"`
+	service           bool                      `ddl:"static" sql:"SERVICE"`
+	IfExists          *bool                     `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier    `ddl:"identifier"`
+	Resume            *bool                     `ddl:"keyword" sql:"RESUME"`
+	Suspend           *bool                     `ddl:"keyword" sql:"SUSPEND"`
+	FromSpecification *ServiceFromSpecification `ddl:"keyword"`
+	Set               *ServiceSet               `ddl:"keyword" sql:"SET"`
+	Unset             *ServiceUnset             `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags           []TagAssociation          `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags         []ObjectIdentifier        `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type ServiceSet struct {
+	MinInstances               *int                      `ddl:"parameter" sql:"MIN_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" sql:"MAX_INSTANCES"`
+	MinReadyInstances          *int                      `ddl:"parameter" sql:"MIN_READY_INSTANCES"`
+	AutoSuspendSecs            *int                      `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	QueryWarehouse             *AccountObjectIdentifier  `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	AutoResume                 *bool                     `ddl:"parameter" sql:"AUTO_RESUME"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ServiceUnset struct {
+	MinInstances               *bool `ddl:"keyword" sql:"MIN_INSTANCES"`
+	MaxInstances               *bool `ddl:"keyword" sql:"MAX_INSTANCES"`
+	MinReadyInstances          *bool `ddl:"keyword" sql:"MIN_READY_INSTANCES"`
+	AutoSuspendSecs            *bool `ddl:"keyword" sql:"AUTO_SUSPEND_SECS"`
+	QueryWarehouse             *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+	AutoResume                 *bool `ddl:"keyword" sql:"AUTO_RESUME"`
+	ExternalAccessIntegrations *bool `ddl:"keyword" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-service.
+type DropServiceOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	service  bool                   `ddl:"static" sql:"SERVICE"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Force    *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+// ShowServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-services.
+type ShowServiceOptions struct {
+	show        bool       `ddl:"static" sql:"SHOW"`
+	services    bool       `ddl:"static" sql:"SERVICES"`
+	ExcludeJobs *bool      `ddl:"keyword" sql:"EXCLUDE JOBS"`
+	Like        *Like      `ddl:"keyword" sql:"LIKE"`
+	In          *In        `ddl:"keyword" sql:"IN"`
+	StartsWith  *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit       *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type servicesRow struct {
+	Name                       string         `db:"name"`
+	Status                     sql.NullString `db:"status"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      string         `db:"owner"`
+	ComputePool                string         `db:"compute_pool"`
+	DnsName                    string         `db:"dns_name"`
+	CurrentInstances           sql.NullInt64  `db:"current_instances"`
+	TargetInstances            sql.NullInt64  `db:"target_instances"`
+	MinReadyInstances          sql.NullInt64  `db:"min_ready_instances"`
+	MinInstances               int            `db:"min_instances"`
+	MaxInstances               int            `db:"max_instances"`
+	AutoResume                 bool           `db:"auto_resume"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	CreatedOn                  time.Time      `db:"created_on"`
+	UpdatedOn                  sql.NullTime   `db:"updated_on"`
+	ResumedOn                  sql.NullTime   `db:"resumed_on"`
+	SuspendedOn                sql.NullTime   `db:"suspended_on"`
+	AutoSuspendSecs            sql.NullInt64  `db:"auto_suspend_secs"`
+	Comment                    sql.NullString `db:"comment"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	IsJob                      sql.NullBool   `db:"is_job"`
+	SpecDigest                 sql.NullString `db:"spec_digest"`
+}
+
+type Service struct {
+	Name                       string
+	Status                     *ServiceStatus
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ComputePool                string
+	DnsName                    string
+	CurrentInstances           *int
+	TargetInstances            *int
+	MinReadyInstances          *int
+	MinInstances               int
+	MaxInstances               int
+	AutoResume                 bool
+	ExternalAccessIntegrations []string
+	CreatedOn                  time.Time
+	UpdatedOn                  *time.Time
+	ResumedOn                  *time.Time
+	SuspendedOn                *time.Time
+	AutoSuspendSecs            *int
+	Comment                    *string
+	OwnerRoleType              *string
+	QueryWarehouse             *string
+	IsJob                      *bool
+	SpecDigest                 *string
+}
+
+// DescribeServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-service.
+type DescribeServiceOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"`
+	service  bool                   `ddl:"static" sql:"SERVICE"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type serviceDetailsRow struct {
+	Name                       string         `db:"name"`
+	Status                     sql.NullString `db:"status"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      string         `db:"owner"`
+	ComputePool                string         `db:"compute_pool"`
+	Spec                       string         `db:"spec"`
+	DnsName                    string         `db:"dns_name"`
+	CurrentInstances           sql.NullInt64  `db:"current_instances"`
+	TargetInstances            sql.NullInt64  `db:"target_instances"`
+	MinReadyInstances          sql.NullInt64  `db:"min_ready_instances"`
+	MinInstances               int            `db:"min_instances"`
+	MaxInstances               int            `db:"max_instances"`
+	AutoResume                 bool           `db:"auto_resume"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	CreatedOn                  time.Time      `db:"created_on"`
+	UpdatedOn                  sql.NullTime   `db:"updated_on"`
+	ResumedOn                  sql.NullTime   `db:"resumed_on"`
+	SuspendedOn                sql.NullTime   `db:"suspended_on"`
+	AutoSuspendSecs            sql.NullInt64  `db:"auto_suspend_secs"`
+	Comment                    sql.NullString `db:"comment"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	IsJob                      sql.NullBool   `db:"is_job"`
+	SpecDigest                 sql.NullString `db:"spec_digest"`
+}
+
+type ServiceDetails struct {
+	Name                       string
+	Status                     *ServiceStatus
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ComputePool                string
+	Spec                       string
+	DnsName                    string
+	CurrentInstances           *int
+	TargetInstances            *int
+	MinReadyInstances          *int
+	MinInstances               int
+	MaxInstances               int
+	AutoResume                 bool
+	ExternalAccessIntegrations []string
+	CreatedOn                  time.Time
+	UpdatedOn                  *time.Time
+	ResumedOn                  *time.Time
+	SuspendedOn                *time.Time
+	AutoSuspendSecs            *int
+	Comment                    *string
+	OwnerRoleType              *string
+	QueryWarehouse             *string
+	IsJob                      *bool
+	SpecDigest                 *string
+}
+
+// custom:begin additional
+func (v *Service) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// custom:end additional
@@ -0,0 +1,409 @@
+package sdk
+
+import "testing"
+
+func TestServices_Create(t *testing.T) {
+	// custom:begin CreateServiceOptions: default options
+	id := randomSchemaObjectIdentifier()
+	computePoolId := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateServiceOptions
+	defaultOpts := func() *CreateServiceOptions {
+		return &CreateServiceOptions{
+			name:          id,
+			InComputePool: computePoolId,
+			FromSpecification: &ServiceFromSpecification{
+				Stage:             String("@db.schema.stage"),
+				SpecificationFile: String("spec.yaml"),
+			},
+		}
+	}
+	// custom:end CreateServiceOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateServiceOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateServiceOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.InComputePool]", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.InComputePool = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateServiceOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: [opts.FromSpecification] should be set", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: validation (value set)
+		opts := defaultOpts()
+		opts.FromSpecification = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateServiceOptions", "FromSpecification"))
+		// custom:end CreateServiceOptions: validation (value set)
+	})
+
+	t.Run("validation: valid identifier for [opts.QueryWarehouse] if set", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.QueryWarehouse = Pointer(NewAccountObjectIdentifier(""))
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end CreateServiceOptions: validation (valid identifier if set)
+	})
+
+	t.Run("validation: exactly one field from [opts.FromSpecification.SpecificationFile opts.FromSpecification.Specification] should be present", func(t *testing.T) {
+		// custom:begin CreateServiceOptions.FromSpecification: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateServiceOptions.FromSpecification", "SpecificationFile", "Specification"))
+		// custom:end CreateServiceOptions.FromSpecification: validation (exactly one value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.FromSpecification.Stage opts.FromSpecification.Specification]", func(t *testing.T) {
+		// custom:begin CreateServiceOptions.FromSpecification: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			Stage:         String("@db.schema.stage"),
+			Specification: String("spec"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateServiceOptions.FromSpecification", "Stage", "Specification"))
+		// custom:end CreateServiceOptions.FromSpecification: validation (conflicting fields)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SERVICE %s IN COMPUTE POOL %s FROM @db.schema.stage SPECIFICATION_FILE = 'spec.yaml'", id.FullyQualifiedName(), computePoolId.FullyQualifiedName())
+		// custom:end CreateServiceOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin CreateServiceOptions: all options
+		integrationId := randomAccountObjectIdentifier()
+		warehouseId := randomAccountObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.AutoSuspendSecs = Int(600)
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{integrationId}
+		opts.AutoResume = Bool(false)
+		opts.MinInstances = Int(1)
+		opts.MinReadyInstances = Int(1)
+		opts.MaxInstances = Int(2)
+		opts.QueryWarehouse = &warehouseId
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SERVICE IF NOT EXISTS %s IN COMPUTE POOL %s FROM @db.schema.stage SPECIFICATION_FILE = 'spec.yaml' AUTO_SUSPEND_SECS = 600 EXTERNAL_ACCESS_INTEGRATIONS = (%s) AUTO_RESUME = false MIN_INSTANCES = 1 MIN_READY_INSTANCES = 1 MAX_INSTANCES = 2 QUERY_WAREHOUSE = %s TAG (%s = 'v1') COMMENT = 'some comment'", id.FullyQualifiedName(), computePoolId.FullyQualifiedName(), integrationId.FullyQualifiedName(), warehouseId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		// custom:end CreateServiceOptions: all options
+	})
+
+	// custom:begin CreateServiceOptions: additional test cases
+	t.Run("inline specification", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			Specification: String("spec:\n  containers:\n  - name: 'main'"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE SERVICE %s IN COMPUTE POOL %s FROM SPECIFICATION 'spec:\n  containers:\n  - name: \'main\''`, id.FullyQualifiedName(), computePoolId.FullyQualifiedName())
+	})
+	// custom:end CreateServiceOptions: additional test cases
+}
+
+func TestServices_Alter(t *testing.T) {
+	// custom:begin AlterServiceOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterServiceOptions
+	defaultOpts := func() *AlterServiceOptions {
+		return &AlterServiceOptions{
+			name: id,
+		}
+	}
+	// custom:end AlterServiceOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterServiceOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin AlterServiceOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Suspend = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterServiceOptions: validation (valid identifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.FromSpecification opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		// custom:begin AlterServiceOptions: validation (exactly one value set)
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterServiceOptions", "Resume", "Suspend", "FromSpecification", "Set", "Unset", "SetTags", "UnsetTags"))
+
+		opts.Resume = Bool(true)
+		opts.Suspend = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterServiceOptions", "Resume", "Suspend", "FromSpecification", "Set", "Unset", "SetTags", "UnsetTags"))
+		// custom:end AlterServiceOptions: validation (exactly one value set)
+	})
+
+	t.Run("validation: exactly one field from [opts.FromSpecification.SpecificationFile opts.FromSpecification.Specification] should be present", func(t *testing.T) {
+		// custom:begin AlterServiceOptions.FromSpecification: validation (exactly one value set)
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			SpecificationFile: String("spec.yaml"),
+			Specification:     String("spec"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterServiceOptions.FromSpecification", "SpecificationFile", "Specification"))
+		// custom:end AlterServiceOptions.FromSpecification: validation (exactly one value set)
+	})
+
+	t.Run("validation: conflicting fields for [opts.FromSpecification.Stage opts.FromSpecification.Specification]", func(t *testing.T) {
+		// custom:begin AlterServiceOptions.FromSpecification: validation (conflicting fields)
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			Stage:         String("@db.schema.stage"),
+			Specification: String("spec"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterServiceOptions.FromSpecification", "Stage", "Specification"))
+		// custom:end AlterServiceOptions.FromSpecification: validation (conflicting fields)
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.QueryWarehouse] if set", func(t *testing.T) {
+		// custom:begin AlterServiceOptions.Set: validation (valid identifier if set)
+		opts := defaultOpts()
+		opts.Set = &ServiceSet{QueryWarehouse: Pointer(NewAccountObjectIdentifier(""))}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end AlterServiceOptions.Set: validation (valid identifier if set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.MinInstances opts.Set.MaxInstances opts.Set.MinReadyInstances opts.Set.AutoSuspendSecs opts.Set.QueryWarehouse opts.Set.AutoResume opts.Set.ExternalAccessIntegrations opts.Set.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterServiceOptions.Set: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Set = &ServiceSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterServiceOptions.Set", "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"))
+		// custom:end AlterServiceOptions.Set: validation (at least one value set)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.MinInstances opts.Unset.MaxInstances opts.Unset.MinReadyInstances opts.Unset.AutoSuspendSecs opts.Unset.QueryWarehouse opts.Unset.AutoResume opts.Unset.ExternalAccessIntegrations opts.Unset.Comment] should be set", func(t *testing.T) {
+		// custom:begin AlterServiceOptions.Unset: validation (at least one value set)
+		opts := defaultOpts()
+		opts.Unset = &ServiceUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterServiceOptions.Unset", "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"))
+		// custom:end AlterServiceOptions.Unset: validation (at least one value set)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin AlterServiceOptions: basic
+		opts := defaultOpts()
+		opts.Suspend = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s SUSPEND", id.FullyQualifiedName())
+		// custom:end AlterServiceOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin AlterServiceOptions: all options
+		integrationId := randomAccountObjectIdentifier()
+		warehouseId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &ServiceSet{
+			MinInstances:               Int(1),
+			MaxInstances:               Int(3),
+			MinReadyInstances:          Int(2),
+			AutoSuspendSecs:            Int(300),
+			QueryWarehouse:             &warehouseId,
+			AutoResume:                 Bool(true),
+			ExternalAccessIntegrations: []AccountObjectIdentifier{integrationId},
+			Comment:                    String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE IF EXISTS %s SET MIN_INSTANCES = 1 MAX_INSTANCES = 3 MIN_READY_INSTANCES = 2 AUTO_SUSPEND_SECS = 300 QUERY_WAREHOUSE = %s AUTO_RESUME = true EXTERNAL_ACCESS_INTEGRATIONS = (%s) COMMENT = 'some comment'", id.FullyQualifiedName(), warehouseId.FullyQualifiedName(), integrationId.FullyQualifiedName())
+		// custom:end AlterServiceOptions: all options
+	})
+
+	// custom:begin AlterServiceOptions: additional test cases
+	t.Run("resume", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Resume = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s RESUME", id.FullyQualifiedName())
+	})
+
+	t.Run("from specification file", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			Stage:             String("@db.schema.stage"),
+			SpecificationFile: String("spec.yaml"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s FROM @db.schema.stage SPECIFICATION_FILE = 'spec.yaml'", id.FullyQualifiedName())
+	})
+
+	t.Run("from inline specification", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.FromSpecification = &ServiceFromSpecification{
+			Specification: String("spec:\n  containers: []"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SERVICE %s FROM SPECIFICATION 'spec:\n  containers: []'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ServiceUnset{
+			MinInstances:               Bool(true),
+			MaxInstances:               Bool(true),
+			MinReadyInstances:          Bool(true),
+			AutoSuspendSecs:            Bool(true),
+			QueryWarehouse:             Bool(true),
+			AutoResume:                 Bool(true),
+			ExternalAccessIntegrations: Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s UNSET MIN_INSTANCES, MAX_INSTANCES, MIN_READY_INSTANCES, AUTO_SUSPEND_SECS, QUERY_WAREHOUSE, AUTO_RESUME, EXTERNAL_ACCESS_INTEGRATIONS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SERVICE %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+	// custom:end AlterServiceOptions: additional test cases
+}
+
+func TestServices_Drop(t *testing.T) {
+	// custom:begin DropServiceOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DropServiceOptions
+	defaultOpts := func() *DropServiceOptions {
+		return &DropServiceOptions{
+			name: id,
+		}
+	}
+	// custom:end DropServiceOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropServiceOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DropServiceOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DropServiceOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DropServiceOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP SERVICE %s", id.FullyQualifiedName())
+		// custom:end DropServiceOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DropServiceOptions: all options
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Force = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP SERVICE IF EXISTS %s FORCE", id.FullyQualifiedName())
+		// custom:end DropServiceOptions: all options
+	})
+
+	// custom:begin DropServiceOptions: additional test cases
+	// custom:end DropServiceOptions: additional test cases
+}
+
+func TestServices_Show(t *testing.T) {
+	// custom:begin ShowServiceOptions: default options
+	// Minimal valid ShowServiceOptions
+	defaultOpts := func() *ShowServiceOptions {
+		return &ShowServiceOptions{}
+	}
+	// custom:end ShowServiceOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowServiceOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin ShowServiceOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SERVICES")
+		// custom:end ShowServiceOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin ShowServiceOptions: all options
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.ExcludeJobs = Bool(true)
+		opts.Like = &Like{Pattern: String("some pattern")}
+		opts.In = &In{Schema: schemaId}
+		opts.StartsWith = String("some prefix")
+		opts.Limit = &LimitFrom{Rows: Int(10), From: String("from name")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SERVICES EXCLUDE JOBS LIKE 'some pattern' IN SCHEMA %s STARTS WITH 'some prefix' LIMIT 10 FROM 'from name'", schemaId.FullyQualifiedName())
+		// custom:end ShowServiceOptions: all options
+	})
+
+	// custom:begin ShowServiceOptions: additional test cases
+	// custom:end ShowServiceOptions: additional test cases
+}
+
+func TestServices_Describe(t *testing.T) {
+	// custom:begin DescribeServiceOptions: default options
+	id := randomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeServiceOptions
+	defaultOpts := func() *DescribeServiceOptions {
+		return &DescribeServiceOptions{
+			name: id,
+		}
+	}
+	// custom:end DescribeServiceOptions: default options
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeServiceOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		// custom:begin DescribeServiceOptions: validation (valid identifier)
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+		// custom:end DescribeServiceOptions: validation (valid identifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		// custom:begin DescribeServiceOptions: basic
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SERVICE %s", id.FullyQualifiedName())
+		// custom:end DescribeServiceOptions: basic
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// custom:begin DescribeServiceOptions: all options
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE SERVICE %s", id.FullyQualifiedName())
+		// custom:end DescribeServiceOptions: all options
+	})
+
+	// custom:begin DescribeServiceOptions: additional test cases
+	// custom:end DescribeServiceOptions: additional test cases
+}
+
+// custom:begin additional
+// custom:end additional
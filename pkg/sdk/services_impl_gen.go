@@ -0,0 +1,288 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ Services = (*services)(nil)
+
+type services struct {
+	client *Client
+}
+
+func (v *services) Create(ctx context.Context, request *CreateServiceRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *services) Alter(ctx context.Context, request *AlterServiceRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *services) Drop(ctx context.Context, request *DropServiceRequest) error {
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *services) Show(ctx context.Context, request *ShowServiceRequest) ([]Service, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, err
+	}
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[servicesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[servicesRow, Service](dbRows)
+	return resultList, nil
+}
+
+func (v *services) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error) {
+	// custom:begin ShowByID
+	services, err := v.Show(ctx, NewShowServiceRequest().
+		WithIn(In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(Like{Pattern: String(id.Name())}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(services, func(r Service) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
+}
+
+func (v *services) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ServiceDetails, error) {
+	opts := &DescribeServiceOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[serviceDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateServiceRequest) toOpts() *CreateServiceOptions {
+	opts := &CreateServiceOptions{
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		InComputePool:              r.InComputePool,
+		AutoSuspendSecs:            r.AutoSuspendSecs,
+		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
+		AutoResume:                 r.AutoResume,
+		MinInstances:               r.MinInstances,
+		MinReadyInstances:          r.MinReadyInstances,
+		MaxInstances:               r.MaxInstances,
+		QueryWarehouse:             r.QueryWarehouse,
+		Tag:                        r.Tag,
+		Comment:                    r.Comment,
+	}
+	if r.FromSpecification != nil {
+		opts.FromSpecification = &ServiceFromSpecification{
+			Stage:             r.FromSpecification.Stage,
+			SpecificationFile: r.FromSpecification.SpecificationFile,
+			Specification:     r.FromSpecification.Specification,
+		}
+	}
+	return opts
+}
+
+func (r *AlterServiceRequest) toOpts() *AlterServiceOptions {
+	opts := &AlterServiceOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		Resume:    r.Resume,
+		Suspend:   r.Suspend,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.FromSpecification != nil {
+		opts.FromSpecification = &ServiceFromSpecification{
+			Stage:             r.FromSpecification.Stage,
+			SpecificationFile: r.FromSpecification.SpecificationFile,
+			Specification:     r.FromSpecification.Specification,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &ServiceSet{
+			MinInstances:               r.Set.MinInstances,
+			MaxInstances:               r.Set.MaxInstances,
+			MinReadyInstances:          r.Set.MinReadyInstances,
+			AutoSuspendSecs:            r.Set.AutoSuspendSecs,
+			QueryWarehouse:             r.Set.QueryWarehouse,
+			AutoResume:                 r.Set.AutoResume,
+			ExternalAccessIntegrations: r.Set.ExternalAccessIntegrations,
+			Comment:                    r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ServiceUnset{
+			MinInstances:               r.Unset.MinInstances,
+			MaxInstances:               r.Unset.MaxInstances,
+			MinReadyInstances:          r.Unset.MinReadyInstances,
+			AutoSuspendSecs:            r.Unset.AutoSuspendSecs,
+			QueryWarehouse:             r.Unset.QueryWarehouse,
+			AutoResume:                 r.Unset.AutoResume,
+			ExternalAccessIntegrations: r.Unset.ExternalAccessIntegrations,
+			Comment:                    r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropServiceRequest) toOpts() *DropServiceOptions {
+	opts := &DropServiceOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		Force:    r.Force,
+	}
+	return opts
+}
+
+func (r *ShowServiceRequest) toOpts() *ShowServiceOptions {
+	opts := &ShowServiceOptions{
+		ExcludeJobs: r.ExcludeJobs,
+		Like:        r.Like,
+		In:          r.In,
+		StartsWith:  r.StartsWith,
+		Limit:       r.Limit,
+	}
+	return opts
+}
+
+func (r servicesRow) convert() *Service {
+	service := Service{
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+		ComputePool:  r.ComputePool,
+		DnsName:      r.DnsName,
+		MinInstances: r.MinInstances,
+		MaxInstances: r.MaxInstances,
+		AutoResume:   r.AutoResume,
+		CreatedOn:    r.CreatedOn,
+	}
+	if r.Status.Valid {
+		service.Status = Pointer(ServiceStatus(r.Status.String))
+	}
+	if r.CurrentInstances.Valid {
+		service.CurrentInstances = Int(int(r.CurrentInstances.Int64))
+	}
+	if r.TargetInstances.Valid {
+		service.TargetInstances = Int(int(r.TargetInstances.Int64))
+	}
+	if r.MinReadyInstances.Valid {
+		service.MinReadyInstances = Int(int(r.MinReadyInstances.Int64))
+	}
+	if r.ExternalAccessIntegrations.Valid {
+		service.ExternalAccessIntegrations = ParseCommaSeparatedStringArray(r.ExternalAccessIntegrations.String)
+	}
+	if r.UpdatedOn.Valid {
+		service.UpdatedOn = Pointer(r.UpdatedOn.Time)
+	}
+	if r.ResumedOn.Valid {
+		service.ResumedOn = Pointer(r.ResumedOn.Time)
+	}
+	if r.SuspendedOn.Valid {
+		service.SuspendedOn = Pointer(r.SuspendedOn.Time)
+	}
+	if r.AutoSuspendSecs.Valid {
+		service.AutoSuspendSecs = Int(int(r.AutoSuspendSecs.Int64))
+	}
+	if r.Comment.Valid {
+		service.Comment = String(r.Comment.String)
+	}
+	if r.OwnerRoleType.Valid {
+		service.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	if r.QueryWarehouse.Valid {
+		service.QueryWarehouse = String(r.QueryWarehouse.String)
+	}
+	if r.IsJob.Valid {
+		service.IsJob = Bool(r.IsJob.Bool)
+	}
+	if r.SpecDigest.Valid {
+		service.SpecDigest = String(r.SpecDigest.String)
+	}
+	return &service
+}
+
+func (r *DescribeServiceRequest) toOpts() *DescribeServiceOptions {
+	opts := &DescribeServiceOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r serviceDetailsRow) convert() *ServiceDetails {
+	serviceDetails := ServiceDetails{
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+		ComputePool:  r.ComputePool,
+		Spec:         r.Spec,
+		DnsName:      r.DnsName,
+		MinInstances: r.MinInstances,
+		MaxInstances: r.MaxInstances,
+		AutoResume:   r.AutoResume,
+		CreatedOn:    r.CreatedOn,
+	}
+	if r.Status.Valid {
+		serviceDetails.Status = Pointer(ServiceStatus(r.Status.String))
+	}
+	if r.CurrentInstances.Valid {
+		serviceDetails.CurrentInstances = Int(int(r.CurrentInstances.Int64))
+	}
+	if r.TargetInstances.Valid {
+		serviceDetails.TargetInstances = Int(int(r.TargetInstances.Int64))
+	}
+	if r.MinReadyInstances.Valid {
+		serviceDetails.MinReadyInstances = Int(int(r.MinReadyInstances.Int64))
+	}
+	if r.ExternalAccessIntegrations.Valid {
+		serviceDetails.ExternalAccessIntegrations = ParseCommaSeparatedStringArray(r.ExternalAccessIntegrations.String)
+	}
+	if r.UpdatedOn.Valid {
+		serviceDetails.UpdatedOn = Pointer(r.UpdatedOn.Time)
+	}
+	if r.ResumedOn.Valid {
+		serviceDetails.ResumedOn = Pointer(r.ResumedOn.Time)
+	}
+	if r.SuspendedOn.Valid {
+		serviceDetails.SuspendedOn = Pointer(r.SuspendedOn.Time)
+	}
+	if r.AutoSuspendSecs.Valid {
+		serviceDetails.AutoSuspendSecs = Int(int(r.AutoSuspendSecs.Int64))
+	}
+	if r.Comment.Valid {
+		serviceDetails.Comment = String(r.Comment.String)
+	}
+	if r.OwnerRoleType.Valid {
+		serviceDetails.OwnerRoleType = String(r.OwnerRoleType.String)
+	}
+	if r.QueryWarehouse.Valid {
+		serviceDetails.QueryWarehouse = String(r.QueryWarehouse.String)
+	}
+	if r.IsJob.Valid {
+		serviceDetails.IsJob = Bool(r.IsJob.Bool)
+	}
+	if r.SpecDigest.Valid {
+		serviceDetails.SpecDigest = String(r.SpecDigest.String)
+	}
+	return &serviceDetails
+}
+
+// custom:begin additional
+// custom:end additional
@@ -0,0 +1,115 @@
+package sdk
+
+var (
+	_ validatable = new(CreateServiceOptions)
+	_ validatable = new(AlterServiceOptions)
+	_ validatable = new(DropServiceOptions)
+	_ validatable = new(ShowServiceOptions)
+	_ validatable = new(DescribeServiceOptions)
+)
+
+func (opts *CreateServiceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.InComputePool) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.FromSpecification) {
+		errs = append(errs, errNotSet("CreateServiceOptions", "FromSpecification"))
+	}
+	if opts.QueryWarehouse != nil && !ValidObjectIdentifier(opts.QueryWarehouse) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if valueSet(opts.FromSpecification) {
+		if !exactlyOneValueSet(opts.FromSpecification.SpecificationFile, opts.FromSpecification.Specification) {
+			errs = append(errs, errExactlyOneOf("CreateServiceOptions.FromSpecification", "SpecificationFile", "Specification"))
+		}
+		if moreThanOneValueSet(opts.FromSpecification.Stage, opts.FromSpecification.Specification) {
+			errs = append(errs, errOneOf("CreateServiceOptions.FromSpecification", "Stage", "Specification"))
+		}
+	}
+	// custom:begin CreateServiceOptions: additional validations
+	// custom:end CreateServiceOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterServiceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Resume, opts.Suspend, opts.FromSpecification, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterServiceOptions", "Resume", "Suspend", "FromSpecification", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.FromSpecification) {
+		if !exactlyOneValueSet(opts.FromSpecification.SpecificationFile, opts.FromSpecification.Specification) {
+			errs = append(errs, errExactlyOneOf("AlterServiceOptions.FromSpecification", "SpecificationFile", "Specification"))
+		}
+		if moreThanOneValueSet(opts.FromSpecification.Stage, opts.FromSpecification.Specification) {
+			errs = append(errs, errOneOf("AlterServiceOptions.FromSpecification", "Stage", "Specification"))
+		}
+	}
+	if valueSet(opts.Set) {
+		if opts.Set.QueryWarehouse != nil && !ValidObjectIdentifier(opts.Set.QueryWarehouse) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !anyValueSet(opts.Set.MinInstances, opts.Set.MaxInstances, opts.Set.MinReadyInstances, opts.Set.AutoSuspendSecs, opts.Set.QueryWarehouse, opts.Set.AutoResume, opts.Set.ExternalAccessIntegrations, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterServiceOptions.Set", "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.MinInstances, opts.Unset.MaxInstances, opts.Unset.MinReadyInstances, opts.Unset.AutoSuspendSecs, opts.Unset.QueryWarehouse, opts.Unset.AutoResume, opts.Unset.ExternalAccessIntegrations, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterServiceOptions.Unset", "MinInstances", "MaxInstances", "MinReadyInstances", "AutoSuspendSecs", "QueryWarehouse", "AutoResume", "ExternalAccessIntegrations", "Comment"))
+		}
+	}
+	// custom:begin AlterServiceOptions: additional validations
+	// custom:end AlterServiceOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DropServiceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DropServiceOptions: additional validations
+	// custom:end DropServiceOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowServiceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// custom:begin ShowServiceOptions: additional validations
+	// custom:end ShowServiceOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+func (opts *DescribeServiceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	// custom:begin DescribeServiceOptions: additional validations
+	// custom:end DescribeServiceOptions: additional validations
+	return JoinErrors(errs...)
+}
+
+// custom:begin additional
+// custom:end additional
@@ -4,6 +4,14 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var sessionPolicySecondaryRolesDef = g.NewQueryStruct("SessionPolicySecondaryRoles").
+	PredefinedQueryStructField("equals", "bool", g.StaticOptions().SQL("=")).
+	PredefinedQueryStructField("leftParen", "bool", g.StaticOptions().SQL("(")).
+	PredefinedQueryStructField("All", "*bool", g.KeywordOptions().SingleQuotes().SQL("ALL")).
+	List("Roles", "AccountObjectIdentifier", g.ListOptions().NoParentheses()).
+	PredefinedQueryStructField("rightParen", "bool", g.StaticOptions().SQL(")")).
+	WithValidation(g.ConflictingFields, "All", "Roles")
+
 var SessionPoliciesDef = g.NewInterface(
 	"SessionPolicies",
 	"SessionPolicy",
@@ -19,6 +27,7 @@ var SessionPoliciesDef = g.NewInterface(
 			Name().
 			OptionalNumberAssignment("SESSION_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
 			OptionalNumberAssignment("SESSION_UI_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
+			OptionalQueryStructField("AllowedSecondaryRoles", sessionPolicySecondaryRolesDef, g.KeywordOptions().SQL("ALLOWED_SECONDARY_ROLES")).
 			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 			WithValidation(g.ValidIdentifier, "name").
 			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
@@ -36,8 +45,9 @@ var SessionPoliciesDef = g.NewInterface(
 				g.NewQueryStruct("SessionPolicySet").
 					OptionalNumberAssignment("SESSION_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
 					OptionalNumberAssignment("SESSION_UI_IDLE_TIMEOUT_MINS", g.ParameterOptions().NoQuotes()).
+					OptionalQueryStructField("AllowedSecondaryRoles", sessionPolicySecondaryRolesDef, g.KeywordOptions().SQL("ALLOWED_SECONDARY_ROLES")).
 					OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"),
+					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"),
 				g.KeywordOptions().SQL("SET"),
 			).
 			OptionalSetTags().
@@ -47,9 +57,10 @@ var SessionPoliciesDef = g.NewInterface(
 				g.NewQueryStruct("SessionPolicyUnset").
 					OptionalSQL("SESSION_IDLE_TIMEOUT_MINS").
 					OptionalSQL("SESSION_UI_IDLE_TIMEOUT_MINS").
+					OptionalSQL("ALLOWED_SECONDARY_ROLES").
 					OptionalSQL("COMMENT").
-					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"),
-				g.KeywordOptions().SQL("UNSET"),
+					WithValidation(g.AtLeastOneValueSet, "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
 			).
 			WithValidation(g.ValidIdentifier, "name").
 			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"),
@@ -87,8 +98,11 @@ var SessionPoliciesDef = g.NewInterface(
 			Field("OwnerRoleType", "string"),
 		g.NewQueryStruct("ShowSessionPolicies").
 			Show().
-			SQL("SESSION POLICIES"),
+			SQL("SESSION POLICIES").
+			OptionalLike().
+			OptionalIn(),
 	).
+	ShowByIdOperation().
 	DescribeOperation(
 		g.DescriptionMappingKindSingleValue,
 		"https://docs.snowflake.com/en/sql-reference/sql/desc-session-policy",
@@ -97,12 +111,14 @@ var SessionPoliciesDef = g.NewInterface(
 			Field("name", "string").
 			Field("session_idle_timeout_mins", "int").
 			Field("session_ui_idle_timeout_mins", "int").
+			Field("allowed_secondary_roles", "sql.NullString").
 			Field("comment", "string"),
 		g.PlainStruct("SessionPolicyDescription").
 			Field("CreatedOn", "string").
 			Field("Name", "string").
 			Field("SessionIdleTimeoutMins", "int").
 			Field("SessionUIIdleTimeoutMins", "int").
+			Field("AllowedSecondaryRoles", "[]string").
 			Field("Comment", "string"),
 		g.NewQueryStruct("DescribeSessionPolicy").
 			Describe().
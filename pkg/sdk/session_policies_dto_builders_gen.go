@@ -30,11 +30,30 @@ func (s *CreateSessionPolicyRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleT
 	return s
 }
 
+func (s *CreateSessionPolicyRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles *SessionPolicySecondaryRolesRequest) *CreateSessionPolicyRequest {
+	s.AllowedSecondaryRoles = AllowedSecondaryRoles
+	return s
+}
+
 func (s *CreateSessionPolicyRequest) WithComment(Comment *string) *CreateSessionPolicyRequest {
 	s.Comment = Comment
 	return s
 }
 
+func NewSessionPolicySecondaryRolesRequest() *SessionPolicySecondaryRolesRequest {
+	return &SessionPolicySecondaryRolesRequest{}
+}
+
+func (s *SessionPolicySecondaryRolesRequest) WithAll(All *bool) *SessionPolicySecondaryRolesRequest {
+	s.All = All
+	return s
+}
+
+func (s *SessionPolicySecondaryRolesRequest) WithRoles(Roles []AccountObjectIdentifier) *SessionPolicySecondaryRolesRequest {
+	s.Roles = Roles
+	return s
+}
+
 func NewAlterSessionPolicyRequest(
 	name SchemaObjectIdentifier,
 ) *AlterSessionPolicyRequest {
@@ -87,6 +106,11 @@ func (s *SessionPolicySetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTime
 	return s
 }
 
+func (s *SessionPolicySetRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles *SessionPolicySecondaryRolesRequest) *SessionPolicySetRequest {
+	s.AllowedSecondaryRoles = AllowedSecondaryRoles
+	return s
+}
+
 func (s *SessionPolicySetRequest) WithComment(Comment *string) *SessionPolicySetRequest {
 	s.Comment = Comment
 	return s
@@ -106,6 +130,11 @@ func (s *SessionPolicyUnsetRequest) WithSessionUiIdleTimeoutMins(SessionUiIdleTi
 	return s
 }
 
+func (s *SessionPolicyUnsetRequest) WithAllowedSecondaryRoles(AllowedSecondaryRoles *bool) *SessionPolicyUnsetRequest {
+	s.AllowedSecondaryRoles = AllowedSecondaryRoles
+	return s
+}
+
 func (s *SessionPolicyUnsetRequest) WithComment(Comment *bool) *SessionPolicyUnsetRequest {
 	s.Comment = Comment
 	return s
@@ -128,6 +157,16 @@ func NewShowSessionPolicyRequest() *ShowSessionPolicyRequest {
 	return &ShowSessionPolicyRequest{}
 }
 
+func (s *ShowSessionPolicyRequest) WithLike(Like *Like) *ShowSessionPolicyRequest {
+	s.Like = Like
+	return s
+}
+
+func (s *ShowSessionPolicyRequest) WithIn(In *In) *ShowSessionPolicyRequest {
+	s.In = In
+	return s
+}
+
 func NewDescribeSessionPolicyRequest(
 	name SchemaObjectIdentifier,
 ) *DescribeSessionPolicyRequest {
@@ -16,9 +16,15 @@ type CreateSessionPolicyRequest struct {
 	name                     SchemaObjectIdentifier // required
 	SessionIdleTimeoutMins   *int
 	SessionUiIdleTimeoutMins *int
+	AllowedSecondaryRoles    *SessionPolicySecondaryRolesRequest
 	Comment                  *string
 }
 
+type SessionPolicySecondaryRolesRequest struct {
+	All   *bool
+	Roles []AccountObjectIdentifier
+}
+
 type AlterSessionPolicyRequest struct {
 	IfExists  *bool
 	name      SchemaObjectIdentifier // required
@@ -32,12 +38,14 @@ type AlterSessionPolicyRequest struct {
 type SessionPolicySetRequest struct {
 	SessionIdleTimeoutMins   *int
 	SessionUiIdleTimeoutMins *int
+	AllowedSecondaryRoles    *SessionPolicySecondaryRolesRequest
 	Comment                  *string
 }
 
 type SessionPolicyUnsetRequest struct {
 	SessionIdleTimeoutMins   *bool
 	SessionUiIdleTimeoutMins *bool
+	AllowedSecondaryRoles    *bool
 	Comment                  *bool
 }
 
@@ -46,7 +54,10 @@ type DropSessionPolicyRequest struct {
 	name     SchemaObjectIdentifier // required
 }
 
-type ShowSessionPolicyRequest struct{}
+type ShowSessionPolicyRequest struct {
+	Like *Like
+	In   *In
+}
 
 type DescribeSessionPolicyRequest struct {
 	name SchemaObjectIdentifier // required
@@ -16,14 +16,23 @@ type SessionPolicies interface {
 
 // CreateSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-session-policy.
 type CreateSessionPolicyOptions struct {
-	create                   bool                   `ddl:"static" sql:"CREATE"`
-	OrReplace                *bool                  `ddl:"keyword" sql:"OR REPLACE"`
-	sessionPolicy            bool                   `ddl:"static" sql:"SESSION POLICY"`
-	IfNotExists              *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                     SchemaObjectIdentifier `ddl:"identifier"`
-	SessionIdleTimeoutMins   *int                   `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
-	SessionUiIdleTimeoutMins *int                   `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
-	Comment                  *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                   bool                         `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                        `ddl:"keyword" sql:"OR REPLACE"`
+	sessionPolicy            bool                         `ddl:"static" sql:"SESSION POLICY"`
+	IfNotExists              *bool                        `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier       `ddl:"identifier"`
+	SessionIdleTimeoutMins   *int                         `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUiIdleTimeoutMins *int                         `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *SessionPolicySecondaryRoles `ddl:"keyword" sql:"ALLOWED_SECONDARY_ROLES"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type SessionPolicySecondaryRoles struct {
+	equals     bool                      `ddl:"static" sql:"="`
+	leftParen  bool                      `ddl:"static" sql:"("`
+	All        *bool                     `ddl:"keyword,single_quotes" sql:"ALL"`
+	Roles      []AccountObjectIdentifier `ddl:"list,no_parentheses"`
+	rightParen bool                      `ddl:"static" sql:")"`
 }
 
 // AlterSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-session-policy.
@@ -36,18 +45,20 @@ type AlterSessionPolicyOptions struct {
 	Set           *SessionPolicySet       `ddl:"keyword" sql:"SET"`
 	SetTags       []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
 	UnsetTags     []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
-	Unset         *SessionPolicyUnset     `ddl:"keyword" sql:"UNSET"`
+	Unset         *SessionPolicyUnset     `ddl:"list,no_parentheses" sql:"UNSET"`
 }
 
 type SessionPolicySet struct {
-	SessionIdleTimeoutMins   *int    `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
-	SessionUiIdleTimeoutMins *int    `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
-	Comment                  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	SessionIdleTimeoutMins   *int                         `ddl:"parameter,no_quotes" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUiIdleTimeoutMins *int                         `ddl:"parameter,no_quotes" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *SessionPolicySecondaryRoles `ddl:"keyword" sql:"ALLOWED_SECONDARY_ROLES"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type SessionPolicyUnset struct {
 	SessionIdleTimeoutMins   *bool `ddl:"keyword" sql:"SESSION_IDLE_TIMEOUT_MINS"`
 	SessionUiIdleTimeoutMins *bool `ddl:"keyword" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	AllowedSecondaryRoles    *bool `ddl:"keyword" sql:"ALLOWED_SECONDARY_ROLES"`
 	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
@@ -61,8 +72,10 @@ type DropSessionPolicyOptions struct {
 
 // ShowSessionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-session-policies.
 type ShowSessionPolicyOptions struct {
-	show            bool `ddl:"static" sql:"SHOW"`
-	sessionPolicies bool `ddl:"static" sql:"SESSION POLICIES"`
+	show            bool  `ddl:"static" sql:"SHOW"`
+	sessionPolicies bool  `ddl:"static" sql:"SESSION POLICIES"`
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+	In              *In   `ddl:"keyword" sql:"IN"`
 }
 
 type showSessionPolicyDBRow struct {
@@ -101,6 +114,7 @@ type describeSessionPolicyDBRow struct {
 	Name                     string         `db:"name"`
 	SessionIdleTimeoutMins   int            `db:"session_idle_timeout_mins"`
 	SessionUiIdleTimeoutMins int            `db:"session_ui_idle_timeout_mins"`
+	AllowedSecondaryRoles    sql.NullString `db:"allowed_secondary_roles"`
 	Comment                  sql.NullString `db:"comment"`
 }
 
@@ -109,6 +123,7 @@ type SessionPolicyDescription struct {
 	Name                     string
 	SessionIdleTimeoutMins   int
 	SessionUIIdleTimeoutMins int
+	AllowedSecondaryRoles    []string
 	Comment                  string
 }
 
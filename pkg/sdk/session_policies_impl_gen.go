@@ -38,12 +38,15 @@ func (v *sessionPolicies) Show(ctx context.Context, request *ShowSessionPolicyRe
 }
 
 func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error) {
-	sessionPolicies, err := v.Show(ctx, NewShowSessionPolicyRequest())
+	// custom:begin ShowByID
+	sessionPolicies, err := v.Show(ctx, NewShowSessionPolicyRequest().
+		WithIn(&In{Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())}).
+		WithLike(&Like{Pattern: String(id.Name())}))
 	if err != nil {
 		return nil, err
 	}
-
 	return collections.FindOne(sessionPolicies, func(r SessionPolicy) bool { return r.Name == id.Name() })
+	// custom:end ShowByID
 }
 
 func (v *sessionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDescription, error) {
@@ -64,7 +67,14 @@ func (r *CreateSessionPolicyRequest) toOpts() *CreateSessionPolicyOptions {
 		name:                     r.name,
 		SessionIdleTimeoutMins:   r.SessionIdleTimeoutMins,
 		SessionUiIdleTimeoutMins: r.SessionUiIdleTimeoutMins,
-		Comment:                  r.Comment,
+
+		Comment: r.Comment,
+	}
+	if r.AllowedSecondaryRoles != nil {
+		opts.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+			All:   r.AllowedSecondaryRoles.All,
+			Roles: r.AllowedSecondaryRoles.Roles,
+		}
 	}
 	return opts
 }
@@ -82,13 +92,21 @@ func (r *AlterSessionPolicyRequest) toOpts() *AlterSessionPolicyOptions {
 		opts.Set = &SessionPolicySet{
 			SessionIdleTimeoutMins:   r.Set.SessionIdleTimeoutMins,
 			SessionUiIdleTimeoutMins: r.Set.SessionUiIdleTimeoutMins,
-			Comment:                  r.Set.Comment,
+
+			Comment: r.Set.Comment,
+		}
+		if r.Set.AllowedSecondaryRoles != nil {
+			opts.Set.AllowedSecondaryRoles = &SessionPolicySecondaryRoles{
+				All:   r.Set.AllowedSecondaryRoles.All,
+				Roles: r.Set.AllowedSecondaryRoles.Roles,
+			}
 		}
 	}
 	if r.Unset != nil {
 		opts.Unset = &SessionPolicyUnset{
 			SessionIdleTimeoutMins:   r.Unset.SessionIdleTimeoutMins,
 			SessionUiIdleTimeoutMins: r.Unset.SessionUiIdleTimeoutMins,
+			AllowedSecondaryRoles:    r.Unset.AllowedSecondaryRoles,
 			Comment:                  r.Unset.Comment,
 		}
 	}
@@ -104,7 +122,10 @@ func (r *DropSessionPolicyRequest) toOpts() *DropSessionPolicyOptions {
 }
 
 func (r *ShowSessionPolicyRequest) toOpts() *ShowSessionPolicyOptions {
-	opts := &ShowSessionPolicyOptions{}
+	opts := &ShowSessionPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
 	return opts
 }
 
@@ -136,6 +157,11 @@ func (r describeSessionPolicyDBRow) convert() *SessionPolicyDescription {
 		SessionIdleTimeoutMins:   r.SessionIdleTimeoutMins,
 		SessionUIIdleTimeoutMins: r.SessionUiIdleTimeoutMins,
 	}
+	// custom:begin AllowedSecondaryRoles
+	if r.AllowedSecondaryRoles.Valid {
+		sessionPolicyDescription.AllowedSecondaryRoles = ParseCommaSeparatedStringArray(r.AllowedSecondaryRoles.String)
+	}
+	// custom:end AllowedSecondaryRoles
 	if r.Comment.Valid {
 		sessionPolicyDescription.Comment = r.Comment.String
 	}
@@ -21,6 +21,11 @@ func (opts *CreateSessionPolicyOptions) validate() error {
 	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
 		errs = append(errs, errOneOf("CreateSessionPolicyOptions", "OrReplace", "IfNotExists"))
 	}
+	if valueSet(opts.AllowedSecondaryRoles) {
+		if everyValueSet(opts.AllowedSecondaryRoles.All, opts.AllowedSecondaryRoles.Roles) {
+			errs = append(errs, errOneOf("CreateSessionPolicyOptions.AllowedSecondaryRoles", "All", "Roles"))
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -36,13 +41,18 @@ func (opts *AlterSessionPolicyOptions) validate() error {
 		errs = append(errs, errExactlyOneOf("AlterSessionPolicyOptions", "RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		if ok := anyValueSet(opts.Set.SessionIdleTimeoutMins, opts.Set.SessionUiIdleTimeoutMins, opts.Set.AllowedSecondaryRoles, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Set", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
+		}
+		if valueSet(opts.Set.AllowedSecondaryRoles) {
+			if everyValueSet(opts.Set.AllowedSecondaryRoles.All, opts.Set.AllowedSecondaryRoles.Roles) {
+				errs = append(errs, errOneOf("AlterSessionPolicyOptions.Set.AllowedSecondaryRoles", "All", "Roles"))
+			}
 		}
 	}
 	if valueSet(opts.Unset) {
-		if ok := anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "Comment"))
+		if ok := anyValueSet(opts.Unset.SessionIdleTimeoutMins, opts.Unset.SessionUiIdleTimeoutMins, opts.Unset.AllowedSecondaryRoles, opts.Unset.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("AlterSessionPolicyOptions.Unset", "SessionIdleTimeoutMins", "SessionUiIdleTimeoutMins", "AllowedSecondaryRoles", "Comment"))
 		}
 	}
 	return errors.Join(errs...)
@@ -4,13 +4,24 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 
 //go:generate go run ./poc/main.go
 
+var streamlitExternalAccessIntegrations = g.NewQueryStruct("StreamlitExternalAccessIntegrations").
+	List("ExternalAccessIntegrations", "AccountObjectIdentifier", g.ListOptions().MustParentheses())
+
 var streamlitSet = g.NewQueryStruct("StreamlitSet").
 	OptionalTextAssignment("ROOT_LOCATION", g.ParameterOptions().SingleQuotes().Required()).
 	OptionalTextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes().Required()).
 	OptionalIdentifier("Warehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
+	OptionalQueryStructField("ExternalAccessIntegrations", streamlitExternalAccessIntegrations, g.ParameterOptions().SQL("EXTERNAL_ACCESS_INTEGRATIONS").Parentheses()).
 	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+	OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 	WithValidation(g.ValidIdentifierIfSet, "Warehouse")
 
+var streamlitUnset = g.NewQueryStruct("StreamlitUnset").
+	OptionalSQL("QUERY_WAREHOUSE").
+	OptionalSQL("COMMENT").
+	OptionalSQL("TITLE").
+	WithValidation(g.AtLeastOneValueSet, "QueryWarehouse", "Comment", "Title")
+
 var StreamlitsDef = g.NewInterface(
 	"Streamlits",
 	"Streamlit",
@@ -26,7 +37,9 @@ var StreamlitsDef = g.NewInterface(
 		TextAssignment("ROOT_LOCATION", g.ParameterOptions().SingleQuotes().Required()).
 		TextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes().Required()).
 		OptionalIdentifier("Warehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
+		ListAssignment("EXTERNAL_ACCESS_INTEGRATIONS", "AccountObjectIdentifier", g.ParameterOptions().Parentheses()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "Warehouse").
 		WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
@@ -42,10 +55,15 @@ var StreamlitsDef = g.NewInterface(
 			streamlitSet,
 			g.KeywordOptions().SQL("SET"),
 		).
+		OptionalQueryStructField(
+			"Unset",
+			streamlitUnset,
+			g.ListOptions().NoParentheses().SQL("UNSET"),
+		).
 		Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-streamlit",
 	g.NewQueryStruct("DropStreamlit").
@@ -94,17 +112,54 @@ var StreamlitsDef = g.NewInterface(
 		Field("root_location", "string").
 		Field("main_file", "string").
 		Field("query_warehouse", "sql.NullString").
-		Field("url_id", "string"),
+		Field("url_id", "string").
+		Field("external_access_integrations", "sql.NullString").
+		Field("external_access_secrets", "sql.NullString"),
 	g.PlainStruct("StreamlitDetail").
 		Field("Name", "string").
 		Field("Title", "string").
 		Field("RootLocation", "string").
 		Field("MainFile", "string").
 		Field("QueryWarehouse", "string").
-		Field("UrlId", "string"),
+		Field("UrlId", "string").
+		Field("ExternalAccessIntegrations", "[]string").
+		Field("ExternalAccessSecrets", "string"),
 	g.NewQueryStruct("DescribeStreamlit").
 		Describe().
 		SQL("STREAMLIT").
 		Name().
 		WithValidation(g.ValidIdentifier, "name"),
 )
+
+var StreamlitResourceDef = g.NewResourceDefinition(
+	StreamlitsDef,
+	"streamlit",
+	g.ResourceAttr("root_location", g.ResourceAttributeKindString, "Specifies the full path to the named stage containing the Streamlit Python files, media files, and the environment.yml file.").
+		WithRequired().
+		OnCreate("RootLocation").
+		OnSet("Set.RootLocation"),
+	g.ResourceAttr("main_file", g.ResourceAttributeKindString, "Specifies the filename of the Streamlit Python application. This filename is relative to the value of `root_location`.").
+		WithRequired().
+		OnCreate("MainFile").
+		OnSet("Set.MainFile"),
+	g.ResourceAttr("query_warehouse", g.ResourceAttributeKindString, "Specifies the warehouse where SQL queries issued by the Streamlit application are run.").
+		OnCreate("Warehouse").
+		OnSet("Set.Warehouse").
+		OnUnset("Unset.QueryWarehouse").
+		ReadFrom("QueryWarehouse"),
+	g.ResourceAttr("external_access_integrations", g.ResourceAttributeKindStringSet, "External access integrations connected to the Streamlit.").
+		OnCreate("ExternalAccessIntegrations").
+		OnSet("Set.ExternalAccessIntegrations"),
+	g.ResourceAttr("title", g.ResourceAttributeKindString, "Specifies a title for the Streamlit app to display in Snowsight.").
+		OnCreate("Title").
+		OnSet("Set.Title").
+		OnUnset("Unset.Title").
+		ReadFrom("Title"),
+	g.ResourceAttr("comment", g.ResourceAttributeKindString, "Specifies a comment for the streamlit.").
+		OnCreate("Comment").
+		OnSet("Set.Comment").
+		OnUnset("Unset.Comment").
+		ReadFrom("Comment"),
+	g.ResourceAttr("url_id", g.ResourceAttributeKindString, "Unique identifier of the Streamlit app used in its URL.").
+		ReadFrom("UrlId"),
+)
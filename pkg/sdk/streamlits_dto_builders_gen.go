@@ -31,11 +31,21 @@ func (s *CreateStreamlitRequest) WithWarehouse(Warehouse *AccountObjectIdentifie
 	return s
 }
 
+func (s *CreateStreamlitRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *CreateStreamlitRequest {
+	s.ExternalAccessIntegrations = ExternalAccessIntegrations
+	return s
+}
+
 func (s *CreateStreamlitRequest) WithComment(Comment *string) *CreateStreamlitRequest {
 	s.Comment = Comment
 	return s
 }
 
+func (s *CreateStreamlitRequest) WithTitle(Title *string) *CreateStreamlitRequest {
+	s.Title = Title
+	return s
+}
+
 func NewAlterStreamlitRequest(
 	name SchemaObjectIdentifier,
 ) *AlterStreamlitRequest {
@@ -54,6 +64,11 @@ func (s *AlterStreamlitRequest) WithSet(Set *StreamlitSetRequest) *AlterStreamli
 	return s
 }
 
+func (s *AlterStreamlitRequest) WithUnset(Unset *StreamlitUnsetRequest) *AlterStreamlitRequest {
+	s.Unset = Unset
+	return s
+}
+
 func (s *AlterStreamlitRequest) WithRenameTo(RenameTo *SchemaObjectIdentifier) *AlterStreamlitRequest {
 	s.RenameTo = RenameTo
 	return s
@@ -74,11 +89,49 @@ func (s *StreamlitSetRequest) WithWarehouse(Warehouse *AccountObjectIdentifier)
 	return s
 }
 
+func (s *StreamlitSetRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations *StreamlitExternalAccessIntegrationsRequest) *StreamlitSetRequest {
+	s.ExternalAccessIntegrations = ExternalAccessIntegrations
+	return s
+}
+
 func (s *StreamlitSetRequest) WithComment(Comment *string) *StreamlitSetRequest {
 	s.Comment = Comment
 	return s
 }
 
+func (s *StreamlitSetRequest) WithTitle(Title *string) *StreamlitSetRequest {
+	s.Title = Title
+	return s
+}
+
+func NewStreamlitExternalAccessIntegrationsRequest() *StreamlitExternalAccessIntegrationsRequest {
+	return &StreamlitExternalAccessIntegrationsRequest{}
+}
+
+func (s *StreamlitExternalAccessIntegrationsRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations []AccountObjectIdentifier) *StreamlitExternalAccessIntegrationsRequest {
+	s.ExternalAccessIntegrations = ExternalAccessIntegrations
+	return s
+}
+
+func NewStreamlitUnsetRequest() *StreamlitUnsetRequest {
+	return &StreamlitUnsetRequest{}
+}
+
+func (s *StreamlitUnsetRequest) WithQueryWarehouse(QueryWarehouse *bool) *StreamlitUnsetRequest {
+	s.QueryWarehouse = QueryWarehouse
+	return s
+}
+
+func (s *StreamlitUnsetRequest) WithComment(Comment *bool) *StreamlitUnsetRequest {
+	s.Comment = Comment
+	return s
+}
+
+func (s *StreamlitUnsetRequest) WithTitle(Title *bool) *StreamlitUnsetRequest {
+	s.Title = Title
+	return s
+}
+
 func NewDropStreamlitRequest(
 	name SchemaObjectIdentifier,
 ) *DropStreamlitRequest {
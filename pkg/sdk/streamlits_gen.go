@@ -16,15 +16,17 @@ type Streamlits interface {
 
 // CreateStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-streamlit.
 type CreateStreamlitOptions struct {
-	create       bool                     `ddl:"static" sql:"CREATE"`
-	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
-	streamlit    bool                     `ddl:"static" sql:"STREAMLIT"`
-	IfNotExists  *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name         SchemaObjectIdentifier   `ddl:"identifier"`
-	RootLocation string                   `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
-	MainFile     string                   `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
-	Warehouse    *AccountObjectIdentifier `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
-	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                     bool                      `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                     `ddl:"keyword" sql:"OR REPLACE"`
+	streamlit                  bool                      `ddl:"static" sql:"STREAMLIT"`
+	IfNotExists                *bool                     `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier    `ddl:"identifier"`
+	RootLocation               string                    `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	MainFile                   string                    `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	Warehouse                  *AccountObjectIdentifier  `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Title                      *string                   `ddl:"parameter,single_quotes" sql:"TITLE"`
 }
 
 // AlterStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-streamlit.
@@ -34,14 +36,27 @@ type AlterStreamlitOptions struct {
 	IfExists  *bool                   `ddl:"keyword" sql:"IF EXISTS"`
 	name      SchemaObjectIdentifier  `ddl:"identifier"`
 	Set       *StreamlitSet           `ddl:"keyword" sql:"SET"`
+	Unset     *StreamlitUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
 	RenameTo  *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
 }
 
 type StreamlitSet struct {
-	RootLocation *string                  `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
-	MainFile     *string                  `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
-	Warehouse    *AccountObjectIdentifier `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
-	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	RootLocation               *string                              `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	MainFile                   *string                              `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	Warehouse                  *AccountObjectIdentifier             `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations *StreamlitExternalAccessIntegrations `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                              `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Title                      *string                              `ddl:"parameter,single_quotes" sql:"TITLE"`
+}
+
+type StreamlitExternalAccessIntegrations struct {
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"list,must_parentheses"`
+}
+
+type StreamlitUnset struct {
+	QueryWarehouse *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+	Comment        *bool `ddl:"keyword" sql:"COMMENT"`
+	Title          *bool `ddl:"keyword" sql:"TITLE"`
 }
 
 // DropStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-streamlit.
@@ -100,19 +115,23 @@ type DescribeStreamlitOptions struct {
 }
 
 type streamlitsDetailRow struct {
-	Name           string         `db:"name"`
-	Title          sql.NullString `db:"title"`
-	RootLocation   string         `db:"root_location"`
-	MainFile       string         `db:"main_file"`
-	QueryWarehouse sql.NullString `db:"query_warehouse"`
-	UrlId          string         `db:"url_id"`
+	Name                       string         `db:"name"`
+	Title                      sql.NullString `db:"title"`
+	RootLocation               string         `db:"root_location"`
+	MainFile                   string         `db:"main_file"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	UrlId                      string         `db:"url_id"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	ExternalAccessSecrets      sql.NullString `db:"external_access_secrets"`
 }
 
 type StreamlitDetail struct {
-	Name           string
-	Title          string
-	RootLocation   string
-	MainFile       string
-	QueryWarehouse string
-	UrlId          string
+	Name                       string
+	Title                      string
+	RootLocation               string
+	MainFile                   string
+	QueryWarehouse             string
+	UrlId                      string
+	ExternalAccessIntegrations []string
+	ExternalAccessSecrets      string
 }
@@ -36,8 +36,10 @@ func TestStreamlits_Create(t *testing.T) {
 		opts.RootLocation = "@test"
 		opts.MainFile = "manifest.yml"
 		opts.Warehouse = &warehouse
+		opts.ExternalAccessIntegrations = []AccountObjectIdentifier{NewAccountObjectIdentifier("integration1"), NewAccountObjectIdentifier("integration2")}
 		opts.Comment = String("test")
-		assertOptsValidAndSQLEquals(t, opts, `CREATE STREAMLIT IF NOT EXISTS %s ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s COMMENT = 'test'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		opts.Title = String("title")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE STREAMLIT IF NOT EXISTS %s ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s EXTERNAL_ACCESS_INTEGRATIONS = ("integration1", "integration2") COMMENT = 'test' TITLE = 'title'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
 	})
 }
 
@@ -64,7 +66,13 @@ func TestStreamlits_Alter(t *testing.T) {
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.QueryWarehouse opts.Unset.Comment opts.Unset.Title] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &StreamlitUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterStreamlitOptions.Unset", "QueryWarehouse", "Comment", "Title"))
 	})
 
 	t.Run("alter: set options", func(t *testing.T) {
@@ -75,9 +83,31 @@ func TestStreamlits_Alter(t *testing.T) {
 			RootLocation: String("@test"),
 			MainFile:     String("manifest.yml"),
 			Warehouse:    &warehouse,
-			Comment:      String("test"),
+			ExternalAccessIntegrations: &StreamlitExternalAccessIntegrations{
+				ExternalAccessIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("integration1")},
+			},
+			Comment: String("test"),
+			Title:   String("title"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s SET ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s EXTERNAL_ACCESS_INTEGRATIONS = ("integration1") COMMENT = 'test' TITLE = 'title'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+	})
+
+	t.Run("alter: set empty external access integrations", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &StreamlitSet{
+			ExternalAccessIntegrations: &StreamlitExternalAccessIntegrations{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s SET EXTERNAL_ACCESS_INTEGRATIONS = ()`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &StreamlitUnset{
+			QueryWarehouse: Bool(true),
+			Comment:        Bool(true),
+			Title:          Bool(true),
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s SET ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s COMMENT = 'test'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s UNSET QUERY_WAREHOUSE, COMMENT, TITLE`, id.FullyQualifiedName())
 	})
 }
 
@@ -59,13 +59,15 @@ func (v *streamlits) Describe(ctx context.Context, id SchemaObjectIdentifier) (*
 
 func (r *CreateStreamlitRequest) toOpts() *CreateStreamlitOptions {
 	opts := &CreateStreamlitOptions{
-		OrReplace:    r.OrReplace,
-		IfNotExists:  r.IfNotExists,
-		name:         r.name,
-		RootLocation: r.RootLocation,
-		MainFile:     r.MainFile,
-		Warehouse:    r.Warehouse,
-		Comment:      r.Comment,
+		OrReplace:                  r.OrReplace,
+		IfNotExists:                r.IfNotExists,
+		name:                       r.name,
+		RootLocation:               r.RootLocation,
+		MainFile:                   r.MainFile,
+		Warehouse:                  r.Warehouse,
+		ExternalAccessIntegrations: r.ExternalAccessIntegrations,
+		Comment:                    r.Comment,
+		Title:                      r.Title,
 	}
 	return opts
 }
@@ -83,6 +85,19 @@ func (r *AlterStreamlitRequest) toOpts() *AlterStreamlitOptions {
 			MainFile:     r.Set.MainFile,
 			Warehouse:    r.Set.Warehouse,
 			Comment:      r.Set.Comment,
+			Title:        r.Set.Title,
+		}
+		if r.Set.ExternalAccessIntegrations != nil {
+			opts.Set.ExternalAccessIntegrations = &StreamlitExternalAccessIntegrations{
+				ExternalAccessIntegrations: r.Set.ExternalAccessIntegrations.ExternalAccessIntegrations,
+			}
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &StreamlitUnset{
+			QueryWarehouse: r.Unset.QueryWarehouse,
+			Comment:        r.Unset.Comment,
+			Title:          r.Unset.Title,
 		}
 	}
 	return opts
@@ -148,5 +163,11 @@ func (r streamlitsDetailRow) convert() *StreamlitDetail {
 	if r.QueryWarehouse.Valid {
 		e.QueryWarehouse = r.QueryWarehouse.String
 	}
+	if r.ExternalAccessIntegrations.Valid {
+		e.ExternalAccessIntegrations = ParseCommaSeparatedStringArray(r.ExternalAccessIntegrations.String)
+	}
+	if r.ExternalAccessSecrets.Valid {
+		e.ExternalAccessSecrets = r.ExternalAccessSecrets.String
+	}
 	return e
 }
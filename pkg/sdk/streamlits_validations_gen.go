@@ -36,14 +36,19 @@ func (opts *AlterStreamlitOptions) validate() error {
 	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if !exactlyOneValueSet(opts.RenameTo, opts.Set) {
-		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set"))
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset) {
+		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset"))
 	}
 	if valueSet(opts.Set) {
 		if opts.Set.Warehouse != nil && !ValidObjectIdentifier(opts.Set.Warehouse) {
 			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
 	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.QueryWarehouse, opts.Unset.Comment, opts.Unset.Title) {
+			errs = append(errs, errAtLeastOneOf("AlterStreamlitOptions.Unset", "QueryWarehouse", "Comment", "Title"))
+		}
+	}
 	return JoinErrors(errs...)
 }
 
@@ -12,7 +12,6 @@ var _ convertibleRow[Table] = new(tableDBRow)
 
 // TODO [SNOW-1007542]: add missing features:
 // - show columns (https://docs.snowflake.com/en/sql-reference/sql/show-columns)
-// - show primary keys (https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys)
 // - describe search optimization (https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization)
 // - truncate table (https://docs.snowflake.com/en/sql-reference/sql/truncate-table)
 // - undrop table (https://docs.snowflake.com/en/sql-reference/sql/undrop-table)
@@ -28,6 +27,9 @@ type Tables interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
 	DescribeColumns(ctx context.Context, req *DescribeTableColumnsRequest) ([]TableColumnDetails, error)
 	DescribeStage(ctx context.Context, req *DescribeTableStageRequest) ([]TableStageDetails, error)
+	ShowPrimaryKeys(ctx context.Context, req *ShowTablePrimaryKeysRequest) ([]TableKeyColumn, error)
+	ShowUniqueKeys(ctx context.Context, req *ShowTableUniqueKeysRequest) ([]TableKeyColumn, error)
+	ShowImportedKeys(ctx context.Context, req *ShowTableImportedKeysRequest) ([]TableImportedKeyColumn, error)
 }
 
 // TODO: check if [...] in the docs (like in https://docs.snowflake.com/en/sql-reference/sql/create-table#create-table-using-template) mean that we can reuse all parameters from "normal" createTableOptions
@@ -223,6 +225,8 @@ type alterTableOptions struct {
 	DropRowAccessPolicy       *TableDropRowAccessPolicy       `ddl:"keyword"`
 	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
 	DropAllAccessRowPolicies  *bool                           `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	SetAggregationPolicy      *TableSetAggregationPolicy      `ddl:"keyword"`
+	UnsetAggregationPolicy    *bool                           `ddl:"keyword" sql:"UNSET AGGREGATION POLICY"`
 }
 
 type TableClusteringAction struct {
@@ -252,14 +256,16 @@ const (
 
 type TableColumnAction struct {
 	// One of
-	Add                *TableColumnAddAction                     `ddl:"keyword" sql:"ADD"`
-	Rename             *TableColumnRenameAction                  `ddl:"keyword"`
-	Alter              []TableColumnAlterAction                  `ddl:"keyword" sql:"ALTER"`
-	SetMaskingPolicy   *TableColumnAlterSetMaskingPolicyAction   `ddl:"keyword"`
-	UnsetMaskingPolicy *TableColumnAlterUnsetMaskingPolicyAction `ddl:"keyword"`
-	SetTags            *TableColumnAlterSetTagsAction            `ddl:"keyword"`
-	UnsetTags          *TableColumnAlterUnsetTagsAction          `ddl:"keyword"`
-	DropColumns        *TableColumnAlterDropColumns              `ddl:"keyword"`
+	Add                   *TableColumnAddAction                        `ddl:"keyword" sql:"ADD"`
+	Rename                *TableColumnRenameAction                     `ddl:"keyword"`
+	Alter                 []TableColumnAlterAction                     `ddl:"keyword" sql:"ALTER"`
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyAction      `ddl:"keyword"`
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyAction    `ddl:"keyword"`
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyAction   `ddl:"keyword"`
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyAction `ddl:"keyword"`
+	SetTags               *TableColumnAlterSetTagsAction               `ddl:"keyword"`
+	UnsetTags             *TableColumnAlterUnsetTagsAction             `ddl:"keyword"`
+	DropColumns           *TableColumnAlterDropColumns                 `ddl:"keyword"`
 }
 
 type TableColumnAddAction struct {
@@ -321,6 +327,20 @@ type TableColumnAlterUnsetMaskingPolicyAction struct {
 	setMaskingPolicy bool   `ddl:"static" sql:"UNSET MASKING POLICY"`
 }
 
+type TableColumnAlterSetProjectionPolicyAction struct {
+	alter                bool                   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName           string                 `ddl:"keyword"`
+	setProjectionPolicy  bool                   `ddl:"static" sql:"SET PROJECTION POLICY"`
+	ProjectionPolicyName SchemaObjectIdentifier `ddl:"identifier"`
+	Force                *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type TableColumnAlterUnsetProjectionPolicyAction struct {
+	alter                 bool   `ddl:"static" sql:"ALTER COLUMN"`
+	ColumnName            string `ddl:"keyword"`
+	unsetProjectionPolicy bool   `ddl:"static" sql:"UNSET PROJECTION POLICY"`
+}
+
 type TableColumnAlterSetTagsAction struct {
 	alter      bool             `ddl:"static" sql:"ALTER COLUMN"`
 	ColumnName string           `ddl:"keyword"`
@@ -484,6 +504,13 @@ type TableDropAndAddRowAccessPolicy struct {
 	Add  TableAddRowAccessPolicy  `ddl:"keyword"`
 }
 
+type TableSetAggregationPolicy struct {
+	set               bool                   `ddl:"static" sql:"SET"`
+	AggregationPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"AGGREGATION POLICY"`
+	EntityKey         []string               `ddl:"keyword,parentheses" sql:"ENTITY KEY"`
+	Force             *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
 // dropTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-table
 type dropTableOptions struct {
 	drop     bool                   `ddl:"static" sql:"DROP"`
@@ -776,3 +803,120 @@ func (r tableStageDetailsRow) convert() *TableStageDetails {
 		PropertyDefault: r.PropertyDefault,
 	}
 }
+
+// showTablePrimaryKeysOptions based on https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys
+type showTablePrimaryKeysOptions struct {
+	showPrimaryKeys bool                   `ddl:"static" sql:"SHOW PRIMARY KEYS IN TABLE"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// showTableUniqueKeysOptions based on https://docs.snowflake.com/en/sql-reference/sql/show-unique-keys
+type showTableUniqueKeysOptions struct {
+	showUniqueKeys bool                   `ddl:"static" sql:"SHOW UNIQUE KEYS IN TABLE"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// TableKeyColumn is a single column of a primary or unique key. Keys consisting of multiple columns are returned as multiple rows ordered by KeySequence.
+type TableKeyColumn struct {
+	CreatedOn      string
+	DatabaseName   string
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	KeySequence    int
+	ConstraintName string
+	Comment        *string
+}
+
+type tableKeyColumnRow struct {
+	CreatedOn      string         `db:"created_on"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	TableName      string         `db:"table_name"`
+	ColumnName     string         `db:"column_name"`
+	KeySequence    int            `db:"key_sequence"`
+	ConstraintName string         `db:"constraint_name"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+func (r tableKeyColumnRow) convert() *TableKeyColumn {
+	keyColumn := &TableKeyColumn{
+		CreatedOn:      r.CreatedOn,
+		DatabaseName:   r.DatabaseName,
+		SchemaName:     r.SchemaName,
+		TableName:      r.TableName,
+		ColumnName:     r.ColumnName,
+		KeySequence:    r.KeySequence,
+		ConstraintName: r.ConstraintName,
+	}
+	if r.Comment.Valid {
+		keyColumn.Comment = String(r.Comment.String)
+	}
+	return keyColumn
+}
+
+// showTableImportedKeysOptions based on https://docs.snowflake.com/en/sql-reference/sql/show-imported-keys
+type showTableImportedKeysOptions struct {
+	showImportedKeys bool                   `ddl:"static" sql:"SHOW IMPORTED KEYS IN TABLE"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// TableImportedKeyColumn is a single column of a foreign key together with the referenced column. Foreign keys consisting of multiple columns
+// are returned as multiple rows ordered by KeySequence.
+type TableImportedKeyColumn struct {
+	CreatedOn      string
+	PkDatabaseName string
+	PkSchemaName   string
+	PkTableName    string
+	PkColumnName   string
+	FkDatabaseName string
+	FkSchemaName   string
+	FkTableName    string
+	FkColumnName   string
+	KeySequence    int
+	FkName         string
+	PkName         string
+	Comment        *string
+}
+
+type tableImportedKeyColumnRow struct {
+	CreatedOn      string         `db:"created_on"`
+	PkDatabaseName string         `db:"pk_database_name"`
+	PkSchemaName   string         `db:"pk_schema_name"`
+	PkTableName    string         `db:"pk_table_name"`
+	PkColumnName   string         `db:"pk_column_name"`
+	FkDatabaseName string         `db:"fk_database_name"`
+	FkSchemaName   string         `db:"fk_schema_name"`
+	FkTableName    string         `db:"fk_table_name"`
+	FkColumnName   string         `db:"fk_column_name"`
+	KeySequence    int            `db:"key_sequence"`
+	FkName         string         `db:"fk_name"`
+	PkName         string         `db:"pk_name"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+func (r tableImportedKeyColumnRow) convert() *TableImportedKeyColumn {
+	keyColumn := &TableImportedKeyColumn{
+		CreatedOn:      r.CreatedOn,
+		PkDatabaseName: r.PkDatabaseName,
+		PkSchemaName:   r.PkSchemaName,
+		PkTableName:    r.PkTableName,
+		PkColumnName:   r.PkColumnName,
+		FkDatabaseName: r.FkDatabaseName,
+		FkSchemaName:   r.FkSchemaName,
+		FkTableName:    r.FkTableName,
+		FkColumnName:   r.FkColumnName,
+		KeySequence:    r.KeySequence,
+		FkName:         r.FkName,
+		PkName:         r.PkName,
+	}
+	if r.Comment.Valid {
+		keyColumn.Comment = String(r.Comment.String)
+	}
+	return keyColumn
+}
+
+// PkTableId returns the identifier of the table referenced by the foreign key.
+func (v *TableImportedKeyColumn) PkTableId() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.PkDatabaseName, v.PkSchemaName, v.PkTableName)
+}
@@ -184,6 +184,8 @@ type AlterTableRequest struct {
 	DropRowAccessPolicy       *TableDropRowAccessPolicyRequest
 	DropAndAddRowAccessPolicy *TableDropAndAddRowAccessPolicy
 	DropAllAccessRowPolicies  *bool
+	SetAggregationPolicy      *TableSetAggregationPolicyRequest
+	UnsetAggregationPolicy    *bool
 }
 
 type DropTableRequest struct {
@@ -241,6 +243,12 @@ type TableDropAndAddRowAccessPolicyRequest struct {
 	Add  TableAddRowAccessPolicyRequest  // required
 }
 
+type TableSetAggregationPolicyRequest struct {
+	AggregationPolicy SchemaObjectIdentifier // required
+	EntityKey         []string
+	Force             *bool
+}
+
 type TableUnsetRequest struct {
 	DataRetentionTimeInDays    bool
 	MaxDataExtensionTimeInDays bool
@@ -350,15 +358,17 @@ type TableReclusterChangeStateRequest struct {
 }
 
 type TableColumnActionRequest struct {
-	Add                 *TableColumnAddActionRequest
-	Rename              *TableColumnRenameActionRequest
-	Alter               []TableColumnAlterActionRequest
-	SetMaskingPolicy    *TableColumnAlterSetMaskingPolicyActionRequest
-	UnsetMaskingPolicy  *TableColumnAlterUnsetMaskingPolicyActionRequest
-	SetTags             *TableColumnAlterSetTagsActionRequest
-	UnsetTags           *TableColumnAlterUnsetTagsActionRequest
-	DropColumnsIfExists *bool
-	DropColumns         []string
+	Add                   *TableColumnAddActionRequest
+	Rename                *TableColumnRenameActionRequest
+	Alter                 []TableColumnAlterActionRequest
+	SetMaskingPolicy      *TableColumnAlterSetMaskingPolicyActionRequest
+	UnsetMaskingPolicy    *TableColumnAlterUnsetMaskingPolicyActionRequest
+	SetProjectionPolicy   *TableColumnAlterSetProjectionPolicyActionRequest
+	UnsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest
+	SetTags               *TableColumnAlterSetTagsActionRequest
+	UnsetTags             *TableColumnAlterUnsetTagsActionRequest
+	DropColumnsIfExists   *bool
+	DropColumns           []string
 }
 
 type TableColumnAddActionRequest struct {
@@ -415,6 +425,16 @@ type TableColumnAlterUnsetMaskingPolicyActionRequest struct {
 	ColumnName string // required
 }
 
+type TableColumnAlterSetProjectionPolicyActionRequest struct {
+	ColumnName           string                 // required
+	ProjectionPolicyName SchemaObjectIdentifier // required
+	Force                *bool
+}
+
+type TableColumnAlterUnsetProjectionPolicyActionRequest struct {
+	ColumnName string // required
+}
+
 type TableColumnAlterSetTagsActionRequest struct {
 	ColumnName string           // required
 	Tags       []TagAssociation // required
@@ -541,3 +561,15 @@ type DescribeTableColumnsRequest struct {
 type DescribeTableStageRequest struct {
 	id SchemaObjectIdentifier // required
 }
+
+type ShowTablePrimaryKeysRequest struct {
+	id SchemaObjectIdentifier // required
+}
+
+type ShowTableUniqueKeysRequest struct {
+	id SchemaObjectIdentifier // required
+}
+
+type ShowTableImportedKeysRequest struct {
+	id SchemaObjectIdentifier // required
+}
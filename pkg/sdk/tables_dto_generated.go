@@ -652,6 +652,16 @@ func (s *AlterTableRequest) WithDropAllAccessRowPolicies(dropAllAccessRowPolicie
 	return s
 }
 
+func (s *AlterTableRequest) WithSetAggregationPolicy(setAggregationPolicy *TableSetAggregationPolicyRequest) *AlterTableRequest {
+	s.SetAggregationPolicy = setAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy *bool) *AlterTableRequest {
+	s.UnsetAggregationPolicy = unsetAggregationPolicy
+	return s
+}
+
 func NewDropTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropTableRequest {
@@ -703,6 +713,24 @@ func NewTableDropAndAddRowAccessPolicyRequest(
 	return &s
 }
 
+func NewTableSetAggregationPolicyRequest(
+	aggregationPolicy SchemaObjectIdentifier,
+) *TableSetAggregationPolicyRequest {
+	s := TableSetAggregationPolicyRequest{}
+	s.AggregationPolicy = aggregationPolicy
+	return &s
+}
+
+func (s *TableSetAggregationPolicyRequest) WithEntityKey(entityKey []string) *TableSetAggregationPolicyRequest {
+	s.EntityKey = entityKey
+	return s
+}
+
+func (s *TableSetAggregationPolicyRequest) WithForce(force *bool) *TableSetAggregationPolicyRequest {
+	s.Force = force
+	return s
+}
+
 func NewTableUnsetRequest() *TableUnsetRequest {
 	return &TableUnsetRequest{}
 }
@@ -1127,6 +1155,16 @@ func (s *TableColumnActionRequest) WithUnsetMaskingPolicy(unsetMaskingPolicy *Ta
 	return s
 }
 
+func (s *TableColumnActionRequest) WithSetProjectionPolicy(setProjectionPolicy *TableColumnAlterSetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.SetProjectionPolicy = setProjectionPolicy
+	return s
+}
+
+func (s *TableColumnActionRequest) WithUnsetProjectionPolicy(unsetProjectionPolicy *TableColumnAlterUnsetProjectionPolicyActionRequest) *TableColumnActionRequest {
+	s.UnsetProjectionPolicy = unsetProjectionPolicy
+	return s
+}
+
 func (s *TableColumnActionRequest) WithSetTags(setTags *TableColumnAlterSetTagsActionRequest) *TableColumnActionRequest {
 	s.SetTags = setTags
 	return s
@@ -1313,6 +1351,29 @@ func NewTableColumnAlterUnsetMaskingPolicyActionRequest(
 	return &s
 }
 
+func NewTableColumnAlterSetProjectionPolicyActionRequest(
+	columnName string,
+	projectionPolicyName SchemaObjectIdentifier,
+) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s := TableColumnAlterSetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	s.ProjectionPolicyName = projectionPolicyName
+	return &s
+}
+
+func (s *TableColumnAlterSetProjectionPolicyActionRequest) WithForce(force *bool) *TableColumnAlterSetProjectionPolicyActionRequest {
+	s.Force = force
+	return s
+}
+
+func NewTableColumnAlterUnsetProjectionPolicyActionRequest(
+	columnName string,
+) *TableColumnAlterUnsetProjectionPolicyActionRequest {
+	s := TableColumnAlterUnsetProjectionPolicyActionRequest{}
+	s.ColumnName = columnName
+	return &s
+}
+
 func NewTableColumnAlterSetTagsActionRequest(
 	columnName string,
 	tags []TagAssociation,
@@ -1677,3 +1738,27 @@ func NewDescribeTableStageRequest(
 	s.id = id
 	return &s
 }
+
+func NewShowTablePrimaryKeysRequest(
+	id SchemaObjectIdentifier,
+) *ShowTablePrimaryKeysRequest {
+	s := ShowTablePrimaryKeysRequest{}
+	s.id = id
+	return &s
+}
+
+func NewShowTableUniqueKeysRequest(
+	id SchemaObjectIdentifier,
+) *ShowTableUniqueKeysRequest {
+	s := ShowTableUniqueKeysRequest{}
+	s.id = id
+	return &s
+}
+
+func NewShowTableImportedKeysRequest(
+	id SchemaObjectIdentifier,
+) *ShowTableImportedKeysRequest {
+	s := ShowTableImportedKeysRequest{}
+	s.id = id
+	return &s
+}
@@ -19,6 +19,9 @@ var (
 	_ optionsProvider[showTableOptions]                = new(ShowTableRequest)
 	_ optionsProvider[describeTableColumnsOptions]     = new(DescribeTableColumnsRequest)
 	_ optionsProvider[describeTableStageOptions]       = new(DescribeTableStageRequest)
+	_ optionsProvider[showTablePrimaryKeysOptions]     = new(ShowTablePrimaryKeysRequest)
+	_ optionsProvider[showTableUniqueKeysOptions]      = new(ShowTableUniqueKeysRequest)
+	_ optionsProvider[showTableImportedKeysOptions]    = new(ShowTableImportedKeysRequest)
 	_ optionsProvider[TableColumnAction]               = new(TableColumnActionRequest)
 	_ optionsProvider[TableConstraintAction]           = new(TableConstraintActionRequest)
 	_ optionsProvider[TableExternalTableAction]        = new(TableExternalTableActionRequest)
@@ -102,6 +105,30 @@ func (v *tables) DescribeStage(ctx context.Context, req *DescribeTableStageReque
 	return convertRows[tableStageDetailsRow, TableStageDetails](rows), nil
 }
 
+func (v *tables) ShowPrimaryKeys(ctx context.Context, req *ShowTablePrimaryKeysRequest) ([]TableKeyColumn, error) {
+	rows, err := validateAndQuery[tableKeyColumnRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tableKeyColumnRow, TableKeyColumn](rows), nil
+}
+
+func (v *tables) ShowUniqueKeys(ctx context.Context, req *ShowTableUniqueKeysRequest) ([]TableKeyColumn, error) {
+	rows, err := validateAndQuery[tableKeyColumnRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tableKeyColumnRow, TableKeyColumn](rows), nil
+}
+
+func (v *tables) ShowImportedKeys(ctx context.Context, req *ShowTableImportedKeysRequest) ([]TableImportedKeyColumn, error) {
+	rows, err := validateAndQuery[tableImportedKeyColumnRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tableImportedKeyColumnRow, TableImportedKeyColumn](rows), nil
+}
+
 func (s *AlterTableRequest) toOpts() *alterTableOptions {
 	var clusteringAction *TableClusteringAction
 	if s.ClusteringAction != nil {
@@ -186,6 +213,14 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 			Add:  add,
 		}
 	}
+	var setAggregationPolicy *TableSetAggregationPolicy
+	if s.SetAggregationPolicy != nil {
+		setAggregationPolicy = &TableSetAggregationPolicy{
+			AggregationPolicy: s.SetAggregationPolicy.AggregationPolicy,
+			EntityKey:         s.SetAggregationPolicy.EntityKey,
+			Force:             s.SetAggregationPolicy.Force,
+		}
+	}
 
 	return &alterTableOptions{
 		IfExists:                  s.IfExists,
@@ -205,6 +240,8 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 		DropRowAccessPolicy:       dropRowAccessPolicy,
 		DropAndAddRowAccessPolicy: dropAndAddRowAccessPolicy,
 		DropAllAccessRowPolicies:  s.DropAllAccessRowPolicies,
+		SetAggregationPolicy:      setAggregationPolicy,
+		UnsetAggregationPolicy:    s.UnsetAggregationPolicy,
 	}
 }
 
@@ -448,6 +485,22 @@ func (r *TableColumnActionRequest) toOpts() *TableColumnAction {
 			},
 		}
 	}
+	if r.SetProjectionPolicy != nil {
+		return &TableColumnAction{
+			SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+				ColumnName:           r.SetProjectionPolicy.ColumnName,
+				ProjectionPolicyName: r.SetProjectionPolicy.ProjectionPolicyName,
+				Force:                r.SetProjectionPolicy.Force,
+			},
+		}
+	}
+	if r.UnsetProjectionPolicy != nil {
+		return &TableColumnAction{
+			UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+				ColumnName: r.UnsetProjectionPolicy.ColumnName,
+			},
+		}
+	}
 	if r.SetTags != nil {
 		return &TableColumnAction{
 			SetTags: &TableColumnAlterSetTagsAction{
@@ -819,3 +872,21 @@ func (v *DescribeTableStageRequest) toOpts() *describeTableStageOptions {
 		name: v.id,
 	}
 }
+
+func (v *ShowTablePrimaryKeysRequest) toOpts() *showTablePrimaryKeysOptions {
+	return &showTablePrimaryKeysOptions{
+		name: v.id,
+	}
+}
+
+func (v *ShowTableUniqueKeysRequest) toOpts() *showTableUniqueKeysOptions {
+	return &showTableUniqueKeysOptions{
+		name: v.id,
+	}
+}
+
+func (v *ShowTableImportedKeysRequest) toOpts() *showTableImportedKeysOptions {
+	return &showTableImportedKeysOptions{
+		name: v.id,
+	}
+}
@@ -722,7 +722,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("validation: no action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy"))
 	})
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
@@ -736,7 +736,7 @@ func TestTableAlter(t *testing.T) {
 		opts.NewName = Pointer(NewSchemaObjectIdentifier("test", "test", "test"))
 		opts.SwapWith = Pointer(NewSchemaObjectIdentifier("test", "test", "test"))
 
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy"))
 	})
 
 	t.Run("validation: NewName's incorrect identifier", func(t *testing.T) {
@@ -772,7 +772,7 @@ func TestTableAlter(t *testing.T) {
 	t.Run("validation: column action - no option present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.ColumnAction = &TableColumnAction{}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action - two options present", func(t *testing.T) {
@@ -784,7 +784,7 @@ func TestTableAlter(t *testing.T) {
 				OldName: "old",
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 	})
 
 	t.Run("validation: column action alter - no option present", func(t *testing.T) {
@@ -1077,6 +1077,33 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET MASKING POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set projection policy", func(t *testing.T) {
+		projectionPolicyName := randomSchemaObjectIdentifier()
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				SetProjectionPolicy: &TableColumnAlterSetProjectionPolicyAction{
+					ColumnName:           "COLUMN_1",
+					ProjectionPolicyName: projectionPolicyName,
+					Force:                Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 SET PROJECTION POLICY %s FORCE", id.FullyQualifiedName(), projectionPolicyName.FullyQualifiedName())
+	})
+
+	t.Run("alter: unset projection policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			ColumnAction: &TableColumnAction{
+				UnsetProjectionPolicy: &TableColumnAlterUnsetProjectionPolicyAction{
+					ColumnName: "COLUMN_1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ALTER COLUMN COLUMN_1 UNSET PROJECTION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set tags", func(t *testing.T) {
 		columnTags := []TagAssociation{
 			{
@@ -1430,6 +1457,38 @@ func TestTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP ALL ROW ACCESS POLICIES`, id.FullyQualifiedName())
 	})
+
+	t.Run("validation: set aggregation policy with invalid identifier", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			SetAggregationPolicy: &TableSetAggregationPolicy{
+				AggregationPolicy: NewSchemaObjectIdentifier("", "", ""),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("TableSetAggregationPolicy", "AggregationPolicy"))
+	})
+
+	t.Run("set aggregation policy", func(t *testing.T) {
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			SetAggregationPolicy: &TableSetAggregationPolicy{
+				AggregationPolicy: aggregationPolicyId,
+				EntityKey:         []string{"FIRST_COLUMN", "SECOND_COLUMN"},
+				Force:             Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET AGGREGATION POLICY %s ENTITY KEY (FIRST_COLUMN, SECOND_COLUMN) FORCE`, id.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset aggregation policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:                   id,
+			UnsetAggregationPolicy: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET AGGREGATION POLICY`, id.FullyQualifiedName())
+	})
 }
 
 func TestTableDrop(t *testing.T) {
@@ -1528,6 +1587,81 @@ func TestTableDescribeColumns(t *testing.T) {
 	})
 }
 
+func TestTableShowPrimaryKeys(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	defaultOpts := func() *showTablePrimaryKeysOptions {
+		return &showTablePrimaryKeysOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *showTablePrimaryKeysOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("showTablePrimaryKeysOptions", "name"))
+	})
+
+	t.Run("show", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW PRIMARY KEYS IN TABLE %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestTableShowUniqueKeys(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	defaultOpts := func() *showTableUniqueKeysOptions {
+		return &showTableUniqueKeysOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *showTableUniqueKeysOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("showTableUniqueKeysOptions", "name"))
+	})
+
+	t.Run("show", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW UNIQUE KEYS IN TABLE %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestTableShowImportedKeys(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	defaultOpts := func() *showTableImportedKeysOptions {
+		return &showTableImportedKeysOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *showTableImportedKeysOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("showTableImportedKeysOptions", "name"))
+	})
+
+	t.Run("show", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW IMPORTED KEYS IN TABLE %s`, id.FullyQualifiedName())
+	})
+}
+
 func TestTableColumnDetailsRow_SplitTypeAndCollation(t *testing.T) {
 	t.Run("with utf8", func(t *testing.T) {
 		row := tableColumnDetailsRow{
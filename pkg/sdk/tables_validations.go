@@ -13,6 +13,9 @@ var (
 	_ validatable = new(showTableOptions)
 	_ validatable = new(describeTableColumnsOptions)
 	_ validatable = new(describeTableStageOptions)
+	_ validatable = new(showTablePrimaryKeysOptions)
+	_ validatable = new(showTableUniqueKeysOptions)
+	_ validatable = new(showTableImportedKeysOptions)
 )
 
 func (opts *createTableOptions) validate() error {
@@ -159,8 +162,10 @@ func (opts *alterTableOptions) validate() error {
 		opts.DropRowAccessPolicy,
 		opts.DropAndAddRowAccessPolicy,
 		opts.DropAllAccessRowPolicies,
+		opts.SetAggregationPolicy,
+		opts.UnsetAggregationPolicy,
 	); !ok {
-		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies"))
+		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy"))
 	}
 	if opts.NewName != nil {
 		if !ValidObjectIdentifier(*opts.NewName) {
@@ -172,6 +177,11 @@ func (opts *alterTableOptions) validate() error {
 			errs = append(errs, errInvalidIdentifier("alterTableOptions", "SwapWith"))
 		}
 	}
+	if opts.SetAggregationPolicy != nil {
+		if !ValidObjectIdentifier(opts.SetAggregationPolicy.AggregationPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetAggregationPolicy", "AggregationPolicy"))
+		}
+	}
 	if clusteringAction := opts.ClusteringAction; valueSet(clusteringAction) {
 		if ok := exactlyOneValueSet(
 			clusteringAction.ClusterBy,
@@ -189,11 +199,13 @@ func (opts *alterTableOptions) validate() error {
 			columnAction.Alter,
 			columnAction.SetMaskingPolicy,
 			columnAction.UnsetMaskingPolicy,
+			columnAction.SetProjectionPolicy,
+			columnAction.UnsetProjectionPolicy,
 			columnAction.SetTags,
 			columnAction.UnsetTags,
 			columnAction.DropColumns,
 		); !ok {
-			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetTags", "UnsetTags", "DropColumns"))
+			errs = append(errs, errExactlyOneOf("ColumnAction", "Add", "Rename", "Alter", "SetMaskingPolicy", "UnsetMaskingPolicy", "SetProjectionPolicy", "UnsetProjectionPolicy", "SetTags", "UnsetTags", "DropColumns"))
 		}
 		for _, alterAction := range columnAction.Alter {
 			if ok := exactlyOneValueSet(
@@ -315,6 +327,39 @@ func (opts *describeTableStageOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *showTablePrimaryKeysOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("showTablePrimaryKeysOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *showTableUniqueKeysOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("showTableUniqueKeysOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *showTableImportedKeysOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("showTableImportedKeysOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
 func (v *OutOfLineConstraint) validate() error {
 	var errs []error
 	switch v.Type {
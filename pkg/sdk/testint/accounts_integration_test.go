@@ -267,6 +267,28 @@ func TestInt_AccountAlter(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("set and unset packages policy", func(t *testing.T) {
+		packagesPolicyTest, packagesPolicyCleanup := testClientHelper().PackagesPolicy.CreatePackagesPolicy(t)
+		t.Cleanup(packagesPolicyCleanup)
+
+		opts := &sdk.AlterAccountOptions{
+			Set: &sdk.AccountSet{
+				PackagesPolicy: packagesPolicyTest.ID(),
+			},
+		}
+		err := client.Accounts.Alter(ctx, opts)
+		require.NoError(t, err)
+
+		// now unset
+		opts = &sdk.AlterAccountOptions{
+			Unset: &sdk.AccountUnset{
+				PackagesPolicy: sdk.Bool(true),
+			},
+		}
+		err = client.Accounts.Alter(ctx, opts)
+		require.NoError(t, err)
+	})
+
 	t.Run("set and unset tag", func(t *testing.T) {
 		tagTest1, tagCleanup1 := testClientHelper().Tag.CreateTag(t)
 		t.Cleanup(tagCleanup1)
@@ -0,0 +1,206 @@
+package testint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AggregationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	assertAggregationPolicy := func(t *testing.T, aggregationPolicy *sdk.AggregationPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.Equal(t, id, aggregationPolicy.ID())
+		assert.NotEmpty(t, aggregationPolicy.CreatedOn)
+		assert.Equal(t, "AGGREGATION_POLICY", aggregationPolicy.Kind)
+		assert.Equal(t, expectedComment, aggregationPolicy.Comment)
+		assert.Equal(t, "ACCOUNTADMIN", aggregationPolicy.Owner)
+		assert.Equal(t, "ROLE", aggregationPolicy.OwnerRoleType)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Create(ctx, sdk.NewCreateAggregationPolicyRequest(id, body))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AggregationPolicy.DropAggregationPolicyFunc(t, id))
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, "")
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Create(ctx, sdk.NewCreateAggregationPolicyRequest(id, body).
+			WithOrReplace(true).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AggregationPolicy.DropAggregationPolicyFunc(t, id))
+
+		aggregationPolicy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, "some comment")
+	})
+
+	t.Run("Alter - set body", func(t *testing.T) {
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+		id := aggregationPolicy.ID()
+
+		newBody := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		description, err := client.AggregationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, newBody, description.Body)
+	})
+
+	t.Run("Alter - set and unset comment", func(t *testing.T) {
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+		id := aggregationPolicy.ID()
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment("altered comment"))
+		require.NoError(t, err)
+
+		aggregationPolicy, err = client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, "altered comment")
+
+		err = client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		aggregationPolicy, err = client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, id, "")
+	})
+
+	t.Run("Alter - rename", func(t *testing.T) {
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+		id := aggregationPolicy.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AggregationPolicy.DropAggregationPolicyFunc(t, newId))
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		aggregationPolicy, err = client.AggregationPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertAggregationPolicy(t, aggregationPolicy, newId, "")
+	})
+
+	t.Run("Drop - existing", func(t *testing.T) {
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+		id := aggregationPolicy.ID()
+
+		err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.AggregationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Drop - non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AggregationPolicies.Drop(ctx, sdk.NewDropAggregationPolicyRequest(id))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show - with like and in", func(t *testing.T) {
+		aggregationPolicy1, cleanup1 := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup1)
+		aggregationPolicy2, cleanup2 := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup2)
+
+		aggregationPolicies, err := client.AggregationPolicies.Show(ctx, sdk.NewShowAggregationPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(aggregationPolicy1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}),
+		)
+		require.NoError(t, err)
+		assert.Len(t, aggregationPolicies, 1)
+		assert.Contains(t, aggregationPolicies, *aggregationPolicy1)
+		assert.NotContains(t, aggregationPolicies, *aggregationPolicy2)
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		aggregationPolicy, cleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(cleanup)
+
+		description, err := client.AggregationPolicies.Describe(ctx, aggregationPolicy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, aggregationPolicy.Name, description.Name)
+		assert.Equal(t, "()", description.Signature)
+		assert.Equal(t, "AGGREGATION_CONSTRAINT", description.ReturnType)
+		assert.Equal(t, body, description.Body)
+	})
+
+	t.Run("Set and unset on table", func(t *testing.T) {
+		aggregationPolicy, aggregationPolicyCleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(aggregationPolicyCleanup)
+		table, tableCleanup := testClientHelper().Table.CreateTable(t)
+		t.Cleanup(tableCleanup)
+
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithSetAggregationPolicy(
+			sdk.NewTableSetAggregationPolicyRequest(aggregationPolicy.ID()).WithEntityKey([]string{"id"}),
+		))
+		require.NoError(t, err)
+
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(table.ID(), sdk.PolicyEntityDomainTable))
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, aggregationPolicy.Name, policyReferences[0].PolicyName)
+		assert.Equal(t, "AGGREGATION_POLICY", policyReferences[0].PolicyKind)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithUnsetAggregationPolicy(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		policyReferences, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(table.ID(), sdk.PolicyEntityDomainTable))
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
+	t.Run("Set and unset on view", func(t *testing.T) {
+		aggregationPolicy, aggregationPolicyCleanup := testClientHelper().AggregationPolicy.CreateAggregationPolicy(t)
+		t.Cleanup(aggregationPolicyCleanup)
+		table, tableCleanup := testClientHelper().Table.CreateTable(t)
+		t.Cleanup(tableCleanup)
+		view, viewCleanup := testClientHelper().View.CreateView(t, fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName()))
+		t.Cleanup(viewCleanup)
+
+		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithSetAggregationPolicy(
+			sdk.NewViewSetAggregationPolicyRequest(aggregationPolicy.ID()),
+		))
+		require.NoError(t, err)
+
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(view.ID(), sdk.PolicyEntityDomainView))
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, aggregationPolicy.Name, policyReferences[0].PolicyName)
+		assert.Equal(t, "AGGREGATION_POLICY", policyReferences[0].PolicyKind)
+
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithUnsetAggregationPolicy(sdk.Bool(true)))
+		require.NoError(t, err)
+
+		policyReferences, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(view.ID(), sdk.PolicyEntityDomainView))
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+}
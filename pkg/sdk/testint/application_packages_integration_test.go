@@ -228,6 +228,7 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		require.Equal(t, 1, len(versions))
 		require.Equal(t, version, versions[0].Version)
 		require.Equal(t, 0, versions[0].Patch)
+		require.Equal(t, "add version V001", *versions[0].Label)
 
 		// add patch for application package version
 		pr := sdk.NewAddPatchForVersionRequest(&version, using).WithLabel(sdk.String("patch version V001"))
@@ -274,5 +275,27 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		r2 := sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(rr)
 		err = client.ApplicationPackages.Alter(ctx, r2)
 		require.NoError(t, err)
+		releaseDirectives := testClientHelper().ApplicationPackage.ShowReleaseDirectives(t, e.ID())
+		require.Equal(t, 1, len(releaseDirectives))
+		require.Equal(t, "DEFAULT", releaseDirectives[0].Name)
+		require.Equal(t, version, releaseDirectives[0].Version)
+		require.Equal(t, 0, releaseDirectives[0].Patch)
+	})
+
+	t.Run("show versions: with like", func(t *testing.T) {
+		e := createApplicationPackageHandle(t)
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+		testClientHelper().Stage.PutOnStageWithContent(t, stage.ID(), "manifest.yml", "")
+		testClientHelper().Stage.PutOnStageWithContent(t, stage.ID(), "setup.sql", "")
+
+		testClientHelper().ApplicationPackage.AddApplicationPackageVersion(t, e.ID(), stage.ID(), "V001")
+		testClientHelper().ApplicationPackage.AddApplicationPackageVersion(t, e.ID(), stage.ID(), "V002")
+
+		versions, err := client.ApplicationPackageVersions.Show(ctx, sdk.NewShowApplicationPackageVersionRequest(e.ID()).WithLike(sdk.Like{Pattern: sdk.String("V002")}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(versions))
+		require.Equal(t, "V002", versions[0].Version)
+		require.Equal(t, 0, versions[0].Patch)
 	})
 }
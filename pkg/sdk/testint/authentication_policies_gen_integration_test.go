@@ -0,0 +1,182 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AuthenticationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertAuthenticationPolicy := func(t *testing.T, authenticationPolicy *sdk.AuthenticationPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.Equal(t, id, authenticationPolicy.ID())
+		assert.NotEmpty(t, authenticationPolicy.CreatedOn)
+		assert.Equal(t, expectedComment, authenticationPolicy.Comment)
+		assert.Equal(t, "ACCOUNTADMIN", authenticationPolicy.Owner)
+		assert.Equal(t, "ROLE", authenticationPolicy.OwnerRoleType)
+	}
+
+	findProperty := func(t *testing.T, description []sdk.AuthenticationPolicyDescription, property string) string {
+		t.Helper()
+		row, err := collections.FindOne(description, func(r sdk.AuthenticationPolicyDescription) bool { return r.Property == property })
+		require.NoError(t, err)
+		return row.Value
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AuthenticationPolicy.DropAuthenticationPolicyFunc(t, id))
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, "")
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AuthenticationPolicies.Create(ctx, sdk.NewCreateAuthenticationPolicyRequest(id).
+			WithIfNotExists(true).
+			WithAuthenticationMethods([]sdk.AuthenticationMethods{{Method: sdk.AuthenticationMethodsPassword}, {Method: sdk.AuthenticationMethodsKeyPair}}).
+			WithMfaAuthenticationMethods([]sdk.MfaAuthenticationMethods{{Method: sdk.MfaAuthenticationMethodsPassword}}).
+			WithMfaEnrollment(sdk.MfaEnrollmentOptional).
+			WithClientTypes([]sdk.ClientTypes{{ClientType: sdk.ClientTypesSnowflakeUi}, {ClientType: sdk.ClientTypesDrivers}}).
+			WithSecurityIntegrations([]sdk.SecurityIntegrationsOption{{Name: "ALL"}}).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AuthenticationPolicy.DropAuthenticationPolicyFunc(t, id))
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, "some comment")
+
+		description, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "[PASSWORD, KEYPAIR]", findProperty(t, description, "AUTHENTICATION_METHODS"))
+		assert.Equal(t, "[PASSWORD]", findProperty(t, description, "MFA_AUTHENTICATION_METHODS"))
+		assert.Equal(t, "OPTIONAL", findProperty(t, description, "MFA_ENROLLMENT"))
+		assert.Equal(t, "[SNOWFLAKE_UI, DRIVERS]", findProperty(t, description, "CLIENT_TYPES"))
+		assert.Equal(t, "[ALL]", findProperty(t, description, "SECURITY_INTEGRATIONS"))
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		authenticationPolicy, cleanup := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup)
+		id := authenticationPolicy.ID()
+
+		err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithSet(
+			*sdk.NewAuthenticationPolicySetRequest().
+				WithAuthenticationMethods([]sdk.AuthenticationMethods{{Method: sdk.AuthenticationMethodsKeyPair}}).
+				WithMfaAuthenticationMethods([]sdk.MfaAuthenticationMethods{{Method: sdk.MfaAuthenticationMethodsSaml}}).
+				WithMfaEnrollment(sdk.MfaEnrollmentRequired).
+				WithClientTypes([]sdk.ClientTypes{{ClientType: sdk.ClientTypesSnowSql}}).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		authenticationPolicy, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, "altered comment")
+
+		description, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "[KEYPAIR]", findProperty(t, description, "AUTHENTICATION_METHODS"))
+		assert.Equal(t, "[SAML]", findProperty(t, description, "MFA_AUTHENTICATION_METHODS"))
+		assert.Equal(t, "REQUIRED", findProperty(t, description, "MFA_ENROLLMENT"))
+		assert.Equal(t, "[SNOWSQL]", findProperty(t, description, "CLIENT_TYPES"))
+
+		err = client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithUnset(
+			*sdk.NewAuthenticationPolicyUnsetRequest().
+				WithClientTypes(true).
+				WithAuthenticationMethods(true).
+				WithSecurityIntegrations(true).
+				WithMfaAuthenticationMethods(true).
+				WithMfaEnrollment(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		authenticationPolicy, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, id, "")
+
+		description, err = client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "[ALL]", findProperty(t, description, "AUTHENTICATION_METHODS"))
+		assert.Equal(t, "OPTIONAL", findProperty(t, description, "MFA_ENROLLMENT"))
+		assert.Equal(t, "[ALL]", findProperty(t, description, "CLIENT_TYPES"))
+	})
+
+	t.Run("Alter - rename", func(t *testing.T) {
+		authenticationPolicy, cleanup := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup)
+		id := authenticationPolicy.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().AuthenticationPolicy.DropAuthenticationPolicyFunc(t, newId))
+
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		authenticationPolicy, err = client.AuthenticationPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertAuthenticationPolicy(t, authenticationPolicy, newId, "")
+	})
+
+	t.Run("Drop - existing", func(t *testing.T) {
+		authenticationPolicy, cleanup := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup)
+		id := authenticationPolicy.ID()
+
+		err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Drop - non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.AuthenticationPolicies.Drop(ctx, sdk.NewDropAuthenticationPolicyRequest(id))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show - with like and in", func(t *testing.T) {
+		authenticationPolicy1, cleanup1 := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup1)
+		authenticationPolicy2, cleanup2 := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup2)
+
+		authenticationPolicies, err := client.AuthenticationPolicies.Show(ctx, sdk.NewShowAuthenticationPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(authenticationPolicy1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}),
+		)
+		require.NoError(t, err)
+		assert.Len(t, authenticationPolicies, 1)
+		assert.Contains(t, authenticationPolicies, *authenticationPolicy1)
+		assert.NotContains(t, authenticationPolicies, *authenticationPolicy2)
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		authenticationPolicy, cleanup := testClientHelper().AuthenticationPolicy.CreateAuthenticationPolicy(t)
+		t.Cleanup(cleanup)
+
+		description, err := client.AuthenticationPolicies.Describe(ctx, authenticationPolicy.ID())
+		require.NoError(t, err)
+		assert.NotEmpty(t, description)
+		assert.Equal(t, authenticationPolicy.Name, findProperty(t, description, "NAME"))
+	})
+}
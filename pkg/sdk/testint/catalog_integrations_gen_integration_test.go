@@ -0,0 +1,181 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CatalogIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	findProperty := func(t *testing.T, properties []sdk.CatalogIntegrationProperty, name string) sdk.CatalogIntegrationProperty {
+		t.Helper()
+		property, err := collections.FindOne(properties, func(p sdk.CatalogIntegrationProperty) bool { return p.Name == name })
+		require.NoError(t, err)
+		return *property
+	}
+
+	assertCatalogIntegration := func(t *testing.T, id sdk.AccountObjectIdentifier, enabled bool, comment string) {
+		t.Helper()
+		catalogIntegration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, catalogIntegration.ID())
+		assert.Equal(t, "CATALOG", catalogIntegration.Category)
+		assert.Equal(t, enabled, catalogIntegration.Enabled)
+		if comment == "" {
+			assert.Nil(t, catalogIntegration.Comment)
+		} else {
+			assert.Equal(t, sdk.String(comment), catalogIntegration.Comment)
+		}
+	}
+
+	t.Run("Create - object store", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateCatalogIntegrationRequest(id, true).
+			WithObjectStoreCatalogSourceParams(*sdk.NewObjectStoreCatalogSourceParamsRequest(sdk.CatalogIntegrationTableFormatDelta)).
+			WithRefreshIntervalSeconds(60).
+			WithComment("some comment")
+
+		err := client.CatalogIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		assertCatalogIntegration(t, id, true, "some comment")
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "OBJECT_STORE", findProperty(t, properties, "CATALOG_SOURCE").Value)
+		assert.Equal(t, "DELTA", findProperty(t, properties, "TABLE_FORMAT").Value)
+		assert.Equal(t, "60", findProperty(t, properties, "REFRESH_INTERVAL_SECONDS").Value)
+	})
+
+	t.Run("Create - glue", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateCatalogIntegrationRequest(id, false).
+			WithGlueCatalogSourceParams(*sdk.NewGlueCatalogSourceParamsRequest("arn:aws:iam::123456789012:role/myrole", "123456789012").
+				WithGlueRegion("us-west-2").
+				WithCatalogNamespace("my_namespace"))
+
+		err := client.CatalogIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		assertCatalogIntegration(t, id, false, "")
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "GLUE", findProperty(t, properties, "CATALOG_SOURCE").Value)
+		assert.Equal(t, "arn:aws:iam::123456789012:role/myrole", findProperty(t, properties, "GLUE_AWS_ROLE_ARN").Value)
+		assert.Equal(t, "123456789012", findProperty(t, properties, "GLUE_CATALOG_ID").Value)
+		assert.Equal(t, "us-west-2", findProperty(t, properties, "GLUE_REGION").Value)
+		assert.Equal(t, "my_namespace", findProperty(t, properties, "CATALOG_NAMESPACE").Value)
+		assert.NotEmpty(t, findProperty(t, properties, "GLUE_AWS_IAM_USER_ARN").Value)
+		assert.NotEmpty(t, findProperty(t, properties, "GLUE_AWS_EXTERNAL_ID").Value)
+	})
+
+	t.Run("Create - iceberg rest with bearer token", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateCatalogIntegrationRequest(id, false).
+			WithIcebergRestCatalogSourceParams(*sdk.NewIcebergRestCatalogSourceParamsRequest(
+				*sdk.NewIcebergRestRestConfigRequest("https://example.com/api/catalog").WithCatalogName("my_catalog"),
+				*sdk.NewCatalogIntegrationIcebergRestAuthenticationRequest().WithBearer(*sdk.NewCatalogIntegrationBearerRequest("token")),
+			))
+
+		err := client.CatalogIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().CatalogIntegration.DropFunc(t, id))
+
+		assertCatalogIntegration(t, id, false, "")
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "ICEBERG_REST", findProperty(t, properties, "CATALOG_SOURCE").Value)
+	})
+
+	t.Run("Alter - set", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).
+			WithSet(*sdk.NewCatalogIntegrationSetRequest().WithRefreshIntervalSeconds(120).WithComment("altered comment")),
+		)
+		require.NoError(t, err)
+
+		assertCatalogIntegration(t, id, true, "altered comment")
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "120", findProperty(t, properties, "REFRESH_INTERVAL_SECONDS").Value)
+	})
+
+	t.Run("Alter - set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).
+			WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}),
+		)
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", returnedTagValue)
+
+		err = client.CatalogIntegrations.Alter(ctx, sdk.NewAlterCatalogIntegrationRequest(id).
+			WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.Error(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.CatalogIntegrations.Drop(ctx, sdk.NewDropCatalogIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+		otherId, otherCleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(otherCleanup)
+
+		catalogIntegrations, err := client.CatalogIntegrations.Show(ctx, sdk.NewShowCatalogIntegrationRequest())
+		require.NoError(t, err)
+		ids := make([]sdk.AccountObjectIdentifier, len(catalogIntegrations))
+		for i, catalogIntegration := range catalogIntegrations {
+			ids[i] = catalogIntegration.ID()
+		}
+		assert.Contains(t, ids, id)
+		assert.Contains(t, ids, otherId)
+
+		catalogIntegrations, err = client.CatalogIntegrations.Show(ctx, sdk.NewShowCatalogIntegrationRequest().WithLike(sdk.Like{Pattern: sdk.String(id.Name())}))
+		require.NoError(t, err)
+		require.Len(t, catalogIntegrations, 1)
+		assert.Equal(t, id, catalogIntegrations[0].ID())
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id, cleanup := testClientHelper().CatalogIntegration.Create(t)
+		t.Cleanup(cleanup)
+
+		properties, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "true", findProperty(t, properties, "ENABLED").Value)
+		assert.Equal(t, "OBJECT_STORE", findProperty(t, properties, "CATALOG_SOURCE").Value)
+		assert.Equal(t, "ICEBERG", findProperty(t, properties, "TABLE_FORMAT").Value)
+	})
+}
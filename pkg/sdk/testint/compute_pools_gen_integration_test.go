@@ -0,0 +1,188 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ComputePools(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertComputePool := func(t *testing.T, computePool *sdk.ComputePool, id sdk.AccountObjectIdentifier, minNodes int, maxNodes int, autoResume bool, autoSuspendSecs int, comment *string) {
+		t.Helper()
+		assert.Equal(t, id, computePool.ID())
+		assert.Equal(t, minNodes, computePool.MinNodes)
+		assert.Equal(t, maxNodes, computePool.MaxNodes)
+		assert.Equal(t, sdk.ComputePoolInstanceFamilyCpuX64XS, computePool.InstanceFamily)
+		assert.Equal(t, autoResume, computePool.AutoResume)
+		assert.Equal(t, autoSuspendSecs, computePool.AutoSuspendSecs)
+		assert.Equal(t, comment, computePool.Comment)
+		assert.False(t, computePool.IsExclusive)
+		assert.Nil(t, computePool.Application)
+		assert.NotEmpty(t, computePool.Owner)
+		assert.NotEmpty(t, computePool.CreatedOn)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ComputePools.Create(ctx, sdk.NewCreateComputePoolRequest(id, 1, 1, sdk.ComputePoolInstanceFamilyCpuX64XS).
+			WithInitiallySuspended(true),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ComputePool.DropFunc(t, id))
+
+		computePool, err := client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertComputePool(t, computePool, id, 1, 1, true, 3600, nil)
+		assert.Equal(t, sdk.ComputePoolStateSuspended, computePool.State)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ComputePools.Create(ctx, sdk.NewCreateComputePoolRequest(id, 1, 2, sdk.ComputePoolInstanceFamilyCpuX64XS).
+			WithIfNotExists(true).
+			WithAutoResume(false).
+			WithInitiallySuspended(true).
+			WithAutoSuspendSecs(600).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ComputePool.DropFunc(t, id))
+
+		computePool, err := client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertComputePool(t, computePool, id, 1, 2, false, 600, sdk.String("some comment"))
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+		id := computePool.ID()
+
+		err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithSet(
+			*sdk.NewComputePoolSetRequest().
+				WithMinNodes(2).
+				WithMaxNodes(3).
+				WithAutoResume(true).
+				WithAutoSuspendSecs(300).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		computePool, err = client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertComputePool(t, computePool, id, 2, 3, true, 300, sdk.String("altered comment"))
+
+		err = client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithUnset(
+			*sdk.NewComputePoolUnsetRequest().
+				WithAutoResume(true).
+				WithAutoSuspendSecs(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		computePool, err = client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertComputePool(t, computePool, id, 2, 3, true, 3600, nil)
+	})
+
+	t.Run("Alter - resume and suspend", func(t *testing.T) {
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+		id := computePool.ID()
+		require.Equal(t, sdk.ComputePoolStateSuspended, computePool.State)
+
+		err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithResume(true))
+		require.NoError(t, err)
+
+		computePool, err = client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotEqual(t, sdk.ComputePoolStateSuspended, computePool.State)
+
+		err = client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithStopAll(true))
+		require.NoError(t, err)
+
+		err = client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithSuspend(true))
+		require.NoError(t, err)
+
+		computePool, err = client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, []sdk.ComputePoolState{sdk.ComputePoolStateSuspended, sdk.ComputePoolStateStopping}, computePool.State)
+	})
+
+	t.Run("Alter - set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+		id := computePool.ID()
+
+		err := client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithSetTags([]sdk.TagAssociation{
+			{Name: tag.ID(), Value: "v1"},
+		}))
+		require.NoError(t, err)
+
+		value, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeComputePool)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+
+		err = client.ComputePools.Alter(ctx, sdk.NewAlterComputePoolRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeComputePool)
+		require.Error(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+		id := computePool.ID()
+
+		err := client.ComputePools.Drop(ctx, sdk.NewDropComputePoolRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ComputePools.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+		otherComputePool, otherCleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(otherCleanup)
+
+		computePools, err := client.ComputePools.Show(ctx, sdk.NewShowComputePoolRequest())
+		require.NoError(t, err)
+		assert.Contains(t, computePools, *computePool)
+		assert.Contains(t, computePools, *otherComputePool)
+
+		computePools, err = client.ComputePools.Show(ctx, sdk.NewShowComputePoolRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(computePool.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, computePools, 1)
+		assert.Equal(t, *computePool, computePools[0])
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		computePool, cleanup := testClientHelper().ComputePool.Create(t)
+		t.Cleanup(cleanup)
+
+		details, err := client.ComputePools.Describe(ctx, computePool.ID())
+		require.NoError(t, err)
+		assert.Equal(t, computePool.Name, details.Name)
+		assert.Equal(t, sdk.ComputePoolStateSuspended, details.State)
+		assert.Equal(t, computePool.MinNodes, details.MinNodes)
+		assert.Equal(t, computePool.MaxNodes, details.MaxNodes)
+		assert.Equal(t, computePool.InstanceFamily, details.InstanceFamily)
+		assert.Equal(t, computePool.AutoResume, details.AutoResume)
+		assert.Equal(t, computePool.AutoSuspendSecs, details.AutoSuspendSecs)
+	})
+}
@@ -0,0 +1,176 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Connections(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	accountId := testClientHelper().Account.GetAccountIdentifier(t)
+	secondaryAccountId := secondaryTestClientHelper().Account.GetAccountIdentifier(t)
+
+	assertConnection := func(t *testing.T, connection *sdk.Connection, id sdk.AccountObjectIdentifier, isPrimary bool, comment *string) {
+		t.Helper()
+		assert.Equal(t, id, connection.ID())
+		assert.Equal(t, isPrimary, connection.IsPrimary)
+		assert.Equal(t, comment, connection.Comment)
+		assert.NotEmpty(t, connection.SnowflakeRegion)
+		assert.NotEmpty(t, connection.AccountName)
+		assert.NotEmpty(t, connection.OrganizationName)
+		assert.NotEmpty(t, connection.AccountLocator)
+		assert.NotEmpty(t, connection.ConnectionUrl)
+		assert.NotEmpty(t, connection.CreatedOn)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.Connections.Create(ctx, sdk.NewCreateConnectionRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Connection.DropFunc(t, id))
+
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, true, nil)
+		assert.Equal(t, id.Name(), connection.Primary.Name())
+		assert.Equal(t, []sdk.AccountIdentifier{accountId}, connection.FailoverAllowedToAccounts)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.Connections.Create(ctx, sdk.NewCreateConnectionRequest(id).
+			WithIfNotExists(true).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Connection.DropFunc(t, id))
+
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, true, sdk.String("some comment"))
+	})
+
+	t.Run("Alter - set and unset comment", func(t *testing.T) {
+		connection, cleanup := testClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup)
+		id := connection.ID()
+
+		err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithSet(*sdk.NewConnectionSetRequest().WithComment("altered comment")))
+		require.NoError(t, err)
+
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, true, sdk.String("altered comment"))
+
+		err = client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithUnset(*sdk.NewConnectionUnsetRequest().WithComment(true)))
+		require.NoError(t, err)
+
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, true, nil)
+	})
+
+	t.Run("Alter - enable and disable failover", func(t *testing.T) {
+		connection, cleanup := testClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup)
+		id := connection.ID()
+
+		err := client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithEnableConnectionFailover(
+			*sdk.NewEnableConnectionFailoverRequest([]sdk.AccountIdentifier{secondaryAccountId}).WithIgnoreEditionCheck(true),
+		))
+		require.NoError(t, err)
+
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.AccountIdentifier{accountId, secondaryAccountId}, connection.FailoverAllowedToAccounts)
+
+		err = client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithDisableConnectionFailover(
+			*sdk.NewDisableConnectionFailoverRequest().WithToAccounts([]sdk.AccountIdentifier{secondaryAccountId}),
+		))
+		require.NoError(t, err)
+
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountIdentifier{accountId}, connection.FailoverAllowedToAccounts)
+	})
+
+	t.Run("Create replica, refresh and promote", func(t *testing.T) {
+		secondaryClient := testSecondaryClient(t)
+
+		primaryConnection, cleanup := secondaryTestClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup)
+		id := primaryConnection.ID()
+
+		err := secondaryClient.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithEnableConnectionFailover(
+			*sdk.NewEnableConnectionFailoverRequest([]sdk.AccountIdentifier{accountId}),
+		))
+		require.NoError(t, err)
+
+		primaryId := sdk.NewExternalObjectIdentifier(secondaryAccountId, id)
+		err = client.Connections.Create(ctx, sdk.NewCreateConnectionRequest(id).WithAsReplicaOf(primaryId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Connection.DropFunc(t, id))
+
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, false, nil)
+		assert.Equal(t, secondaryAccountId, connection.Primary.AccountIdentifier())
+
+		err = client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithRefresh(true))
+		require.NoError(t, err)
+
+		err = client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithPrimary(true))
+		require.NoError(t, err)
+
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertConnection(t, connection, id, true, nil)
+		assert.Equal(t, accountId, connection.Primary.AccountIdentifier())
+
+		// the connection in the secondary account is no longer the primary one
+		connection, err = secondaryClient.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, connection.IsPrimary)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		connection, cleanup := testClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup)
+		id := connection.ID()
+
+		err := client.Connections.Drop(ctx, sdk.NewDropConnectionRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.Connections.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		connection1, cleanup1 := testClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup1)
+		connection2, cleanup2 := testClientHelper().Connection.Create(t)
+		t.Cleanup(cleanup2)
+
+		connections, err := client.Connections.Show(ctx, sdk.NewShowConnectionRequest().WithLike(sdk.Like{Pattern: sdk.String(connection1.Name)}))
+		require.NoError(t, err)
+		require.Len(t, connections, 1)
+		assert.Equal(t, connection1.ID(), connections[0].ID())
+
+		connections, err = client.Connections.Show(ctx, sdk.NewShowConnectionRequest())
+		require.NoError(t, err)
+		ids := make([]sdk.AccountObjectIdentifier, len(connections))
+		for i, c := range connections {
+			ids[i] = c.ID()
+		}
+		assert.Contains(t, ids, connection1.ID())
+		assert.Contains(t, ids, connection2.ID())
+	})
+}
@@ -411,7 +411,7 @@ func TestInt_DatabasesAlter(t *testing.T) {
 			assert.Equal(t, newName.Name(), database.Name)
 		})
 
-		t.Run(fmt.Sprintf("Database: %s - setting and unsetting log_level and trace_level", testCase.DatabaseType), func(t *testing.T) {
+		t.Run(fmt.Sprintf("Database: %s - setting and unsetting log_level, trace_level and metric_level", testCase.DatabaseType), func(t *testing.T) {
 			if testCase.DatabaseType == "From Share" {
 				t.Skipf("Skipping database test because from share is not supported")
 			}
@@ -421,25 +421,62 @@ func TestInt_DatabasesAlter(t *testing.T) {
 
 			err := client.Databases.Alter(ctx, databaseTest.ID(), &sdk.AlterDatabaseOptions{
 				Set: &sdk.DatabaseSet{
-					LogLevel:   sdk.Pointer(sdk.LogLevelInfo),
-					TraceLevel: sdk.Pointer(sdk.TraceLevelOnEvent),
+					LogLevel:    sdk.Pointer(sdk.LogLevelInfo),
+					TraceLevel:  sdk.Pointer(sdk.TraceLevelOnEvent),
+					MetricLevel: sdk.Pointer(sdk.MetricLevelAll),
 				},
 			})
 			require.NoError(t, err)
 
 			require.Equal(t, string(sdk.LogLevelInfo), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterLogLevel))
 			require.Equal(t, string(sdk.TraceLevelOnEvent), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterTraceLevel))
+			require.Equal(t, string(sdk.MetricLevelAll), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterMetricLevel))
 
 			err = client.Databases.Alter(ctx, databaseTest.ID(), &sdk.AlterDatabaseOptions{
 				Unset: &sdk.DatabaseUnset{
-					LogLevel:   sdk.Bool(true),
-					TraceLevel: sdk.Bool(true),
+					LogLevel:    sdk.Bool(true),
+					TraceLevel:  sdk.Bool(true),
+					MetricLevel: sdk.Bool(true),
 				},
 			})
 			require.NoError(t, err)
 
 			require.Equal(t, string(sdk.LogLevelOff), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterLogLevel))
 			require.Equal(t, string(sdk.TraceLevelOff), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterTraceLevel))
+			require.Equal(t, string(sdk.MetricLevelNone), queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterMetricLevel))
+		})
+
+		t.Run(fmt.Sprintf("Database: %s - setting and unsetting event_table", testCase.DatabaseType), func(t *testing.T) {
+			if testCase.DatabaseType == "From Share" {
+				t.Skipf("Skipping database test because from share is not supported")
+			}
+
+			databaseTest, databaseTestCleanup := testCase.CreateFn(t)
+			t.Cleanup(databaseTestCleanup)
+
+			eventTableId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+			err := client.EventTables.Create(ctx, sdk.NewCreateEventTableRequest(eventTableId))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				err := client.EventTables.Drop(ctx, sdk.NewDropEventTableRequest(eventTableId).WithIfExists(sdk.Bool(true)))
+				require.NoError(t, err)
+			})
+
+			err = client.Databases.Alter(ctx, databaseTest.ID(), &sdk.AlterDatabaseOptions{
+				Set: &sdk.DatabaseSet{
+					EventTable: &eventTableId,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, eventTableId, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterEventTable)))
+
+			err = client.Databases.Alter(ctx, databaseTest.ID(), &sdk.AlterDatabaseOptions{
+				Unset: &sdk.DatabaseUnset{
+					EventTable: sdk.Bool(true),
+				},
+			})
+			require.NoError(t, err)
+			require.Empty(t, queryParameterValueForDatabase(t, databaseTest.ID(), sdk.ObjectParameterEventTable))
 		})
 
 		t.Run(fmt.Sprintf("Database: %s - setting and unsetting external volume and catalog", testCase.DatabaseType), func(t *testing.T) {
@@ -0,0 +1,146 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	networkRule, networkRuleCleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	secretId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+	_, secretCleanup := testClientHelper().Secret.CreateWithGenericString(t, secretId, "foo")
+	t.Cleanup(secretCleanup)
+
+	assertProperty := func(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string, expectedValue string) {
+		t.Helper()
+		property, err := collections.FindOne(properties, func(p sdk.ExternalAccessIntegrationProperty) bool { return p.Name == name })
+		require.NoError(t, err)
+		assert.Equal(t, expectedValue, property.Value)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+			WithComment("some comment")
+
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropFunc(t, id))
+
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, integration.ID())
+		assert.Equal(t, "EXTERNAL_ACCESS", integration.Type)
+		assert.Equal(t, "SECURITY", integration.Category)
+		assert.True(t, integration.Enabled)
+		assert.Equal(t, sdk.String("some comment"), integration.Comment)
+		assert.False(t, integration.CreatedOn.IsZero())
+	})
+
+	t.Run("Alter", func(t *testing.T) {
+		integration, cleanup := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup)
+		id := integration.ID()
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithSet(*sdk.NewExternalAccessIntegrationSetRequest().
+				WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secretId}).
+				WithEnabled(false).
+				WithComment("altered comment"),
+			),
+		)
+		require.NoError(t, err)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assertProperty(t, properties, "ENABLED", "false")
+		assertProperty(t, properties, "COMMENT", "altered comment")
+		assert.Equal(t, []string{secretId.FullyQualifiedName()}, externalAccessIntegrationPropertyIdentifiers(t, properties, "ALLOWED_AUTHENTICATION_SECRETS"))
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).
+			WithUnset(*sdk.NewExternalAccessIntegrationUnsetRequest().
+				WithAllowedAuthenticationSecrets(true).
+				WithComment(true),
+			),
+		)
+		require.NoError(t, err)
+
+		integration, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, integration.Comment)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		integration, cleanup := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(integration.ID()))
+		require.NoError(t, err)
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, integration.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		integration1, cleanup1 := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup1)
+		integration2, cleanup2 := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup2)
+
+		integrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest())
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(integrations), 2)
+
+		integrations, err = client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(integration1.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, integrations, 1)
+		assert.Equal(t, integration1.ID(), integrations[0].ID())
+		assert.NotEqual(t, integration2.ID(), integrations[0].ID())
+	})
+
+	t.Run("ShowByID", func(t *testing.T) {
+		integration, cleanup := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		found, err := client.ExternalAccessIntegrations.ShowByID(ctx, integration.ID())
+		require.NoError(t, err)
+		assert.Equal(t, integration.ID(), found.ID())
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, sdk.NewAccountObjectIdentifier("does_not_exist"))
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		integration, cleanup := testClientHelper().ExternalAccessIntegration.Create(t, networkRule.ID())
+		t.Cleanup(cleanup)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, integration.ID())
+		require.NoError(t, err)
+		assertProperty(t, properties, "ENABLED", "true")
+		assert.Equal(t, []string{networkRule.ID().FullyQualifiedName()}, externalAccessIntegrationPropertyIdentifiers(t, properties, "ALLOWED_NETWORK_RULES"))
+	})
+}
+
+// externalAccessIntegrationPropertyIdentifiers returns the identifiers listed in the given property as fully qualified names.
+func externalAccessIntegrationPropertyIdentifiers(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string) []string {
+	t.Helper()
+	property, err := collections.FindOne(properties, func(p sdk.ExternalAccessIntegrationProperty) bool { return p.Name == name })
+	require.NoError(t, err)
+	result := make([]string, 0)
+	for _, v := range sdk.ParseCommaSeparatedStringArray(property.Value) {
+		result = append(result, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v).FullyQualifiedName())
+	}
+	return result
+}
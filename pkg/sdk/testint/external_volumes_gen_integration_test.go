@@ -0,0 +1,157 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalVolumes(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	s3StorageLocation := func(name string) sdk.ExternalVolumeStorageLocationRequest {
+		return *sdk.NewExternalVolumeStorageLocationRequest().WithS3StorageLocationParams(
+			*sdk.NewS3StorageLocationParamsRequest(name, sdk.S3StorageProviderS3, "arn:aws:iam::123456789012:role/myrole", "s3://my_example_bucket/").
+				WithEncryption(*sdk.NewExternalVolumeS3EncryptionRequest(sdk.S3EncryptionTypeSseKms).WithKmsKeyId("1234abcd-12ab-34cd-56ef-1234567890ab")),
+		)
+	}
+	gcsStorageLocation := func(name string) sdk.ExternalVolumeStorageLocationRequest {
+		return *sdk.NewExternalVolumeStorageLocationRequest().WithGCSStorageLocationParams(
+			*sdk.NewGCSStorageLocationParamsRequest(name, "gcs://my_example_bucket/").
+				WithEncryption(*sdk.NewExternalVolumeGCSEncryptionRequest(sdk.GCSEncryptionTypeNone)),
+		)
+	}
+	azureStorageLocation := func(name string) sdk.ExternalVolumeStorageLocationRequest {
+		return *sdk.NewExternalVolumeStorageLocationRequest().WithAzureStorageLocationParams(
+			*sdk.NewAzureStorageLocationParamsRequest(name, "a123b4c5-1234-123a-a12b-1a23b45678c9", "azure://exampleacct.blob.core.windows.net/my_container/"),
+		)
+	}
+
+	assertStorageLocationNames := func(t *testing.T, id sdk.AccountObjectIdentifier, expectedNames ...string) {
+		t.Helper()
+		properties, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		storageLocations, err := sdk.ParseExternalVolumeStorageLocations(properties)
+		require.NoError(t, err)
+		names := make([]string, len(storageLocations))
+		for i, storageLocation := range storageLocations {
+			names[i] = storageLocation.Name
+		}
+		assert.Equal(t, expectedNames, names)
+	}
+
+	t.Run("Create - S3 storage location", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocationRequest{s3StorageLocation("s3-location")}).
+			WithAllowWrites(false).
+			WithComment("some comment")
+
+		err := client.ExternalVolumes.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalVolume.DropFunc(t, id))
+
+		externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, externalVolume.ID())
+		assert.False(t, externalVolume.AllowWrites)
+		assert.Equal(t, sdk.String("some comment"), externalVolume.Comment)
+
+		properties, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		storageLocations, err := sdk.ParseExternalVolumeStorageLocations(properties)
+		require.NoError(t, err)
+		require.Len(t, storageLocations, 1)
+		assert.Equal(t, "s3-location", storageLocations[0].Name)
+		assert.Equal(t, "S3", storageLocations[0].StorageProvider)
+		assert.Equal(t, "s3://my_example_bucket/", storageLocations[0].StorageBaseUrl)
+		assert.Equal(t, "arn:aws:iam::123456789012:role/myrole", storageLocations[0].StorageAwsRoleArn)
+		assert.Equal(t, "AWS_SSE_KMS", storageLocations[0].EncryptionType)
+		assert.Equal(t, "1234abcd-12ab-34cd-56ef-1234567890ab", storageLocations[0].EncryptionKmsKeyId)
+	})
+
+	t.Run("Create - multiple storage locations", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalVolumeRequest(id, []sdk.ExternalVolumeStorageLocationRequest{
+			s3StorageLocation("s3-location"),
+			gcsStorageLocation("gcs-location"),
+			azureStorageLocation("azure-location"),
+		})
+
+		err := client.ExternalVolumes.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalVolume.DropFunc(t, id))
+
+		assertStorageLocationNames(t, id, "s3-location", "gcs-location", "azure-location")
+	})
+
+	t.Run("Alter - set and add/remove storage locations", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalVolume.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).
+			WithSet(*sdk.NewAlterExternalVolumeSetRequest().WithAllowWrites(false).WithComment("altered comment")),
+		)
+		require.NoError(t, err)
+
+		externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, externalVolume.AllowWrites)
+		assert.Equal(t, sdk.String("altered comment"), externalVolume.Comment)
+
+		err = client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithAddStorageLocation(gcsStorageLocation("gcs-location")))
+		require.NoError(t, err)
+		assertStorageLocationNames(t, id, "my-s3-us-west-2", "gcs-location")
+
+		err = client.ExternalVolumes.Alter(ctx, sdk.NewAlterExternalVolumeRequest(id).WithRemoveStorageLocation("my-s3-us-west-2"))
+		require.NoError(t, err)
+		assertStorageLocationNames(t, id, "gcs-location")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalVolume.Create(t)
+		t.Cleanup(cleanup)
+
+		err := client.ExternalVolumes.Drop(ctx, sdk.NewDropExternalVolumeRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ExternalVolumes.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalVolume.Create(t)
+		t.Cleanup(cleanup)
+		otherId, otherCleanup := testClientHelper().ExternalVolume.Create(t)
+		t.Cleanup(otherCleanup)
+
+		externalVolumes, err := client.ExternalVolumes.Show(ctx, sdk.NewShowExternalVolumeRequest())
+		require.NoError(t, err)
+		ids := make([]sdk.AccountObjectIdentifier, len(externalVolumes))
+		for i, externalVolume := range externalVolumes {
+			ids[i] = externalVolume.ID()
+		}
+		assert.Contains(t, ids, id)
+		assert.Contains(t, ids, otherId)
+
+		externalVolumes, err = client.ExternalVolumes.Show(ctx, sdk.NewShowExternalVolumeRequest().WithLike(sdk.Like{Pattern: sdk.String(id.Name())}))
+		require.NoError(t, err)
+		require.Len(t, externalVolumes, 1)
+		assert.Equal(t, id, externalVolumes[0].ID())
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id, cleanup := testClientHelper().ExternalVolume.Create(t)
+		t.Cleanup(cleanup)
+
+		properties, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		allowWrites, err := collections.FindOne(properties, func(p sdk.ExternalVolumeProperty) bool { return p.Name == "ALLOW_WRITES" })
+		require.NoError(t, err)
+		assert.Equal(t, "true", allowWrites.Value)
+		assertStorageLocationNames(t, id, "my-s3-us-west-2")
+	})
+}
@@ -0,0 +1,182 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_GitRepositories(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	apiIntegrationId, apiIntegrationCleanup := testClientHelper().ApiIntegration.CreateGitApiIntegration(t)
+	t.Cleanup(apiIntegrationCleanup)
+
+	assertGitRepository := func(t *testing.T, gitRepository *sdk.GitRepository, id sdk.SchemaObjectIdentifier, gitCredentials *string, comment *string) {
+		t.Helper()
+		assert.Equal(t, id, gitRepository.ID())
+		assert.Equal(t, helpers.GitRepositoryOrigin, gitRepository.Origin)
+		assert.Equal(t, apiIntegrationId.Name(), gitRepository.ApiIntegration)
+		assert.Equal(t, gitCredentials, gitRepository.GitCredentials)
+		assert.Equal(t, comment, gitRepository.Comment)
+		assert.NotEmpty(t, gitRepository.Owner)
+		assert.NotEmpty(t, gitRepository.CreatedOn)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.GitRepositories.Create(ctx, sdk.NewCreateGitRepositoryRequest(id, helpers.GitRepositoryOrigin, apiIntegrationId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().GitRepository.DropFunc(t, id))
+
+		gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertGitRepository(t, gitRepository, id, nil, nil)
+		assert.NotNil(t, gitRepository.LastFetchedAt)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		secretId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, secretCleanup := testClientHelper().Secret.CreateWithBasicAuthentication(t, secretId, "user", "password")
+		t.Cleanup(secretCleanup)
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.GitRepositories.Create(ctx, sdk.NewCreateGitRepositoryRequest(id, helpers.GitRepositoryOrigin, apiIntegrationId).
+			WithIfNotExists(true).
+			WithGitCredentials(secretId).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().GitRepository.DropFunc(t, id))
+
+		gitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertGitRepository(t, gitRepository, id, sdk.String(secretId.FullyQualifiedName()), sdk.String("some comment"))
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		secretId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, secretCleanup := testClientHelper().Secret.CreateWithBasicAuthentication(t, secretId, "user", "password")
+		t.Cleanup(secretCleanup)
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		id := gitRepository.ID()
+
+		err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithSet(
+			*sdk.NewGitRepositorySetRequest().
+				WithGitCredentials(secretId).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		gitRepository, err = client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertGitRepository(t, gitRepository, id, sdk.String(secretId.FullyQualifiedName()), sdk.String("altered comment"))
+
+		err = client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithUnset(
+			*sdk.NewGitRepositoryUnsetRequest().
+				WithGitCredentials(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		gitRepository, err = client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertGitRepository(t, gitRepository, id, nil, nil)
+	})
+
+	t.Run("Alter - fetch", func(t *testing.T) {
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		id := gitRepository.ID()
+
+		err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(true))
+		require.NoError(t, err)
+
+		fetchedGitRepository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, fetchedGitRepository.LastFetchedAt)
+		assert.False(t, fetchedGitRepository.LastFetchedAt.Before(*gitRepository.LastFetchedAt))
+	})
+
+	t.Run("Alter - set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		id := gitRepository.ID()
+
+		err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithSetTags([]sdk.TagAssociation{
+			{Name: tag.ID(), Value: "v1"},
+		}))
+		require.NoError(t, err)
+
+		value, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeGitRepository)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+
+		err = client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeGitRepository)
+		require.Error(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		id := gitRepository.ID()
+
+		err := client.GitRepositories.Drop(ctx, sdk.NewDropGitRepositoryRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.GitRepositories.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		otherGitRepository, otherCleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(otherCleanup)
+
+		gitRepositories, err := client.GitRepositories.Show(ctx, sdk.NewShowGitRepositoryRequest().
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}))
+		require.NoError(t, err)
+		assert.Contains(t, gitRepositories, *gitRepository)
+		assert.Contains(t, gitRepositories, *otherGitRepository)
+
+		gitRepositories, err = client.GitRepositories.Show(ctx, sdk.NewShowGitRepositoryRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(gitRepository.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, gitRepositories, 1)
+		assert.Equal(t, *gitRepository, gitRepositories[0])
+	})
+
+	t.Run("Show branches and tags", func(t *testing.T) {
+		gitRepository, cleanup := testClientHelper().GitRepository.Create(t, apiIntegrationId)
+		t.Cleanup(cleanup)
+		id := gitRepository.ID()
+
+		branches, err := client.GitBranches.Show(ctx, sdk.NewShowGitBranchRequest(id))
+		require.NoError(t, err)
+		branch, err := collections.FindOne(branches, func(b sdk.GitBranch) bool { return b.Name == "main" })
+		require.NoError(t, err)
+		assert.Equal(t, "/branches/main", branch.Path)
+		assert.NotEmpty(t, branch.CommitHash)
+
+		tags, err := client.GitTags.Show(ctx, sdk.NewShowGitTagRequest(id).WithLike(sdk.Like{Pattern: sdk.String("v0.90.0")}))
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "v0.90.0", tags[0].Name)
+		assert.Equal(t, "/tags/v0.90.0", tags[0].Path)
+		assert.NotEmpty(t, tags[0].CommitHash)
+	})
+}
@@ -0,0 +1,228 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_HybridTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("Create - with constraints and indexes", func(t *testing.T) {
+		referencedTable, referencedTableCleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(referencedTableCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := sdk.NewHybridTableColumnsConstraintsAndIndexesRequest().
+			WithColumns([]sdk.HybridTableColumnRequest{
+				*sdk.NewHybridTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(true),
+				*sdk.NewHybridTableColumnRequest("EMAIL", sdk.DataTypeVARCHAR).WithComment("email column"),
+				*sdk.NewHybridTableColumnRequest("PARENT_ID", sdk.DataTypeNumber),
+			}).
+			WithOutOfLineConstraint([]sdk.OutOfLineConstraint{
+				{Type: sdk.ColumnConstraintTypePrimaryKey, Columns: []string{"ID"}},
+				{Type: sdk.ColumnConstraintTypeUnique, Columns: []string{"EMAIL"}},
+				{
+					Type:    sdk.ColumnConstraintTypeForeignKey,
+					Columns: []string{"PARENT_ID"},
+					ForeignKey: &sdk.OutOfLineForeignKey{
+						TableName:   referencedTable.ID(),
+						ColumnNames: []string{"ID"},
+					},
+				},
+			}).
+			WithOutOfLineIndex([]sdk.HybridTableOutOfLineIndexRequest{
+				*sdk.NewHybridTableOutOfLineIndexRequest("IDX_PARENT", []string{"PARENT_ID"}).WithIncludeColumns([]string{"EMAIL"}),
+			})
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, *columns).WithComment("some comment"))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, id))
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, hybridTable.ID())
+		assert.Equal(t, sdk.String("some comment"), hybridTable.Comment)
+
+		details, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, details, 3)
+		assert.True(t, details[0].IsPrimary)
+		assert.False(t, details[0].IsNullable)
+
+		indexes := testClientHelper().HybridTable.ShowIndexes(t, id)
+		index, err := collections.FindOne(indexes, func(i sdk.HybridTableIndex) bool { return i.Name == "IDX_PARENT" })
+		require.NoError(t, err)
+		assert.False(t, index.IsUnique)
+		assert.Equal(t, []string{"PARENT_ID"}, index.Columns)
+		assert.Equal(t, []string{"EMAIL"}, index.IncludedColumns)
+
+		primaryKeys, err := client.Tables.ShowPrimaryKeys(ctx, sdk.NewShowTablePrimaryKeysRequest(id))
+		require.NoError(t, err)
+		require.Len(t, primaryKeys, 1)
+		assert.Equal(t, "ID", primaryKeys[0].ColumnName)
+		assert.Equal(t, 1, primaryKeys[0].KeySequence)
+		assert.NotEmpty(t, primaryKeys[0].ConstraintName)
+
+		uniqueKeys, err := client.Tables.ShowUniqueKeys(ctx, sdk.NewShowTableUniqueKeysRequest(id))
+		require.NoError(t, err)
+		require.Len(t, uniqueKeys, 1)
+		assert.Equal(t, "EMAIL", uniqueKeys[0].ColumnName)
+
+		importedKeys, err := client.Tables.ShowImportedKeys(ctx, sdk.NewShowTableImportedKeysRequest(id))
+		require.NoError(t, err)
+		require.Len(t, importedKeys, 1)
+		assert.Equal(t, "PARENT_ID", importedKeys[0].FkColumnName)
+		assert.Equal(t, "ID", importedKeys[0].PkColumnName)
+		assert.Equal(t, referencedTable.ID(), importedKeys[0].PkTableId())
+	})
+
+	t.Run("Alter - columns", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).
+			WithAddColumn(*sdk.NewHybridTableAddColumnRequest("EMAIL", sdk.DataTypeVARCHAR)),
+		)
+		require.NoError(t, err)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).
+			WithAlterColumn(*sdk.NewHybridTableAlterColumnRequest("EMAIL").WithComment("email column")),
+		)
+		require.NoError(t, err)
+
+		details, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, details, 3)
+		assert.Equal(t, sdk.String("email column"), details[2].Comment)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).
+			WithDropColumns(*sdk.NewHybridTableDropColumnsRequest([]string{"EMAIL"})),
+		)
+		require.NoError(t, err)
+
+		details, err = client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).
+			WithSet(*sdk.NewHybridTableSetRequest().WithDataRetentionTimeInDays(2).WithComment("altered comment")),
+		)
+		require.NoError(t, err)
+
+		hybridTable, err = client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.String("altered comment"), hybridTable.Comment)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).
+			WithUnset(*sdk.NewHybridTableUnsetRequest().WithDataRetentionTimeInDays(true).WithComment(true)),
+		)
+		require.NoError(t, err)
+
+		hybridTable, err = client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, hybridTable.Comment)
+	})
+
+	t.Run("Alter - rename", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, newId))
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+		_, err = client.HybridTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+
+		err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		otherHybridTable, otherCleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(otherCleanup)
+
+		hybridTables, err := client.HybridTables.Show(ctx, sdk.NewShowHybridTableRequest().WithIn(sdk.In{
+			Schema: testClientHelper().Ids.SchemaId(),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, hybridTables, *hybridTable)
+		assert.Contains(t, hybridTables, *otherHybridTable)
+
+		hybridTables, err = client.HybridTables.Show(ctx, sdk.NewShowHybridTableRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(hybridTable.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, hybridTables, 1)
+		assert.Equal(t, *hybridTable, hybridTables[0])
+	})
+}
+
+func TestInt_HybridTableIndexes(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("Create and drop", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+
+		indexName := testClientHelper().Ids.Alpha()
+		err := client.HybridTableIndexes.Create(ctx, sdk.NewCreateHybridTableIndexRequest(indexName, hybridTable.ID(), []string{"NAME"}).
+			WithIncludeColumns([]string{"ID"}),
+		)
+		require.NoError(t, err)
+		indexId := sdk.NewTableColumnIdentifier(hybridTable.DatabaseName, hybridTable.SchemaName, hybridTable.Name, indexName)
+
+		index, err := client.HybridTableIndexes.ShowByID(ctx, indexId)
+		require.NoError(t, err)
+		assert.Equal(t, indexId, index.ID())
+		assert.False(t, index.IsUnique)
+		assert.Equal(t, []string{"NAME"}, index.Columns)
+		assert.Equal(t, []string{"ID"}, index.IncludedColumns)
+
+		err = client.HybridTableIndexes.Drop(ctx, sdk.NewDropHybridTableIndexRequest(indexId))
+		require.NoError(t, err)
+
+		_, err = client.HybridTableIndexes.ShowByID(ctx, indexId)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.Create(t)
+		t.Cleanup(cleanup)
+		indexId := testClientHelper().HybridTable.CreateIndex(t, hybridTable.ID(), []string{"NAME"})
+
+		indexes, err := client.HybridTableIndexes.Show(ctx, sdk.NewShowHybridTableIndexRequest().WithInTable(hybridTable.ID()))
+		require.NoError(t, err)
+		_, err = collections.FindOne(indexes, func(i sdk.HybridTableIndex) bool { return i.ID() == indexId })
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,204 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_IcebergTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	externalVolume := testenvs.GetOrSkipTest(t, testenvs.IcebergExternalVolume)
+
+	findColumn := func(t *testing.T, columns []sdk.IcebergTableColumnDetails, name string) sdk.IcebergTableColumnDetails {
+		t.Helper()
+		column, err := collections.FindOne(columns, func(c sdk.IcebergTableColumnDetails) bool { return c.Name == name })
+		require.NoError(t, err)
+		return *column
+	}
+
+	t.Run("Create - Snowflake catalog", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.IcebergTableColumnRequest{
+			*sdk.NewIcebergTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(true).WithComment("id column"),
+			*sdk.NewIcebergTableColumnRequest("NAME", sdk.DataTypeVARCHAR),
+		}
+		request := sdk.NewCreateIcebergTableRequest(id, columns, "base/location").
+			WithExternalVolume(externalVolume).
+			WithClusterBy([]string{"ID"}).
+			WithStorageSerializationPolicy(sdk.IcebergTableStorageSerializationPolicyOptimized).
+			WithComment("some comment")
+
+		err := client.IcebergTables.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, id))
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, icebergTable.ID())
+		assert.Equal(t, externalVolume, icebergTable.ExternalVolumeName)
+		assert.Equal(t, "SNOWFLAKE", icebergTable.CatalogName)
+		assert.Equal(t, "base/location", icebergTable.BaseLocation)
+		assert.Equal(t, "OPTIMIZED", icebergTable.StorageSerializationPolicy)
+		assert.Equal(t, sdk.String("some comment"), icebergTable.Comment)
+
+		details, err := client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+		idColumn := findColumn(t, details, "ID")
+		assert.False(t, idColumn.IsNullable)
+		assert.Equal(t, sdk.String("id column"), idColumn.Comment)
+		assert.True(t, findColumn(t, details, "NAME").IsNullable)
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithSet(*sdk.NewIcebergTableSetRequest().WithDataRetentionTimeInDays(2).WithComment("altered comment")),
+		)
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.String("altered comment"), icebergTable.Comment)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithUnset(*sdk.NewIcebergTableUnsetRequest().WithDataRetentionTimeInDays(true).WithComment(true)),
+		)
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, icebergTable.Comment)
+	})
+
+	t.Run("Alter - columns", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithAddColumn(*sdk.NewIcebergTableAddColumnRequest("NAME", sdk.DataTypeVARCHAR).WithComment("name column")),
+		)
+		require.NoError(t, err)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithRenameColumn(*sdk.NewIcebergTableRenameColumnRequest("NAME", "NEW_NAME")),
+		)
+		require.NoError(t, err)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithAlterColumn(*sdk.NewIcebergTableAlterColumnRequest("NEW_NAME").WithComment("altered comment")),
+		)
+		require.NoError(t, err)
+
+		details, err := client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+		assert.Equal(t, sdk.String("altered comment"), findColumn(t, details, "NEW_NAME").Comment)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithDropColumns(*sdk.NewIcebergTableDropColumnsRequest([]string{"NEW_NAME"})),
+		)
+		require.NoError(t, err)
+
+		details, err = client.IcebergTables.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+	})
+
+	t.Run("Alter - clustering", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithClusterBy([]string{"ID"}))
+		require.NoError(t, err)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithDropClusteringKey(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("Alter - rename", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		t.Cleanup(testClientHelper().IcebergTable.DropFunc(t, newId))
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+
+		_, err = client.IcebergTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+		_, err = client.IcebergTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("Alter - set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithSetTags([]sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}),
+		)
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeTable)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", returnedTagValue)
+
+		err = client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).
+			WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}),
+		)
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeTable)
+		require.Error(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		id := icebergTable.ID()
+
+		err := client.IcebergTables.Drop(ctx, sdk.NewDropIcebergTableRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.IcebergTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		icebergTable, cleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(cleanup)
+		otherIcebergTable, otherCleanup := testClientHelper().IcebergTable.Create(t, externalVolume)
+		t.Cleanup(otherCleanup)
+
+		icebergTables, err := client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().WithIn(sdk.In{
+			Schema: testClientHelper().Ids.SchemaId(),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, icebergTables, *icebergTable)
+		assert.Contains(t, icebergTables, *otherIcebergTable)
+
+		icebergTables, err = client.IcebergTables.Show(ctx, sdk.NewShowIcebergTableRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(icebergTable.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, icebergTables, 1)
+		assert.Equal(t, *icebergTable, icebergTables[0])
+	})
+}
@@ -0,0 +1,132 @@
+package testint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ImageRepositories(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertImageRepository := func(t *testing.T, imageRepository *sdk.ImageRepository, id sdk.SchemaObjectIdentifier, comment *string) {
+		t.Helper()
+		assert.Equal(t, id, imageRepository.ID())
+		assert.Equal(t, comment, imageRepository.Comment)
+		assert.NotEmpty(t, imageRepository.Owner)
+		assert.NotEmpty(t, imageRepository.CreatedOn)
+		// repository url has the form <orgname>-<acctname>.registry.snowflakecomputing.com/<db>/<schema>/<repository>
+		assert.True(t, strings.HasSuffix(strings.ToLower(imageRepository.RepositoryUrl), strings.ToLower(strings.Join([]string{id.DatabaseName(), id.SchemaName(), id.Name()}, "/"))))
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ImageRepositories.Create(ctx, sdk.NewCreateImageRepositoryRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ImageRepository.DropFunc(t, id))
+
+		imageRepository, err := client.ImageRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertImageRepository(t, imageRepository, id, nil)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ImageRepositories.Create(ctx, sdk.NewCreateImageRepositoryRequest(id).
+			WithIfNotExists(true).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ImageRepository.DropFunc(t, id))
+
+		imageRepository, err := client.ImageRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertImageRepository(t, imageRepository, id, sdk.String("some comment"))
+	})
+
+	t.Run("Alter - set and unset comment", func(t *testing.T) {
+		imageRepository, cleanup := testClientHelper().ImageRepository.Create(t)
+		t.Cleanup(cleanup)
+		id := imageRepository.ID()
+
+		err := client.ImageRepositories.Alter(ctx, sdk.NewAlterImageRepositoryRequest(id).WithSet(
+			*sdk.NewImageRepositorySetRequest().WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		imageRepository, err = client.ImageRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertImageRepository(t, imageRepository, id, sdk.String("altered comment"))
+
+		err = client.ImageRepositories.Alter(ctx, sdk.NewAlterImageRepositoryRequest(id).WithUnset(
+			*sdk.NewImageRepositoryUnsetRequest().WithComment(true),
+		))
+		require.NoError(t, err)
+
+		imageRepository, err = client.ImageRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertImageRepository(t, imageRepository, id, nil)
+	})
+
+	t.Run("Alter - set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+		imageRepository, cleanup := testClientHelper().ImageRepository.Create(t)
+		t.Cleanup(cleanup)
+		id := imageRepository.ID()
+
+		err := client.ImageRepositories.Alter(ctx, sdk.NewAlterImageRepositoryRequest(id).WithSetTags([]sdk.TagAssociation{
+			{Name: tag.ID(), Value: "v1"},
+		}))
+		require.NoError(t, err)
+
+		value, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeImageRepository)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+
+		err = client.ImageRepositories.Alter(ctx, sdk.NewAlterImageRepositoryRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeImageRepository)
+		require.Error(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		imageRepository, cleanup := testClientHelper().ImageRepository.Create(t)
+		t.Cleanup(cleanup)
+		id := imageRepository.ID()
+
+		err := client.ImageRepositories.Drop(ctx, sdk.NewDropImageRepositoryRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ImageRepositories.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		imageRepository, cleanup := testClientHelper().ImageRepository.Create(t)
+		t.Cleanup(cleanup)
+		otherImageRepository, otherCleanup := testClientHelper().ImageRepository.Create(t)
+		t.Cleanup(otherCleanup)
+
+		imageRepositories, err := client.ImageRepositories.Show(ctx, sdk.NewShowImageRepositoryRequest().
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}))
+		require.NoError(t, err)
+		assert.Contains(t, imageRepositories, *imageRepository)
+		assert.Contains(t, imageRepositories, *otherImageRepository)
+
+		imageRepositories, err = client.ImageRepositories.Show(ctx, sdk.NewShowImageRepositoryRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(imageRepository.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, imageRepositories, 1)
+		assert.Equal(t, *imageRepository, imageRepositories[0])
+	})
+}
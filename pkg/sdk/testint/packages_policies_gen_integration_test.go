@@ -0,0 +1,153 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PackagesPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertPackagesPolicy := func(t *testing.T, packagesPolicy *sdk.PackagesPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.Equal(t, id, packagesPolicy.ID())
+		assert.NotEmpty(t, packagesPolicy.CreatedOn)
+		assert.Equal(t, "PACKAGES_POLICY", packagesPolicy.Kind)
+		assert.Equal(t, expectedComment, packagesPolicy.Comment)
+		assert.Equal(t, "ACCOUNTADMIN", packagesPolicy.Owner)
+		assert.Equal(t, "ROLE", packagesPolicy.OwnerRoleType)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.PackagesPolicies.Create(ctx, sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguagePython))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().PackagesPolicy.DropPackagesPolicyFunc(t, id))
+
+		packagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, packagesPolicy, id, "")
+
+		description, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), description.Name)
+		assert.Equal(t, "PYTHON", description.Language)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.PackagesPolicies.Create(ctx, sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguagePython).
+			WithIfNotExists(true).
+			WithAllowlist([]sdk.PackagesPolicyPackage{{Spec: "numpy"}, {Spec: "pandas==1.5.3"}}).
+			WithBlocklist([]sdk.PackagesPolicyPackage{{Spec: "requests"}}).
+			WithAdditionalCreationBlocklist([]sdk.PackagesPolicyPackage{{Spec: "scipy"}}).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().PackagesPolicy.DropPackagesPolicyFunc(t, id))
+
+		packagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, packagesPolicy, id, "some comment")
+
+		description, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "PYTHON", description.Language)
+		assert.Equal(t, []string{"numpy", "pandas==1.5.3"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist))
+		assert.Equal(t, []string{"requests"}, sdk.ParseCommaSeparatedStringArray(description.Blocklist))
+		assert.Equal(t, []string{"scipy"}, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist))
+		assert.Equal(t, "some comment", description.Comment)
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		packagesPolicy, cleanup := testClientHelper().PackagesPolicy.CreatePackagesPolicy(t)
+		t.Cleanup(cleanup)
+		id := packagesPolicy.ID()
+
+		err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(
+			*sdk.NewPackagesPolicySetRequest().
+				WithAllowlist([]sdk.PackagesPolicyPackage{{Spec: "numpy"}}).
+				WithBlocklist([]sdk.PackagesPolicyPackage{{Spec: "requests"}, {Spec: "scipy"}}).
+				WithAdditionalCreationBlocklist([]sdk.PackagesPolicyPackage{{Spec: "pandas"}}).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		packagesPolicy, err = client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, packagesPolicy, id, "altered comment")
+
+		description, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"numpy"}, sdk.ParseCommaSeparatedStringArray(description.Allowlist))
+		assert.Equal(t, []string{"requests", "scipy"}, sdk.ParseCommaSeparatedStringArray(description.Blocklist))
+		assert.Equal(t, []string{"pandas"}, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist))
+
+		err = client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(
+			*sdk.NewPackagesPolicyUnsetRequest().
+				WithAllowlist(true).
+				WithBlocklist(true).
+				WithAdditionalCreationBlocklist(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		packagesPolicy, err = client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertPackagesPolicy(t, packagesPolicy, id, "")
+
+		description, err = client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.Blocklist))
+		assert.Empty(t, sdk.ParseCommaSeparatedStringArray(description.AdditionalCreationBlocklist))
+	})
+
+	t.Run("Drop - existing", func(t *testing.T) {
+		packagesPolicy, cleanup := testClientHelper().PackagesPolicy.CreatePackagesPolicy(t)
+		t.Cleanup(cleanup)
+		id := packagesPolicy.ID()
+
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.PackagesPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Drop - non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show - with like and in", func(t *testing.T) {
+		packagesPolicy1, cleanup1 := testClientHelper().PackagesPolicy.CreatePackagesPolicy(t)
+		t.Cleanup(cleanup1)
+		packagesPolicy2, cleanup2 := testClientHelper().PackagesPolicy.CreatePackagesPolicy(t)
+		t.Cleanup(cleanup2)
+
+		packagesPolicies, err := client.PackagesPolicies.Show(ctx, sdk.NewShowPackagesPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(packagesPolicy1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}),
+		)
+		require.NoError(t, err)
+		assert.Len(t, packagesPolicies, 1)
+		assert.Contains(t, packagesPolicies, *packagesPolicy1)
+		assert.NotContains(t, packagesPolicies, *packagesPolicy2)
+	})
+
+	t.Run("Describe - non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		_, err := client.PackagesPolicies.Describe(ctx, id)
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
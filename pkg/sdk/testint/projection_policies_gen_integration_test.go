@@ -0,0 +1,212 @@
+package testint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "PROJECTION_CONSTRAINT(ALLOW => false)"
+
+	assertProjectionPolicy := func(t *testing.T, projectionPolicy *sdk.ProjectionPolicy, id sdk.SchemaObjectIdentifier, expectedComment string) {
+		t.Helper()
+		assert.Equal(t, id, projectionPolicy.ID())
+		assert.NotEmpty(t, projectionPolicy.CreatedOn)
+		assert.Equal(t, "PROJECTION_POLICY", projectionPolicy.Kind)
+		assert.Equal(t, expectedComment, projectionPolicy.Comment)
+		assert.Equal(t, "ACCOUNTADMIN", projectionPolicy.Owner)
+		assert.Equal(t, "ROLE", projectionPolicy.OwnerRoleType)
+	}
+
+	t.Run("Create - basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, id))
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, "")
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body).
+			WithOrReplace(true).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, id))
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, "some comment")
+	})
+
+	t.Run("Alter - set body", func(t *testing.T) {
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+		id := projectionPolicy.ID()
+
+		newBody := "PROJECTION_CONSTRAINT(ALLOW => true)"
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		description, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, newBody, description.Body)
+	})
+
+	t.Run("Alter - set and unset comment", func(t *testing.T) {
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+		id := projectionPolicy.ID()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetComment("altered comment"))
+		require.NoError(t, err)
+
+		projectionPolicy, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, "altered comment")
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		projectionPolicy, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, id, "")
+	})
+
+	t.Run("Alter - rename", func(t *testing.T) {
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+		id := projectionPolicy.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, newId))
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		projectionPolicy, err = client.ProjectionPolicies.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assertProjectionPolicy(t, projectionPolicy, newId, "")
+	})
+
+	t.Run("Drop - existing", func(t *testing.T) {
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+		id := projectionPolicy.ID()
+
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Drop - non-existing", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("Show - with like and in", func(t *testing.T) {
+		projectionPolicy1, cleanup1 := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup1)
+		projectionPolicy2, cleanup2 := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup2)
+
+		projectionPolicies, err := client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(projectionPolicy1.Name)}).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}),
+		)
+		require.NoError(t, err)
+		assert.Len(t, projectionPolicies, 1)
+		assert.Contains(t, projectionPolicies, *projectionPolicy1)
+		assert.NotContains(t, projectionPolicies, *projectionPolicy2)
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		projectionPolicy, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+
+		description, err := client.ProjectionPolicies.Describe(ctx, projectionPolicy.ID())
+		require.NoError(t, err)
+		assert.Equal(t, projectionPolicy.Name, description.Name)
+		assert.Equal(t, "()", description.Signature)
+		assert.Equal(t, "PROJECTION_CONSTRAINT", description.ReturnType)
+		assert.Equal(t, body, description.Body)
+	})
+
+	t.Run("Set and unset on table column", func(t *testing.T) {
+		projectionPolicy, projectionPolicyCleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(projectionPolicyCleanup)
+		table, tableCleanup := testClientHelper().Table.CreateTable(t)
+		t.Cleanup(tableCleanup)
+
+		err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetProjectionPolicy(
+			sdk.NewTableColumnAlterSetProjectionPolicyActionRequest("id", projectionPolicy.ID()),
+		)))
+		require.NoError(t, err)
+
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(table.ID(), sdk.PolicyEntityDomainTable))
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, projectionPolicy.Name, policyReferences[0].PolicyName)
+		assert.Equal(t, "PROJECTION_POLICY", policyReferences[0].PolicyKind)
+		require.NotNil(t, policyReferences[0].RefColumnName)
+		assert.Equal(t, "ID", *policyReferences[0].RefColumnName)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(table.ID()).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetProjectionPolicy(
+			sdk.NewTableColumnAlterUnsetProjectionPolicyActionRequest("id"),
+		)))
+		require.NoError(t, err)
+
+		policyReferences, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(table.ID(), sdk.PolicyEntityDomainTable))
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
+	t.Run("Set and unset on view column", func(t *testing.T) {
+		projectionPolicy, projectionPolicyCleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(projectionPolicyCleanup)
+		table, tableCleanup := testClientHelper().Table.CreateTable(t)
+		t.Cleanup(tableCleanup)
+		view, viewCleanup := testClientHelper().View.CreateView(t, fmt.Sprintf("SELECT id FROM %s", table.ID().FullyQualifiedName()))
+		t.Cleanup(viewCleanup)
+
+		err := client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithSetProjectionPolicyOnColumn(
+			sdk.NewViewSetColumnProjectionPolicyRequest("id", projectionPolicy.ID()),
+		))
+		require.NoError(t, err)
+
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(view.ID(), sdk.PolicyEntityDomainView))
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, projectionPolicy.Name, policyReferences[0].PolicyName)
+		assert.Equal(t, "PROJECTION_POLICY", policyReferences[0].PolicyKind)
+
+		err = client.Views.Alter(ctx, sdk.NewAlterViewRequest(view.ID()).WithUnsetProjectionPolicyOnColumn(
+			sdk.NewViewUnsetColumnProjectionPolicyRequest("id"),
+		))
+		require.NoError(t, err)
+
+		policyReferences, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(view.ID(), sdk.PolicyEntityDomainView))
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+}
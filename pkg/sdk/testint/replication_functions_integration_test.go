@@ -142,3 +142,31 @@ func TestInt_ShowRegions(t *testing.T) {
 		assert.Equal(t, "US West (Oregon)", region.DisplayName)
 	})
 }
+
+func TestInt_ReplicationGroupRefreshProgress(t *testing.T) {
+	client := testClient(t)
+	secondaryClient := testSecondaryClient(t)
+	ctx := testContext(t)
+
+	accountId := testClientHelper().Account.GetAccountIdentifier(t)
+	secondaryAccountId := secondaryTestClientHelper().Account.GetAccountIdentifier(t)
+
+	// the primary replication group is located in the secondary account
+	id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+	err := secondaryClient.ReplicationGroups.Create(ctx, id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{accountId}, nil)
+	require.NoError(t, err)
+	t.Cleanup(secondaryTestClientHelper().ReplicationGroup.DropFunc(t, id))
+
+	err = client.ReplicationGroups.CreateSecondary(ctx, id, sdk.NewExternalObjectIdentifier(secondaryAccountId, id), nil)
+	require.NoError(t, err)
+	t.Cleanup(testClientHelper().ReplicationGroup.DropFunc(t, id))
+
+	err = client.ReplicationGroups.AlterTarget(ctx, id, &sdk.AlterTargetReplicationGroupOptions{Refresh: sdk.Bool(true)})
+	require.NoError(t, err)
+
+	phases, err := client.ReplicationFunctions.ReplicationGroupRefreshProgress(ctx, id)
+	require.NoError(t, err)
+	require.NotEmpty(t, phases)
+	assert.NotEmpty(t, phases[0].PhaseName)
+	assert.NotEmpty(t, phases[0].StartTime)
+}
@@ -0,0 +1,170 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ReplicationGroups(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	secondaryAccountId := secondaryTestClientHelper().Account.GetAccountIdentifier(t)
+
+	t.Run("Create - minimal", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ReplicationGroups.Create(ctx, id, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{secondaryAccountId}, nil)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropFunc(t, id))
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, replicationGroup.ID())
+		assert.Equal(t, "REPLICATION", replicationGroup.Type)
+		assert.True(t, replicationGroup.IsPrimary)
+		assert.Equal(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, replicationGroup.ObjectTypes)
+		assert.Contains(t, replicationGroup.AllowedAccounts, secondaryAccountId)
+		assert.Empty(t, replicationGroup.ReplicationSchedule)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ReplicationGroups.Create(ctx, id, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases}, []sdk.AccountIdentifier{secondaryAccountId}, &sdk.CreateReplicationGroupOptions{
+			IfNotExists:         sdk.Bool(true),
+			AllowedDatabases:    []sdk.AccountObjectIdentifier{database.ID()},
+			IgnoreEditionCheck:  sdk.Bool(true),
+			ReplicationSchedule: sdk.String("10 MINUTE"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropFunc(t, id))
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "10 MINUTE", replicationGroup.ReplicationSchedule)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{database.ID()}, databases)
+	})
+
+	t.Run("Alter source", func(t *testing.T) {
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+		share, shareCleanup := testClientHelper().Share.CreateShare(t)
+		t.Cleanup(shareCleanup)
+		replicationGroup, cleanup := testClientHelper().ReplicationGroup.CreateWithOptions(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{secondaryAccountId}, nil)
+		t.Cleanup(cleanup)
+		id := replicationGroup.ID()
+
+		err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Set: &sdk.ReplicationGroupSet{
+				ObjectTypes:         []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares},
+				ReplicationSchedule: sdk.String("20 MINUTE"),
+			},
+		})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Add: &sdk.ReplicationGroupAdd{AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()}},
+		})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Add: &sdk.ReplicationGroupAdd{AllowedShares: []sdk.AccountObjectIdentifier{share.ID()}},
+		})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares}, replicationGroup.ObjectTypes)
+		assert.Equal(t, "20 MINUTE", replicationGroup.ReplicationSchedule)
+
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{database.ID()}, databases)
+
+		shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []sdk.AccountObjectIdentifier{share.ID()}, shares)
+
+		err = client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Remove: &sdk.ReplicationGroupRemove{AllowedDatabases: []sdk.AccountObjectIdentifier{database.ID()}},
+		})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{
+			Unset: &sdk.ReplicationGroupUnset{ReplicationSchedule: sdk.Bool(true)},
+		})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, replicationGroup.ReplicationSchedule)
+
+		databases, err = client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, databases)
+	})
+
+	t.Run("Create secondary, refresh, suspend and resume", func(t *testing.T) {
+		secondaryClient := testSecondaryClient(t)
+		accountId := testClientHelper().Account.GetAccountIdentifier(t)
+
+		// the primary replication group is located in the secondary account
+		primaryId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		err := secondaryClient.ReplicationGroups.Create(ctx, primaryId, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{accountId}, nil)
+		require.NoError(t, err)
+		t.Cleanup(secondaryTestClientHelper().ReplicationGroup.DropFunc(t, primaryId))
+
+		err = client.ReplicationGroups.CreateSecondary(ctx, primaryId, sdk.NewExternalObjectIdentifier(secondaryAccountId, primaryId), &sdk.CreateReplicationGroupAsReplicaOptions{
+			IfNotExists: sdk.Bool(true),
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropFunc(t, primaryId))
+
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, primaryId)
+		require.NoError(t, err)
+		assert.False(t, replicationGroup.IsPrimary)
+		assert.Equal(t, secondaryAccountId, replicationGroup.Primary.AccountIdentifier())
+
+		err = client.ReplicationGroups.AlterTarget(ctx, primaryId, &sdk.AlterTargetReplicationGroupOptions{Refresh: sdk.Bool(true)})
+		require.NoError(t, err)
+
+		err = client.ReplicationGroups.AlterTarget(ctx, primaryId, &sdk.AlterTargetReplicationGroupOptions{Suspend: sdk.Bool(true)})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, primaryId)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.FailoverGroupSecondaryStateSuspended, replicationGroup.SecondaryState)
+
+		err = client.ReplicationGroups.AlterTarget(ctx, primaryId, &sdk.AlterTargetReplicationGroupOptions{Resume: sdk.Bool(true)})
+		require.NoError(t, err)
+
+		replicationGroup, err = client.ReplicationGroups.ShowByID(ctx, primaryId)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.FailoverGroupSecondaryStateStarted, replicationGroup.SecondaryState)
+	})
+
+	t.Run("Rename and drop", func(t *testing.T) {
+		replicationGroup, cleanup := testClientHelper().ReplicationGroup.CreateWithOptions(t, []sdk.PluralObjectType{sdk.PluralObjectTypeRoles}, []sdk.AccountIdentifier{secondaryAccountId}, nil)
+		t.Cleanup(cleanup)
+		newId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.ReplicationGroups.AlterSource(ctx, replicationGroup.ID(), &sdk.AlterSourceReplicationGroupOptions{NewName: &newId})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ReplicationGroup.DropFunc(t, newId))
+
+		err = client.ReplicationGroups.Drop(ctx, newId, nil)
+		require.NoError(t, err)
+
+		_, err = client.ReplicationGroups.ShowByID(ctx, newId)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
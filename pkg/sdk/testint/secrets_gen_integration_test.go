@@ -0,0 +1,234 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Secrets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	clientCredentialsIntegrationId, clientCredentialsIntegrationCleanup := testClientHelper().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(clientCredentialsIntegrationCleanup)
+
+	authorizationCodeIntegrationId, authorizationCodeIntegrationCleanup := testClientHelper().SecurityIntegration.CreateApiAuthenticationWithAuthorizationCodeGrantFlow(t)
+	t.Cleanup(authorizationCodeIntegrationCleanup)
+
+	assertSecret := func(t *testing.T, id sdk.SchemaObjectIdentifier, secretType sdk.SecretType, comment string) *sdk.SecretDetails {
+		t.Helper()
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, secret.ID())
+		assert.False(t, secret.CreatedOn.IsZero())
+		assert.Equal(t, secretType, secret.SecretType)
+		assert.NotEmpty(t, secret.Owner)
+		assert.Equal(t, "ROLE", secret.OwnerRoleType)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, id.DatabaseName(), details.DatabaseName)
+		assert.Equal(t, id.SchemaName(), details.SchemaName)
+		assert.Equal(t, secretType, details.SecretType)
+		if comment == "" {
+			assert.Nil(t, secret.Comment)
+			assert.Nil(t, details.Comment)
+		} else {
+			assert.Equal(t, sdk.String(comment), secret.Comment)
+			assert.Equal(t, sdk.String(comment), details.Comment)
+		}
+		return details
+	}
+
+	t.Run("CreateWithOAuthClientCredentialsFlow", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateWithOAuthClientCredentialsFlowSecretRequest(id, clientCredentialsIntegrationId, []sdk.SecretScope{{Scope: "foo"}, {Scope: "bar"}}).
+			WithComment("a")
+
+		err := client.Secrets.CreateWithOAuthClientCredentialsFlow(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Secret.DropFunc(t, id))
+
+		details := assertSecret(t, id, sdk.SecretTypeOAuth2, "a")
+		assert.Equal(t, []string{"foo", "bar"}, details.OauthScopes)
+		assert.Equal(t, sdk.String(clientCredentialsIntegrationId.Name()), details.IntegrationName)
+	})
+
+	t.Run("CreateWithOAuthAuthorizationCodeFlow", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateWithOAuthAuthorizationCodeFlowSecretRequest(id, "foo", "2030-01-02 10:00:00", authorizationCodeIntegrationId).
+			WithComment("a")
+
+		err := client.Secrets.CreateWithOAuthAuthorizationCodeFlow(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Secret.DropFunc(t, id))
+
+		details := assertSecret(t, id, sdk.SecretTypeOAuth2, "a")
+		require.NotNil(t, details.OauthRefreshTokenExpiryTime)
+		assert.Equal(t, 2030, details.OauthRefreshTokenExpiryTime.Year())
+		assert.Equal(t, sdk.String(authorizationCodeIntegrationId.Name()), details.IntegrationName)
+	})
+
+	t.Run("CreateWithBasicAuthentication", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateWithBasicAuthenticationSecretRequest(id, "foo", "bar").
+			WithComment("a")
+
+		err := client.Secrets.CreateWithBasicAuthentication(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Secret.DropFunc(t, id))
+
+		details := assertSecret(t, id, sdk.SecretTypePassword, "a")
+		assert.Equal(t, sdk.String("foo"), details.Username)
+	})
+
+	t.Run("CreateWithGenericString", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateWithGenericStringSecretRequest(id, "foo")
+
+		err := client.Secrets.CreateWithGenericString(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Secret.DropFunc(t, id))
+
+		assertSecret(t, id, sdk.SecretTypeGenericString, "")
+	})
+
+	t.Run("Alter: OAuth client credentials flow", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().Secret.CreateWithOAuthClientCredentialsFlow(t, id, clientCredentialsIntegrationId, []sdk.SecretScope{{Scope: "foo"}})
+		t.Cleanup(cleanup)
+
+		setRequest := sdk.NewAlterSecretRequest(id).
+			WithSet(*sdk.NewSecretSetRequest().
+				WithComment("a").
+				WithSetForOAuthClientCredentialsFlow(*sdk.NewSetForOAuthClientCredentialsFlowRequest([]sdk.SecretScope{{Scope: "bar"}})),
+			)
+		err := client.Secrets.Alter(ctx, setRequest)
+		require.NoError(t, err)
+
+		details := assertSecret(t, id, sdk.SecretTypeOAuth2, "a")
+		assert.Equal(t, []string{"bar"}, details.OauthScopes)
+
+		unsetRequest := sdk.NewAlterSecretRequest(id).
+			WithUnset(*sdk.NewSecretUnsetRequest().WithComment(true))
+		err = client.Secrets.Alter(ctx, unsetRequest)
+		require.NoError(t, err)
+
+		assertSecret(t, id, sdk.SecretTypeOAuth2, "")
+	})
+
+	t.Run("Alter: OAuth authorization code flow", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().Secret.CreateWithOAuthAuthorizationCodeFlow(t, id, authorizationCodeIntegrationId, "foo", "2030-01-02 10:00:00")
+		t.Cleanup(cleanup)
+
+		setRequest := sdk.NewAlterSecretRequest(id).
+			WithSet(*sdk.NewSecretSetRequest().
+				WithSetForOAuthAuthorizationFlow(*sdk.NewSetForOAuthAuthorizationFlowRequest().
+					WithOauthRefreshToken("bar").
+					WithOauthRefreshTokenExpiryTime("2031-01-02 10:00:00"),
+				),
+			)
+		err := client.Secrets.Alter(ctx, setRequest)
+		require.NoError(t, err)
+
+		details := assertSecret(t, id, sdk.SecretTypeOAuth2, "")
+		require.NotNil(t, details.OauthRefreshTokenExpiryTime)
+		assert.Equal(t, 2031, details.OauthRefreshTokenExpiryTime.Year())
+	})
+
+	t.Run("Alter: basic authentication", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().Secret.CreateWithBasicAuthentication(t, id, "foo", "bar")
+		t.Cleanup(cleanup)
+
+		setRequest := sdk.NewAlterSecretRequest(id).
+			WithSet(*sdk.NewSecretSetRequest().
+				WithComment("a").
+				WithSetForBasicAuthentication(*sdk.NewSetForBasicAuthenticationRequest().
+					WithUsername("new_foo").
+					WithPassword("new_bar"),
+				),
+			)
+		err := client.Secrets.Alter(ctx, setRequest)
+		require.NoError(t, err)
+
+		details := assertSecret(t, id, sdk.SecretTypePassword, "a")
+		assert.Equal(t, sdk.String("new_foo"), details.Username)
+	})
+
+	t.Run("Alter: generic string", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().Secret.CreateWithGenericString(t, id, "foo")
+		t.Cleanup(cleanup)
+
+		setRequest := sdk.NewAlterSecretRequest(id).
+			WithSet(*sdk.NewSecretSetRequest().
+				WithSetForGenericString(*sdk.NewSetForGenericStringRequest("bar")),
+			)
+		err := client.Secrets.Alter(ctx, setRequest)
+		require.NoError(t, err)
+
+		assertSecret(t, id, sdk.SecretTypeGenericString, "")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup := testClientHelper().Secret.CreateWithGenericString(t, id, "foo")
+		t.Cleanup(cleanup)
+
+		err := client.Secrets.Drop(ctx, sdk.NewDropSecretRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.Secrets.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id1 := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		id2 := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		_, cleanup1 := testClientHelper().Secret.CreateWithGenericString(t, id1, "foo")
+		t.Cleanup(cleanup1)
+		_, cleanup2 := testClientHelper().Secret.CreateWithBasicAuthentication(t, id2, "foo", "bar")
+		t.Cleanup(cleanup2)
+
+		secrets, err := client.Secrets.Show(ctx, sdk.NewShowSecretRequest().WithIn(sdk.In{
+			Schema: id1.SchemaId(),
+		}))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(secrets), 2)
+
+		secrets, err = client.Secrets.Show(ctx, sdk.NewShowSecretRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(id1.Name()),
+		}))
+		require.NoError(t, err)
+		require.Len(t, secrets, 1)
+		assert.Equal(t, id1, secrets[0].ID())
+	})
+
+	t.Run("ShowByID: same name in different schemas", func(t *testing.T) {
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+
+		id1 := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		id2 := sdk.NewSchemaObjectIdentifierInSchema(schema.ID(), id1.Name())
+
+		_, cleanup1 := testClientHelper().Secret.CreateWithGenericString(t, id1, "foo")
+		t.Cleanup(cleanup1)
+		_, cleanup2 := testClientHelper().Secret.CreateWithGenericString(t, id2, "foo")
+		t.Cleanup(cleanup2)
+
+		secret1, err := client.Secrets.ShowByID(ctx, id1)
+		require.NoError(t, err)
+		assert.Equal(t, id1, secret1.ID())
+
+		secret2, err := client.Secrets.ShowByID(ctx, id2)
+		require.NoError(t, err)
+		assert.Equal(t, id2, secret2.ID())
+	})
+}
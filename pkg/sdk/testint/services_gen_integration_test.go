@@ -0,0 +1,188 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Services(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	// services can be only created from images that were pushed to one of the image repositories in the account
+	image := testenvs.GetOrSkipTest(t, testenvs.ServiceImage)
+
+	computePool, computePoolCleanup := testClientHelper().ComputePool.Create(t)
+	t.Cleanup(computePoolCleanup)
+	testClientHelper().ComputePool.Alter(t, sdk.NewAlterComputePoolRequest(computePool.ID()).WithSet(*sdk.NewComputePoolSetRequest().WithAutoResume(true)))
+
+	assertService := func(t *testing.T, service *sdk.Service, id sdk.SchemaObjectIdentifier, minInstances int, maxInstances int, comment *string) {
+		t.Helper()
+		assert.Equal(t, id, service.ID())
+		assert.Equal(t, computePool.Name, service.ComputePool)
+		assert.Equal(t, minInstances, service.MinInstances)
+		assert.Equal(t, maxInstances, service.MaxInstances)
+		assert.Equal(t, comment, service.Comment)
+		assert.NotEmpty(t, service.DnsName)
+		assert.NotEmpty(t, service.Owner)
+		assert.NotEmpty(t, service.CreatedOn)
+	}
+
+	t.Run("Create - inline specification", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Services.Create(ctx, sdk.NewCreateServiceRequest(id, computePool.ID()).
+			WithFromSpecification(*sdk.NewServiceFromSpecificationRequest().WithSpecification(helpers.ServiceSpecification(image))),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Service.DropFunc(t, id))
+
+		service, err := client.Services.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertService(t, service, id, 1, 1, nil)
+		assert.Empty(t, service.ExternalAccessIntegrations)
+	})
+
+	t.Run("Create - complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Services.Create(ctx, sdk.NewCreateServiceRequest(id, computePool.ID()).
+			WithIfNotExists(true).
+			WithFromSpecification(*sdk.NewServiceFromSpecificationRequest().WithSpecification(helpers.ServiceSpecification(image))).
+			WithAutoResume(true).
+			WithMinInstances(1).
+			WithMaxInstances(2).
+			WithQueryWarehouse(testClientHelper().Ids.WarehouseId()).
+			WithComment("some comment"),
+		)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Service.DropFunc(t, id))
+
+		service, err := client.Services.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertService(t, service, id, 1, 2, sdk.String("some comment"))
+		assert.True(t, service.AutoResume)
+		require.NotNil(t, service.QueryWarehouse)
+		assert.Equal(t, testClientHelper().Ids.WarehouseId().Name(), *service.QueryWarehouse)
+	})
+
+	t.Run("Alter - set and unset", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+		id := service.ID()
+
+		err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithSet(
+			*sdk.NewServiceSetRequest().
+				WithMaxInstances(2).
+				WithAutoResume(false).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		service, err = client.Services.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertService(t, service, id, 1, 2, sdk.String("altered comment"))
+		assert.False(t, service.AutoResume)
+
+		err = client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithUnset(
+			*sdk.NewServiceUnsetRequest().
+				WithAutoResume(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		service, err = client.Services.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assertService(t, service, id, 1, 2, nil)
+		assert.True(t, service.AutoResume)
+	})
+
+	t.Run("Alter - from specification", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+		id := service.ID()
+
+		details, err := client.Services.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, details.Spec, "port: 8080")
+
+		newSpecification := `spec:
+  containers:
+  - name: main
+    image: ` + image + `
+  endpoints:
+  - name: api
+    port: 8081
+    public: true
+`
+		err = client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithFromSpecification(
+			*sdk.NewServiceFromSpecificationRequest().WithSpecification(newSpecification),
+		))
+		require.NoError(t, err)
+
+		details, err = client.Services.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, details.Spec, "port: 8081")
+	})
+
+	t.Run("Alter - suspend and resume", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+		id := service.ID()
+
+		err := client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithSuspend(true))
+		require.NoError(t, err)
+
+		err = client.Services.Alter(ctx, sdk.NewAlterServiceRequest(id).WithResume(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+		id := service.ID()
+
+		err := client.Services.Drop(ctx, sdk.NewDropServiceRequest(id).WithForce(true))
+		require.NoError(t, err)
+
+		_, err = client.Services.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+
+		services, err := client.Services.Show(ctx, sdk.NewShowServiceRequest().
+			WithExcludeJobs(true).
+			WithIn(sdk.In{Schema: testClientHelper().Ids.SchemaId()}))
+		require.NoError(t, err)
+		assert.Contains(t, collections.Map(services, func(s sdk.Service) string { return s.Name }), service.Name)
+
+		services, err = client.Services.Show(ctx, sdk.NewShowServiceRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(service.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+		assert.Equal(t, service.ID(), services[0].ID())
+	})
+
+	t.Run("Show endpoints", func(t *testing.T) {
+		service, cleanup := testClientHelper().Service.Create(t, computePool.ID(), image)
+		t.Cleanup(cleanup)
+
+		endpoints, err := client.ServiceEndpoints.Show(ctx, sdk.NewShowServiceEndpointRequest(service.ID()))
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+		assert.Equal(t, "api", endpoints[0].Name)
+		require.NotNil(t, endpoints[0].Port)
+		assert.Equal(t, 8080, *endpoints[0].Port)
+		assert.True(t, endpoints[0].IsPublic)
+	})
+}
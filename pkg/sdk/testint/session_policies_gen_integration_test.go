@@ -147,6 +147,45 @@ func TestInt_SessionPolicies(t *testing.T) {
 		assert.Equal(t, "", alteredSessionPolicy.Comment)
 	})
 
+	t.Run("alter session_policy: set and unset all values", func(t *testing.T) {
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.SessionPolicies.Create(ctx, sdk.NewCreateSessionPolicyRequest(id).
+			WithAllowedSecondaryRoles(sdk.NewSessionPolicySecondaryRolesRequest().WithAll(sdk.Bool(true))))
+		require.NoError(t, err)
+		t.Cleanup(cleanupSessionPolicyProvider(id))
+
+		description, err := client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ALL"}, description.AllowedSecondaryRoles)
+
+		err = client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithSet(sdk.NewSessionPolicySetRequest().
+			WithSessionIdleTimeoutMins(sdk.Int(10)).
+			WithSessionUiIdleTimeoutMins(sdk.Int(20)).
+			WithAllowedSecondaryRoles(sdk.NewSessionPolicySecondaryRolesRequest().WithRoles([]sdk.AccountObjectIdentifier{role.ID()}))))
+		require.NoError(t, err)
+
+		description, err = client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 10, description.SessionIdleTimeoutMins)
+		assert.Equal(t, 20, description.SessionUIIdleTimeoutMins)
+		assert.Equal(t, []string{role.ID().Name()}, description.AllowedSecondaryRoles)
+
+		err = client.SessionPolicies.Alter(ctx, sdk.NewAlterSessionPolicyRequest(id).WithUnset(sdk.NewSessionPolicyUnsetRequest().
+			WithSessionIdleTimeoutMins(sdk.Bool(true)).
+			WithSessionUiIdleTimeoutMins(sdk.Bool(true)).
+			WithAllowedSecondaryRoles(sdk.Bool(true))))
+		require.NoError(t, err)
+
+		description, err = client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 240, description.SessionIdleTimeoutMins)
+		assert.Equal(t, 240, description.SessionUIIdleTimeoutMins)
+		assert.Equal(t, []string{"ALL"}, description.AllowedSecondaryRoles)
+	})
+
 	t.Run("set and unset tag", func(t *testing.T) {
 		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
 		t.Cleanup(tagCleanup)
@@ -228,6 +267,21 @@ func TestInt_SessionPolicies(t *testing.T) {
 		assert.Contains(t, returnedSessionPolicies, *sessionPolicy2)
 	})
 
+	t.Run("show session_policy: with like and in", func(t *testing.T) {
+		sessionPolicy1 := createSessionPolicy(t)
+		sessionPolicy2 := createSessionPolicy(t)
+
+		showRequest := sdk.NewShowSessionPolicyRequest().
+			WithLike(&sdk.Like{Pattern: sdk.String(sessionPolicy1.Name)}).
+			WithIn(&sdk.In{Schema: testClientHelper().Ids.SchemaId()})
+		returnedSessionPolicies, err := client.SessionPolicies.Show(ctx, showRequest)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, len(returnedSessionPolicies))
+		assert.Contains(t, returnedSessionPolicies, *sessionPolicy1)
+		assert.NotContains(t, returnedSessionPolicies, *sessionPolicy2)
+	})
+
 	t.Run("describe session_policy", func(t *testing.T) {
 		sessionPolicy := createSessionPolicy(t)
 
@@ -218,6 +218,31 @@ func TestInt_Streamlits(t *testing.T) {
 		assertStreamlit(t, id, comment, "")
 	})
 
+	t.Run("alter streamlit: set and unset title, comment and query warehouse", func(t *testing.T) {
+		stage, cleanupStage := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(cleanupStage)
+		e := createStreamlitHandle(t, stage, "manifest.yml")
+
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, e.Name)
+		warehouse := testWarehouse(t).ID()
+		comment := random.StringN(4)
+		title := random.StringN(4)
+		set := sdk.NewStreamlitSetRequest(nil, nil).WithWarehouse(&warehouse).WithComment(&comment).WithTitle(&title)
+		err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithSet(set))
+		require.NoError(t, err)
+
+		streamlit, err := client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, title, streamlit.Title)
+		require.Equal(t, comment, streamlit.Comment)
+		require.Equal(t, warehouse.Name(), streamlit.QueryWarehouse)
+
+		unset := sdk.NewStreamlitUnsetRequest().WithQueryWarehouse(sdk.Bool(true)).WithComment(sdk.Bool(true)).WithTitle(sdk.Bool(true))
+		err = client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithUnset(unset))
+		require.NoError(t, err)
+		assertStreamlit(t, id, "", "")
+	})
+
 	t.Run("alter function: rename", func(t *testing.T) {
 		stage, cleanupStage := testClientHelper().Stage.CreateStage(t)
 		t.Cleanup(cleanupStage)
@@ -289,6 +314,7 @@ func TestInt_Streamlits(t *testing.T) {
 		require.Equal(t, stage.ID().FullyQualifiedName(), sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(detail.RootLocation[1:]).FullyQualifiedName())
 		require.Empty(t, detail.Title)
 		require.Empty(t, detail.QueryWarehouse)
+		require.Empty(t, detail.ExternalAccessIntegrations)
 	})
 }
 
@@ -192,15 +192,46 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET PASSWORD", id.FullyQualifiedName())
 	})
 
-	t.Run("with unsetting a policy", func(t *testing.T) {
-		sessionPolicy := "SESSION_POLICY1"
+	t.Run("with setting a session policy", func(t *testing.T) {
+		sessionPolicy := randomSchemaObjectIdentifier()
 		opts := &AlterUserOptions{
 			name: id,
 			Set: &UserSet{
-				SessionPolicy: String(sessionPolicy),
+				SessionPolicy: &sessionPolicy,
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET SESSION POLICY = %s", id.FullyQualifiedName(), sessionPolicy)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET SESSION POLICY %s", id.FullyQualifiedName(), sessionPolicy.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting a session policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				SessionPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET SESSION POLICY", id.FullyQualifiedName())
+	})
+
+	t.Run("with setting an authentication policy", func(t *testing.T) {
+		authenticationPolicy := randomSchemaObjectIdentifier()
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				AuthenticationPolicy: &authenticationPolicy,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET AUTHENTICATION POLICY %s", id.FullyQualifiedName(), authenticationPolicy.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting an authentication policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				AuthenticationPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET AUTHENTICATION POLICY", id.FullyQualifiedName())
 	})
 
 	t.Run("with removing delegated authorization of role", func(t *testing.T) {
@@ -108,6 +108,30 @@ var viewUnsetColumnMaskingPolicy = g.NewQueryStruct("ViewUnsetColumnMaskingPolic
 	SQL("UNSET").
 	SQL("MASKING POLICY")
 
+var viewSetAggregationPolicy = g.NewQueryStruct("ViewSetAggregationPolicy").
+	SQL("SET").
+	Identifier("AggregationPolicy", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("AGGREGATION POLICY").Required()).
+	NamedListWithParens("ENTITY KEY", g.KindOfT[string](), nil).
+	OptionalSQL("FORCE").
+	WithValidation(g.ValidIdentifier, "AggregationPolicy")
+
+var viewSetColumnProjectionPolicy = g.NewQueryStruct("ViewSetColumnProjectionPolicy").
+	// In the docs there is a MODIFY alternative, but for simplicity only one is supported here.
+	SQL("ALTER").
+	SQL("COLUMN").
+	Text("Name", g.KeywordOptions().Required()).
+	SQL("SET").
+	Identifier("ProjectionPolicy", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PROJECTION POLICY").Required()).
+	OptionalSQL("FORCE")
+
+var viewUnsetColumnProjectionPolicy = g.NewQueryStruct("ViewUnsetColumnProjectionPolicy").
+	// In the docs there is a MODIFY alternative, but for simplicity only one is supported here.
+	SQL("ALTER").
+	SQL("COLUMN").
+	Text("Name", g.KeywordOptions().Required()).
+	SQL("UNSET").
+	SQL("PROJECTION POLICY")
+
 var viewSetColumnTags = g.NewQueryStruct("ViewSetColumnTags").
 	// In the docs there is a MODIFY alternative, but for simplicity only one is supported here.
 	SQL("ALTER").
@@ -172,12 +196,16 @@ var ViewsDef = g.NewInterface(
 			OptionalQueryStructField("DropRowAccessPolicy", viewDropRowAccessPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("DropAndAddRowAccessPolicy", viewDropAndAddRowAccessPolicy, g.ListOptions().NoParentheses()).
 			OptionalSQL("DROP ALL ROW ACCESS POLICIES").
+			OptionalQueryStructField("SetAggregationPolicy", viewSetAggregationPolicy, g.KeywordOptions()).
+			OptionalSQL("UNSET AGGREGATION POLICY").
 			OptionalQueryStructField("SetMaskingPolicyOnColumn", viewSetColumnMaskingPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetMaskingPolicyOnColumn", viewUnsetColumnMaskingPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("SetProjectionPolicyOnColumn", viewSetColumnProjectionPolicy, g.KeywordOptions()).
+			OptionalQueryStructField("UnsetProjectionPolicyOnColumn", viewUnsetColumnProjectionPolicy, g.KeywordOptions()).
 			OptionalQueryStructField("SetTagsOnColumn", viewSetColumnTags, g.KeywordOptions()).
 			OptionalQueryStructField("UnsetTagsOnColumn", viewUnsetColumnTags, g.KeywordOptions()).
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"),
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"),
 	).
 	DropOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/drop-view",
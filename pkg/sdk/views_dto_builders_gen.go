@@ -185,6 +185,16 @@ func (s *AlterViewRequest) WithDropAllRowAccessPolicies(DropAllRowAccessPolicies
 	return s
 }
 
+func (s *AlterViewRequest) WithSetAggregationPolicy(SetAggregationPolicy *ViewSetAggregationPolicyRequest) *AlterViewRequest {
+	s.SetAggregationPolicy = SetAggregationPolicy
+	return s
+}
+
+func (s *AlterViewRequest) WithUnsetAggregationPolicy(UnsetAggregationPolicy *bool) *AlterViewRequest {
+	s.UnsetAggregationPolicy = UnsetAggregationPolicy
+	return s
+}
+
 func (s *AlterViewRequest) WithSetMaskingPolicyOnColumn(SetMaskingPolicyOnColumn *ViewSetColumnMaskingPolicyRequest) *AlterViewRequest {
 	s.SetMaskingPolicyOnColumn = SetMaskingPolicyOnColumn
 	return s
@@ -195,6 +205,16 @@ func (s *AlterViewRequest) WithUnsetMaskingPolicyOnColumn(UnsetMaskingPolicyOnCo
 	return s
 }
 
+func (s *AlterViewRequest) WithSetProjectionPolicyOnColumn(SetProjectionPolicyOnColumn *ViewSetColumnProjectionPolicyRequest) *AlterViewRequest {
+	s.SetProjectionPolicyOnColumn = SetProjectionPolicyOnColumn
+	return s
+}
+
+func (s *AlterViewRequest) WithUnsetProjectionPolicyOnColumn(UnsetProjectionPolicyOnColumn *ViewUnsetColumnProjectionPolicyRequest) *AlterViewRequest {
+	s.UnsetProjectionPolicyOnColumn = UnsetProjectionPolicyOnColumn
+	return s
+}
+
 func (s *AlterViewRequest) WithSetTagsOnColumn(SetTagsOnColumn *ViewSetColumnTagsRequest) *AlterViewRequest {
 	s.SetTagsOnColumn = SetTagsOnColumn
 	return s
@@ -233,6 +253,24 @@ func NewViewDropAndAddRowAccessPolicyRequest(
 	return &s
 }
 
+func NewViewSetAggregationPolicyRequest(
+	AggregationPolicy SchemaObjectIdentifier,
+) *ViewSetAggregationPolicyRequest {
+	s := ViewSetAggregationPolicyRequest{}
+	s.AggregationPolicy = AggregationPolicy
+	return &s
+}
+
+func (s *ViewSetAggregationPolicyRequest) WithEntityKey(EntityKey []string) *ViewSetAggregationPolicyRequest {
+	s.EntityKey = EntityKey
+	return s
+}
+
+func (s *ViewSetAggregationPolicyRequest) WithForce(Force *bool) *ViewSetAggregationPolicyRequest {
+	s.Force = Force
+	return s
+}
+
 func NewViewSetColumnMaskingPolicyRequest(
 	Name string,
 	MaskingPolicy SchemaObjectIdentifier,
@@ -261,6 +299,29 @@ func NewViewUnsetColumnMaskingPolicyRequest(
 	return &s
 }
 
+func NewViewSetColumnProjectionPolicyRequest(
+	Name string,
+	ProjectionPolicy SchemaObjectIdentifier,
+) *ViewSetColumnProjectionPolicyRequest {
+	s := ViewSetColumnProjectionPolicyRequest{}
+	s.Name = Name
+	s.ProjectionPolicy = ProjectionPolicy
+	return &s
+}
+
+func (s *ViewSetColumnProjectionPolicyRequest) WithForce(Force *bool) *ViewSetColumnProjectionPolicyRequest {
+	s.Force = Force
+	return s
+}
+
+func NewViewUnsetColumnProjectionPolicyRequest(
+	Name string,
+) *ViewUnsetColumnProjectionPolicyRequest {
+	s := ViewUnsetColumnProjectionPolicyRequest{}
+	s.Name = Name
+	return &s
+}
+
 func NewViewSetColumnTagsRequest(
 	Name string,
 	SetTags []TagAssociation,
@@ -48,24 +48,28 @@ type ViewRowAccessPolicyRequest struct {
 }
 
 type AlterViewRequest struct {
-	IfExists                   *bool
-	name                       SchemaObjectIdentifier // required
-	RenameTo                   *SchemaObjectIdentifier
-	SetComment                 *string
-	UnsetComment               *bool
-	SetSecure                  *bool
-	SetChangeTracking          *bool
-	UnsetSecure                *bool
-	SetTags                    []TagAssociation
-	UnsetTags                  []ObjectIdentifier
-	AddRowAccessPolicy         *ViewAddRowAccessPolicyRequest
-	DropRowAccessPolicy        *ViewDropRowAccessPolicyRequest
-	DropAndAddRowAccessPolicy  *ViewDropAndAddRowAccessPolicyRequest
-	DropAllRowAccessPolicies   *bool
-	SetMaskingPolicyOnColumn   *ViewSetColumnMaskingPolicyRequest
-	UnsetMaskingPolicyOnColumn *ViewUnsetColumnMaskingPolicyRequest
-	SetTagsOnColumn            *ViewSetColumnTagsRequest
-	UnsetTagsOnColumn          *ViewUnsetColumnTagsRequest
+	IfExists                      *bool
+	name                          SchemaObjectIdentifier // required
+	RenameTo                      *SchemaObjectIdentifier
+	SetComment                    *string
+	UnsetComment                  *bool
+	SetSecure                     *bool
+	SetChangeTracking             *bool
+	UnsetSecure                   *bool
+	SetTags                       []TagAssociation
+	UnsetTags                     []ObjectIdentifier
+	AddRowAccessPolicy            *ViewAddRowAccessPolicyRequest
+	DropRowAccessPolicy           *ViewDropRowAccessPolicyRequest
+	DropAndAddRowAccessPolicy     *ViewDropAndAddRowAccessPolicyRequest
+	DropAllRowAccessPolicies      *bool
+	SetAggregationPolicy          *ViewSetAggregationPolicyRequest
+	UnsetAggregationPolicy        *bool
+	SetMaskingPolicyOnColumn      *ViewSetColumnMaskingPolicyRequest
+	UnsetMaskingPolicyOnColumn    *ViewUnsetColumnMaskingPolicyRequest
+	SetProjectionPolicyOnColumn   *ViewSetColumnProjectionPolicyRequest
+	UnsetProjectionPolicyOnColumn *ViewUnsetColumnProjectionPolicyRequest
+	SetTagsOnColumn               *ViewSetColumnTagsRequest
+	UnsetTagsOnColumn             *ViewUnsetColumnTagsRequest
 }
 
 type ViewAddRowAccessPolicyRequest struct {
@@ -82,6 +86,12 @@ type ViewDropAndAddRowAccessPolicyRequest struct {
 	Add  ViewAddRowAccessPolicyRequest  // required
 }
 
+type ViewSetAggregationPolicyRequest struct {
+	AggregationPolicy SchemaObjectIdentifier // required
+	EntityKey         []string
+	Force             *bool
+}
+
 type ViewSetColumnMaskingPolicyRequest struct {
 	Name          string                 // required
 	MaskingPolicy SchemaObjectIdentifier // required
@@ -93,6 +103,16 @@ type ViewUnsetColumnMaskingPolicyRequest struct {
 	Name string // required
 }
 
+type ViewSetColumnProjectionPolicyRequest struct {
+	Name             string                 // required
+	ProjectionPolicy SchemaObjectIdentifier // required
+	Force            *bool
+}
+
+type ViewUnsetColumnProjectionPolicyRequest struct {
+	Name string // required
+}
+
 type ViewSetColumnTagsRequest struct {
 	Name    string           // required
 	SetTags []TagAssociation // required
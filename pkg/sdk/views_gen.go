@@ -54,26 +54,30 @@ type ViewRowAccessPolicy struct {
 
 // AlterViewOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-view.
 type AlterViewOptions struct {
-	alter                      bool                           `ddl:"static" sql:"ALTER"`
-	view                       bool                           `ddl:"static" sql:"VIEW"`
-	IfExists                   *bool                          `ddl:"keyword" sql:"IF EXISTS"`
-	name                       SchemaObjectIdentifier         `ddl:"identifier"`
-	RenameTo                   *SchemaObjectIdentifier        `ddl:"identifier" sql:"RENAME TO"`
-	SetComment                 *string                        `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
-	UnsetComment               *bool                          `ddl:"keyword" sql:"UNSET COMMENT"`
-	SetSecure                  *bool                          `ddl:"keyword" sql:"SET SECURE"`
-	SetChangeTracking          *bool                          `ddl:"parameter" sql:"SET CHANGE_TRACKING"`
-	UnsetSecure                *bool                          `ddl:"keyword" sql:"UNSET SECURE"`
-	SetTags                    []TagAssociation               `ddl:"keyword" sql:"SET TAG"`
-	UnsetTags                  []ObjectIdentifier             `ddl:"keyword" sql:"UNSET TAG"`
-	AddRowAccessPolicy         *ViewAddRowAccessPolicy        `ddl:"keyword"`
-	DropRowAccessPolicy        *ViewDropRowAccessPolicy       `ddl:"keyword"`
-	DropAndAddRowAccessPolicy  *ViewDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
-	DropAllRowAccessPolicies   *bool                          `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
-	SetMaskingPolicyOnColumn   *ViewSetColumnMaskingPolicy    `ddl:"keyword"`
-	UnsetMaskingPolicyOnColumn *ViewUnsetColumnMaskingPolicy  `ddl:"keyword"`
-	SetTagsOnColumn            *ViewSetColumnTags             `ddl:"keyword"`
-	UnsetTagsOnColumn          *ViewUnsetColumnTags           `ddl:"keyword"`
+	alter                         bool                             `ddl:"static" sql:"ALTER"`
+	view                          bool                             `ddl:"static" sql:"VIEW"`
+	IfExists                      *bool                            `ddl:"keyword" sql:"IF EXISTS"`
+	name                          SchemaObjectIdentifier           `ddl:"identifier"`
+	RenameTo                      *SchemaObjectIdentifier          `ddl:"identifier" sql:"RENAME TO"`
+	SetComment                    *string                          `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment                  *bool                            `ddl:"keyword" sql:"UNSET COMMENT"`
+	SetSecure                     *bool                            `ddl:"keyword" sql:"SET SECURE"`
+	SetChangeTracking             *bool                            `ddl:"parameter" sql:"SET CHANGE_TRACKING"`
+	UnsetSecure                   *bool                            `ddl:"keyword" sql:"UNSET SECURE"`
+	SetTags                       []TagAssociation                 `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags                     []ObjectIdentifier               `ddl:"keyword" sql:"UNSET TAG"`
+	AddRowAccessPolicy            *ViewAddRowAccessPolicy          `ddl:"keyword"`
+	DropRowAccessPolicy           *ViewDropRowAccessPolicy         `ddl:"keyword"`
+	DropAndAddRowAccessPolicy     *ViewDropAndAddRowAccessPolicy   `ddl:"list,no_parentheses"`
+	DropAllRowAccessPolicies      *bool                            `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	SetAggregationPolicy          *ViewSetAggregationPolicy        `ddl:"keyword"`
+	UnsetAggregationPolicy        *bool                            `ddl:"keyword" sql:"UNSET AGGREGATION POLICY"`
+	SetMaskingPolicyOnColumn      *ViewSetColumnMaskingPolicy      `ddl:"keyword"`
+	UnsetMaskingPolicyOnColumn    *ViewUnsetColumnMaskingPolicy    `ddl:"keyword"`
+	SetProjectionPolicyOnColumn   *ViewSetColumnProjectionPolicy   `ddl:"keyword"`
+	UnsetProjectionPolicyOnColumn *ViewUnsetColumnProjectionPolicy `ddl:"keyword"`
+	SetTagsOnColumn               *ViewSetColumnTags               `ddl:"keyword"`
+	UnsetTagsOnColumn             *ViewUnsetColumnTags             `ddl:"keyword"`
 }
 
 type ViewAddRowAccessPolicy struct {
@@ -92,6 +96,13 @@ type ViewDropAndAddRowAccessPolicy struct {
 	Add  ViewAddRowAccessPolicy  `ddl:"keyword"`
 }
 
+type ViewSetAggregationPolicy struct {
+	set               bool                   `ddl:"static" sql:"SET"`
+	AggregationPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"AGGREGATION POLICY"`
+	EntityKey         []string               `ddl:"keyword,parentheses" sql:"ENTITY KEY"`
+	Force             *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
 type ViewSetColumnMaskingPolicy struct {
 	alter         bool                   `ddl:"static" sql:"ALTER"`
 	column        bool                   `ddl:"static" sql:"COLUMN"`
@@ -110,6 +121,23 @@ type ViewUnsetColumnMaskingPolicy struct {
 	maskingPolicy bool   `ddl:"static" sql:"MASKING POLICY"`
 }
 
+type ViewSetColumnProjectionPolicy struct {
+	alter            bool                   `ddl:"static" sql:"ALTER"`
+	column           bool                   `ddl:"static" sql:"COLUMN"`
+	Name             string                 `ddl:"keyword"`
+	set              bool                   `ddl:"static" sql:"SET"`
+	ProjectionPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"PROJECTION POLICY"`
+	Force            *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type ViewUnsetColumnProjectionPolicy struct {
+	alter            bool   `ddl:"static" sql:"ALTER"`
+	column           bool   `ddl:"static" sql:"COLUMN"`
+	Name             string `ddl:"keyword"`
+	unset            bool   `ddl:"static" sql:"UNSET"`
+	projectionPolicy bool   `ddl:"static" sql:"PROJECTION POLICY"`
+}
+
 type ViewSetColumnTags struct {
 	alter   bool             `ddl:"static" sql:"ALTER"`
 	column  bool             `ddl:"static" sql:"COLUMN"`
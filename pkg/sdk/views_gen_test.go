@@ -114,16 +114,16 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
 	})
 
-	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present - more present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment opts.SetSecure opts.SetChangeTracking opts.UnsetSecure opts.SetTags opts.UnsetTags opts.AddRowAccessPolicy opts.DropRowAccessPolicy opts.DropAndAddRowAccessPolicy opts.DropAllRowAccessPolicies opts.SetAggregationPolicy opts.UnsetAggregationPolicy opts.SetMaskingPolicyOnColumn opts.UnsetMaskingPolicyOnColumn opts.SetProjectionPolicyOnColumn opts.UnsetProjectionPolicyOnColumn opts.SetTagsOnColumn opts.UnsetTagsOnColumn] should be present - more present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetChangeTracking = Bool(true)
 		opts.DropAllRowAccessPolicies = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterViewOptions", "RenameTo", "SetComment", "UnsetComment", "SetSecure", "SetChangeTracking", "UnsetSecure", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetMaskingPolicyOnColumn", "UnsetMaskingPolicyOnColumn", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn", "SetTagsOnColumn", "UnsetTagsOnColumn"))
 	})
 
 	t.Run("validation: valid identifier for [opts.DropRowAccessPolicy.RowAccessPolicy]", func(t *testing.T) {
@@ -142,6 +142,14 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
+	t.Run("validation: valid identifier for [opts.SetAggregationPolicy.AggregationPolicy]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetAggregationPolicy = &ViewSetAggregationPolicy{
+			AggregationPolicy: NewSchemaObjectIdentifier("", "", ""),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
 	t.Run("validation: empty columns for row access policy (add)", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.AddRowAccessPolicy = &ViewAddRowAccessPolicy{
@@ -303,6 +311,24 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s DROP ALL ROW ACCESS POLICIES", id.FullyQualifiedName())
 	})
 
+	t.Run("set aggregation policy", func(t *testing.T) {
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.SetAggregationPolicy = &ViewSetAggregationPolicy{
+			AggregationPolicy: aggregationPolicyId,
+			EntityKey:         []string{"a", "b"},
+			Force:             Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s SET AGGREGATION POLICY %s ENTITY KEY (a, b) FORCE", id.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset aggregation policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetAggregationPolicy = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s UNSET AGGREGATION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("set masking policy on column", func(t *testing.T) {
 		maskingPolicyId := randomSchemaObjectIdentifier()
 
@@ -324,6 +350,26 @@ func TestViews_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s ALTER COLUMN column UNSET MASKING POLICY", id.FullyQualifiedName())
 	})
 
+	t.Run("set projection policy on column", func(t *testing.T) {
+		projectionPolicyId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.SetProjectionPolicyOnColumn = &ViewSetColumnProjectionPolicy{
+			Name:             "column",
+			ProjectionPolicy: projectionPolicyId,
+			Force:            Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s ALTER COLUMN column SET PROJECTION POLICY %s FORCE", id.FullyQualifiedName(), projectionPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset projection policy on column", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetProjectionPolicyOnColumn = &ViewUnsetColumnProjectionPolicy{
+			Name: "column",
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s ALTER COLUMN column UNSET PROJECTION POLICY", id.FullyQualifiedName())
+	})
+
 	t.Run("set tags on column", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.SetTagsOnColumn = &ViewSetColumnTags{
@@ -108,6 +108,7 @@ func (r *AlterViewRequest) toOpts() *AlterViewOptions {
 		SetTags:                  r.SetTags,
 		UnsetTags:                r.UnsetTags,
 		DropAllRowAccessPolicies: r.DropAllRowAccessPolicies,
+		UnsetAggregationPolicy:   r.UnsetAggregationPolicy,
 	}
 	if r.AddRowAccessPolicy != nil {
 		opts.AddRowAccessPolicy = &ViewAddRowAccessPolicy{
@@ -130,6 +131,13 @@ func (r *AlterViewRequest) toOpts() *AlterViewOptions {
 			On:              r.DropAndAddRowAccessPolicy.Add.On,
 		}
 	}
+	if r.SetAggregationPolicy != nil {
+		opts.SetAggregationPolicy = &ViewSetAggregationPolicy{
+			AggregationPolicy: r.SetAggregationPolicy.AggregationPolicy,
+			EntityKey:         r.SetAggregationPolicy.EntityKey,
+			Force:             r.SetAggregationPolicy.Force,
+		}
+	}
 	if r.SetMaskingPolicyOnColumn != nil {
 		opts.SetMaskingPolicyOnColumn = &ViewSetColumnMaskingPolicy{
 			Name:          r.SetMaskingPolicyOnColumn.Name,
@@ -143,6 +151,18 @@ func (r *AlterViewRequest) toOpts() *AlterViewOptions {
 			Name: r.UnsetMaskingPolicyOnColumn.Name,
 		}
 	}
+	if r.SetProjectionPolicyOnColumn != nil {
+		opts.SetProjectionPolicyOnColumn = &ViewSetColumnProjectionPolicy{
+			Name:             r.SetProjectionPolicyOnColumn.Name,
+			ProjectionPolicy: r.SetProjectionPolicyOnColumn.ProjectionPolicy,
+			Force:            r.SetProjectionPolicyOnColumn.Force,
+		}
+	}
+	if r.UnsetProjectionPolicyOnColumn != nil {
+		opts.UnsetProjectionPolicyOnColumn = &ViewUnsetColumnProjectionPolicy{
+			Name: r.UnsetProjectionPolicyOnColumn.Name,
+		}
+	}
 	if r.SetTagsOnColumn != nil {
 		opts.SetTagsOnColumn = &ViewSetColumnTags{
 			Name:    r.SetTagsOnColumn.Name,